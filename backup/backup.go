@@ -0,0 +1,385 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package backup exports the data of a cluster, or of a key span or SQL
+// database within it, into portable files in a directory, and restores
+// such a backup into a cluster.
+//
+// A backup reads every range at a single MVCC timestamp, so it is a
+// consistent snapshot of the data. Each range is exported by its leader
+// replica using an Export command, which iterates the range's data as of
+// the backup timestamp. The exported key/value pairs of each range are
+// written to a separate data file; a manifest describing the backup is
+// written last, so an incomplete backup has no manifest.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+const (
+	// ManifestName is the name of the file describing a backup.
+	ManifestName = "BACKUP"
+	// rangeLookupBatchSize is the number of range descriptors read from
+	// the meta2 records at a time.
+	rangeLookupBatchSize = 1000
+	// exportBatchSize is the number of key/value pairs exported by a single
+	// Export request.
+	exportBatchSize = 10000
+)
+
+// A Manifest describes the contents of a backup directory.
+type Manifest struct {
+	// Timestamp is the MVCC timestamp as of which the data was exported.
+	Timestamp proto.Timestamp `json:"timestamp"`
+	// StartKey and EndKey bound the backed up key span. They are unset for
+	// a backup of a SQL database.
+	StartKey proto.Key `json:"start_key,omitempty"`
+	EndKey   proto.Key `json:"end_key,omitempty"`
+	// Database is the name of the backed up SQL database, if any.
+	Database string `json:"database,omitempty"`
+	// Files are the data files of the backup, in key order.
+	Files []File `json:"files"`
+}
+
+// A File describes a data file of a backup. A data file holds the
+// key/value pairs of the span [StartKey, EndKey), each encoded as a
+// uvarint length followed by a marshaled proto.KeyValue.
+type File struct {
+	Name     string    `json:"name"`
+	StartKey proto.Key `json:"start_key"`
+	EndKey   proto.Key `json:"end_key"`
+	NumKeys  int       `json:"num_keys"`
+}
+
+// Backup exports all the data in the cluster outside of the range
+// addressing records as of timestamp into dir.
+func Backup(db *client.DB, dir string, timestamp proto.Timestamp) (*Manifest, error) {
+	return BackupSpan(db, dir, keys.MetaMax, proto.KeyMax, timestamp)
+}
+
+// BackupSpan exports the data in [start, end) as of timestamp into dir.
+// The span is exported one range at a time.
+func BackupSpan(db *client.DB, dir string, start, end proto.Key, timestamp proto.Timestamp) (*Manifest, error) {
+	if !start.Less(end) {
+		return nil, util.Errorf("invalid backup span [%s, %s)", start, end)
+	}
+	w, err := newBackupWriter(db, dir, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	w.manifest.StartKey = start
+	w.manifest.EndKey = end
+	if err := w.exportSpan(start, end); err != nil {
+		return nil, err
+	}
+	return w.finish()
+}
+
+// BackupDatabase exports the SQL database with the given name as of
+// timestamp into dir. This includes the descriptors of the database and
+// of its tables, and the data of its tables.
+func BackupDatabase(db *client.DB, dir, name string, timestamp proto.Timestamp) (*Manifest, error) {
+	w, err := newBackupWriter(db, dir, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	w.manifest.Database = name
+
+	// Read the descriptors of the database and its tables as of the backup
+	// timestamp; they are written to the first data file.
+	nameKey := structured.MakeNameMetadataKey(structured.RootNamespaceID, name)
+	metadata, err := w.exportAll(nameKey, nameKey.Next())
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, util.Errorf("database %q does not exist", name)
+	}
+	dbDescKey := proto.Key(metadata[0].Value.Bytes)
+	_, dbID, err := decodeDescMetadataKey(dbDescKey)
+	if err != nil {
+		return nil, err
+	}
+	nsPrefix := structured.MakeNameMetadataKey(dbID, "")
+	tableNames, err := w.exportAll(nsPrefix, nsPrefix.PrefixEnd())
+	if err != nil {
+		return nil, err
+	}
+	metadata = append(metadata, tableNames...)
+	descKeys := []proto.Key{dbDescKey}
+	var tableIDs []structured.ID
+	for _, kv := range tableNames {
+		descKey := proto.Key(kv.Value.Bytes)
+		_, tableID, err := decodeDescMetadataKey(descKey)
+		if err != nil {
+			return nil, err
+		}
+		descKeys = append(descKeys, descKey)
+		tableIDs = append(tableIDs, tableID)
+	}
+	for _, descKey := range descKeys {
+		desc, err := w.exportAll(descKey, descKey.Next())
+		if err != nil {
+			return nil, err
+		}
+		if len(desc) == 0 {
+			return nil, util.Errorf("descriptor %s not found", descKey)
+		}
+		metadata = append(metadata, desc...)
+	}
+	if err := w.writeFile(nameKey, nameKey.Next(), metadata); err != nil {
+		return nil, err
+	}
+
+	// Export the data of each table.
+	for _, tableID := range tableIDs {
+		prefix := proto.Key(structured.MakeTablePrefix(tableID))
+		if err := w.exportSpan(prefix, prefix.PrefixEnd()); err != nil {
+			return nil, err
+		}
+	}
+	return w.finish()
+}
+
+// A backupWriter writes the data files and the manifest of a backup.
+type backupWriter struct {
+	db       *client.DB
+	dir      string
+	manifest Manifest
+}
+
+func newBackupWriter(db *client.DB, dir string, timestamp proto.Timestamp) (*backupWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, ManifestName)); err == nil {
+		return nil, util.Errorf("%s already contains a backup", dir)
+	}
+	return &backupWriter{
+		db:       db,
+		dir:      dir,
+		manifest: Manifest{Timestamp: timestamp},
+	}, nil
+}
+
+// exportSpan exports the data in [start, end) into one data file per
+// range overlapping the span.
+func (w *backupWriter) exportSpan(start, end proto.Key) error {
+	descs, err := lookupRanges(w.db, start, end)
+	if err != nil {
+		return err
+	}
+	for _, desc := range descs {
+		rangeStart, rangeEnd := proto.Key(desc.StartKey), proto.Key(desc.EndKey)
+		if rangeStart.Less(start) {
+			rangeStart = start
+		}
+		if end.Less(rangeEnd) {
+			rangeEnd = end
+		}
+		if err := w.exportFile(rangeStart, rangeEnd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportFile exports the data in [start, end) into a new data file, which
+// is added to the manifest. The data is written as it is exported, one
+// batch at a time.
+func (w *backupWriter) exportFile(start, end proto.Key) error {
+	df, err := w.createFile(start, end)
+	if err != nil {
+		return err
+	}
+	defer df.f.Close()
+	if err := w.export(start, end, df.write); err != nil {
+		return err
+	}
+	return w.finishFile(df)
+}
+
+// export invokes fn on the key/value pairs in [start, end) as of the
+// backup timestamp, in key order, exportBatchSize pairs at a time.
+func (w *backupWriter) export(start, end proto.Key, fn func([]proto.KeyValue) error) error {
+	for start != nil {
+		args := &proto.ExportRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       start,
+				EndKey:    end,
+				Timestamp: w.manifest.Timestamp,
+			},
+			MaxResults: exportBatchSize,
+		}
+		reply := &proto.ExportResponse{}
+		b := &client.Batch{}
+		b.InternalAddCall(proto.Call{Args: args, Reply: reply})
+		if err := w.db.Run(b); err != nil {
+			return util.Errorf("failed to export [%s, %s): %s", start, end, err)
+		}
+		if err := fn(reply.Rows); err != nil {
+			return err
+		}
+		start = reply.ResumeKey
+	}
+	return nil
+}
+
+// exportAll returns the key/value pairs in [start, end) as of the backup
+// timestamp.
+func (w *backupWriter) exportAll(start, end proto.Key) ([]proto.KeyValue, error) {
+	var kvs []proto.KeyValue
+	err := w.export(start, end, func(batch []proto.KeyValue) error {
+		kvs = append(kvs, batch...)
+		return nil
+	})
+	return kvs, err
+}
+
+// A dataFile is a data file of a backup which is being written.
+type dataFile struct {
+	File
+	f  *os.File
+	bw *bufio.Writer
+}
+
+// createFile creates a new data file for the key/value pairs of
+// [start, end).
+func (w *backupWriter) createFile(start, end proto.Key) (*dataFile, error) {
+	name := fmt.Sprintf("data-%06d", len(w.manifest.Files)+1)
+	f, err := os.Create(filepath.Join(w.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	return &dataFile{
+		File: File{Name: name, StartKey: start, EndKey: end},
+		f:    f,
+		bw:   bufio.NewWriter(f),
+	}, nil
+}
+
+// write appends the key/value pairs to the data file.
+func (df *dataFile) write(kvs []proto.KeyValue) error {
+	var lenBuf [binary.MaxVarintLen64]byte
+	for i := range kvs {
+		data, err := gogoproto.Marshal(&kvs[i])
+		if err != nil {
+			return err
+		}
+		n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+		if _, err := df.bw.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := df.bw.Write(data); err != nil {
+			return err
+		}
+	}
+	df.NumKeys += len(kvs)
+	return nil
+}
+
+// finishFile flushes the data file to disk and adds it to the manifest. The
+// caller remains responsible for closing the underlying file.
+func (w *backupWriter) finishFile(df *dataFile) error {
+	if err := df.bw.Flush(); err != nil {
+		return err
+	}
+	if err := df.f.Sync(); err != nil {
+		return err
+	}
+	w.manifest.Files = append(w.manifest.Files, df.File)
+	return nil
+}
+
+// writeFile writes the key/value pairs of [start, end) to a new data file
+// and adds it to the manifest.
+func (w *backupWriter) writeFile(start, end proto.Key, kvs []proto.KeyValue) error {
+	df, err := w.createFile(start, end)
+	if err != nil {
+		return err
+	}
+	defer df.f.Close()
+	if err := df.write(kvs); err != nil {
+		return err
+	}
+	return w.finishFile(df)
+}
+
+// finish writes the manifest, completing the backup.
+func (w *backupWriter) finish() (*Manifest, error) {
+	data, err := json.MarshalIndent(&w.manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(w.dir, ManifestName), data, 0644); err != nil {
+		return nil, err
+	}
+	return &w.manifest, nil
+}
+
+// lookupRanges returns the descriptors of the ranges overlapping
+// [start, end), in key order, as recorded by the meta2 records.
+func lookupRanges(db *client.DB, start, end proto.Key) ([]proto.RangeDescriptor, error) {
+	var descs []proto.RangeDescriptor
+	// The meta2 record of a range is keyed by its end key, so the first
+	// range overlapping the span is the first record following start.
+	metaKey := keys.RangeMetaKey(start).Next()
+	for {
+		rows, err := db.Scan(metaKey, keys.Meta2Prefix.PrefixEnd(), rangeLookupBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			var desc proto.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return nil, err
+			}
+			if !proto.Key(desc.StartKey).Less(end) {
+				return descs, nil
+			}
+			descs = append(descs, desc)
+		}
+		if len(rows) < rangeLookupBatchSize {
+			return descs, nil
+		}
+		metaKey = proto.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// decodeDescMetadataKey returns the descriptor ID encoded in a descriptor
+// metadata key, along with the remainder of the key.
+func decodeDescMetadataKey(key proto.Key) ([]byte, structured.ID, error) {
+	if !bytes.HasPrefix(key, keys.DescMetadataPrefix) {
+		return nil, 0, util.Errorf("%s is not a descriptor metadata key", key)
+	}
+	rest, id := encoding.DecodeUvarint(key[len(keys.DescMetadataPrefix):])
+	return rest, structured.ID(id), nil
+}
@@ -0,0 +1,291 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package backup
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func setup(t *testing.T) (*server.TestServer, *sql.DB, *client.DB) {
+	s := server.StartTestServer(nil)
+	sqlDB, err := sql.Open("cockroach", "https://root@"+s.ServingAddr()+"?certs=test_certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kvDB, err := client.Open("https://root@" + s.ServingAddr() + "?certs=test_certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, sqlDB, kvDB
+}
+
+func cleanup(s *server.TestServer, db *sql.DB) {
+	_ = db.Close()
+	s.Stop()
+}
+
+// lookupID returns the descriptor ID of the given name in the parent
+// namespace.
+func lookupID(t *testing.T, db *client.DB, parentID structured.ID, name string) structured.ID {
+	gr, err := db.Get(structured.MakeNameMetadataKey(parentID, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("%q does not exist", name)
+	}
+	_, id, err := decodeDescMetadataKey(gr.ValueBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// TestBackupRestoreDatabase verifies that a SQL database is backed up as
+// of the backup timestamp and restored under a new table ID.
+func TestBackupRestoreDatabase(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+	dir := util.CreateTempDir(t, "backup")
+	defer util.CleanupDir(dir)
+
+	for _, stmt := range []string{
+		`CREATE DATABASE bank`,
+		`CREATE TABLE bank.accounts (id INT PRIMARY KEY, balance INT)`,
+		`INSERT INTO bank.accounts VALUES (1, 100), (2, 200)`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	timestamp := s.Clock().Now()
+	if _, err := sqlDB.Exec(`INSERT INTO bank.accounts VALUES (3, 300)`); err != nil {
+		t.Fatal(err)
+	}
+	tableID := lookupID(t, kvDB, lookupID(t, kvDB, structured.RootNamespaceID, "bank"), "accounts")
+
+	if _, err := BackupDatabase(kvDB, dir, "bank", timestamp); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BackupDatabase(kvDB, dir, "bank", timestamp); err == nil {
+		t.Error("expected a second backup to the same directory to fail")
+	}
+	if _, err := Restore(kvDB, dir); !testutils.IsError(err, "already exists") {
+		t.Errorf("expected restore of an existing database to fail; got %v", err)
+	}
+
+	if _, err := sqlDB.Exec(`DROP DATABASE bank`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(kvDB, dir); err != nil {
+		t.Fatal(err)
+	}
+	if newID := lookupID(t, kvDB, lookupID(t, kvDB, structured.RootNamespaceID, "bank"), "accounts"); newID == tableID {
+		t.Errorf("expected the restored table to have a new ID; got %d", newID)
+	}
+
+	rows, err := sqlDB.Query(`SELECT id, balance FROM bank.accounts`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var results [][2]int
+	for rows.Next() {
+		var id, balance int
+		if err := rows.Scan(&id, &balance); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, [2]int{id, balance})
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][2]int{{1, 100}, {2, 200}}; !reflect.DeepEqual(expected, results) {
+		t.Errorf("expected %v; got %v", expected, results)
+	}
+}
+
+// TestBackupRestoreSpan verifies that a key span spanning several ranges
+// is backed up as of the backup timestamp and restored into another
+// cluster.
+func TestBackupRestoreSpan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+	dir := util.CreateTempDir(t, "backup")
+	defer util.CleanupDir(dir)
+
+	if err := kvDB.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvDB.Inc("b", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.AdminSplit("b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.Put("c", "3"); err != nil {
+		t.Fatal(err)
+	}
+	timestamp := s.Clock().Now()
+	if err := kvDB.Put("a", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.Put("b2", "4"); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := BackupSpan(kvDB, dir, proto.Key("a"), proto.Key("d"), timestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Files) != 2 {
+		t.Errorf("expected one data file per range; got %d", len(manifest.Files))
+	}
+
+	s2, sqlDB2, kvDB2 := setup(t)
+	defer cleanup(s2, sqlDB2)
+	if _, err := Restore(kvDB2, dir); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := kvDB2.Scan("a", "d", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows; got %d", len(rows))
+	}
+	if v := string(rows[0].ValueBytes()); v != "1" {
+		t.Errorf("expected a=1; got %q", v)
+	}
+	if v := rows[1].ValueInt(); v != 5 {
+		t.Errorf("expected b=5; got %d", v)
+	}
+	if v := string(rows[2].ValueBytes()); v != "3" {
+		t.Errorf("expected c=3; got %q", v)
+	}
+	// The restored counter remains usable as one.
+	if kv, err := kvDB2.Inc("b", 1); err != nil || kv.ValueInt() != 6 {
+		t.Errorf("expected b=6; got %v, %v", kv, err)
+	}
+}
+
+// createSystemDatabase creates the descriptor of a database with a
+// reserved ID, standing in for the system database every cluster has.
+func createSystemDatabase(t *testing.T, db *client.DB) {
+	desc := &structured.DatabaseDescriptor{Name: "system", ID: 1}
+	descKey := structured.MakeDescMetadataKey(desc.ID)
+	if err := db.Put(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put(structured.MakeNameMetadataKey(structured.RootNamespaceID, desc.Name), descKey); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBackupRestoreCluster verifies that a backup of a whole cluster is
+// restored into another cluster, which keeps its own system descriptors.
+func TestBackupRestoreCluster(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+	dir := util.CreateTempDir(t, "backup")
+	defer util.CleanupDir(dir)
+
+	createSystemDatabase(t, kvDB)
+	for _, stmt := range []string{
+		`CREATE DATABASE bank`,
+		`CREATE TABLE bank.accounts (id INT PRIMARY KEY, balance INT)`,
+		`INSERT INTO bank.accounts VALUES (1, 100), (2, 200)`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := Backup(kvDB, dir, s.Clock().Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	s2, sqlDB2, kvDB2 := setup(t)
+	defer cleanup(s2, sqlDB2)
+	createSystemDatabase(t, kvDB2)
+	if _, err := Restore(kvDB2, dir); err != nil {
+		t.Fatal(err)
+	}
+	if id := lookupID(t, kvDB2, structured.RootNamespaceID, "system"); id != 1 {
+		t.Errorf("expected the system database to keep ID 1; got %d", id)
+	}
+
+	var count, sum int
+	if err := sqlDB2.QueryRow(`SELECT COUNT(*), SUM(balance) FROM bank.accounts`).Scan(&count, &sum); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || sum != 300 {
+		t.Errorf("expected 2 accounts holding 300; got %d holding %d", count, sum)
+	}
+}
+
+// TestExportPaging verifies that Export stops at MaxResults entries and
+// returns the key from which to resume.
+func TestExportPaging(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	for _, key := range []string{"a", "b", "c", "d"} {
+		if err := kvDB.Put(key, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var pages [][]string
+	for start := proto.Key("a"); start != nil; {
+		reply := &proto.ExportResponse{}
+		b := &client.Batch{}
+		b.InternalAddCall(proto.Call{
+			Args: &proto.ExportRequest{
+				RequestHeader: proto.RequestHeader{Key: start, EndKey: proto.Key("f")},
+				MaxResults:    2,
+			},
+			Reply: reply,
+		})
+		if err := kvDB.Run(b); err != nil {
+			t.Fatal(err)
+		}
+		var page []string
+		for _, kv := range reply.Rows {
+			page = append(page, string(kv.Key))
+		}
+		pages = append(pages, page)
+		start = reply.ResumeKey
+	}
+	// The export resumes after the second page, which holds the last
+	// entries, since it can't tell whether any follow.
+	if expected := [][]string{{"a", "b"}, {"c", "d"}, nil}; !reflect.DeepEqual(expected, pages) {
+		t.Errorf("expected pages %v; got %v", expected, pages)
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// restoreBatchSize is the number of key/value pairs written per batch
+// during a restore.
+const restoreBatchSize = 1000
+
+// skippedKeys are the system keys whose values describe the cluster a
+// backup was taken from rather than its data. They are never restored.
+var skippedKeys = []proto.Key{
+	keys.DescIDGenerator,
+	keys.NodeIDGenerator,
+	keys.RangeIDGenerator,
+	keys.StoreIDGenerator,
+	keys.RangeTreeRoot,
+}
+
+// ReadManifest reads the manifest of the backup in dir.
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, util.Errorf("%s does not contain a complete backup", dir)
+		}
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, util.Errorf("invalid backup manifest: %s", err)
+	}
+	return manifest, nil
+}
+
+// Restore ingests the backup in dir into the cluster. The SQL databases
+// and tables of the backup keep their descriptor IDs unless those IDs
+// may already be in use by the cluster, in which case new IDs are
+// allocated and the table data is rewritten accordingly. Restoring a
+// database or table whose name is already in use fails before any data
+// is written. The system descriptors, whose IDs are reserved, exist in
+// every cluster; the cluster keeps its own, while the rows of the system
+// tables of the backup are restored into them.
+func Restore(db *client.DB, dir string) (*Manifest, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the SQL metadata of the backup and reserve its descriptor IDs.
+	md := newSQLMetadata()
+	if err := forEachKeyValue(dir, manifest, func(kv proto.KeyValue) error {
+		return md.add(kv)
+	}); err != nil {
+		return nil, err
+	}
+	if err := md.allocateIDs(db); err != nil {
+		return nil, err
+	}
+
+	// Write the data. The SQL metadata is written last, so that the
+	// restored tables only become visible once their data is in place.
+	b := &client.Batch{}
+	n := 0
+	if err := forEachKeyValue(dir, manifest, func(kv proto.KeyValue) error {
+		key, ok := md.rewriteKey(kv.Key)
+		if !ok {
+			return nil
+		}
+		value := kv.Value
+		value.Timestamp = nil
+		value.Checksum = nil
+		b.InternalAddCall(proto.PutCall(key, value))
+		if n++; n%restoreBatchSize == 0 {
+			if err := db.Run(b); err != nil {
+				return err
+			}
+			b = &client.Batch{}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if n%restoreBatchSize != 0 {
+		if err := db.Run(b); err != nil {
+			return nil, err
+		}
+	}
+	if err := md.write(db); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// forEachKeyValue invokes fn on each key/value pair of the backup in dir,
+// in key order.
+func forEachKeyValue(dir string, manifest *Manifest, fn func(proto.KeyValue) error) error {
+	for _, file := range manifest.Files {
+		if err := readFile(filepath.Join(dir, file.Name), file.NumKeys, fn); err != nil {
+			return util.Errorf("%s: %s", file.Name, err)
+		}
+	}
+	return nil
+}
+
+// readFile invokes fn on each key/value pair of the data file at path,
+// which must contain numKeys pairs.
+func readFile(path string, numKeys int, fn func(proto.KeyValue) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var data []byte
+	for i := 0; ; i++ {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			if i != numKeys {
+				return util.Errorf("expected %d keys; found %d", numKeys, i)
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if uint64(cap(data)) < size {
+			data = make([]byte, size)
+		}
+		data = data[:size]
+		if _, err := io.ReadFull(r, data); err != nil {
+			return err
+		}
+		var kv proto.KeyValue
+		if err := gogoproto.Unmarshal(data, &kv); err != nil {
+			return err
+		}
+		if err := fn(kv); err != nil {
+			return err
+		}
+	}
+}
+
+// A nameEntry is an entry of the SQL namespace, mapping a name within
+// the parent namespace to a descriptor.
+type nameEntry struct {
+	parentID structured.ID
+	name     string
+	id       structured.ID
+}
+
+// sqlMetadata holds the SQL namespace entries and descriptors of a backup,
+// along with the IDs they are restored under.
+type sqlMetadata struct {
+	names []nameEntry
+	descs map[structured.ID]proto.Value
+	// newIDs maps the descriptor IDs of the backup which are rewritten on
+	// restore to their new IDs.
+	newIDs map[structured.ID]structured.ID
+}
+
+func newSQLMetadata() *sqlMetadata {
+	return &sqlMetadata{
+		descs:  map[structured.ID]proto.Value{},
+		newIDs: map[structured.ID]structured.ID{},
+	}
+}
+
+// add records kv if it is a SQL namespace entry or descriptor. The entries
+// and descriptors with reserved IDs are those of the system, which the
+// cluster already has; they are skipped.
+func (md *sqlMetadata) add(kv proto.KeyValue) error {
+	switch {
+	case kv.Key.Equal(keys.DescIDGenerator):
+	case bytes.HasPrefix(kv.Key, keys.NameMetadataPrefix):
+		rest, parentID := encoding.DecodeUvarint(kv.Key[len(keys.NameMetadataPrefix):])
+		_, id, err := decodeDescMetadataKey(kv.Value.Bytes)
+		if err != nil {
+			return err
+		}
+		if id <= structured.MaxReservedDescID {
+			return nil
+		}
+		md.names = append(md.names, nameEntry{
+			parentID: structured.ID(parentID),
+			name:     string(rest),
+			id:       id,
+		})
+	case bytes.HasPrefix(kv.Key, keys.DescMetadataPrefix):
+		_, id, err := decodeDescMetadataKey(kv.Key)
+		if err != nil {
+			return err
+		}
+		if id <= structured.MaxReservedDescID {
+			return nil
+		}
+		md.descs[id] = kv.Value
+	}
+	return nil
+}
+
+// newID returns the ID under which the descriptor with the given ID of the
+// backup is restored.
+func (md *sqlMetadata) newID(id structured.ID) structured.ID {
+	if newID, ok := md.newIDs[id]; ok {
+		return newID
+	}
+	return id
+}
+
+// allocateIDs reserves the descriptor IDs of the backup in the cluster's
+// descriptor ID sequence. IDs not yet allocated by the cluster are kept;
+// the others are rewritten to new IDs. It fails if any restored name is
+// already in use.
+func (md *sqlMetadata) allocateIDs(db *client.DB) error {
+	ids := make([]int, 0, len(md.descs))
+	for id := range md.descs {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+
+	return db.Txn(func(txn *client.Txn) error {
+		md.newIDs = map[structured.ID]structured.ID{}
+		gr, err := txn.Get(keys.DescIDGenerator)
+		if err != nil {
+			return err
+		}
+		first := structured.ID(gr.ValueInt())
+		next := first
+		for _, id := range ids {
+			if structured.ID(id) >= next {
+				next = structured.ID(id) + 1
+			}
+		}
+		for _, id := range ids {
+			if structured.ID(id) < first {
+				md.newIDs[structured.ID(id)] = next
+				next++
+			}
+		}
+
+		for _, entry := range md.names {
+			nameKey := structured.MakeNameMetadataKey(md.newID(entry.parentID), entry.name)
+			gr, err := txn.Get(nameKey)
+			if err != nil {
+				return err
+			}
+			if gr.Exists() {
+				return util.Errorf("%q already exists", entry.name)
+			}
+		}
+		if next > first {
+			if _, err := txn.Inc(keys.DescIDGenerator, int64(next-first)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// rewriteKey returns the key under which the data at key of the backup is
+// restored. It returns false for keys which are not restored as data: the
+// range addressing records, cluster-specific system keys and the SQL
+// metadata, which is written separately.
+func (md *sqlMetadata) rewriteKey(key proto.Key) (proto.Key, bool) {
+	if key.Less(keys.MetaMax) || bytes.HasPrefix(key, keys.StatusPrefix) ||
+		bytes.HasPrefix(key, keys.NameMetadataPrefix) || bytes.HasPrefix(key, keys.DescMetadataPrefix) {
+		return nil, false
+	}
+	for _, skipped := range skippedKeys {
+		if key.Equal(skipped) {
+			return nil, false
+		}
+	}
+	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
+		return key, true
+	}
+	rest, id := encoding.DecodeUvarint(key[len(keys.TableDataPrefix):])
+	newID, ok := md.newIDs[structured.ID(id)]
+	if !ok {
+		return key, true
+	}
+	newKey := append(structured.MakeTablePrefix(newID), rest...)
+	return newKey, true
+}
+
+// write writes the SQL namespace entries and descriptors of the backup
+// under their new IDs.
+func (md *sqlMetadata) write(db *client.DB) error {
+	databases := map[structured.ID]bool{}
+	for _, entry := range md.names {
+		if entry.parentID == structured.RootNamespaceID {
+			databases[entry.id] = true
+		}
+	}
+
+	return db.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		for _, entry := range md.names {
+			nameKey := structured.MakeNameMetadataKey(md.newID(entry.parentID), entry.name)
+			b.CPut(nameKey, structured.MakeDescMetadataKey(md.newID(entry.id)), nil)
+		}
+		for id, value := range md.descs {
+			descKey := structured.MakeDescMetadataKey(md.newID(id))
+			if databases[id] {
+				desc := &structured.DatabaseDescriptor{}
+				if err := gogoproto.Unmarshal(value.Bytes, desc); err != nil {
+					return err
+				}
+				if err := desc.ConvertLegacyPrivileges(); err != nil {
+					return err
+				}
+				desc.SetID(md.newID(desc.ID))
+				b.CPut(descKey, desc, nil)
+				continue
+			}
+			desc := &structured.TableDescriptor{}
+			if err := gogoproto.Unmarshal(value.Bytes, desc); err != nil {
+				return err
+			}
+			if err := desc.ConvertLegacyPrivileges(); err != nil {
+				return err
+			}
+			md.rewriteTableDesc(desc)
+			if err := desc.Validate(); err != nil {
+				return err
+			}
+			b.CPut(descKey, desc, nil)
+		}
+		return txn.Run(b)
+	})
+}
+
+// rewriteTableDesc rewrites the IDs of desc and of the tables it refers to.
+func (md *sqlMetadata) rewriteTableDesc(desc *structured.TableDescriptor) {
+	desc.ID = md.newID(desc.ID)
+	for i := range desc.ForeignKeys {
+		desc.ForeignKeys[i].Table = md.newID(desc.ForeignKeys[i].Table)
+	}
+	for i, id := range desc.ReferencedBy {
+		desc.ReferencedBy[i] = md.newID(id)
+	}
+}
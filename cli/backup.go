@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/backup"
+	"github.com/cockroachdb/cockroach/proto"
+
+	"github.com/spf13/cobra"
+)
+
+var backupDatabase string
+
+// A backupCmd command exports the data of a cluster to a directory.
+var backupCmd = &cobra.Command{
+	Use:   "backup [options] <dir> [<start-key> [<end-key>]]",
+	Short: "backs up the cluster to a directory",
+	Long: `
+Exports the data of the cluster as of the current time into files in the
+given directory. If a key span is given, only the keys within it are
+exported. If --database is given, only the named SQL database is exported.
+`,
+	Run: runBackup,
+}
+
+func runBackup(cmd *cobra.Command, args []string) {
+	if len(args) < 1 || len(args) > 3 || (backupDatabase != "" && len(args) > 1) {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	dir := args[0]
+	timestamp := proto.Timestamp{WallTime: time.Now().UnixNano()}
+	var manifest *backup.Manifest
+	var err error
+	switch {
+	case backupDatabase != "":
+		manifest, err = backup.BackupDatabase(kvDB, dir, backupDatabase, timestamp)
+	case len(args) > 1:
+		start, end := proto.Key(args[1]), proto.KeyMax
+		if len(args) > 2 {
+			end = proto.Key(args[2])
+		}
+		manifest, err = backup.BackupSpan(kvDB, dir, start, end, timestamp)
+	default:
+		manifest, err = backup.Backup(kvDB, dir, timestamp)
+	}
+	if err != nil {
+		fmt.Fprintf(osStderr, "backup failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("backed up %d keys as of %s to %s\n", numKeys(manifest), manifest.Timestamp, dir)
+}
+
+// A restoreCmd command ingests a backup into a cluster.
+var restoreCmd = &cobra.Command{
+	Use:   "restore [options] <dir>",
+	Short: "restores a backup from a directory",
+	Long: `
+Ingests the backup in the given directory into the cluster. SQL tables are
+restored under new IDs if their IDs may already be in use. Restoring a SQL
+database or table whose name is already in use fails.
+`,
+	Run: runRestore,
+}
+
+func runRestore(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	manifest, err := backup.Restore(kvDB, args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "restore failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("restored %d keys as of %s from %s\n", numKeys(manifest), manifest.Timestamp, args[0])
+}
+
+func numKeys(manifest *backup.Manifest) int {
+	n := 0
+	for _, file := range manifest.Files {
+		n += file.NumKeys
+	}
+	return n
+}
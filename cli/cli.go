@@ -73,6 +73,8 @@ func init() {
 		userCmd,
 		rangeCmd,
 		zoneCmd,
+		backupCmd,
+		restoreCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
@@ -19,6 +19,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strings"
@@ -49,8 +50,11 @@ func newCLITest() cliTest {
 }
 
 func (c cliTest) Run(line string) {
-	a := strings.Fields(line)
+	c.RunWithArgs(strings.Fields(line))
+}
 
+// RunWithArgs runs a command whose arguments may contain spaces.
+func (c cliTest) RunWithArgs(a []string) {
 	var args []string
 	args = append(args, a[0])
 	args = append(args, fmt.Sprintf("--addr=%s", c.ServingAddr()))
@@ -59,7 +63,7 @@ func (c cliTest) Run(line string) {
 	args = append(args, a[1:]...)
 
 	fmt.Fprintf(os.Stderr, "%s\n", args)
-	fmt.Println(line)
+	fmt.Println(strings.Join(a, " "))
 	if err := Run(args); err != nil {
 		fmt.Println(err)
 	}
@@ -154,6 +158,72 @@ func Example_quoted() {
 	// node drained and shutdown: ok
 }
 
+func Example_sql() {
+	c := newCLITest()
+
+	c.RunWithArgs([]string{"sql", "-e", "create database t; create table t.f (x int primary key, y text)"})
+	c.RunWithArgs([]string{"sql", "-e", "insert into t.f values (1, 'a;b'), (2, NULL)"})
+	c.RunWithArgs([]string{"sql", "--format=table", "-e", "select * from t.f"})
+	c.RunWithArgs([]string{"sql", "--format=csv", "-e", "select * from t.f"})
+	c.RunWithArgs([]string{"sql", "--format=json", "-e", "select * from t.f; select 3 as z"})
+	c.RunWithArgs([]string{"sql", "--format=table", "-e", "select * from t.g; select 1"})
+
+	// A script read from standard input.
+	f, err := ioutil.TempFile("", "script")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.WriteString("-- Multi-line statements.\nselect x\n  from t.f\n  where x = 2;\nselect 'a'\n"); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		log.Fatal(err)
+	}
+	stdin := os.Stdin
+	os.Stdin = f
+	// Flags keep their values between commands.
+	sqlExecute = ""
+	c.RunWithArgs([]string{"sql", "--format=table", "-f", "-"})
+	os.Stdin = stdin
+	_ = f.Close()
+	c.Run("quit")
+
+	// Output:
+	// sql -e create database t; create table t.f (x int primary key, y text)
+	// OK
+	// OK
+	// sql -e insert into t.f values (1, 'a;b'), (2, NULL)
+	// OK
+	// sql --format=table -e select * from t.f
+	// x	y
+	// 1	a;b
+	// 2	NULL
+	// sql --format=csv -e select * from t.f
+	// x,y
+	// 1,a;b
+	// 2,
+	// sql --format=json -e select * from t.f; select 3 as z
+	// [
+	//   {"x": "1", "y": "a;b"},
+	//   {"x": "2", "y": null}
+	// ]
+	// [
+	//   {"z": "3"}
+	// ]
+	// sql --format=table -e select * from t.g; select 1
+	// Error: query error: table "g" does not exist
+	// sql --format=table -f -
+	// x
+	// 2
+	// 'a'
+	// a
+	// quit
+	// node drained and shutdown: ok
+}
+
 func Example_insecure() {
 	c := cliTest{}
 	c.TestServer = &server.TestServer{}
@@ -46,6 +46,10 @@ func (v pflagValue) IsBoolFlag() bool {
 var flagUsage = map[string]string{
 	"addr": `
         The host:port to bind for HTTP/RPC traffic.
+`,
+	"pgaddr": `
+        The host:port to bind for PostgreSQL wire protocol traffic, used by
+        standard PostgreSQL drivers and tools such as psql.
 `,
 	"attrs": `
         An ordered, colon-separated list of node attributes. Attributes are
@@ -67,6 +71,10 @@ var flagUsage = map[string]string{
 	"certs": `
         Directory containing RSA key and x509 certs. This flag is required if
         --insecure=false.
+`,
+	"database": `
+        The name of the SQL database to back up. If set, only the database's
+        tables and their data are backed up.
 `,
 	"gossip": `
         A comma-separated list of gossip addresses or resolvers for gossip
@@ -130,6 +138,16 @@ var flagUsage = map[string]string{
 `,
 	"max-results": `
         Define the maximum number of results that will be retrieved.
+`,
+	"execute": `
+        Execute the given semicolon separated SQL statements and exit.
+`,
+	"file": `
+        Execute the SQL script read from the given file, or from standard
+        input if the file is "-", and exit.
+`,
+	"format": `
+        The format of the results of queries: "table", "csv" or "json".
 `,
 }
 
@@ -160,6 +178,7 @@ func initFlags(ctx *server.Context) {
 
 		// Server flags.
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
+		f.StringVar(&ctx.PGAddr, "pgaddr", ctx.PGAddr, flagUsage["pgaddr"])
 		f.StringVar(&ctx.Attrs, "attrs", ctx.Attrs, flagUsage["attrs"])
 		f.StringVar(&ctx.Stores, "stores", ctx.Stores, flagUsage["stores"])
 		f.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, flagUsage["max-offset"])
@@ -214,6 +233,7 @@ func initFlags(ctx *server.Context) {
 	clientCmds := []*cobra.Command{
 		sqlShellCmd, kvCmd, rangeCmd,
 		acctCmd, permCmd, userCmd, zoneCmd,
+		backupCmd, restoreCmd,
 		exterminateCmd, quitCmd, /* startCmd is covered above */
 	}
 	for _, cmd := range clientCmds {
@@ -223,6 +243,16 @@ func initFlags(ctx *server.Context) {
 		f.StringVar(&ctx.Certs, "certs", ctx.Certs, flagUsage["certs"])
 	}
 
+	if f := sqlShellCmd.Flags(); true {
+		f.StringVarP(&sqlExecute, "execute", "e", sqlExecute, flagUsage["execute"])
+		f.StringVarP(&sqlFile, "file", "f", sqlFile, flagUsage["file"])
+		f.StringVar(&sqlFormat, "format", sqlFormat, flagUsage["format"])
+	}
+
+	if f := backupCmd.Flags(); true {
+		f.StringVar(&backupDatabase, "database", backupDatabase, flagUsage["database"])
+	}
+
 	// Max results flag for scan and reverse scan.
 	for _, cmd := range []*cobra.Command{scanCmd, reverseScanCmd} {
 		f := cmd.Flags()
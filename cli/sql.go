@@ -18,20 +18,36 @@
 package cli
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 	"text/tabwriter"
 
 	// Import cockroach driver.
 	_ "github.com/cockroachdb/cockroach/sql/driver"
-	"github.com/cockroachdb/cockroach/util"
 	"github.com/peterh/liner"
 	"github.com/spf13/cobra"
 )
 
+// The formats of the results of queries.
+const (
+	formatTable = "table"
+	formatCSV   = "csv"
+	formatJSON  = "json"
+)
+
+var (
+	sqlExecute string
+	sqlFile    string
+	sqlFormat  = formatTable
+)
+
 func makeSQLClient() *sql.DB {
 	// TODO(pmattis): Initialize the user to something more
 	// reasonable. Perhaps Context.Addr should be considered a URL.
@@ -44,6 +60,10 @@ func makeSQLClient() *sql.DB {
 		fmt.Fprintf(osStderr, "failed to initialize SQL client: %s\n", err)
 		osExit(1)
 	}
+	// The session state, such as the current database and an open
+	// transaction, is kept by the connection, so all statements are executed
+	// on the same connection.
+	db.SetMaxOpenConns(1)
 	return db
 }
 
@@ -53,85 +73,305 @@ var sqlShellCmd = &cobra.Command{
 	Short: "open a sql shell",
 	Long: `
 Open a sql shell running against the cockroach database at --addr.
+
+Statements are terminated by semicolons and can span several lines. The
+statements given by --execute, or the SQL script read from --file or from
+standard input if it is not a terminal, are executed without opening an
+interactive shell. Execution stops at the first statement which fails, and
+the command exits with a non-zero status.
 `,
 	Run: runTerm,
 }
 
-// processOneLine takes a line from the terminal, runs it,
-// and displays the result.
-// TODO(marc): handle multi-line, this will require ';' terminated statements.
-func processOneLine(db *sql.DB, line string) error {
+// splitStatements splits sql into the statements terminated by semicolons
+// which are not part of string literals, quoted identifiers or comments.
+// Statements consisting only of whitespace and comments are dropped. The
+// text after the last semicolon is returned as the remainder, unless it
+// consists only of whitespace and terminated comments.
+func splitStatements(sql string) (stmts []string, rest string) {
+	start := 0
+	content, open := false, false
+	for i := 0; i < len(sql); i++ {
+		switch ch := sql[i]; {
+		case ch == '\'' || ch == '"':
+			// Backslash escapes are only allowed in E'...' strings.
+			escapes := ch == '\'' && i > 0 && (sql[i-1] == 'e' || sql[i-1] == 'E') &&
+				(i == 1 || !isIdentChar(sql[i-2]))
+			for i++; i < len(sql) && sql[i] != ch; i++ {
+				if escapes && sql[i] == '\\' {
+					i++
+				}
+			}
+			content = true
+			open = i >= len(sql)
+		case ch == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+		case ch == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			depth := 0
+			for ; i+1 < len(sql); i++ {
+				if sql[i] == '/' && sql[i+1] == '*' {
+					depth++
+					i++
+				} else if sql[i] == '*' && sql[i+1] == '/' {
+					depth--
+					i++
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if depth > 0 {
+				i = len(sql)
+				open = true
+			}
+		case ch == ';':
+			if content {
+				stmts = append(stmts, strings.TrimSpace(sql[start:i]))
+			}
+			start = i + 1
+			content = false
+		case ch != ' ' && ch != '\t' && ch != '\n' && ch != '\r':
+			content = true
+		}
+	}
+	if !content && !open {
+		return stmts, ""
+	}
+	return stmts, sql[start:]
+}
+
+func isIdentChar(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') ||
+		(ch >= '0' && ch <= '9')
+}
+
+// runStatement runs a statement and writes its result to w in the output
+// format.
+func runStatement(db *sql.DB, w io.Writer, stmt string) error {
 	// Issues a query and examine returned Rows.
-	rows, err := db.Query(line)
+	rows, err := db.Query(stmt)
 	if err != nil {
-		return util.Errorf("query error: %s", err)
+		return fmt.Errorf("query error: %s", err)
 	}
 
 	defer rows.Close()
 	cols, err := rows.Columns()
 	if err != nil {
-		return util.Errorf("rows.Columns() error: %s", err)
+		return fmt.Errorf("rows.Columns() error: %s", err)
 	}
 
 	if len(cols) == 0 {
 		// This operation did not return rows, just show success.
-		fmt.Printf("OK\n")
+		if sqlFormat == formatTable {
+			fmt.Fprintf(w, "OK\n")
+		}
 		return nil
 	}
 
-	// Format all rows using tabwriter.
-	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 0, '\t', 0)
-	fmt.Fprintf(tw, "%s\n", strings.Join(cols, "\t"))
+	var allRows [][]*string
 	vals := make([]interface{}, len(cols))
 	for rows.Next() {
 		for i := range vals {
 			vals[i] = new(sql.NullString)
 		}
 		if err := rows.Scan(vals...); err != nil {
-			return util.Errorf("scan error: %s", err)
-		}
-		for _, v := range vals {
-			nullStr := v.(*sql.NullString)
-			if nullStr.Valid {
-				fmt.Fprintf(tw, "%s\t", nullStr.String)
-			} else {
-				fmt.Fprint(tw, "NULL\t")
+			return fmt.Errorf("scan error: %s", err)
+		}
+		row := make([]*string, len(cols))
+		for i, v := range vals {
+			if nullStr := v.(*sql.NullString); nullStr.Valid {
+				row[i] = &nullStr.String
 			}
 		}
-		fmt.Fprintf(tw, "\n")
+		allRows = append(allRows, row)
 	}
-	_ = tw.Flush()
-	return nil
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("query error: %s", err)
+	}
+	return printRows(w, cols, allRows)
 }
 
-func runTerm(cmd *cobra.Command, args []string) {
-	if len(args) != 0 {
-		cmd.Usage()
-		return
+// printRows writes the columns and rows of the result of a query to w in the
+// output format. A nil value is NULL.
+func printRows(w io.Writer, cols []string, rows [][]*string) error {
+	switch sqlFormat {
+	case formatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(cols); err != nil {
+			return err
+		}
+		record := make([]string, len(cols))
+		for _, row := range rows {
+			for i, v := range row {
+				record[i] = ""
+				if v != nil {
+					record[i] = *v
+				}
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case formatJSON:
+		// The values of each row are written as an object whose keys are in
+		// the order of the columns.
+		var buf bytes.Buffer
+		buf.WriteString("[")
+		for i, row := range rows {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString("\n  {")
+			for j, v := range row {
+				if j > 0 {
+					buf.WriteString(", ")
+				}
+				key, err := json.Marshal(cols[j])
+				if err != nil {
+					return err
+				}
+				val, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(&buf, "%s: %s", key, val)
+			}
+			buf.WriteString("}")
+		}
+		if len(rows) > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("]\n")
+		_, err := buf.WriteTo(w)
+		return err
+
+	default:
+		// Format all rows using tabwriter.
+		tw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+		fmt.Fprintf(tw, "%s\n", strings.Join(cols, "\t"))
+		record := make([]string, len(cols))
+		for _, row := range rows {
+			for i, v := range row {
+				record[i] = "NULL"
+				if v != nil {
+					record[i] = *v
+				}
+			}
+			fmt.Fprintf(tw, "%s\n", strings.Join(record, "\t"))
+		}
+		return tw.Flush()
 	}
+}
 
-	db := makeSQLClient()
+// runStatements runs the semicolon separated statements of sql, stopping
+// at the first statement which fails. The last statement does not need to
+// be terminated by a semicolon.
+func runStatements(db *sql.DB, sql string) error {
+	stmts, rest := splitStatements(sql)
+	if rest != "" {
+		stmts = append(stmts, strings.TrimSpace(rest))
+	}
+	for _, stmt := range stmts {
+		if err := runStatement(db, os.Stdout, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// runInteractive reads statements from the terminal and runs them. A
+// statement is run once the line terminating it has been entered.
+func runInteractive(db *sql.DB) {
 	liner := liner.NewLiner()
 	defer func() {
 		_ = liner.Close()
 	}()
 
+	var pending string
 	for {
-		l, err := liner.Prompt("> ")
+		prompt := "> "
+		if pending != "" {
+			prompt = "-> "
+		}
+		l, err := liner.Prompt(prompt)
 		if err != nil {
 			if err != io.EOF {
 				fmt.Fprintf(os.Stderr, "Input error: %s\n", err)
 			}
 			break
 		}
-		if len(l) == 0 {
+		if len(l) == 0 && pending == "" {
 			continue
 		}
-		liner.AppendHistory(l)
 
-		if err := processOneLine(db, l); err != nil {
-			fmt.Printf("Error: %s\n", err)
+		var stmts []string
+		stmts, pending = splitStatements(pending + l + "\n")
+		for _, stmt := range stmts {
+			liner.AppendHistory(stmt + ";")
+			if err := runStatement(db, os.Stdout, stmt); err != nil {
+				fmt.Printf("Error: %s\n", err)
+			}
 		}
 	}
 }
+
+func runTerm(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cmd.Usage()
+		return
+	}
+	switch sqlFormat {
+	case formatTable, formatCSV, formatJSON:
+	default:
+		fmt.Fprintf(osStderr, "invalid output format %q\n", sqlFormat)
+		osExit(1)
+		return
+	}
+
+	var script string
+	switch {
+	case sqlExecute != "":
+		script = sqlExecute
+	case sqlFile != "":
+		r := io.Reader(os.Stdin)
+		if sqlFile != "-" {
+			f, err := os.Open(sqlFile)
+			if err != nil {
+				fmt.Fprintf(osStderr, "failed to open SQL script: %s\n", err)
+				osExit(1)
+				return
+			}
+			defer f.Close()
+			r = f
+		}
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			fmt.Fprintf(osStderr, "failed to read SQL script: %s\n", err)
+			osExit(1)
+			return
+		}
+		script = string(b)
+	default:
+		if fi, err := os.Stdin.Stat(); err != nil || fi.Mode()&os.ModeCharDevice != 0 {
+			runInteractive(makeSQLClient())
+			return
+		}
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintf(osStderr, "failed to read SQL script: %s\n", err)
+			osExit(1)
+			return
+		}
+		script = string(b)
+	}
+
+	if err := runStatements(makeSQLClient(), script); err != nil {
+		fmt.Fprintf(osStderr, "Error: %s\n", err)
+		osExit(1)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	testData := []struct {
+		sql   string
+		stmts []string
+		rest  string
+	}{
+		{"", nil, ""},
+		{"SELECT 1", nil, "SELECT 1"},
+		{"SELECT 1;", []string{"SELECT 1"}, ""},
+		{"SELECT 1; SELECT 2;\n", []string{"SELECT 1", "SELECT 2"}, ""},
+		{"SELECT\n1\n;", []string{"SELECT\n1"}, ""},
+		{";; ;SELECT 1;", []string{"SELECT 1"}, ""},
+		{"SELECT 'a;b'; SELECT 2", []string{"SELECT 'a;b'"}, " SELECT 2"},
+		{"SELECT 'it''s;'; SELECT \"a;\"", []string{"SELECT 'it''s;'"}, ` SELECT "a;"`},
+		{`SELECT e'\';'; SELECT 'a\';`, []string{`SELECT e'\';'`, `SELECT 'a\'`}, ""},
+		{"SELECT 'a;\n", nil, "SELECT 'a;\n"},
+		{"SELECT 1; -- comment; SELECT 2\n", []string{"SELECT 1"}, ""},
+		{"SELECT 1 -- comment;\n;", []string{"SELECT 1 -- comment;"}, ""},
+		{"-- comment\n;SELECT 1;", []string{"SELECT 1"}, ""},
+		{"SELECT /* a; /* b; */ c; */ 1;", []string{"SELECT /* a; /* b; */ c; */ 1"}, ""},
+		{"SELECT 1; /* a;", []string{"SELECT 1"}, " /* a;"},
+	}
+	for _, d := range testData {
+		stmts, rest := splitStatements(d.sql)
+		if !reflect.DeepEqual(d.stmts, stmts) || d.rest != rest {
+			t.Errorf("%q: expected %q, %q, but found %q, %q", d.sql, d.stmts, d.rest, stmts, rest)
+		}
+	}
+}
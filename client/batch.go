@@ -135,6 +135,13 @@ func (b *Batch) fillResults() error {
 					row.Key = kv.Key
 					row.setValue(&kv.Value)
 				}
+			case *proto.ExportResponse:
+				result.Rows = make([]KeyValue, len(t.Rows))
+				for j, kv := range t.Rows {
+					row := &result.Rows[j]
+					row.Key = kv.Key
+					row.setValue(&kv.Value)
+				}
 			case *proto.DeleteResponse:
 				row := &result.Rows[k]
 				row.Key = []byte(call.Args.(*proto.DeleteRequest).Key)
@@ -152,6 +159,7 @@ func (b *Batch) fillResults() error {
 			case *proto.MergeResponse:
 			case *proto.TruncateLogResponse:
 			case *proto.LeaderLeaseResponse:
+			case *proto.ChecksumSnapshotResponse:
 			case *proto.BatchResponse:
 				// Nothing to do for these methods as they do not generate any
 				// rows.
@@ -82,6 +82,30 @@ func newTxn(db DB, depth int) *Txn {
 	return txn
 }
 
+// NewTxn returns a new transaction which, unlike the transactions run by
+// DB.Txn, is not run within a retry loop and is not committed automatically.
+// The caller is responsible for ending the transaction with Commit or
+// Rollback. NewTxn is used when the lifetime of a transaction spans several
+// requests (e.g. an SQL transaction opened with BEGIN), in which case the
+// state of the transaction is saved with Proto and restored with Resume.
+func NewTxn(db DB) *Txn {
+	return newTxn(db, 1 /* depth */)
+}
+
+// Proto returns the state of the transaction. The Writing field indicates
+// whether the transaction has performed any writes.
+func (txn *Txn) Proto() proto.Transaction {
+	state := txn.txn
+	state.Writing = state.Writing || txn.haveTxnWrite
+	return state
+}
+
+// Resume restores the state of a transaction previously returned by Proto.
+func (txn *Txn) Resume(state proto.Transaction) {
+	txn.txn = state
+	txn.haveTxnWrite = state.Writing
+}
+
 // SetDebugName sets the debug name associated with the transaction which will
 // appear in log files and the web UI. Each transaction starts out with an
 // automatically assigned debug name composed of the file and line number where
@@ -102,7 +102,12 @@ func (m *PermConfig) GetWrite() []string {
 // UserConfig holds per-user configuration needed for authentication.
 type UserConfig struct {
 	// Output of bcrypt: contains hash, salt, and cost.
-	HashedPassword   []byte `protobuf:"bytes,1,opt,name=hashed_password" json:"hashed_password,omitempty" yaml:"hashed_password,omitempty"`
+	HashedPassword []byte `protobuf:"bytes,1,opt,name=hashed_password" json:"hashed_password,omitempty" yaml:"hashed_password,omitempty"`
+	// Roles lists the roles granted to the user, sorted by name.
+	Roles []string `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty" yaml:"roles,omitempty"`
+	// IsRole is true if this is a role created by CREATE ROLE, which cannot
+	// be used to log in.
+	IsRole           bool   `protobuf:"varint,3,opt,name=is_role" json:"is_role,omitempty" yaml:"is_role,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -117,6 +122,20 @@ func (m *UserConfig) GetHashedPassword() []byte {
 	return nil
 }
 
+func (m *UserConfig) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *UserConfig) GetIsRole() bool {
+	if m != nil {
+		return m.IsRole
+	}
+	return false
+}
+
 // ZoneConfig holds configuration that is needed for a range of KV pairs.
 type ZoneConfig struct {
 	// ReplicaAttrs is a slice of Attributes, each describing required attributes
@@ -427,6 +446,45 @@ func (m *UserConfig) Unmarshal(data []byte) error {
 			}
 			m.HashedPassword = append([]byte{}, data[iNdEx:postIndex]...)
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRole", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRole = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -731,6 +789,13 @@ func (m *UserConfig) Size() (n int) {
 		l = len(m.HashedPassword)
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -892,6 +957,29 @@ func (m *UserConfig) MarshalTo(data []byte) (n int, err error) {
 		i = encodeVarintConfig(data, i, uint64(len(m.HashedPassword)))
 		i += copy(data[i:], m.HashedPassword)
 	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	data[i] = 0x18
+	i++
+	if m.IsRole {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
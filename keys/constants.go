@@ -56,6 +56,10 @@ var (
 	// LocalStoreIdentSuffix stores an immutable identifier for this
 	// store, created when the store is first bootstrapped.
 	LocalStoreIdentSuffix = proto.Key("iden")
+	// LocalStoreSnapshotSuffix is the suffix for the keys under which the
+	// chunks of a streamed raft snapshot are staged until raft accepts the
+	// snapshot and its data is installed into the range.
+	LocalStoreSnapshotSuffix = proto.Key("snap")
 
 	// LocalRangeIDPrefix is the prefix identifying per-range data
 	// indexed by Range ID. The Range ID is appended to this prefix,
@@ -44,6 +44,18 @@ func StoreIdentKey() proto.Key {
 	return MakeStoreKey(LocalStoreIdentSuffix, proto.Key{})
 }
 
+// StoreSnapshotPrefix returns the store-local prefix under which the
+// chunks of a streamed raft snapshot of the specified range are staged.
+func StoreSnapshotPrefix(rangeID proto.RangeID) proto.Key {
+	return MakeStoreKey(LocalStoreSnapshotSuffix, encoding.EncodeUvarint(nil, uint64(rangeID)))
+}
+
+// StoreSnapshotKey returns the store-local key under which the specified
+// engine key of a streamed raft snapshot of the range is staged.
+func StoreSnapshotKey(rangeID proto.RangeID, key proto.EncodedKey) proto.Key {
+	return MakeKey(StoreSnapshotPrefix(rangeID), proto.Key(key))
+}
+
 // StoreStatusKey returns the key for accessing the store status for the
 // specified store ID.
 func StoreStatusKey(storeID int32) proto.Key {
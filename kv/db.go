@@ -73,6 +73,7 @@ var allPublicMethods = map[string]proto.Method{
 	proto.Batch.String():          proto.Batch,
 	proto.AdminSplit.String():     proto.AdminSplit,
 	proto.AdminMerge.String():     proto.AdminMerge,
+	proto.Export.String():         proto.Export,
 }
 
 // createArgsAndReply returns allocated request and response pairs
@@ -107,6 +108,8 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.AdminSplitRequest{}, &proto.AdminSplitResponse{}
 		case proto.AdminMerge:
 			return &proto.AdminMergeRequest{}, &proto.AdminMergeResponse{}
+		case proto.Export:
+			return &proto.ExportRequest{}, &proto.ExportResponse{}
 		}
 	}
 	return nil, nil
@@ -204,6 +207,7 @@ func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 		&proto.BatchRequest{},
 		&proto.AdminSplitRequest{},
 		&proto.AdminMergeRequest{},
+		&proto.ExportRequest{},
 	}
 	for _, r := range requests {
 		if err := rpcServer.Register("Server."+r.Method().String(),
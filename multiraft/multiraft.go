@@ -75,6 +75,20 @@ type Config struct {
 	// be missed.
 	EventBufferSize int
 
+	// SnapshotChunkSize is the maximum size in bytes of the keys and values
+	// sent in a single chunk of a streamed snapshot. Defaults to
+	// defaultSnapshotChunkSize if zero.
+	SnapshotChunkSize int
+	// SnapshotRateLimit is the maximum rate in bytes per second at which
+	// this node streams snapshot data, across all of its snapshots. Zero
+	// disables the limit.
+	SnapshotRateLimit int64
+	// MaxConcurrentSnapshots is the maximum number of snapshots this node
+	// streams at once, and separately the maximum number it receives at
+	// once. Snapshots beyond the limit fail immediately and are retried by
+	// raft. Zero disables the limit.
+	MaxConcurrentSnapshots int
+
 	EntryFormatter raft.EntryFormatter
 }
 
@@ -110,6 +124,14 @@ type MultiRaft struct {
 	proposalChan    chan *proposal
 	// callbackChan is a generic hook to run a callback in the raft thread.
 	callbackChan chan func()
+	// snapshotSem limits the number of concurrently streamed snapshots.
+	// It is nil if MaxConcurrentSnapshots is zero.
+	snapshotSem chan struct{}
+	// snapshotRecv limits the number of concurrently received snapshots.
+	// It is nil if MaxConcurrentSnapshots is zero.
+	snapshotRecv *snapshotReceiver
+	// snapshotLimiter paces the streaming of snapshot data.
+	snapshotLimiter *rateLimiter
 }
 
 // multiraftServer is a type alias to separate RPC methods
@@ -143,6 +165,10 @@ func NewMultiRaft(nodeID proto.RaftNodeID, config *Config, stopper *stop.Stopper
 		}
 	}
 
+	if config.SnapshotChunkSize == 0 {
+		config.SnapshotChunkSize = defaultSnapshotChunkSize
+	}
+
 	m := &MultiRaft{
 		Config:    *config,
 		stopper:   stopper,
@@ -158,6 +184,12 @@ func NewMultiRaft(nodeID proto.RaftNodeID, config *Config, stopper *stop.Stopper
 		removeGroupChan: make(chan *removeGroupOp),
 		proposalChan:    make(chan *proposal),
 		callbackChan:    make(chan func()),
+
+		snapshotLimiter: newRateLimiter(config.SnapshotRateLimit),
+	}
+	if config.MaxConcurrentSnapshots > 0 {
+		m.snapshotSem = make(chan struct{}, config.MaxConcurrentSnapshots)
+		m.snapshotRecv = newSnapshotReceiver(config.MaxConcurrentSnapshots)
 	}
 
 	if err := m.Transport.Listen(nodeID, (*multiraftServer)(m)); err != nil {
@@ -185,6 +217,31 @@ func (ms *multiraftServer) RaftMessage(req *RaftMessageRequest,
 	}
 }
 
+// RaftSnapshotChunk implements ServerInterface; this method is called by
+// net/rpc when we receive a chunk of a streamed snapshot. It returns once
+// the chunk has been applied to the group's storage.
+func (ms *multiraftServer) RaftSnapshotChunk(req *proto.RaftSnapshotChunkRequest,
+	resp *proto.RaftSnapshotChunkResponse) error {
+	err := ErrStopped
+	ms.stopper.RunTask(func() {
+		streamer, ok := ms.Storage.GroupStorage(req.GroupID).(SnapshotStreamer)
+		if !ok {
+			err = util.Errorf("group %d does not accept streamed snapshots", req.GroupID)
+			return
+		}
+		if ms.snapshotRecv != nil {
+			if err = ms.snapshotRecv.receive(req, time.Now()); err != nil {
+				return
+			}
+		}
+		err = streamer.ApplySnapshotChunk(req)
+		if ms.snapshotRecv != nil && (err != nil || req.Last) {
+			ms.snapshotRecv.done(req.GroupID)
+		}
+	})
+	return err
+}
+
 func (m *MultiRaft) sendEvent(event interface{}) {
 	select {
 	case m.Events <- event:
@@ -510,6 +567,11 @@ func (s *state) start() {
 							break
 						}
 					}
+					if req.Message.Type == raftpb.MsgSnap && !s.snapshotReceived(req) {
+						log.Warningf("node %v: dropping snapshot of group %v whose data was not received",
+							s.nodeID, req.GroupID)
+						break
+					}
 
 					if err := s.multiNode.Step(context.Background(), uint64(req.GroupID), req.Message); err != nil {
 						if log.V(4) {
@@ -924,6 +986,12 @@ func (s *state) sendMessage(groupID proto.RangeID, msg raftpb.Message) {
 				s.nodeID, groupID, nodeID, err)
 		}
 	}
+	if msg.Type == raftpb.MsgSnap && groupID != noGroup {
+		if streamer, ok := s.Storage.GroupStorage(groupID).(SnapshotStreamer); ok {
+			s.sendSnapshot(groupID, msg, streamer)
+			return
+		}
+	}
 	err := s.Transport.Send(&RaftMessageRequest{groupID, msg})
 	snapStatus := raft.SnapshotFinish
 	if err != nil {
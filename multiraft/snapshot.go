@@ -0,0 +1,221 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+const (
+	// defaultSnapshotChunkSize is the default maximum size in bytes of the
+	// keys and values sent in a single chunk of a streamed snapshot.
+	defaultSnapshotChunkSize = 256 << 10
+	// snapshotReceiveTimeout is the duration after which a snapshot being
+	// received without any new chunk arriving is considered abandoned by
+	// its sender, and no longer counts towards MaxConcurrentSnapshots.
+	snapshotReceiveTimeout = time.Minute
+)
+
+// A rateLimiter paces a stream of bytes to a fixed rate. Callers reserve
+// capacity in turn, so concurrent streams share the rate.
+type rateLimiter struct {
+	sync.Mutex
+	rate int64     // bytes per second; zero means unlimited
+	next time.Time // time at which all reserved capacity is used up
+}
+
+func newRateLimiter(rate int64) *rateLimiter {
+	return &rateLimiter{rate: rate}
+}
+
+// reserve reserves capacity for n bytes and returns how long the caller
+// must wait before using it.
+func (rl *rateLimiter) reserve(n int) time.Duration {
+	if rl.rate <= 0 {
+		return 0
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	now := time.Now()
+	if rl.next.Before(now) {
+		rl.next = now
+	}
+	wait := rl.next.Sub(now)
+	rl.next = rl.next.Add(time.Duration(int64(n) * int64(time.Second) / rl.rate))
+	return wait
+}
+
+// wait blocks until n bytes may be sent. It returns false if stop is
+// closed first.
+func (rl *rateLimiter) wait(n int, stop <-chan struct{}) bool {
+	d := rl.reserve(n)
+	if d <= 0 {
+		return true
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+// A snapshotReceiver limits the number of snapshots which are streamed to
+// this node at once.
+type snapshotReceiver struct {
+	sync.Mutex
+	max int
+	// streams maps the groups whose snapshots are being received to the
+	// time at which their last chunk arrived.
+	streams map[proto.RangeID]time.Time
+}
+
+func newSnapshotReceiver(max int) *snapshotReceiver {
+	return &snapshotReceiver{max: max, streams: map[proto.RangeID]time.Time{}}
+}
+
+// receive records the arrival of a chunk of a snapshot of the group. The
+// first chunk starts a stream, replacing any earlier stream for the group;
+// it fails if the node is already receiving the maximum number of
+// snapshots, in which case the sender retries later. Other chunks fail if
+// their stream is no longer being received.
+func (sr *snapshotReceiver) receive(chunk *proto.RaftSnapshotChunkRequest, now time.Time) error {
+	sr.Lock()
+	defer sr.Unlock()
+	if _, ok := sr.streams[chunk.GroupID]; ok || chunk.Seq != 0 {
+		if !ok {
+			return util.Errorf("snapshot of group %d at index %d is no longer being received",
+				chunk.GroupID, chunk.Index)
+		}
+		sr.streams[chunk.GroupID] = now
+		return nil
+	}
+	if len(sr.streams) >= sr.max {
+		for groupID, last := range sr.streams {
+			if now.Sub(last) > snapshotReceiveTimeout {
+				delete(sr.streams, groupID)
+			}
+		}
+		if len(sr.streams) >= sr.max {
+			return util.Errorf("too many snapshots being received; rejecting snapshot of group %d at index %d",
+				chunk.GroupID, chunk.Index)
+		}
+	}
+	sr.streams[chunk.GroupID] = now
+	return nil
+}
+
+// done records that the snapshot of the group is no longer being
+// received, either because its last chunk arrived or because a chunk
+// failed.
+func (sr *snapshotReceiver) done(groupID proto.RangeID) {
+	sr.Lock()
+	defer sr.Unlock()
+	delete(sr.streams, groupID)
+}
+
+// sendSnapshot streams the data of the snapshot carried by msg to its
+// recipient in chunks and then sends msg itself, which arrives without
+// any data. The transfer happens asynchronously; its outcome is reported
+// to raft when done. If the node is already streaming the maximum number
+// of snapshots, the snapshot fails immediately and raft retries later.
+func (s *state) sendSnapshot(groupID proto.RangeID, msg raftpb.Message, streamer SnapshotStreamer) {
+	if s.snapshotSem != nil {
+		select {
+		case s.snapshotSem <- struct{}{}:
+		default:
+			if log.V(1) {
+				log.Infof("node %v: too many snapshots in flight; deferring snapshot of group %v to %v",
+					s.nodeID, groupID, msg.To)
+			}
+			s.multiNode.ReportSnapshot(msg.To, uint64(groupID), raft.SnapshotFailure)
+			return
+		}
+	}
+	release := func() {
+		if s.snapshotSem != nil {
+			<-s.snapshotSem
+		}
+	}
+	if !s.stopper.RunAsyncTask(func() {
+		defer release()
+		snapStatus := raft.SnapshotFinish
+		if err := s.streamSnapshot(groupID, msg, streamer); err != nil {
+			log.Warningf("node %v failed to send snapshot of group %v to %v: %s",
+				s.nodeID, groupID, msg.To, err)
+			snapStatus = raft.SnapshotFailure
+		}
+		s.multiNode.ReportSnapshot(msg.To, uint64(groupID), snapStatus)
+	}) {
+		release()
+	}
+}
+
+// streamSnapshot sends the chunks of the snapshot carried by msg, followed
+// by msg. It blocks until done.
+func (s *state) streamSnapshot(groupID proto.RangeID, msg raftpb.Message, streamer SnapshotStreamer) error {
+	var snapData proto.RaftSnapshotData
+	if err := snapData.Unmarshal(msg.Snapshot.Data); err != nil {
+		return err
+	}
+	var seq uint64
+	err := streamer.StreamSnapshot(msg.Snapshot, s.SnapshotChunkSize,
+		func(kvs []*proto.RaftSnapshotData_KeyValue, last bool) error {
+			chunk := &proto.RaftSnapshotChunkRequest{
+				GroupID: groupID,
+				To:      proto.RaftNodeID(msg.To),
+				Index:   msg.Snapshot.Metadata.Index,
+				Seq:     seq,
+				Last:    last,
+				KV:      kvs,
+			}
+			if seq == 0 {
+				chunk.RangeDescriptor = &snapData.RangeDescriptor
+			}
+			seq++
+			if !s.snapshotLimiter.wait(chunk.Size(), s.stopper.ShouldStop()) {
+				return ErrStopped
+			}
+			return s.Transport.SendSnapshotChunk(chunk)
+		})
+	if err != nil {
+		return err
+	}
+	if err := s.Transport.Send(&RaftMessageRequest{groupID, msg}); err != nil {
+		s.multiNode.ReportUnreachable(msg.To, uint64(groupID))
+		return err
+	}
+	return nil
+}
+
+// snapshotReceived returns false if req carries a snapshot whose data was
+// meant to be streamed ahead of it but hasn't been received in full.
+func (s *state) snapshotReceived(req *RaftMessageRequest) bool {
+	streamer, ok := s.Storage.GroupStorage(req.GroupID).(SnapshotStreamer)
+	if !ok {
+		return true
+	}
+	return streamer.SnapshotReceived(req.Message.Snapshot)
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package multiraft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestRateLimiter verifies that reservations are paced at the configured
+// rate and that a zero rate disables the limit.
+func TestRateLimiter(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	unlimited := newRateLimiter(0)
+	for i := 0; i < 3; i++ {
+		if d := unlimited.reserve(1 << 30); d != 0 {
+			t.Errorf("%d: expected no wait without a limit; got %s", i, d)
+		}
+	}
+
+	rl := newRateLimiter(1000)
+	if d := rl.reserve(500); d != 0 {
+		t.Errorf("expected first reservation not to wait; got %s", d)
+	}
+	// The second reservation waits for the first one's 500 bytes.
+	if d := rl.reserve(500); d <= 400*time.Millisecond || d > 500*time.Millisecond {
+		t.Errorf("expected second reservation to wait ~500ms; got %s", d)
+	}
+	// The third one waits for both.
+	if d := rl.reserve(1); d <= 900*time.Millisecond || d > time.Second {
+		t.Errorf("expected third reservation to wait ~1s; got %s", d)
+	}
+
+	// Waiting is interrupted by stop.
+	stop := make(chan struct{})
+	close(stop)
+	if rl.wait(1, stop) {
+		t.Error("expected wait to be interrupted")
+	}
+}
+
+// TestSnapshotReceiver verifies that the number of snapshots received at
+// once is limited, and that abandoned streams stop counting once they
+// time out.
+func TestSnapshotReceiver(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	sr := newSnapshotReceiver(1)
+	now := time.Now()
+	first := func(groupID proto.RangeID) *proto.RaftSnapshotChunkRequest {
+		return &proto.RaftSnapshotChunkRequest{GroupID: groupID, Index: 10}
+	}
+	if err := sr.receive(first(1), now); err != nil {
+		t.Fatal(err)
+	}
+	if err := sr.receive(&proto.RaftSnapshotChunkRequest{GroupID: 1, Index: 10, Seq: 1}, now); err != nil {
+		t.Fatal(err)
+	}
+	// A new stream for the same group replaces the previous one.
+	if err := sr.receive(first(1), now); err != nil {
+		t.Fatal(err)
+	}
+	if err := sr.receive(first(2), now); err == nil {
+		t.Error("expected snapshot beyond the limit to be rejected")
+	}
+	if err := sr.receive(&proto.RaftSnapshotChunkRequest{GroupID: 2, Index: 10, Seq: 1}, now); err == nil {
+		t.Error("expected chunk of unknown stream to be rejected")
+	}
+
+	// Once a stream is done, another one may start.
+	sr.done(1)
+	if err := sr.receive(first(2), now); err != nil {
+		t.Fatal(err)
+	}
+	// An abandoned stream is evicted once it times out.
+	if err := sr.receive(first(3), now.Add(snapshotReceiveTimeout)); err == nil {
+		t.Error("expected snapshot beyond the limit to be rejected")
+	}
+	if err := sr.receive(first(3), now.Add(2*snapshotReceiveTimeout)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -38,6 +38,26 @@ type WriteableGroupStorage interface {
 
 var _ WriteableGroupStorage = (*raft.MemoryStorage)(nil)
 
+// A SnapshotStreamer is a WriteableGroupStorage whose snapshots don't
+// carry the group's data. Instead, the data of a snapshot is streamed to
+// the recipient over the Transport in bounded chunks ahead of the
+// snapshot itself, and the recipient applies the chunks as they arrive.
+type SnapshotStreamer interface {
+	WriteableGroupStorage
+	// StreamSnapshot calls send with successive chunks of the data of the
+	// supplied snapshot, which was returned by Snapshot(). Each chunk holds
+	// at most maxBytes of keys and values unless a single key/value pair is
+	// larger. The final call to send has last set.
+	StreamSnapshot(snap raftpb.Snapshot, maxBytes int,
+		send func(kvs []*proto.RaftSnapshotData_KeyValue, last bool) error) error
+	// ApplySnapshotChunk applies a chunk of a streamed snapshot.
+	ApplySnapshotChunk(chunk *proto.RaftSnapshotChunkRequest) error
+	// SnapshotReceived returns true if all of the data of the supplied
+	// snapshot has been applied. Snapshots whose data hasn't been received
+	// in full are dropped.
+	SnapshotReceived(snap raftpb.Snapshot) bool
+}
+
 // The Storage interface is supplied by the application to manage persistent storage
 // of raft data.
 type Storage interface {
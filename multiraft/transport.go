@@ -50,6 +50,11 @@ type Transport interface {
 	// Send a message to the node specified in the request's To field.
 	Send(req *RaftMessageRequest) error
 
+	// SendSnapshotChunk sends a chunk of a streamed snapshot to the node
+	// specified in the request's To field. Unlike Send, it blocks until the
+	// chunk has been applied by the recipient or an error occurs.
+	SendSnapshotChunk(req *proto.RaftSnapshotChunkRequest) error
+
 	// Close all associated connections.
 	Close()
 }
@@ -72,10 +77,12 @@ type RaftMessageResponse struct {
 // the 'real' transport).
 type ServerInterface interface {
 	RaftMessage(req *RaftMessageRequest, resp *RaftMessageResponse) error
+	RaftSnapshotChunk(req *proto.RaftSnapshotChunkRequest, resp *proto.RaftSnapshotChunkResponse) error
 }
 
 var (
-	raftMessageName = "MultiRaft.RaftMessage"
+	raftMessageName       = "MultiRaft.RaftMessage"
+	raftSnapshotChunkName = "MultiRaft.RaftSnapshotChunk"
 )
 
 type localRPCTransport struct {
@@ -126,6 +133,19 @@ func (lt *localRPCTransport) Listen(id proto.RaftNodeID, server ServerInterface)
 	if err != nil {
 		return err
 	}
+	err = rpcServer.RegisterAsync(raftSnapshotChunkName,
+		func(argsI gogoproto.Message, callback func(gogoproto.Message, error)) {
+			// Applying a chunk may take a while, so don't hold up the
+			// connection's other messages.
+			go func() {
+				resp := &proto.RaftSnapshotChunkResponse{}
+				err := server.RaftSnapshotChunk(argsI.(*proto.RaftSnapshotChunkRequest), resp)
+				callback(resp, err)
+			}()
+		}, &proto.RaftSnapshotChunkRequest{})
+	if err != nil {
+		return err
+	}
 
 	lt.mu.Lock()
 	if _, ok := lt.servers[id]; ok {
@@ -214,6 +234,20 @@ func (lt *localRPCTransport) Send(req *RaftMessageRequest) error {
 	}
 }
 
+func (lt *localRPCTransport) SendSnapshotChunk(req *proto.RaftSnapshotChunkRequest) error {
+	client, err := lt.getClient(req.To)
+	if err != nil {
+		return err
+	}
+	call := client.Go(raftSnapshotChunkName, req, &proto.RaftSnapshotChunkResponse{}, nil)
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-lt.closed:
+		return util.Errorf("transport is closed")
+	}
+}
+
 func (lt *localRPCTransport) Close() {
 	lt.mu.Lock()
 	defer lt.mu.Unlock()
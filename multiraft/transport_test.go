@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/stop"
 )
 
@@ -111,6 +112,18 @@ func (lt *localInterceptableTransport) Send(req *RaftMessageRequest) error {
 	return nil
 }
 
+// SendSnapshotChunk delivers the chunk to the recipient directly; unlike
+// messages, snapshot chunks are not intercepted.
+func (lt *localInterceptableTransport) SendSnapshotChunk(req *proto.RaftSnapshotChunkRequest) error {
+	lt.mu.Lock()
+	srv, ok := lt.listeners[req.To]
+	lt.mu.Unlock()
+	if !ok {
+		return util.Errorf("unknown peer %v", req.To)
+	}
+	return srv.RaftSnapshotChunk(req, &proto.RaftSnapshotChunkResponse{})
+}
+
 // an interceptMessage is sent by an interceptableClient when a message is to
 // be sent.
 type interceptMessage struct {
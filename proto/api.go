@@ -181,6 +181,16 @@ func (sr *ReverseScanResponse) Combine(c Response) {
 	}
 }
 
+// Combine implements the Combinable interface.
+func (er *ExportResponse) Combine(c Response) {
+	otherER := c.(*ExportResponse)
+	if er != nil {
+		er.Rows = append(er.Rows, otherER.GetRows()...)
+		er.ResumeKey = otherER.ResumeKey
+		er.Header().Combine(otherER.Header())
+	}
+}
+
 // Combine implements the Combinable interface.
 func (dr *DeleteRangeResponse) Combine(c Response) {
 	otherDR := c.(*DeleteRangeResponse)
@@ -343,6 +353,16 @@ func (sr *ReverseScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in ExportRequest.
+func (er *ExportRequest) GetBound() int64 {
+	return er.GetMaxResults()
+}
+
+// SetBound sets the MaxResults field in ExportRequest.
+func (er *ExportRequest) SetBound(bound int64) {
+	er.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
@@ -359,6 +379,11 @@ func (sr *ReverseScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of rows in ExportResponse.
+func (er *ExportResponse) Count() int64 {
+	return int64(len(er.Rows))
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -419,6 +444,18 @@ func (*TruncateLogRequest) Method() Method { return TruncateLog }
 // Method implements the Request interface.
 func (*LeaderLeaseRequest) Method() Method { return LeaderLease }
 
+// Method implements the Request interface.
+func (*ComputeChecksumRequest) Method() Method { return ComputeChecksum }
+
+// Method implements the Request interface.
+func (*VerifyChecksumRequest) Method() Method { return VerifyChecksum }
+
+// Method implements the Request interface.
+func (*ChecksumSnapshotRequest) Method() Method { return ChecksumSnapshot }
+
+// Method implements the Request interface.
+func (*ExportRequest) Method() Method { return Export }
+
 // Method implements the Request interface.
 func (*BatchRequest) Method() Method { return Batch }
 
@@ -484,6 +521,18 @@ func (*TruncateLogRequest) CreateReply() Response { return &TruncateLogResponse{
 // CreateReply implements the Request interface.
 func (*LeaderLeaseRequest) CreateReply() Response { return &LeaderLeaseResponse{} }
 
+// CreateReply implements the Request interface.
+func (*ComputeChecksumRequest) CreateReply() Response { return &ComputeChecksumResponse{} }
+
+// CreateReply implements the Request interface.
+func (*VerifyChecksumRequest) CreateReply() Response { return &VerifyChecksumResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ChecksumSnapshotRequest) CreateReply() Response { return &ChecksumSnapshotResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ExportRequest) CreateReply() Response { return &ExportResponse{} }
+
 // CreateReply implements the Request interface.
 func (*BatchRequest) CreateReply() Response { return &BatchResponse{} }
 
@@ -507,4 +556,8 @@ func (*ResolveIntentRangeRequest) flags() int { return isWrite | isRange }
 func (*MergeRequest) flags() int              { return isWrite }
 func (*TruncateLogRequest) flags() int        { return isWrite }
 func (*LeaderLeaseRequest) flags() int        { return isWrite }
+func (*ComputeChecksumRequest) flags() int    { return isWrite }
+func (*VerifyChecksumRequest) flags() int     { return isWrite }
+func (*ChecksumSnapshotRequest) flags() int   { return isRead }
+func (*ExportRequest) flags() int             { return isRead | isRange }
 func (*BatchRequest) flags() int              { return isWrite }
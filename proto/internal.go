@@ -82,3 +82,10 @@ func (m *RaftMessageRequest) GetUser() string {
 	// TODO(marc): we should use security.NodeUser here, but we need to break cycles first.
 	return "node"
 }
+
+// GetUser implements UserRequest.
+// Snapshot chunks are always sent by the node user.
+func (m *RaftSnapshotChunkRequest) GetUser() string {
+	// TODO(marc): we should use security.NodeUser here, but we need to break cycles first.
+	return "node"
+}
@@ -95,6 +95,23 @@ const (
 	TruncateLog
 	// LeaderLease requests a leader lease for a replica.
 	LeaderLease
+	// ComputeChecksum computes a checksum of the range data on each
+	// replica at the same applied index. It is proposed through raft by
+	// the range leader.
+	ComputeChecksum
+	// VerifyChecksum compares each replica's checksum, as computed by a
+	// previous ComputeChecksum, with the leader's and reports replicas
+	// whose data has diverged.
+	VerifyChecksum
+	// ChecksumSnapshot returns the range data from which the range leader
+	// computed the checksum of a consistency check. It is sent by
+	// replicas whose checksum does not match the leader's.
+	ChecksumSnapshot
+	// Export returns the values of all keys which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey, with the
+	// latter endpoint excluded, as of args.RequestHeader.Timestamp. It
+	// is used to back up the data of a span at a single timestamp.
+	Export
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
@@ -64,11 +64,17 @@ func promptForPassword() ([]byte, error) {
 	return []byte(one), nil
 }
 
-// hashPassword takes a raw password and returns a bcrypt hashed password.
-func hashPassword(raw []byte) ([]byte, error) {
+// HashPassword takes a raw password and returns a bcrypt hashed password.
+func HashPassword(raw []byte) ([]byte, error) {
 	return bcrypt.GenerateFromPassword(raw, bcryptCost)
 }
 
+// CompareHashAndPassword returns nil if `raw` is the password hashed into
+// `hashedPassword` by HashPassword, or an error otherwise.
+func CompareHashAndPassword(hashedPassword, raw []byte) error {
+	return bcrypt.CompareHashAndPassword(hashedPassword, raw)
+}
+
 // PromptForPasswordAndHash prompts for a password on the stdin twice,
 // and if both match, returns a bcrypt hashed password.
 func PromptForPasswordAndHash() ([]byte, error) {
@@ -80,5 +86,5 @@ func PromptForPasswordAndHash() ([]byte, error) {
 	if len(password) == 0 {
 		return nil, util.Errorf("password cannot be empty")
 	}
-	return hashPassword(password)
+	return HashPassword(password)
 }
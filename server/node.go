@@ -247,6 +247,8 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 		&proto.MergeRequest{},
 		&proto.TruncateLogRequest{},
 		&proto.LeaderLeaseRequest{},
+		&proto.ChecksumSnapshotRequest{},
+		&proto.ExportRequest{},
 	}
 	for _, r := range requests {
 		if err := rpcServer.Register("Node."+r.Method().String(), n.executeCmd, r); err != nil {
@@ -36,6 +36,9 @@ import (
 const (
 	raftServiceName = "MultiRaft"
 	raftMessageName = raftServiceName + ".RaftMessage"
+	// raftSnapshotChunkName is the method which receives the chunks of
+	// streamed snapshots.
+	raftSnapshotChunkName = raftServiceName + ".RaftSnapshotChunk"
 	// Outgoing messages are queued on a per-node basis on a channel of
 	// this size.
 	raftSendBufferSize = 500
@@ -70,6 +73,10 @@ func newRPCTransport(gossip *gossip.Gossip, rpcServer *rpc.Server, rpcContext *r
 			&proto.RaftMessageRequest{}); err != nil {
 			return nil, err
 		}
+		if err := t.rpcServer.RegisterAsync(raftSnapshotChunkName, t.RaftSnapshotChunk,
+			&proto.RaftSnapshotChunkRequest{}); err != nil {
+			return nil, err
+		}
 	}
 
 	return t, nil
@@ -105,6 +112,29 @@ func (t *rpcTransport) RaftMessage(args gogoproto.Message, callback func(gogopro
 	callback(&proto.RaftMessageResponse{}, err)
 }
 
+// RaftSnapshotChunk proxies the incoming snapshot chunk to the listening
+// server interface. Unlike raft messages, chunks are applied before the
+// callback is invoked; this happens in a separate goroutine so that the
+// RPC server's goroutine isn't held up.
+func (t *rpcTransport) RaftSnapshotChunk(args gogoproto.Message, callback func(gogoproto.Message, error)) {
+	req := args.(*proto.RaftSnapshotChunkRequest)
+
+	t.mu.Lock()
+	server, ok := t.servers[req.To]
+	t.mu.Unlock()
+
+	if !ok {
+		callback(nil, util.Errorf("Unable to proxy snapshot chunk to node: %d", req.To))
+		return
+	}
+
+	go func() {
+		resp := &proto.RaftSnapshotChunkResponse{}
+		err := server.RaftSnapshotChunk(req, resp)
+		callback(resp, err)
+	}()
+}
+
 // Listen implements the multiraft.Transport interface by registering a ServerInterface
 // to receive proxied messages.
 func (t *rpcTransport) Listen(id proto.RaftNodeID, server multiraft.ServerInterface) error {
@@ -222,6 +252,34 @@ func (t *rpcTransport) Send(req *multiraft.RaftMessageRequest) error {
 	return nil
 }
 
+// SendSnapshotChunk implements the multiraft.Transport interface. It sends
+// the chunk over a dedicated call and waits for the recipient to apply it.
+func (t *rpcTransport) SendSnapshotChunk(req *proto.RaftSnapshotChunkRequest) error {
+	nodeID, _ := proto.DecodeRaftNodeID(req.To)
+	addr, err := t.gossip.GetNodeIDAddress(nodeID)
+	if err != nil {
+		return util.Errorf("could not get address for node %d: %s", nodeID, err)
+	}
+	client := rpc.NewClient(addr, t.rpcContext)
+	select {
+	case <-t.rpcContext.Stopper.ShouldStop():
+		return util.Errorf("stopped")
+	case <-client.Closed:
+		return util.Errorf("raft client for node %d was closed", nodeID)
+	case <-client.Healthy():
+	}
+
+	call := client.Go(raftSnapshotChunkName, req, &proto.RaftSnapshotChunkResponse{}, nil)
+	select {
+	case <-t.rpcContext.Stopper.ShouldStop():
+		return util.Errorf("stopped")
+	case <-client.Closed:
+		return util.Errorf("raft client for node %d was closed", nodeID)
+	case <-call.Done:
+		return call.Error
+	}
+}
+
 // Close shuts down an rpcTransport.
 func (t *rpcTransport) Close() {
 	// No-op since we share the global cache of client connections.
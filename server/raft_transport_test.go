@@ -35,12 +35,14 @@ import (
 
 type channelServer struct {
 	ch       chan *multiraft.RaftMessageRequest
+	chunks   chan *proto.RaftSnapshotChunkRequest
 	maxSleep time.Duration
 }
 
 func newChannelServer(bufSize int, maxSleep time.Duration) channelServer {
 	return channelServer{
 		ch:       make(chan *multiraft.RaftMessageRequest, bufSize),
+		chunks:   make(chan *proto.RaftSnapshotChunkRequest, bufSize),
 		maxSleep: maxSleep,
 	}
 }
@@ -57,6 +59,12 @@ func (s channelServer) RaftMessage(req *multiraft.RaftMessageRequest,
 	return nil
 }
 
+func (s channelServer) RaftSnapshotChunk(req *proto.RaftSnapshotChunkRequest,
+	resp *proto.RaftSnapshotChunkResponse) error {
+	s.chunks <- req
+	return nil
+}
+
 func TestSendAndReceive(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stopper := stop.NewStopper()
@@ -223,3 +231,76 @@ func TestInOrderDelivery(t *testing.T) {
 		}
 	}
 }
+
+// TestSendSnapshotChunk verifies that snapshot chunks have been handed to
+// the recipient by the time SendSnapshotChunk returns.
+func TestSendSnapshotChunk(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+	nodeRPCContext := rpc.NewContext(nodeTestBaseContext, hlc.NewClock(hlc.UnixNano), stopper)
+	g := gossip.New(nodeRPCContext, gossip.TestInterval, gossip.TestBootstrap)
+
+	server := rpc.NewServer(util.CreateTestAddr("tcp"), nodeRPCContext)
+	if err := server.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	const numChunks = 10
+	protoNodeID := proto.NodeID(1)
+	raftNodeID := proto.MakeRaftNodeID(protoNodeID, 1)
+	serverTransport, err := newRPCTransport(g, server, nodeRPCContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverTransport.Close()
+	serverChannel := newChannelServer(numChunks, 0)
+	if err := serverTransport.Listen(raftNodeID, serverChannel); err != nil {
+		t.Fatal(err)
+	}
+	addr := server.Addr()
+	if err := g.AddInfo(gossip.MakeNodeIDKey(protoNodeID),
+		&proto.NodeDescriptor{
+			Address: util.MakeUnresolvedAddr(addr.Network(), addr.String()),
+		},
+		time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	clientTransport, err := newRPCTransport(g, nil, nodeRPCContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientTransport.Close()
+
+	for i := 0; i < numChunks; i++ {
+		req := &proto.RaftSnapshotChunkRequest{
+			GroupID: 1,
+			To:      raftNodeID,
+			Index:   5,
+			Seq:     uint64(i),
+			Last:    i == numChunks-1,
+			KV: []*proto.RaftSnapshotData_KeyValue{
+				{Key: []byte("a"), Value: []byte("b")},
+			},
+		}
+		if err := clientTransport.SendSnapshotChunk(req); err != nil {
+			t.Fatalf("failed to send chunk %d: %s", i, err)
+		}
+		select {
+		case chunk := <-serverChannel.chunks:
+			if chunk.Seq != uint64(i) || chunk.Last != req.Last || len(chunk.KV) != 1 {
+				t.Errorf("unexpected chunk %+v; expected %+v", chunk, req)
+			}
+		default:
+			t.Fatalf("chunk %d not received after SendSnapshotChunk returned", i)
+		}
+	}
+
+	// Chunks for an unknown recipient fail.
+	req := &proto.RaftSnapshotChunkRequest{GroupID: 1, To: proto.MakeRaftNodeID(protoNodeID, 2)}
+	if err := clientTransport.SendSnapshotChunk(req); err == nil {
+		t.Error("expected an error sending a chunk to an unknown store")
+	}
+}
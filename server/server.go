@@ -38,6 +38,7 @@ import (
 	"github.com/cockroachdb/cockroach/server/status"
 	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/pgwire"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/ui"
@@ -67,6 +68,7 @@ type Server struct {
 	db            *client.DB
 	kvDB          *kv.DBServer
 	sqlServer     *sql.Server
+	pgServer      *pgwire.Server
 	node          *Node
 	recorder      *status.NodeStatusRecorder
 	admin         *adminServer
@@ -140,6 +142,7 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	}
 
 	s.sqlServer = sql.NewServer(&s.ctx.Context, s.db)
+	s.pgServer = pgwire.NewServer(&s.ctx.Context, s.sqlServer)
 
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
@@ -161,9 +164,9 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	return s, nil
 }
 
-// Start runs the RPC and HTTP servers, starts the gossip instance (if
-// selfBootstrap is true, uses the rpc server's address as the gossip
-// bootstrap), and starts the node using the supplied engines slice.
+// Start runs the RPC, HTTP and PostgreSQL wire protocol servers, starts the
+// gossip instance (if selfBootstrap is true, uses the rpc server's address as
+// the gossip bootstrap), and starts the node using the supplied engines slice.
 func (s *Server) Start(selfBootstrap bool) error {
 	if err := s.rpc.Listen(); err != nil {
 		return util.Errorf("could not listen on %s: %s", s.ctx.Addr, err)
@@ -194,10 +197,19 @@ func (s *Server) Start(selfBootstrap bool) error {
 	// Begin recording status summaries.
 	s.startWriteSummaries()
 
+	// Begin heartbeating the SQL transactions opened by BEGIN and carrying
+	// out pending schema changes.
+	s.sqlServer.Start(s.stopper)
+
 	log.Infof("starting %s server at %s", s.ctx.RequestScheme(), s.rpc.Addr())
 	// TODO(spencer): go1.5 is supposed to allow shutdown of running http server.
 	s.initHTTP()
 	s.rpc.Serve(s)
+
+	if err := s.pgServer.Start(s.ctx.PGAddr, s.stopper); err != nil {
+		return err
+	}
+	log.Infof("starting postgres server at %s", s.pgServer.Addr())
 	return nil
 }
 
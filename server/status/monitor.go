@@ -18,6 +18,7 @@
 package status
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -42,8 +43,39 @@ type StoreStatusMonitor struct {
 	leaderRangeCount     int32
 	replicatedRangeCount int32
 	availableRangeCount  int32
+
+	// raft log size in bytes.
+	raftLogSize int64
+
+	// inconsistencies found by the consistency checker, by range.
+	inconsistencies map[proto.RangeID]storage.ReplicaInconsistency
+
+	// count of replicas destroyed after being quarantined.
+	quarantinedReplicaCount int64
+}
+
+// sortedInconsistencies returns the replica inconsistencies found on the
+// store, ordered by range ID. The caller must hold the monitor's lock.
+func (ssm *StoreStatusMonitor) sortedInconsistencies() []storage.ReplicaInconsistency {
+	if len(ssm.inconsistencies) == 0 {
+		return nil
+	}
+	result := make([]storage.ReplicaInconsistency, 0, len(ssm.inconsistencies))
+	for _, inconsistency := range ssm.inconsistencies {
+		result = append(result, inconsistency)
+	}
+	sort.Sort(inconsistenciesByRangeID(result))
+	return result
 }
 
+// inconsistenciesByRangeID implements sort.Interface for a slice of
+// ReplicaInconsistency, ordering by range ID.
+type inconsistenciesByRangeID []storage.ReplicaInconsistency
+
+func (s inconsistenciesByRangeID) Len() int           { return len(s) }
+func (s inconsistenciesByRangeID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s inconsistenciesByRangeID) Less(i, j int) bool { return s[i].RangeID < s[j].RangeID }
+
 // NodeStatusMonitor monitors the status of a server node. Status information
 // is collected from event feeds provided by lower level components.
 //
@@ -132,6 +164,17 @@ func (nsm *NodeStatusMonitor) OnRemoveRange(event *storage.RemoveRangeEvent) {
 	nsm.GetStoreMonitor(event.StoreID).removeRange(event)
 }
 
+// OnQuarantineRange receives QuarantineRangeEvents retrieved from a storage
+// event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnQuarantineRange(event *storage.QuarantineRangeEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.removeRange(&event.Removed)
+	ssm.Lock()
+	defer ssm.Unlock()
+	ssm.quarantinedReplicaCount++
+}
+
 // OnSplitRange receives SplitRangeEvents retrieved from a storage event
 // subscription. This method is part of the implementation of
 // store.StoreEventListener.
@@ -188,6 +231,24 @@ func (nsm *NodeStatusMonitor) OnReplicationStatus(event *storage.ReplicationStat
 	ssm.leaderRangeCount = event.LeaderRangeCount
 	ssm.replicatedRangeCount = event.ReplicatedRangeCount
 	ssm.availableRangeCount = event.AvailableRangeCount
+	ssm.raftLogSize = event.RaftLogSize
+}
+
+// OnReplicaInconsistency receives ReplicaInconsistencyEvents retrieved from a
+// storage event subscription. This method is part of the implementation of
+// store.StoreEventListener.
+func (nsm *NodeStatusMonitor) OnReplicaInconsistency(event *storage.ReplicaInconsistencyEvent) {
+	ssm := nsm.GetStoreMonitor(event.StoreID)
+	ssm.Lock()
+	defer ssm.Unlock()
+	if ssm.inconsistencies == nil {
+		ssm.inconsistencies = make(map[proto.RangeID]storage.ReplicaInconsistency)
+	}
+	ssm.inconsistencies[event.RangeID] = storage.ReplicaInconsistency{
+		RangeID:    event.RangeID,
+		DetectedAt: event.DetectedAt,
+		Diff:       event.Diff,
+	}
 }
 
 // OnStartNode receives StartNodeEvents from a node event subscription. This
@@ -120,6 +120,9 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []proto.TimeSeriesData {
 		data = append(data, ssr.recordInt("ranges.leader", int64(ssr.leaderRangeCount)))
 		data = append(data, ssr.recordInt("ranges.replicated", int64(ssr.replicatedRangeCount)))
 		data = append(data, ssr.recordInt("ranges.available", int64(ssr.availableRangeCount)))
+		data = append(data, ssr.recordInt("raftlog.size", ssr.raftLogSize))
+		data = append(data, ssr.recordInt("ranges.inconsistent", int64(len(ssr.inconsistencies))))
+		data = append(data, ssr.recordInt("replicas.quarantined", ssr.quarantinedReplicaCount))
 
 		// Record statistics from descriptor.
 		if ssr.desc != nil {
@@ -184,6 +187,8 @@ func (nsr *NodeStatusRecorder) GetStatusSummaries() (*NodeStatus, []storage.Stor
 			LeaderRangeCount:     ssm.leaderRangeCount,
 			ReplicatedRangeCount: ssm.replicatedRangeCount,
 			AvailableRangeCount:  ssm.availableRangeCount,
+			RaftLogSize:          ssm.raftLogSize,
+			Inconsistencies:      ssm.sortedInconsistencies(),
 		}
 		storeStats = append(storeStats, status)
 	})
@@ -164,6 +164,12 @@ func TestNodeStatusRecorder(t *testing.T) {
 		Stats:   stats,
 		Scan:    true,
 	})
+	monitor.OnRegisterRange(&storage.RegisterRangeEvent{
+		StoreID: proto.StoreID(2),
+		Desc:    desc2,
+		Stats:   stats,
+		Scan:    true,
+	})
 	monitor.OnEndScanRanges(&storage.EndScanRangesEvent{
 		StoreID: proto.StoreID(1),
 	})
@@ -181,12 +187,36 @@ func TestNodeStatusRecorder(t *testing.T) {
 		LeaderRangeCount:     1,
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
+		RaftLogSize:          300,
 	})
 	monitor.OnReplicationStatus(&storage.ReplicationStatusEvent{
 		StoreID:              proto.StoreID(2),
 		LeaderRangeCount:     1,
 		AvailableRangeCount:  2,
 		ReplicatedRangeCount: 0,
+		RaftLogSize:          200,
+	})
+	// Consistency checker events.
+	inconsistency := storage.ReplicaInconsistency{
+		RangeID:    proto.RangeID(2),
+		DetectedAt: 80,
+		Diff: []storage.ReplicaSnapshotDiff{
+			{Key: proto.Key("a"), Value: []byte("b")},
+		},
+	}
+	monitor.OnReplicaInconsistency(&storage.ReplicaInconsistencyEvent{
+		StoreID:    proto.StoreID(2),
+		RangeID:    inconsistency.RangeID,
+		DetectedAt: inconsistency.DetectedAt,
+		Diff:       inconsistency.Diff,
+	})
+	monitor.OnQuarantineRange(&storage.QuarantineRangeEvent{
+		StoreID: proto.StoreID(2),
+		Removed: storage.RemoveRangeEvent{
+			Desc:  desc2,
+			Stats: stats,
+		},
+		Cause: "corrupted",
 	})
 	// Node Events.
 	monitor.OnCallSuccess(&CallSuccessEvent{
@@ -246,6 +276,9 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(1, "ranges.leader", 100, 1),
 		generateStoreData(1, "ranges.available", 100, 2),
 		generateStoreData(1, "ranges.replicated", 100, 0),
+		generateStoreData(1, "raftlog.size", 100, 300),
+		generateStoreData(1, "ranges.inconsistent", 100, 0),
+		generateStoreData(1, "replicas.quarantined", 100, 0),
 		generateStoreData(1, "capacity", 100, 100),
 		generateStoreData(1, "capacity.available", 100, 50),
 
@@ -265,6 +298,9 @@ func TestNodeStatusRecorder(t *testing.T) {
 		generateStoreData(2, "ranges.leader", 100, 1),
 		generateStoreData(2, "ranges.available", 100, 2),
 		generateStoreData(2, "ranges.replicated", 100, 0),
+		generateStoreData(2, "raftlog.size", 100, 200),
+		generateStoreData(2, "ranges.inconsistent", 100, 1),
+		generateStoreData(2, "replicas.quarantined", 100, 1),
 		generateStoreData(2, "capacity", 100, 200),
 		generateStoreData(2, "capacity.available", 100, 75),
 
@@ -303,6 +339,7 @@ func TestNodeStatusRecorder(t *testing.T) {
 			LeaderRangeCount:     1,
 			AvailableRangeCount:  2,
 			ReplicatedRangeCount: 0,
+			RaftLogSize:          300,
 		},
 		{
 			Desc:                 storeDesc2,
@@ -313,6 +350,8 @@ func TestNodeStatusRecorder(t *testing.T) {
 			LeaderRangeCount:     1,
 			AvailableRangeCount:  2,
 			ReplicatedRangeCount: 0,
+			RaftLogSize:          200,
+			Inconsistencies:      []storage.ReplicaInconsistency{inconsistency},
 		},
 	}
 	// Use base stats to generate expected summary stat values.
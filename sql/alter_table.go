@@ -0,0 +1,163 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// schemaChangeInTransactionError returns the error for a schema change
+// statement executed inside a transaction block. The rows of the table are
+// rewritten using separate transactions which would not see the uncommitted
+// schema change.
+func schemaChangeInTransactionError(stmt string) error {
+	return fmt.Errorf("%s cannot run inside a transaction block", stmt)
+}
+
+// AlterTable adds columns to and drops columns from a table. The new columns
+// and the dropped columns are recorded as mutations in the table descriptor
+// and the existing rows are rewritten once the statement's transaction has
+// committed (see schemaChanger).
+// Privileges: CREATE on table.
+//   Notes: postgres requires ownership of the table.
+//          mysql requires ALTER, CREATE and INSERT on the table.
+func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
+	if p.session.Txn != nil {
+		return nil, schemaChangeInTransactionError("ALTER TABLE")
+	}
+
+	tableDesc, err := p.getTableDescIfExists(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if tableDesc == nil {
+		if n.IfExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", n.Table.Table())
+	}
+
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeCreate); err != nil {
+		return nil, err
+	}
+
+	for _, cmd := range n.Cmds {
+		switch t := cmd.(type) {
+		case *parser.AlterTableAddColumn:
+			d := t.ColumnDef
+			if d.PrimaryKey || d.Unique {
+				return nil, fmt.Errorf("column %q cannot be added with a PRIMARY KEY or UNIQUE constraint", d.Name)
+			}
+			if len(d.CheckExprs) > 0 {
+				return nil, fmt.Errorf("column %q cannot be added with a CHECK constraint", d.Name)
+			}
+			if d.References != nil {
+				return nil, fmt.Errorf("column %q cannot be added with a REFERENCES constraint", d.Name)
+			}
+			if d.Nullable == parser.NotNull && d.DefaultExpr == nil {
+				return nil, fmt.Errorf("column %q cannot be added as NOT NULL without a DEFAULT", d.Name)
+			}
+			col, err := makeColumnDefDescriptor(d)
+			if err != nil {
+				return nil, err
+			}
+			if col.DefaultExpr == nil {
+				// The column is NULL in all of the existing rows, so it can be made
+				// visible immediately.
+				tableDesc.Columns = append(tableDesc.Columns, *col)
+				continue
+			}
+			tableDesc.Mutations = append(tableDesc.Mutations, structured.DescriptorMutation{
+				Column:    col,
+				Direction: structured.DescriptorMutation_ADD,
+			})
+
+		case *parser.AlterTableDropColumn:
+			i, err := findColumnIndex(tableDesc, string(t.Column))
+			if err != nil {
+				if t.IfExists {
+					// Noop.
+					continue
+				}
+				return nil, err
+			}
+			col := tableDesc.Columns[i]
+			indexes := append([]structured.IndexDescriptor{tableDesc.PrimaryIndex}, writeIndexes(tableDesc)...)
+			for _, index := range indexes {
+				for _, id := range index.ColumnIDs {
+					if id == col.ID {
+						return nil, fmt.Errorf("column %q is referenced by index %q", col.Name, index.Name)
+					}
+				}
+			}
+			for _, check := range tableDesc.Checks {
+				ids, err := checkColumnIDs(tableDesc, check)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := ids[col.ID]; ok {
+					return nil, fmt.Errorf("column %q is referenced by check constraint %s", col.Name, checkName(check))
+				}
+			}
+			for _, fk := range tableDesc.ForeignKeys {
+				for _, id := range fk.ColumnIDs {
+					if id == col.ID {
+						return nil, fmt.Errorf("column %q is referenced by foreign key %q", col.Name, fk.Name)
+					}
+				}
+			}
+			// The column is no longer visible or written while its values are
+			// deleted.
+			tableDesc.Columns = append(tableDesc.Columns[:i], tableDesc.Columns[i+1:]...)
+			tableDesc.Mutations = append(tableDesc.Mutations, structured.DescriptorMutation{
+				Column:    &col,
+				Direction: structured.DescriptorMutation_DROP,
+			})
+
+		default:
+			return nil, fmt.Errorf("unsupported alter command: %T", cmd)
+		}
+	}
+
+	if err := tableDesc.AllocateIDs(); err != nil {
+		return nil, err
+	}
+	tableDesc.Version++
+	if err := p.txn.Put(structured.MakeDescMetadataKey(tableDesc.GetID()), tableDesc); err != nil {
+		return nil, err
+	}
+	if len(tableDesc.Mutations) > 0 {
+		// Mutations left behind by an earlier statement whose schema change was
+		// interrupted are carried out as well.
+		p.schemaChanges = append(p.schemaChanges, tableDesc.ID)
+	}
+	return &valuesNode{}, nil
+}
+
+// findColumnIndex returns the index of the column with the specified name
+// within the visible columns of the table.
+func findColumnIndex(desc *structured.TableDescriptor, name string) (int, error) {
+	for i, c := range desc.Columns {
+		if c.Name == name {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("column \"%s\" does not exist", name)
+}
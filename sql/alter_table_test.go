@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestAlterTableColumns(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (
+  k CHAR PRIMARY KEY,
+  v CHAR
+);
+INSERT INTO t.kv VALUES ('a', 'b'), ('c', 'd'), ('e', NULL);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first `MaxReservedDescID` (plus 0) are set aside.
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("TableDescriptor %q does not exist", nameKey)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	var tablePrefix []byte
+	tablePrefix = append(tablePrefix, keys.TableDataPrefix...)
+	tablePrefix = encoding.EncodeUvarint(tablePrefix, uint64(desc.ID))
+
+	tableStartKey := proto.Key(tablePrefix)
+	tableEndKey := tableStartKey.PrefixEnd()
+	checkKVs := func(l int) {
+		if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
+			t.Fatal(err)
+		} else if len(kvs) != l {
+			t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+		}
+	}
+	checkKVs(5)
+
+	// The default value is backfilled into each of the existing rows.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv ADD COLUMN w CHAR DEFAULT 'x'`); err != nil {
+		t.Fatal(err)
+	}
+	checkKVs(8)
+
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if len(desc.Mutations) != 0 {
+		t.Fatalf("expected no mutations, but found %v", desc.Mutations)
+	}
+	if e, a := 4, int(desc.NextColumnID); e != a {
+		t.Fatalf("expected next column ID %d, but found %d", e, a)
+	}
+
+	// The values of a dropped column are deleted.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv DROP COLUMN v`); err != nil {
+		t.Fatal(err)
+	}
+	checkKVs(6)
+
+	// Column IDs are not reused.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv ADD COLUMN v CHAR`); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	col, err := desc.FindColumnByName("v")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, a := structured.ColumnID(4), col.ID; e != a {
+		t.Fatalf("expected column ID %d, but found %d", e, a)
+	}
+	checkKVs(6)
+}
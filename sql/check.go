@@ -0,0 +1,235 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// checkHelper evaluates the CHECK constraints of a table against the rows
+// written by an insert or an update. The expressions of the constraints are
+// parsed once; the column references in them are replaced by expressions
+// which hold the values of the row being checked.
+type checkHelper struct {
+	desc  *structured.TableDescriptor
+	exprs []parser.Expr
+	qvals qvalMap
+}
+
+// makeCheckHelper parses the CHECK constraints of the table.
+func makeCheckHelper(desc *structured.TableDescriptor) (*checkHelper, error) {
+	c := &checkHelper{
+		desc:  desc,
+		qvals: qvalMap{},
+	}
+	for _, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if expr, err = c.resolve(expr); err != nil {
+			return nil, err
+		}
+		c.exprs = append(c.exprs, expr)
+	}
+	return c, nil
+}
+
+// check returns an error if the row violates a CHECK constraint of the
+// table. A constraint is violated if its expression evaluates to false;
+// NULL satisfies the constraint.
+func (c *checkHelper) check(colIDtoRowIndex map[structured.ColumnID]int,
+	values []parser.Datum) error {
+	if len(c.exprs) == 0 {
+		return nil
+	}
+	for id, qval := range c.qvals {
+		qval.Expr = parser.DNull
+		if i, ok := colIDtoRowIndex[id]; ok {
+			qval.Expr = values[i]
+		}
+	}
+	for i, expr := range c.exprs {
+		d, err := parser.EvalExpr(expr)
+		if err != nil {
+			return err
+		}
+		switch d {
+		case parser.DBool(true), parser.DNull:
+			continue
+		case parser.DBool(false):
+			return fmt.Errorf("new row for table %q violates check constraint %s",
+				c.desc.Name, checkName(c.desc.Checks[i]))
+		default:
+			return fmt.Errorf("CHECK expression %s must be of type bool, but found %s",
+				c.desc.Checks[i].Expr, d.Type())
+		}
+	}
+	return nil
+}
+
+// checkName returns the quoted name of a CHECK constraint or, if the
+// constraint is unnamed, its definition.
+func checkName(check structured.CheckConstraint) string {
+	if check.Name != "" {
+		return fmt.Sprintf("%q", check.Name)
+	}
+	return fmt.Sprintf("CHECK (%s)", check.Expr)
+}
+
+// resolve replaces the column references in expr.
+func (c *checkHelper) resolve(expr parser.Expr) (parser.Expr, error) {
+	v := checkVisitor{checkHelper: c}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.err
+}
+
+type checkVisitor struct {
+	*checkHelper
+	err error
+}
+
+var _ parser.Visitor = &checkVisitor{}
+
+func (v *checkVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	qname, ok := expr.(*parser.QualifiedName)
+	if !ok {
+		return expr
+	}
+	if v.err = qname.NormalizeColumnName(); v.err != nil {
+		return expr
+	}
+	col, err := v.desc.FindColumnByName(qname.Column())
+	if err != nil {
+		v.err = err
+		return expr
+	}
+	qval := v.qvals[col.ID]
+	if qval == nil {
+		qval = &parser.ParenExpr{Expr: parser.DNull}
+		v.qvals[col.ID] = qval
+	}
+	return qval
+}
+
+// checkColumnIDs returns the IDs of the columns referenced by a CHECK
+// constraint of the table.
+func checkColumnIDs(desc *structured.TableDescriptor,
+	check structured.CheckConstraint) (map[structured.ColumnID]struct{}, error) {
+	expr, err := parser.ParseExpr(check.Expr)
+	if err != nil {
+		return nil, err
+	}
+	c := &checkHelper{desc: desc, qvals: qvalMap{}}
+	if _, err := c.resolve(expr); err != nil {
+		return nil, err
+	}
+	ids := map[structured.ColumnID]struct{}{}
+	for id := range c.qvals {
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+// makeCheckConstraint returns the descriptor of a CHECK constraint of the
+// table. The column references of the expression must be to columns of the
+// table and are stored without the table name, so that they are not affected
+// by renaming the table.
+func makeCheckConstraint(desc structured.TableDescriptor, name parser.Name,
+	expr parser.Expr) (structured.CheckConstraint, error) {
+	v := checkNormalizer{desc: &desc}
+	expr = parser.WalkExpr(&v, expr)
+	if v.err != nil {
+		return structured.CheckConstraint{}, v.err
+	}
+	return structured.CheckConstraint{Name: string(name), Expr: expr.String()}, nil
+}
+
+// checkNormalizer rejects the expressions which are not allowed in a CHECK
+// constraint and strips the table name from column references.
+type checkNormalizer struct {
+	desc *structured.TableDescriptor
+	err  error
+}
+
+var _ parser.Visitor = &checkNormalizer{}
+
+func (v *checkNormalizer) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	switch t := expr.(type) {
+	case *parser.Subquery, *parser.ExistsExpr:
+		v.err = fmt.Errorf("subqueries are not allowed in CHECK constraints")
+	case *parser.FuncExpr:
+		if _, ok := parser.Aggregates[strings.ToLower(t.Name.String())]; ok {
+			v.err = fmt.Errorf("aggregate functions are not allowed in CHECK constraints")
+		}
+	case parser.ValArg:
+		v.err = fmt.Errorf("placeholders are not allowed in CHECK constraints")
+	case *parser.QualifiedName:
+		if v.err = t.NormalizeColumnName(); v.err != nil {
+			return expr
+		}
+		if t.IsStar() || len(t.Indirect) != 1 ||
+			(t.Base != "" && !strings.EqualFold(string(t.Base), v.desc.Name)) {
+			v.err = fmt.Errorf("qualified name \"%s\" not found", t)
+			return expr
+		}
+		if _, v.err = v.desc.FindColumnByName(t.Column()); v.err != nil {
+			return expr
+		}
+		return &parser.QualifiedName{Base: parser.Name(t.Column())}
+	}
+	return expr
+}
+
+// renameCheckColumn rewrites the references to a renamed column in the CHECK
+// constraints of the table.
+func renameCheckColumn(desc *structured.TableDescriptor, oldName, newName string) error {
+	for i, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return err
+		}
+		v := columnRenamer{oldName: oldName, newName: newName}
+		desc.Checks[i].Expr = parser.WalkExpr(&v, expr).String()
+	}
+	return nil
+}
+
+// columnRenamer renames the column references of an expression stored by
+// makeCheckConstraint.
+type columnRenamer struct {
+	oldName, newName string
+}
+
+var _ parser.Visitor = &columnRenamer{}
+
+func (v *columnRenamer) Visit(expr parser.Expr) parser.Expr {
+	if qname, ok := expr.(*parser.QualifiedName); ok && len(qname.Indirect) == 0 &&
+		string(qname.Base) == v.oldName {
+		return &parser.QualifiedName{Base: parser.Name(v.newName)}
+	}
+	return expr
+}
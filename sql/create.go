@@ -19,9 +19,12 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
 )
 
 // CreateDatabase creates a database.
@@ -37,6 +40,14 @@ func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, error) {
 		return nil, fmt.Errorf("only %s is allowed to create databases", security.RootUser)
 	}
 
+	if isVirtualDatabase(string(n.Name)) {
+		if n.IfNotExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("database %q already exists", string(n.Name))
+	}
+
 	desc := makeDatabaseDesc(n)
 
 	if err := p.writeDescriptor(databaseKey{string(n.Name)}, &desc, n.IfNotExists); err != nil {
@@ -46,7 +57,7 @@ func (p *planner) CreateDatabase(n *parser.CreateDatabase) (planNode, error) {
 }
 
 // CreateTable creates a table.
-// Privileges: WRITE on database.
+// Privileges: CREATE on database. The table inherits the database privileges.
 //   Notes: postgres/mysql require CREATE on database.
 func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
@@ -58,9 +69,8 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 		return nil, err
 	}
 
-	if !dbDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on database %s",
-			p.user, parser.PrivilegeWrite, dbDesc.Name)
+	if err := p.checkPrivilege(dbDesc, parser.PrivilegeCreate); err != nil {
+		return nil, err
 	}
 
 	desc, err := makeTableDesc(n)
@@ -68,14 +78,83 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 		return nil, err
 	}
 	// Inherit permissions from the database descriptor.
-	desc.PrivilegeDescriptor = dbDesc.PrivilegeDescriptor
+	desc.PrivilegeDescriptor = dbDesc.PrivilegeDescriptor.Copy()
 
 	if err := desc.AllocateIDs(); err != nil {
 		return nil, err
 	}
+	referenced, err := p.resolveForeignKeys(&desc, n)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := p.writeDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
+	if desc.ID == 0 {
+		// The table already exists.
+		return &valuesNode{}, nil
+	}
+
+	// Record the new table in the tables it references, which need to find
+	// the rows referencing their deleted rows.
+	b := client.Batch{}
+	for _, refDesc := range referenced {
+		refDesc.ReferencedBy = append(refDesc.ReferencedBy, desc.ID)
+		refDesc.Version++
+		b.Put(structured.MakeDescMetadataKey(refDesc.ID), refDesc)
+	}
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// CreateIndex creates an index on an existing table. The index is recorded as
+// a mutation in the table descriptor and is backfilled from the rows of the
+// table once the statement's transaction has committed (see schemaChanger).
+// Privileges: CREATE on table.
+//   Notes: postgres requires CREATE on the table.
+//          mysql requires INDEX on the table.
+func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
+	if p.session.Txn != nil {
+		return nil, schemaChangeInTransactionError("CREATE INDEX")
+	}
+
+	tableDesc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeCreate); err != nil {
+		return nil, err
+	}
+
+	if n.IfNotExists {
+		indexes := append([]structured.IndexDescriptor{tableDesc.PrimaryIndex}, writeIndexes(tableDesc)...)
+		for _, index := range indexes {
+			if strings.EqualFold(index.Name, string(n.Name)) {
+				// Noop.
+				return &valuesNode{}, nil
+			}
+		}
+	}
+
+	tableDesc.Mutations = append(tableDesc.Mutations, structured.DescriptorMutation{
+		Index: &structured.IndexDescriptor{
+			Name:        string(n.Name),
+			Unique:      n.Unique,
+			ColumnNames: n.Columns,
+		},
+		Direction: structured.DescriptorMutation_ADD,
+	})
+	if err := tableDesc.AllocateIDs(); err != nil {
+		return nil, err
+	}
+	tableDesc.Version++
+	if err := p.txn.Put(structured.MakeDescMetadataKey(tableDesc.GetID()), tableDesc); err != nil {
+		return nil, err
+	}
+	p.schemaChanges = append(p.schemaChanges, tableDesc.ID)
 	return &valuesNode{}, nil
 }
@@ -18,8 +18,10 @@
 package sql
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/cockroachdb/cockroach/proto"
-	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 )
@@ -39,19 +41,35 @@ func (dk databaseKey) Name() string {
 
 func makeDatabaseDesc(p *parser.CreateDatabase) structured.DatabaseDescriptor {
 	return structured.DatabaseDescriptor{
-		Name: p.Name.String(),
-		PrivilegeDescriptor: structured.PrivilegeDescriptor{
-			Read:  []string{security.RootUser},
-			Write: []string{security.RootUser},
-		},
+		Name:                p.Name.String(),
+		PrivilegeDescriptor: structured.NewDefaultPrivilegeDescriptor(),
 	}
 }
 
 // getDatabaseDesc looks up the database descriptor given its name.
 func (p *planner) getDatabaseDesc(name string) (*structured.DatabaseDescriptor, error) {
+	if isVirtualDatabase(name) {
+		return makeVirtualDatabaseDesc(), nil
+	}
 	desc := structured.DatabaseDescriptor{}
 	if err := p.getDescriptor(databaseKey{name}, &desc); err != nil {
 		return nil, err
 	}
 	return &desc, nil
 }
+
+// getDatabaseNames returns the names of all the databases, including the
+// virtual information_schema database, in sorted order.
+func (p *planner) getDatabaseNames() ([]string, error) {
+	prefix := structured.MakeNameMetadataKey(structured.RootNamespaceID, "")
+	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{informationSchemaName}
+	for _, row := range sr {
+		names = append(names, string(bytes.TrimPrefix(row.Key, prefix)))
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -40,10 +40,7 @@ func TestMakeDatabaseDesc(t *testing.T) {
 	if desc.ID != 0 {
 		t.Fatalf("expected ID == 0, got %d", desc.ID)
 	}
-	if len(desc.Read) != 1 || desc.Read[0] != security.RootUser {
-		t.Fatalf("expected read == [root], got: %v", desc.Read)
-	}
-	if len(desc.Write) != 1 || desc.Write[0] != security.RootUser {
-		t.Fatalf("expected write == [root], got: %v", desc.Write)
+	if !desc.HasPrivilege(security.RootUser, nil, parser.PrivilegeAll) {
+		t.Fatalf("expected root to have ALL privileges, got: %+v", desc.Users)
 	}
 }
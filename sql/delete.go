@@ -19,7 +19,6 @@ package sql
 
 import (
 	"bytes"
-	"fmt"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
@@ -29,7 +28,7 @@ import (
 )
 
 // Delete deletes rows from a table.
-// Privileges: WRITE and READ on table. We currently always use a SELECT statement.
+// Privileges: DELETE and SELECT on table. We currently always use a SELECT statement.
 //   Notes: postgres requires DELETE. Also requires SELECT for "USING" and "WHERE" with tables.
 //          mysql requires DELETE. Also requires SELECT if a table is used in the "WHERE" clause.
 func (p *planner) Delete(n *parser.Delete) (planNode, error) {
@@ -38,9 +37,8 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		return nil, err
 	}
 
-	if !tableDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-			p.user, parser.PrivilegeWrite, tableDesc.Name)
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeDelete); err != nil {
+		return nil, err
 	}
 
 	// TODO(tamird,pmattis): avoid going through Select to avoid encoding
@@ -55,36 +53,54 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := p.deleteRows(tableDesc, node); err != nil {
+		return nil, err
+	}
+
+	// TODO(tamird/pmattis): return the number of affected rows
+	return &valuesNode{}, nil
+}
 
+// deleteRows deletes the rows of the table produced by node, which must
+// contain all the columns of the table. The ON DELETE actions of the foreign
+// keys referencing the table are applied to the deleted rows.
+func (p *planner) deleteRows(tableDesc *structured.TableDescriptor, node planNode) error {
 	// Construct a map from column ID to the index the value appears at within a
 	// row.
 	colIDtoRowIndex := map[structured.ColumnID]int{}
 	for i, name := range node.Columns() {
 		c, err := tableDesc.FindColumnByName(name)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		colIDtoRowIndex[c.ID] = i
 	}
 
 	primaryIndex := tableDesc.PrimaryIndex
 	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	indexes := writeIndexes(tableDesc)
+
+	fks := p.makeFKHelper(tableDesc)
+	var deleted [][]parser.Datum
 
 	b := client.Batch{}
 
 	for node.Next() {
 		values := node.Values()
+		if fks.isReferenced() {
+			deleted = append(deleted, append([]parser.Datum(nil), values...))
+		}
 
 		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
 
 		// Delete the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
+		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		for _, secondaryIndexEntry := range secondaryIndexEntries {
@@ -104,13 +120,17 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	}
 
 	if err := node.Err(); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := p.txn.Run(&b); err != nil {
-		return nil, err
+		return err
 	}
 
-	// TODO(tamird/pmattis): return the number of affected rows
-	return &valuesNode{}, nil
+	for _, values := range deleted {
+		if err := fks.deleted(colIDtoRowIndex, values); err != nil {
+			return err
+		}
+	}
+	return nil
 }
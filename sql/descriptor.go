@@ -47,13 +47,14 @@ type descriptorProto interface {
 	gogoproto.Message
 	Grant(*parser.Grant) error
 	Revoke(*parser.Revoke) error
-	Show() (structured.UserPrivilegeList, error)
-	HasPrivilege(string, parser.PrivilegeType) bool
+	Show() ([]structured.UserPrivilegeString, error)
+	HasPrivilege(string, []string, parser.PrivilegeType) bool
 	GetID() structured.ID
 	SetID(structured.ID)
 	TypeName() string
 	GetName() string
 	SetName(string)
+	ConvertLegacyPrivileges() error
 	Validate() error
 }
 
@@ -108,39 +109,55 @@ func (p *planner) getDescriptor(plainKey descriptorKey, descriptor descriptorPro
 	if err := p.txn.GetProto(descKey, descriptor); err != nil {
 		return err
 	}
+	if err := descriptor.ConvertLegacyPrivileges(); err != nil {
+		return err
+	}
 
 	return descriptor.Validate()
 }
 
-// getDescriptorFromTargetList examines a TargetList and fetches the
-// appropriate descriptor.
-// TODO(marc): support multiple targets.
-func (p *planner) getDescriptorFromTargetList(targets parser.TargetList) (descriptorProto, error) {
+// checkPrivilege returns an error unless the planner's user holds
+// `privilege` on `descriptor`.
+func (p *planner) checkPrivilege(descriptor descriptorProto, privilege parser.PrivilegeType) error {
+	if !descriptor.HasPrivilege(p.user, p.roles, privilege) {
+		return fmt.Errorf("user %s does not have %s privilege on %s %s",
+			p.user, privilege, descriptor.TypeName(), descriptor.GetName())
+	}
+	return nil
+}
+
+// getDescriptorsFromTargetList examines a TargetList and fetches the
+// appropriate descriptors.
+func (p *planner) getDescriptorsFromTargetList(targets parser.TargetList) ([]descriptorProto, error) {
 	if targets.Databases != nil {
 		if len(targets.Databases) == 0 {
 			return nil, errNoDatabase
-		} else if len(targets.Databases) != 1 {
-			return nil, util.Errorf("TODO(marc): multiple targets not implemented")
 		}
-		descriptor, err := p.getDatabaseDesc(targets.Databases[0])
-		if err != nil {
-			return nil, err
+		descs := make([]descriptorProto, 0, len(targets.Databases))
+		for _, database := range targets.Databases {
+			descriptor, err := p.getDatabaseDesc(database)
+			if err != nil {
+				return nil, err
+			}
+			descs = append(descs, descriptor)
 		}
-		return descriptor, nil
+		return descs, nil
 	}
 
 	if targets.Tables == nil {
-		return nil, util.Errorf("no targets speciied")
+		return nil, util.Errorf("no targets specified")
 	}
 
 	if len(targets.Tables) == 0 {
 		return nil, errNoTable
-	} else if len(targets.Tables) != 1 {
-		return nil, util.Errorf("TODO(marc): multiple targets not implemented")
 	}
-	descriptor, err := p.getTableDesc(targets.Tables[0])
-	if err != nil {
-		return nil, err
+	descs := make([]descriptorProto, 0, len(targets.Tables))
+	for _, table := range targets.Tables {
+		descriptor, err := p.getTableDesc(table)
+		if err != nil {
+			return nil, err
+		}
+		descs = append(descs, descriptor)
 	}
-	return descriptor, nil
+	return descs, nil
 }
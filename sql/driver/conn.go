@@ -35,23 +35,56 @@ func (c *conn) Close() error {
 	return nil
 }
 
+// Prepare prepares the statement on the server, which infers the types of
+// its placeholders.
 func (c *conn) Prepare(query string) (driver.Stmt, error) {
-	return &stmt{conn: c, stmt: query}, nil
+	resp, err := c.call(Request{
+		RequestHeader: RequestHeader{Session: c.session},
+		Sql:           query,
+		Prepare:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{
+		conn:     c,
+		id:       resp.Prepared.ID,
+		numInput: len(resp.Prepared.PlaceholderTypes),
+	}, nil
 }
 
 func (c *conn) Begin() (driver.Tx, error) {
+	if _, err := c.Exec("BEGIN TRANSACTION", nil); err != nil {
+		return nil, err
+	}
 	return &tx{conn: c}, nil
 }
 
 func (c *conn) Exec(stmt string, args []driver.Value) (driver.Result, error) {
-	rows, err := c.Query(stmt, args)
+	rows, err := c.query(stmt, args)
 	if err != nil {
 		return nil, err
 	}
 	return driver.RowsAffected(len(rows.rows)), nil
 }
 
-func (c *conn) Query(stmt string, args []driver.Value) (*rows, error) {
+// Query implements the driver.Queryer interface, which allows queries to be
+// executed without preparing them first.
+func (c *conn) Query(stmt string, args []driver.Value) (driver.Rows, error) {
+	return c.query(stmt, args)
+}
+
+func (c *conn) query(stmt string, args []driver.Value) (*rows, error) {
+	return c.send(Request{
+		RequestHeader: RequestHeader{Session: c.session},
+		Sql:           stmt,
+		Params:        makeParams(args),
+	})
+}
+
+// makeParams converts the arguments of a statement into the parameters of a
+// request.
+func makeParams(args []driver.Value) []Datum {
 	params := make([]Datum, 0, len(args))
 	for _, arg := range args {
 		var param Datum
@@ -67,33 +100,36 @@ func (c *conn) Query(stmt string, args []driver.Value) (*rows, error) {
 		case string:
 			param.StringVal = &value
 		case time.Time:
-			// TODO(vivek): pass in time as an input that can be interpreted
-			// by the server.
-			time, err := value.MarshalBinary()
-			if err != nil {
-				return nil, err
-			}
-			param.BytesVal = time
+			param.TimeVal = TimestampFromTime(value)
 		}
 		params = append(params, param)
 	}
-	return c.send(Request{
-		RequestHeader: RequestHeader{Session: c.session},
-		Sql:           stmt,
-		Params:        params,
-	})
+	return params
 }
 
-// send sends the call to the server.
-func (c *conn) send(args Request) (*rows, error) {
+// call sends the call to the server and returns its response.
+func (c *conn) call(args Request) (Response, error) {
 	resp, err := c.sender.Send(args)
 	if err != nil {
-		return nil, err
+		return resp, err
+	}
+	// The session is updated even if an error occurred, which may have aborted
+	// the current transaction.
+	if resp.Session != nil {
+		c.session = resp.Session
 	}
 	if resp.Error != nil {
-		return nil, resp.Error
+		return resp, resp.Error
+	}
+	return resp, nil
+}
+
+// send sends the call to the server and returns the rows of its last result.
+func (c *conn) send(args Request) (*rows, error) {
+	resp, err := c.call(args)
+	if err != nil {
+		return nil, err
 	}
-	c.session = resp.Session
 	// Translate into rows
 	r := &rows{}
 	// Only use the last result to populate the response
@@ -120,6 +156,16 @@ func (c *conn) send(args Request) (*rows, error) {
 				t[j] = datum.BytesVal
 			} else if datum.StringVal != nil {
 				t[j] = []byte(*datum.StringVal)
+			} else if datum.TimeVal != nil {
+				t[j] = datum.TimeVal.GoTime()
+			} else if datum.DateVal != nil {
+				t[j] = time.Unix(int64(*datum.DateVal)*secondsInDay, 0).UTC()
+			} else if datum.IntervalVal != nil {
+				// database/sql has no type for intervals, which are returned
+				// in the format accepted by time.ParseDuration.
+				t[j] = []byte(datum.IntervalVal.String())
+			} else if datum.DecimalVal != nil {
+				t[j] = []byte(*datum.DecimalVal)
 			}
 			if !driver.IsScanValue(t[j]) {
 				panic(fmt.Sprintf("unsupported type %T returned by database", t[j]))
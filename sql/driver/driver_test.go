@@ -200,6 +200,183 @@ func TestPlaceholders(t *testing.T) {
 	if _, err := db.Query("SELECT a, b FROM t.alltypes WHERE d IN ($1)", true); err != nil {
 		t.Fatal(err)
 	}
+
+	// Arguments are checked against the types inferred for their placeholders.
+	if _, err := db.Query("SELECT a FROM t.alltypes WHERE b = $1", 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.alltypes (a, b) VALUES ($1, $2)`, "x", 1.5); !isError(err,
+		`placeholder \$1: expected a value of type int, but found type string`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := db.Exec(`UPDATE t.alltypes SET d = $1`, 1); !isError(err,
+		`placeholder \$1: expected a value of type bool, but found type int`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := db.Query("SELECT a FROM t.alltypes WHERE d = $1 OR c = $1", true); !isError(err,
+		`placeholder \$1 is used as both bool and string`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	ins, err := db.Prepare(`INSERT INTO t.alltypes (a, b) VALUES ($1, $2)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ins.Exec(1, "y"); !isError(err,
+		`placeholder \$2: expected a value of type float, but found type string`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := ins.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPreparedStatements(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.kv (k INT PRIMARY KEY, v TEXT)`); err != nil {
+		t.Fatal(err)
+	}
+
+	insert, err := db.Prepare(`INSERT INTO t.kv VALUES ($1, $2)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer insert.Close()
+	if _, err := insert.Exec(1, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := insert.Exec(2, "b"); err != nil {
+		t.Fatal(err)
+	}
+	// The number of placeholders of the statement is known.
+	if _, err := insert.Exec(3); !isError(err, "expected 2 arguments, got 1") {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	sel, err := db.Prepare(`SELECT * FROM t.kv WHERE k = $1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sel.Close()
+	for _, d := range []struct {
+		k        int
+		expected [][]string
+	}{
+		{1, [][]string{{"k", "v"}, {"1", "a"}}},
+		{2, [][]string{{"k", "v"}, {"2", "b"}}},
+		{3, [][]string{{"k", "v"}}},
+	} {
+		rows, err := sel.Query(d.k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := verifyResults(asResultSlice(d.expected), readAll(t, rows)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A prepared statement sees the changes to the schema of its tables.
+	if _, err := db.Exec(`ALTER TABLE t.kv ADD COLUMN w INT`); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := sel.Query(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := asResultSlice([][]string{{"k", "v", "w"}, {"1", "a", ""}})
+	expected[1][2] = nil
+	if err := verifyResults(expected, readAll(t, rows)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Prepare(`SELECT * FROM t.missing`); !isError(err, `table "missing" does not exist`) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := db.Prepare(`SELECT 1; SELECT 2`); !isError(err, "expected 1 statement, but found 2") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR)`); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func(q interface {
+		Query(string, ...interface{}) (*sql.Rows, error)
+	}) resultSlice {
+		rows, err := q.Query(`SELECT COUNT(*) FROM t.kv`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return readAll(t, rows)
+	}
+
+	// The writes of a transaction are visible within the transaction and are
+	// discarded by a rollback.
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); err != nil {
+		t.Fatal(err)
+	}
+	expected := asResultSlice([][]string{{"COUNT(*)"}, {"1"}})
+	if err := verifyResults(expected, count(tx)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	expected = asResultSlice([][]string{{"COUNT(*)"}, {"0"}})
+	if err := verifyResults(expected, count(db)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b'), ('c', 'd')`); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	expected = asResultSlice([][]string{{"COUNT(*)"}, {"2"}})
+	if err := verifyResults(expected, count(db)); err != nil {
+		t.Fatal(err)
+	}
+
+	// A failed statement aborts the transaction.
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); !isError(err, "duplicate key value") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('e', 'f')`); !isError(err, "current transaction is aborted") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyResults(expected, count(db)); err != nil {
+		t.Fatal(err)
+	}
 }
 
 func TestInsecure(t *testing.T) {
@@ -19,24 +19,43 @@ package driver
 
 import "database/sql/driver"
 
+// stmt implements the sql/driver.Stmt interface for a statement prepared by
+// conn.Prepare. The statement is kept in the session of the connection.
 type stmt struct {
-	conn *conn
-	stmt string
+	conn     *conn
+	id       uint32
+	numInput int
 }
 
 func (s *stmt) Close() error {
-	return nil
+	_, err := s.conn.call(Request{
+		RequestHeader: RequestHeader{Session: s.conn.session},
+		StmtID:        s.id,
+		Deallocate:    true,
+	})
+	return err
 }
 
 func (s *stmt) NumInput() int {
-	// TODO(pmattis): Count the number of parameters.
-	return -1
+	return s.numInput
 }
 
 func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
-	return s.conn.Exec(s.stmt, args)
+	rows, err := s.execute(args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(len(rows.rows)), nil
 }
 
 func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
-	return s.conn.Query(s.stmt, args)
+	return s.execute(args)
+}
+
+func (s *stmt) execute(args []driver.Value) (*rows, error) {
+	return s.conn.send(Request{
+		RequestHeader: RequestHeader{Session: s.conn.session},
+		StmtID:        s.id,
+		Params:        makeParams(args),
+	})
 }
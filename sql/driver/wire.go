@@ -17,14 +17,34 @@
 
 package driver
 
-import "strconv"
+import (
+	"strconv"
+	"time"
+)
 
 const (
 	// Endpoint is the URL path prefix which accepts incoming
 	// HTTP requests for the SQL API.
 	Endpoint = "/sql/"
+
+	secondsInDay = 24 * 60 * 60
 )
 
+// Decimal is the string representation of an exact numeric value, such as
+// "-12.50". Go's database/sql has no decimal type, so decimals are returned
+// to clients as strings.
+type Decimal string
+
+// TimestampFromTime returns the wire representation of t.
+func TimestampFromTime(t time.Time) *Datum_Timestamp {
+	return &Datum_Timestamp{Sec: t.Unix(), Nsec: uint32(t.Nanosecond())}
+}
+
+// GoTime returns the time.Time, in UTC, represented by t.
+func (t Datum_Timestamp) GoTime() time.Time {
+	return time.Unix(t.Sec, int64(t.Nsec)).UTC()
+}
+
 func (d Datum) String() string {
 	if d.BoolVal != nil {
 		if *d.BoolVal {
@@ -44,6 +64,18 @@ func (d Datum) String() string {
 	if d.StringVal != nil {
 		return *d.StringVal
 	}
+	if d.TimeVal != nil {
+		return d.TimeVal.GoTime().Format(time.RFC3339Nano)
+	}
+	if d.DateVal != nil {
+		return time.Unix(int64(*d.DateVal)*secondsInDay, 0).UTC().Format("2006-01-02")
+	}
+	if d.IntervalVal != nil {
+		return d.IntervalVal.String()
+	}
+	if d.DecimalVal != nil {
+		return string(*d.DecimalVal)
+	}
 	return "NULL"
 }
 
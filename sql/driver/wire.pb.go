@@ -3,18 +3,21 @@
 // DO NOT EDIT!
 
 /*
-	Package driver is a generated protocol buffer package.
-
-	It is generated from these files:
-		cockroach/sql/driver/wire.proto
-
-	It has these top-level messages:
-		RequestHeader
-		ResponseHeader
-		Datum
-		Result
-		Request
-		Response
+Package driver is a generated protocol buffer package.
+
+It is generated from these files:
+
+	cockroach/sql/driver/wire.proto
+
+It has these top-level messages:
+
+	RequestHeader
+	ResponseHeader
+	Datum
+	Result
+	Request
+	PreparedStatement
+	Response
 */
 package driver
 
@@ -27,6 +30,8 @@ import cockroach_proto2 "github.com/cockroachdb/cockroach/proto"
 
 import io "io"
 
+import time "time"
+
 import fmt "fmt"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -121,11 +126,17 @@ func (m *ResponseHeader) GetTxn() []byte {
 }
 
 type Datum struct {
-	BoolVal          *bool    `protobuf:"varint,1,opt,name=bool_val" json:"bool_val,omitempty"`
-	IntVal           *int64   `protobuf:"varint,2,opt,name=int_val" json:"int_val,omitempty"`
-	FloatVal         *float64 `protobuf:"fixed64,3,opt,name=float_val" json:"float_val,omitempty"`
-	BytesVal         []byte   `protobuf:"bytes,4,opt,name=bytes_val" json:"bytes_val,omitempty"`
-	StringVal        *string  `protobuf:"bytes,5,opt,name=string_val" json:"string_val,omitempty"`
+	BoolVal   *bool            `protobuf:"varint,1,opt,name=bool_val" json:"bool_val,omitempty"`
+	IntVal    *int64           `protobuf:"varint,2,opt,name=int_val" json:"int_val,omitempty"`
+	FloatVal  *float64         `protobuf:"fixed64,3,opt,name=float_val" json:"float_val,omitempty"`
+	BytesVal  []byte           `protobuf:"bytes,4,opt,name=bytes_val" json:"bytes_val,omitempty"`
+	StringVal *string          `protobuf:"bytes,5,opt,name=string_val" json:"string_val,omitempty"`
+	TimeVal   *Datum_Timestamp `protobuf:"bytes,6,opt,name=time_val" json:"time_val,omitempty"`
+	// The number of days since the Unix epoch.
+	DateVal     *int32         `protobuf:"varint,7,opt,name=date_val" json:"date_val,omitempty"`
+	IntervalVal *time.Duration `protobuf:"varint,8,opt,name=interval_val,casttype=time.Duration" json:"interval_val,omitempty"`
+	// The decimal formatted with all digits of its scale, e.g. "1.50".
+	DecimalVal       *Decimal `protobuf:"bytes,9,opt,name=decimal_val,casttype=Decimal" json:"decimal_val,omitempty"`
 	XXX_unrecognized []byte   `json:"-"`
 }
 
@@ -167,6 +178,61 @@ func (m *Datum) GetStringVal() string {
 	return ""
 }
 
+func (m *Datum) GetTimeVal() *Datum_Timestamp {
+	if m != nil {
+		return m.TimeVal
+	}
+	return nil
+}
+
+func (m *Datum) GetDateVal() int32 {
+	if m != nil && m.DateVal != nil {
+		return *m.DateVal
+	}
+	return 0
+}
+
+func (m *Datum) GetIntervalVal() time.Duration {
+	if m != nil && m.IntervalVal != nil {
+		return *m.IntervalVal
+	}
+	return 0
+}
+
+func (m *Datum) GetDecimalVal() Decimal {
+	if m != nil && m.DecimalVal != nil {
+		return *m.DecimalVal
+	}
+	return ""
+}
+
+// Timestamp is an instant in time with nanosecond precision, represented
+// like time.Time as the seconds since the Unix epoch and the nanoseconds
+// within that second.
+type Datum_Timestamp struct {
+	Sec              int64  `protobuf:"varint,1,opt,name=sec" json:"sec"`
+	Nsec             uint32 `protobuf:"varint,2,opt,name=nsec" json:"nsec"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Datum_Timestamp) Reset()         { *m = Datum_Timestamp{} }
+func (m *Datum_Timestamp) String() string { return proto.CompactTextString(m) }
+func (*Datum_Timestamp) ProtoMessage()    {}
+
+func (m *Datum_Timestamp) GetSec() int64 {
+	if m != nil {
+		return m.Sec
+	}
+	return 0
+}
+
+func (m *Datum_Timestamp) GetNsec() uint32 {
+	if m != nil {
+		return m.Nsec
+	}
+	return 0
+}
+
 // A Result is a collection of rows.
 type Result struct {
 	// The names of the columns returned in the result set in the order specified
@@ -222,8 +288,17 @@ type Request struct {
 	// statements are passed as a single string separated by semicolons.
 	Sql string `protobuf:"bytes,2,opt,name=sql" json:"sql"`
 	// Parameters referred to in the above SQL statement(s) using "?".
-	Params           []Datum `protobuf:"bytes,3,rep,name=params" json:"params"`
-	XXX_unrecognized []byte  `json:"-"`
+	Params []Datum `protobuf:"bytes,3,rep,name=params" json:"params"`
+	// If set, the single statement in sql is prepared instead of being
+	// executed. The response describes the prepared statement.
+	Prepare bool `protobuf:"varint,4,opt,name=prepare" json:"prepare"`
+	// The ID of a prepared statement of the session which is executed with
+	// params instead of sql.
+	StmtID uint32 `protobuf:"varint,5,opt,name=stmt_id" json:"stmt_id"`
+	// If set, the prepared statement identified by stmt_id is released instead
+	// of being executed.
+	Deallocate       bool   `protobuf:"varint,6,opt,name=deallocate" json:"deallocate"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -244,12 +319,74 @@ func (m *Request) GetParams() []Datum {
 	return nil
 }
 
+func (m *Request) GetPrepare() bool {
+	if m != nil {
+		return m.Prepare
+	}
+	return false
+}
+
+func (m *Request) GetStmtID() uint32 {
+	if m != nil {
+		return m.StmtID
+	}
+	return 0
+}
+
+func (m *Request) GetDeallocate() bool {
+	if m != nil {
+		return m.Deallocate
+	}
+	return false
+}
+
+// A PreparedStatement describes a statement prepared by a request.
+type PreparedStatement struct {
+	// The ID of the statement, which is valid within the session returned with
+	// the response.
+	ID uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	// The types inferred for the placeholders of the statement, in the order
+	// of their numbers, e.g. "int" or "string". The type of a placeholder
+	// whose type could not be inferred is empty.
+	PlaceholderTypes []string `protobuf:"bytes,2,rep,name=placeholder_types" json:"placeholder_types,omitempty"`
+	// The names of the columns returned by the statement, if it returns rows.
+	Columns          []string `protobuf:"bytes,3,rep,name=columns" json:"columns,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *PreparedStatement) Reset()         { *m = PreparedStatement{} }
+func (m *PreparedStatement) String() string { return proto.CompactTextString(m) }
+func (*PreparedStatement) ProtoMessage()    {}
+
+func (m *PreparedStatement) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *PreparedStatement) GetPlaceholderTypes() []string {
+	if m != nil {
+		return m.PlaceholderTypes
+	}
+	return nil
+}
+
+func (m *PreparedStatement) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
 type Response struct {
 	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	// The list of results. There is one result object per SQL statement in the
 	// request.
-	Results          []Result `protobuf:"bytes,2,rep,name=results" json:"results"`
-	XXX_unrecognized []byte   `json:"-"`
+	Results []Result `protobuf:"bytes,2,rep,name=results" json:"results"`
+	// The statement prepared by the request, if any.
+	Prepared         *PreparedStatement `protobuf:"bytes,3,opt,name=prepared" json:"prepared,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -263,6 +400,13 @@ func (m *Response) GetResults() []Result {
 	return nil
 }
 
+func (m *Response) GetPrepared() *PreparedStatement {
+	if m != nil {
+		return m.Prepared
+	}
+	return nil
+}
+
 func (m *RequestHeader) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -655,6 +799,171 @@ func (m *Datum) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.StringVal = &s
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeVal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeVal == nil {
+				m.TimeVal = &Datum_Timestamp{}
+			}
+			if err := m.TimeVal.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateVal", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DateVal = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalVal", wireType)
+			}
+			var v time.Duration
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (time.Duration(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntervalVal = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalVal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := Decimal(data[iNdEx:postIndex])
+			m.DecimalVal = &s
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipWire(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *Datum_Timestamp) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sec", wireType)
+			}
+			m.Sec = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sec |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nsec", wireType)
+			}
+			m.Nsec = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Nsec |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -948,25 +1257,181 @@ func (m *Request) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			iNdEx -= sizeOfWire
-			skippy, err := skipWire(data[iNdEx:])
-			if err != nil {
-				return err
+			m.Prepare = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtID", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthWire
+			m.StmtID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.StmtID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deallocate = bool(v != 0)
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipWire(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *PreparedStatement) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceholderTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlaceholderTypes = append(m.PlaceholderTypes, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipWire(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -1049,6 +1514,36 @@ func (m *Response) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prepared == nil {
+				m.Prepared = &PreparedStatement{}
+			}
+			if err := m.Prepared.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1184,6 +1679,18 @@ func (this *Datum) GetValue() interface{} {
 	if this.StringVal != nil {
 		return this.StringVal
 	}
+	if this.TimeVal != nil {
+		return this.TimeVal
+	}
+	if this.DateVal != nil {
+		return this.DateVal
+	}
+	if this.IntervalVal != nil {
+		return this.IntervalVal
+	}
+	if this.DecimalVal != nil {
+		return this.DecimalVal
+	}
 	return nil
 }
 
@@ -1199,6 +1706,14 @@ func (this *Datum) SetValue(value interface{}) bool {
 		this.BytesVal = vt
 	case *string:
 		this.StringVal = vt
+	case *Datum_Timestamp:
+		this.TimeVal = vt
+	case *int32:
+		this.DateVal = vt
+	case *time.Duration:
+		this.IntervalVal = vt
+	case *Decimal:
+		this.DecimalVal = vt
 	default:
 		return false
 	}
@@ -1266,6 +1781,31 @@ func (m *Datum) Size() (n int) {
 		l = len(*m.StringVal)
 		n += 1 + l + sovWire(uint64(l))
 	}
+	if m.TimeVal != nil {
+		l = m.TimeVal.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.DateVal != nil {
+		n += 1 + sovWire(uint64(*m.DateVal))
+	}
+	if m.IntervalVal != nil {
+		n += 1 + sovWire(uint64(*m.IntervalVal))
+	}
+	if m.DecimalVal != nil {
+		l = len(*m.DecimalVal)
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Datum_Timestamp) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovWire(uint64(m.Sec))
+	n += 1 + sovWire(uint64(m.Nsec))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1321,6 +1861,31 @@ func (m *Request) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	n += 2
+	n += 1 + sovWire(uint64(m.StmtID))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PreparedStatement) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovWire(uint64(m.ID))
+	if len(m.PlaceholderTypes) > 0 {
+		for _, s := range m.PlaceholderTypes {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1338,6 +1903,10 @@ func (m *Response) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	if m.Prepared != nil {
+		l = m.Prepared.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1492,6 +2061,59 @@ func (m *Datum) MarshalTo(data []byte) (n int, err error) {
 		i = encodeVarintWire(data, i, uint64(len(*m.StringVal)))
 		i += copy(data[i:], *m.StringVal)
 	}
+	if m.TimeVal != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintWire(data, i, uint64(m.TimeVal.Size()))
+		n3, err := m.TimeVal.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.DateVal != nil {
+		data[i] = 0x38
+		i++
+		i = encodeVarintWire(data, i, uint64(*m.DateVal))
+	}
+	if m.IntervalVal != nil {
+		data[i] = 0x40
+		i++
+		i = encodeVarintWire(data, i, uint64(*m.IntervalVal))
+	}
+	if m.DecimalVal != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintWire(data, i, uint64(len(*m.DecimalVal)))
+		i += copy(data[i:], *m.DecimalVal)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Datum_Timestamp) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Datum_Timestamp) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintWire(data, i, uint64(m.Sec))
+	data[i] = 0x10
+	i++
+	i = encodeVarintWire(data, i, uint64(m.Nsec))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1597,11 +2219,11 @@ func (m *Request) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintWire(data, i, uint64(m.RequestHeader.Size()))
-	n3, err := m.RequestHeader.MarshalTo(data[i:])
+	n4, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	data[i] = 0x12
 	i++
 	i = encodeVarintWire(data, i, uint64(len(m.Sql)))
@@ -1618,6 +2240,79 @@ func (m *Request) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x20
+	i++
+	if m.Prepare {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x28
+	i++
+	i = encodeVarintWire(data, i, uint64(m.StmtID))
+	data[i] = 0x30
+	i++
+	if m.Deallocate {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PreparedStatement) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PreparedStatement) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintWire(data, i, uint64(m.ID))
+	if len(m.PlaceholderTypes) > 0 {
+		for _, s := range m.PlaceholderTypes {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1642,11 +2337,11 @@ func (m *Response) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintWire(data, i, uint64(m.ResponseHeader.Size()))
-	n4, err := m.ResponseHeader.MarshalTo(data[i:])
+	n5, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	if len(m.Results) > 0 {
 		for _, msg := range m.Results {
 			data[i] = 0x12
@@ -1659,6 +2354,16 @@ func (m *Response) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	if m.Prepared != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintWire(data, i, uint64(m.Prepared.Size()))
+		n6, err := m.Prepared.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -18,7 +18,9 @@
 package driver
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -43,6 +45,22 @@ func dString(v string) Datum {
 	return Datum{StringVal: &v}
 }
 
+func dTime(v time.Time) Datum {
+	return Datum{TimeVal: TimestampFromTime(v)}
+}
+
+func dDate(v int32) Datum {
+	return Datum{DateVal: &v}
+}
+
+func dInterval(v time.Duration) Datum {
+	return Datum{IntervalVal: &v}
+}
+
+func dDecimal(v Decimal) Datum {
+	return Datum{DecimalVal: &v}
+}
+
 func TestDatumString(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
@@ -57,6 +75,11 @@ func TestDatumString(t *testing.T) {
 		{dFloat(4.5), "4.5"},
 		{dBytes([]byte("6")), "6"},
 		{dString("hello"), "hello"},
+		{dTime(time.Date(2015, 8, 30, 3, 34, 45, 345670000, time.UTC)), "2015-08-30T03:34:45.34567Z"},
+		{dDate(16677), "2015-08-30"},
+		{dDate(-1), "1969-12-31"},
+		{dInterval(90 * time.Minute), "1h30m0s"},
+		{dDecimal("-12.50"), "-12.50"},
 	}
 	for i, d := range testData {
 		s := d.datum.String()
@@ -65,3 +88,30 @@ func TestDatumString(t *testing.T) {
 		}
 	}
 }
+
+func TestDatumMarshal(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []Datum{
+		dTime(time.Date(2015, 8, 30, 3, 34, 45, 345670000, time.UTC)),
+		dTime(time.Unix(-1, 0)),
+		dDate(16677),
+		dDate(-1),
+		dInterval(90 * time.Minute),
+		dInterval(-time.Second),
+		dDecimal("-12.50"),
+	}
+	for i, d := range testData {
+		data, err := d.Marshal()
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		var decoded Datum
+		if err := decoded.Unmarshal(data); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(d, decoded) {
+			t.Errorf("%d: expected %s, but got %s", i, d, decoded)
+		}
+	}
+}
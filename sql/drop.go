@@ -19,20 +19,104 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 )
 
+// DropIndex drops indexes from tables. The dropped indexes are recorded as
+// mutations in the table descriptors and their entries are deleted once the
+// statement's transaction has committed (see schemaChanger).
+// Privileges: CREATE on table.
+//   Notes: postgres allows only the index owner to DROP an index.
+//          mysql requires the INDEX privilege on the table.
+func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
+	if p.session.Txn != nil {
+		return nil, schemaChangeInTransactionError("DROP INDEX")
+	}
+
+	for _, indexQualifiedName := range n.Names {
+		if err := indexQualifiedName.NormalizeTableName(p.session.Database); err != nil {
+			return nil, err
+		}
+		indexName := indexQualifiedName.Index()
+		if indexName == "" {
+			return nil, fmt.Errorf("no index specified: %s", indexQualifiedName)
+		}
+
+		tableDesc, err := p.getTableDescIfExists(indexQualifiedName)
+		if err != nil {
+			return nil, err
+		}
+		if tableDesc == nil {
+			if n.IfExists {
+				// Noop.
+				continue
+			}
+			return nil, fmt.Errorf("table %q does not exist", indexQualifiedName.Table())
+		}
+
+		if err := p.checkPrivilege(tableDesc, parser.PrivilegeCreate); err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(indexName, tableDesc.PrimaryIndex.Name) {
+			return nil, fmt.Errorf("index %q is the primary index of table %q", indexName, tableDesc.Name)
+		}
+		i := -1
+		for j, index := range tableDesc.Indexes {
+			if strings.EqualFold(index.Name, indexName) {
+				i = j
+				break
+			}
+		}
+		if i == -1 {
+			if n.IfExists {
+				// Noop.
+				continue
+			}
+			return nil, fmt.Errorf("index %q does not exist", indexName)
+		}
+
+		// The index is no longer used or written while its entries are deleted.
+		index := tableDesc.Indexes[i]
+		if index.Unique {
+			if err := p.checkIndexNotReferenced(tableDesc, index); err != nil {
+				return nil, err
+			}
+		}
+		tableDesc.Indexes = append(tableDesc.Indexes[:i], tableDesc.Indexes[i+1:]...)
+		tableDesc.Mutations = append(tableDesc.Mutations, structured.DescriptorMutation{
+			Index:     &index,
+			Direction: structured.DescriptorMutation_DROP,
+		})
+		if err := tableDesc.Validate(); err != nil {
+			return nil, err
+		}
+		tableDesc.Version++
+		if err := p.txn.Put(structured.MakeDescMetadataKey(tableDesc.GetID()), tableDesc); err != nil {
+			return nil, err
+		}
+		p.schemaChanges = append(p.schemaChanges, tableDesc.ID)
+	}
+	return &valuesNode{}, nil
+}
+
 // DropTable drops a table.
-// Privileges: WRITE on table.
+// Privileges: DROP on table.
 //   Notes: postgres allows only the table owner to DROP a table.
 //          mysql requires the DROP privilege on the table.
 func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
-	// TODO(XisiHuang): should do truncate and delete descriptor in
-	// the same txn
-	for i, tableQualifiedName := range n.Names {
+	// The tables are looked up before they are dropped, so that the tables
+	// referencing each other with foreign keys can be dropped together.
+	var names parser.QualifiedNames
+	var descs []*structured.TableDescriptor
+	var nameKeys []proto.Key
+	dropped := map[structured.ID]struct{}{}
+	for _, tableQualifiedName := range n.Names {
 		if err := tableQualifiedName.NormalizeTableName(p.session.Database); err != nil {
 			return nil, err
 		}
@@ -41,6 +125,9 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		if dbDesc.ID == informationSchemaID && getVirtualTableDesc(tableQualifiedName.Table()) != nil {
+			return nil, fmt.Errorf("cannot drop virtual table %q", tableQualifiedName.Table())
+		}
 
 		tbKey := tableKey{dbDesc.ID, tableQualifiedName.Table()}
 		nameKey := tbKey.Key()
@@ -62,34 +149,86 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 		if err := p.txn.GetProto(gr.ValueBytes(), &tableDesc); err != nil {
 			return nil, err
 		}
-		if err := tableDesc.Validate(); err != nil {
+		if err := tableDesc.ConvertLegacyPrivileges(); err != nil {
 			return nil, err
 		}
-
-		if !tableDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-				p.user, parser.PrivilegeWrite, tableDesc.Name)
+		if err := tableDesc.Validate(); err != nil {
+			return nil, err
 		}
 
-		if _, err = p.Truncate(&parser.Truncate{Tables: n.Names[i : i+1]}); err != nil {
+		if err := p.checkPrivilege(&tableDesc, parser.PrivilegeDrop); err != nil {
 			return nil, err
 		}
 
-		// Delete table descriptor
-		descKey := gr.ValueBytes()
-		b := &client.Batch{}
-		b.Del(descKey)
-		b.Del(nameKey)
-		err = p.txn.Run(b)
+		names = append(names, tableQualifiedName)
+		descs = append(descs, &tableDesc)
+		nameKeys = append(nameKeys, nameKey)
+		dropped[tableDesc.ID] = struct{}{}
+	}
+	if len(descs) == 0 {
+		return &valuesNode{}, nil
+	}
+	for _, tableDesc := range descs {
+		other, err := p.referencingTable(tableDesc, dropped)
 		if err != nil {
 			return nil, err
 		}
+		if other != nil {
+			return nil, fmt.Errorf("cannot drop table %q because table %q references it",
+				tableDesc.Name, other.Name)
+		}
+	}
+
+	// TODO(XisiHuang): should do truncate and delete descriptor in
+	// the same txn
+	if _, err := p.Truncate(&parser.Truncate{Tables: names}); err != nil {
+		return nil, err
+	}
+
+	b := &client.Batch{}
+	for i, tableDesc := range descs {
+		// Remove the table from the tables it references which are not dropped.
+		for _, fk := range tableDesc.ForeignKeys {
+			if _, ok := dropped[fk.Table]; ok {
+				continue
+			}
+			refDesc, err := getTableDescByID(p.txn, fk.Table)
+			if err != nil {
+				return nil, err
+			}
+			if refDesc == nil {
+				continue
+			}
+			refDesc.ReferencedBy = removeTableID(refDesc.ReferencedBy, tableDesc.ID)
+			refDesc.Version++
+			if err := p.txn.Put(structured.MakeDescMetadataKey(refDesc.ID), refDesc); err != nil {
+				return nil, err
+			}
+		}
+
+		// Delete table descriptor
+		b.Del(structured.MakeDescMetadataKey(tableDesc.ID))
+		b.Del(nameKeys[i])
+	}
+	if err := p.txn.Run(b); err != nil {
+		return nil, err
 	}
 	return &valuesNode{}, nil
 }
 
+// removeTableID returns the list of table IDs without the specified ID.
+func removeTableID(ids []structured.ID, id structured.ID) []structured.ID {
+	var result []structured.ID
+	for _, other := range ids {
+		if other != id {
+			result = append(result, other)
+		}
+	}
+	return result
+}
+
 // DropDatabase drops a database.
-// Privileges: WRITE on database.
+// Privileges: DROP on database and all its tables.
 //   Notes: postgres allows only the database owner to DROP a database.
 //          mysql requires the DROP privileges on the database.
 // TODO(XisiHuang): our DROP DATABASE is like the postgres DROP SCHEMA
@@ -101,6 +240,10 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 		return nil, errEmptyDatabaseName
 	}
 
+	if isVirtualDatabase(string(n.Name)) {
+		return nil, fmt.Errorf("cannot drop virtual database %q", string(n.Name))
+	}
+
 	nameKey := structured.MakeNameMetadataKey(structured.RootNamespaceID, string(n.Name))
 	gr, err := p.txn.Get(nameKey)
 	if err != nil {
@@ -119,13 +262,15 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 	if err := p.txn.GetProto(descKey, &desc); err != nil {
 		return nil, err
 	}
+	if err := desc.ConvertLegacyPrivileges(); err != nil {
+		return nil, err
+	}
 	if err := desc.Validate(); err != nil {
 		return nil, err
 	}
 
-	if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on database %s",
-			p.user, parser.PrivilegeWrite, desc.Name)
+	if err := p.checkPrivilege(&desc, parser.PrivilegeDrop); err != nil {
+		return nil, err
 	}
 
 	tbNames, err := p.getTableNames(&desc)
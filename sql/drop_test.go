@@ -92,6 +92,91 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 	}
 }
 
+func TestDropIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first `MaxReservedDescID` (plus 0) are set aside.
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("TableDescriptor %q does not exist", nameKey)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	var tablePrefix []byte
+	tablePrefix = append(tablePrefix, keys.TableDataPrefix...)
+	tablePrefix = encoding.EncodeUvarint(tablePrefix, uint64(desc.ID))
+
+	tableStartKey := proto.Key(tablePrefix)
+	tableEndKey := tableStartKey.PrefixEnd()
+	checkKVs := func(l int) {
+		if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
+			t.Fatal(err)
+		} else if len(kvs) != l {
+			t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+		}
+	}
+	checkKVs(6)
+
+	// An entry is backfilled for each of the existing rows.
+	if _, err := sqlDB.Exec(`CREATE INDEX foo ON t.kv (v)`); err != nil {
+		t.Fatal(err)
+	}
+	checkKVs(9)
+
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if len(desc.Mutations) != 0 {
+		t.Fatalf("expected no mutations, but found %v", desc.Mutations)
+	}
+	if len(desc.Indexes) != 1 {
+		t.Fatalf("expected 1 index, but found %d", len(desc.Indexes))
+	}
+	indexID := desc.Indexes[0].ID
+
+	if _, err := sqlDB.Exec(`DROP INDEX t.kv@foo`); err != nil {
+		t.Fatal(err)
+	}
+	checkKVs(6)
+
+	indexStartKey := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, indexID))
+	if kvs, err := kvDB.Scan(indexStartKey, indexStartKey.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 0; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	// Index IDs are not reused.
+	if _, err := sqlDB.Exec(`CREATE INDEX foo ON t.kv (v)`); err != nil {
+		t.Fatal(err)
+	}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if len(desc.Indexes) != 1 || desc.Indexes[0].ID == indexID {
+		t.Fatalf("expected a single index with a new ID, but found %v", desc.Indexes)
+	}
+	checkKVs(9)
+}
+
 func TestDropDatabase(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, sqlDB, kvDB := setup(t)
@@ -0,0 +1,472 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// foreignKeyDefs returns the FOREIGN KEY constraints of a CREATE TABLE
+// statement, including the REFERENCES constraints of its columns.
+func foreignKeyDefs(n *parser.CreateTable) []*parser.ForeignKeyConstraintTableDef {
+	var defs []*parser.ForeignKeyConstraintTableDef
+	for _, def := range n.Defs {
+		switch d := def.(type) {
+		case *parser.ColumnTableDef:
+			if c := d.References; c != nil {
+				fk := &parser.ForeignKeyConstraintTableDef{
+					Name:     c.Name,
+					FromCols: parser.NameList{string(d.Name)},
+					Table:    c.Table,
+					OnDelete: c.OnDelete,
+				}
+				if c.Col != "" {
+					fk.ToCols = parser.NameList{string(c.Col)}
+				}
+				defs = append(defs, fk)
+			}
+		case *parser.ForeignKeyConstraintTableDef:
+			defs = append(defs, d)
+		}
+	}
+	return defs
+}
+
+// resolveForeignKeys adds the FOREIGN KEY constraints of a CREATE TABLE
+// statement to the descriptor of the new table, whose column and index IDs
+// must have been allocated. The descriptors of the other tables referenced by
+// the new table are returned.
+func (p *planner) resolveForeignKeys(desc *structured.TableDescriptor,
+	n *parser.CreateTable) ([]*structured.TableDescriptor, error) {
+	var referenced []*structured.TableDescriptor
+	tables := map[string]*structured.TableDescriptor{n.Table.String(): desc}
+	for _, d := range foreignKeyDefs(n) {
+		if err := d.Table.NormalizeTableName(p.session.Database); err != nil {
+			return nil, err
+		}
+		refDesc, ok := tables[d.Table.String()]
+		if !ok {
+			var err error
+			if refDesc, err = p.getTableDesc(d.Table); err != nil {
+				return nil, err
+			}
+			if err := p.checkPrivilege(refDesc, parser.PrivilegeCreate); err != nil {
+				return nil, err
+			}
+			tables[d.Table.String()] = refDesc
+			referenced = append(referenced, refDesc)
+		}
+
+		fk := structured.ForeignKeyReference{
+			Name:  string(d.Name),
+			Table: refDesc.ID,
+		}
+		if fk.Name == "" {
+			fk.Name = fmt.Sprintf("%s_%s_fkey", desc.Name, strings.Join(d.FromCols, "_"))
+		}
+		if d.OnDelete == parser.Cascade {
+			fk.OnDelete = structured.ForeignKeyReference_CASCADE
+		}
+		for _, name := range d.FromCols {
+			col, err := desc.FindColumnByName(name)
+			if err != nil {
+				return nil, err
+			}
+			fk.ColumnIDs = append(fk.ColumnIDs, col.ID)
+		}
+		if len(d.ToCols) == 0 {
+			fk.ReferencedColumnIDs = append(fk.ReferencedColumnIDs, refDesc.PrimaryIndex.ColumnIDs...)
+		}
+		for _, name := range d.ToCols {
+			col, err := refDesc.FindColumnByName(name)
+			if err != nil {
+				return nil, err
+			}
+			fk.ReferencedColumnIDs = append(fk.ReferencedColumnIDs, col.ID)
+		}
+		if len(fk.ColumnIDs) != len(fk.ReferencedColumnIDs) {
+			return nil, fmt.Errorf("number of referencing and referenced columns for foreign key %q disagree", fk.Name)
+		}
+		for i, id := range fk.ColumnIDs {
+			col, err := desc.FindColumnByID(id)
+			if err != nil {
+				return nil, err
+			}
+			refCol, err := refDesc.FindColumnByID(fk.ReferencedColumnIDs[i])
+			if err != nil {
+				return nil, err
+			}
+			if col.Type.Kind != refCol.Type.Kind {
+				return nil, fmt.Errorf("column %q of type %s cannot reference column %q of type %s",
+					col.Name, col.Type.SQLString(), refCol.Name, refCol.Type.SQLString())
+			}
+		}
+		if !hasUniqueIndex(refDesc, fk.ReferencedColumnIDs, 0) {
+			return nil, fmt.Errorf("there is no unique index on the columns of table %q referenced by foreign key %q",
+				refDesc.Name, fk.Name)
+		}
+		desc.ForeignKeys = append(desc.ForeignKeys, fk)
+	}
+	return referenced, nil
+}
+
+// hasUniqueIndex returns true if the table has a unique index, other than the
+// index with ID skip, on exactly the specified columns.
+func hasUniqueIndex(desc *structured.TableDescriptor, colIDs []structured.ColumnID,
+	skip structured.IndexID) bool {
+	indexes := append([]structured.IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...)
+	for _, index := range indexes {
+		if !index.Unique || index.ID == skip || len(index.ColumnIDs) != len(colIDs) {
+			continue
+		}
+		cols := map[structured.ColumnID]struct{}{}
+		for _, id := range index.ColumnIDs {
+			cols[id] = struct{}{}
+		}
+		matches := true
+		for _, id := range colIDs {
+			if _, ok := cols[id]; !ok {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// referencingTable returns a table, other than the tables in skip, with a
+// foreign key referencing the table, or nil if there is none.
+func (p *planner) referencingTable(desc *structured.TableDescriptor,
+	skip map[structured.ID]struct{}) (*structured.TableDescriptor, error) {
+	for _, id := range desc.ReferencedBy {
+		if _, ok := skip[id]; ok {
+			continue
+		}
+		other, err := getTableDescByID(p.txn, id)
+		if err != nil {
+			return nil, err
+		}
+		if other != nil {
+			return other, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkIndexNotReferenced returns an error if the unique index is required
+// by a foreign key referencing the table.
+func (p *planner) checkIndexNotReferenced(desc *structured.TableDescriptor,
+	index structured.IndexDescriptor) error {
+	f := p.makeFKHelper(desc)
+	if err := f.loadInbound(); err != nil {
+		return err
+	}
+	for _, in := range f.inbound {
+		if !hasUniqueIndex(desc, in.fk.ReferencedColumnIDs, index.ID) {
+			return fmt.Errorf("index %q is required by foreign key %q of table %q",
+				index.Name, in.fk.Name, in.desc.Name)
+		}
+	}
+	return nil
+}
+
+// inboundFK is a foreign key of a table referencing the table of an fkHelper.
+type inboundFK struct {
+	desc *structured.TableDescriptor
+	fk   structured.ForeignKeyReference
+}
+
+// fkHelper enforces the foreign keys of a table, and of the tables
+// referencing it, for the rows written by a statement. The checks read the
+// rows of the referenced and referencing tables in the statement's
+// transaction and are run once the rows have been written, so that a row may
+// reference a row written by the same statement.
+type fkHelper struct {
+	p      *planner
+	desc   *structured.TableDescriptor
+	tables map[structured.ID]*structured.TableDescriptor
+	// inbound is loaded on first use.
+	inbound       []inboundFK
+	inboundLoaded bool
+}
+
+func (p *planner) makeFKHelper(desc *structured.TableDescriptor) *fkHelper {
+	return &fkHelper{
+		p:      p,
+		desc:   desc,
+		tables: map[structured.ID]*structured.TableDescriptor{},
+	}
+}
+
+// needsRows returns true if the rows written by an update must be checked.
+func (f *fkHelper) needsRows() bool {
+	return len(f.desc.ForeignKeys) > 0 || len(f.desc.ReferencedBy) > 0
+}
+
+// isReferenced returns true if the table is referenced by a foreign key,
+// which may be one of its own.
+func (f *fkHelper) isReferenced() bool {
+	return len(f.desc.ReferencedBy) > 0 || f.referencesSelf()
+}
+
+func (f *fkHelper) referencesSelf() bool {
+	for _, fk := range f.desc.ForeignKeys {
+		if fk.Table == f.desc.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fkHelper) getTable(id structured.ID) (*structured.TableDescriptor, error) {
+	if desc, ok := f.tables[id]; ok {
+		return desc, nil
+	}
+	desc, err := getTableDescByID(f.p.txn, id)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		return nil, fmt.Errorf("table with ID %d does not exist", id)
+	}
+	f.tables[id] = desc
+	return desc, nil
+}
+
+func (f *fkHelper) loadInbound() error {
+	if f.inboundLoaded {
+		return nil
+	}
+	ids := f.desc.ReferencedBy
+	if f.referencesSelf() {
+		ids = append([]structured.ID{f.desc.ID}, ids...)
+	}
+	for _, id := range ids {
+		desc, err := f.getTable(id)
+		if err != nil {
+			return err
+		}
+		for _, fk := range desc.ForeignKeys {
+			if fk.Table == f.desc.ID {
+				f.inbound = append(f.inbound, inboundFK{desc: desc, fk: fk})
+			}
+		}
+	}
+	f.inboundLoaded = true
+	return nil
+}
+
+// rowValues returns the values of the specified columns of a row, or nil if
+// one of them is NULL or not present in the row.
+func rowValues(colIDs []structured.ColumnID, colIDtoRowIndex map[structured.ColumnID]int,
+	values []parser.Datum) []parser.Datum {
+	vals := make([]parser.Datum, len(colIDs))
+	for i, id := range colIDs {
+		j, ok := colIDtoRowIndex[id]
+		if !ok || values[j] == parser.DNull {
+			return nil
+		}
+		vals[i] = values[j]
+	}
+	return vals
+}
+
+// intersects returns true if one of the columns is in the set. A nil set
+// contains all the columns.
+func intersects(colIDs []structured.ColumnID, set map[structured.ColumnID]struct{}) bool {
+	if set == nil {
+		return true
+	}
+	for _, id := range colIDs {
+		if _, ok := set[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReferences verifies that a row written to the table references
+// existing rows through the foreign keys of the table containing one of the
+// written columns. A nil set of written columns contains all the columns.
+func (f *fkHelper) checkReferences(colIDtoRowIndex map[structured.ColumnID]int,
+	values []parser.Datum, written map[structured.ColumnID]struct{}) error {
+	for _, fk := range f.desc.ForeignKeys {
+		if !intersects(fk.ColumnIDs, written) {
+			continue
+		}
+		vals := rowValues(fk.ColumnIDs, colIDtoRowIndex, values)
+		if vals == nil {
+			continue
+		}
+		refDesc, err := f.getTable(fk.Table)
+		if err != nil {
+			return err
+		}
+		found, err := f.p.rowExists(refDesc, fk.ReferencedColumnIDs, vals)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("insert or update on table %q violates foreign key constraint %q",
+				f.desc.Name, fk.Name)
+		}
+	}
+	return nil
+}
+
+// checkUpdated verifies that no row of a referencing table references the
+// previous values of the updated columns of a row of the table.
+func (f *fkHelper) checkUpdated(colIDtoRowIndex map[structured.ColumnID]int,
+	oldValues, newValues []parser.Datum, written map[structured.ColumnID]struct{}) error {
+	if err := f.loadInbound(); err != nil {
+		return err
+	}
+	for _, in := range f.inbound {
+		if !intersects(in.fk.ReferencedColumnIDs, written) {
+			continue
+		}
+		vals := rowValues(in.fk.ReferencedColumnIDs, colIDtoRowIndex, oldValues)
+		if vals == nil {
+			continue
+		}
+		changed := false
+		for _, id := range in.fk.ReferencedColumnIDs {
+			i := colIDtoRowIndex[id]
+			if oldValues[i].Compare(newValues[i]) != 0 {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			continue
+		}
+		found, err := f.p.rowExists(in.desc, in.fk.ColumnIDs, vals)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("update on table %q violates foreign key constraint %q on table %q",
+				f.desc.Name, in.fk.Name, in.desc.Name)
+		}
+	}
+	return nil
+}
+
+// deleted applies the ON DELETE actions of the foreign keys referencing a
+// deleted row of the table: the referencing rows are deleted as well or, if
+// the foreign key restricts deletes, an error is returned.
+func (f *fkHelper) deleted(colIDtoRowIndex map[structured.ColumnID]int,
+	values []parser.Datum) error {
+	if err := f.loadInbound(); err != nil {
+		return err
+	}
+	for _, in := range f.inbound {
+		vals := rowValues(in.fk.ReferencedColumnIDs, colIDtoRowIndex, values)
+		if vals == nil {
+			continue
+		}
+		rows, err := f.p.scanRows(in.desc, in.fk.ColumnIDs, vals)
+		if err != nil {
+			return err
+		}
+		if in.fk.OnDelete == structured.ForeignKeyReference_CASCADE {
+			if err := f.p.deleteRows(in.desc, rows); err != nil {
+				return err
+			}
+			continue
+		}
+		if rows.Next() {
+			return fmt.Errorf("delete on table %q violates foreign key constraint %q on table %q",
+				f.desc.Name, in.fk.Name, in.desc.Name)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowExists returns true if the table has a row with the specified values
+// of the specified columns.
+func (p *planner) rowExists(desc *structured.TableDescriptor, colIDs []structured.ColumnID,
+	vals []parser.Datum) (bool, error) {
+	rows, err := p.scanRows(desc, colIDs, vals)
+	if err != nil {
+		return false, err
+	}
+	if rows.Next() {
+		return true, nil
+	}
+	return false, rows.Err()
+}
+
+// scanRows returns a plan for all the columns of the rows of the table with
+// the specified values of the specified columns. The rows are read using an
+// index on the columns if there is one.
+func (p *planner) scanRows(desc *structured.TableDescriptor, colIDs []structured.ColumnID,
+	vals []parser.Datum) (planNode, error) {
+	d := *desc
+	d.Alias = d.Name
+	s := &scanNode{txn: p.txn, desc: &d, visibleCols: d.Columns, index: &d.PrimaryIndex}
+
+	var columns []string
+	var render []parser.Expr
+	for _, col := range d.Columns {
+		columns = append(columns, col.Name)
+		render = append(render, &parser.QualifiedName{Base: parser.Name(col.Name)})
+	}
+	s.setRender(columns, render)
+
+	var filter parser.Expr
+	for i, id := range colIDs {
+		col, err := d.FindColumnByID(id)
+		if err != nil {
+			return nil, err
+		}
+		var expr parser.Expr = &parser.ComparisonExpr{
+			Operator: parser.EQ,
+			Left:     &parser.QualifiedName{Base: parser.Name(col.Name)},
+			Right:    vals[i],
+		}
+		if filter != nil {
+			expr = &parser.AndExpr{Left: filter, Right: expr}
+		}
+		filter = expr
+	}
+	s.setFilter(filter)
+
+	if !s.initExprs() {
+		return nil, s.Err()
+	}
+	if err := p.selectIndex(s, nil); err != nil {
+		return nil, err
+	}
+	if s.isSecondaryIndex && !s.covers(s.index) {
+		join, err := makeIndexJoin(s)
+		if err != nil {
+			return nil, err
+		}
+		return join, nil
+	}
+	return s, nil
+}
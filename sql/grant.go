@@ -18,43 +18,43 @@
 package sql
 
 import (
-	"fmt"
-
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 )
 
 // Grant adds privileges to users.
 // Current status:
-// - Target: DATABASE X only
-// - Privileges: ALL, or one or more of READ, WRITE.
+// - Target: one or more DATABASEs, or one or more TABLEs.
+// - Privileges: ALL, or one or more of CREATE, DROP, GRANT, SELECT, INSERT, DELETE, UPDATE.
 // TODO(marc): open questions:
 // - should we have root always allowed and not present in the permissions list?
 // - should we make users case-insensitive?
-// Privileges: WRITE on database.
+// Privileges: GRANT on each target.
 //   Notes: postgres requires the object owner.
 //          mysql requires the "grant option" and the same privileges, and sometimes superuser.
 func (p *planner) Grant(n *parser.Grant) (planNode, error) {
-	descriptor, err := p.getDescriptorFromTargetList(n.Targets)
+	descriptors, err := p.getDescriptorsFromTargetList(n.Targets)
 	if err != nil {
 		return nil, err
 	}
 
-	if !descriptor.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on %s %s",
-			p.user, parser.PrivilegeWrite, descriptor.TypeName(), descriptor.GetName())
+	for _, descriptor := range descriptors {
+		if err := p.checkPrivilege(descriptor, parser.PrivilegeGrant); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := descriptor.Grant(n); err != nil {
-		return nil, err
-	}
-
-	// Now update the descriptor.
-	// TODO(marc): do this inside a transaction. This will be needed
-	// when modifying multiple descriptors in the same op.
-	descKey := structured.MakeDescMetadataKey(descriptor.GetID())
-	if err := p.txn.Put(descKey, descriptor); err != nil {
-		return nil, err
+	for _, descriptor := range descriptors {
+		if err := descriptor.Grant(n); err != nil {
+			return nil, err
+		}
+
+		// Now update the descriptor. All the descriptors are written
+		// within the planner's transaction.
+		descKey := structured.MakeDescMetadataKey(descriptor.GetID())
+		if err := p.txn.Put(descKey, descriptor); err != nil {
+			return nil, err
+		}
 	}
 
 	return &valuesNode{}, nil
@@ -18,13 +18,28 @@
 package sql_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
-	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
+// privilegesString formats the privileges of a descriptor as
+// "user1:PRIV1,PRIV2 user2:PRIV3".
+func privilegesString(t *testing.T, desc structured.PrivilegeDescriptor) string {
+	userPrivileges, err := desc.Show()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parts []string
+	for _, u := range userPrivileges {
+		parts = append(parts, fmt.Sprintf("%s:%s", u.User, u.Privileges.Join(",")))
+	}
+	return strings.Join(parts, " ")
+}
+
 func TestGrantDatabase(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, sqlDB, kvDB := setup(t)
@@ -37,48 +52,29 @@ func TestGrantDatabase(t *testing.T) {
 	// The first `MaxReservedDescID` (plus 0) are set aside.
 	descKey := structured.MakeDescMetadataKey(structured.MaxReservedDescID + 1)
 	desc := structured.DatabaseDescriptor{}
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 1 || desc.Read[0] != security.RootUser {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
-	}
-	if len(desc.Write) != 1 || desc.Write[0] != security.RootUser {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
-	}
-
-	// Grant WRITE permissions.
-	if _, err := sqlDB.Exec(`GRANT WRITE ON DATABASE TEST TO foo`); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 1 || desc.Read[0] != security.RootUser {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
+	testData := []struct {
+		stmt     string
+		expected string
+	}{
+		{``, `root:ALL`},
+		{`GRANT INSERT, UPDATE ON DATABASE TEST TO foo`, `foo:INSERT,UPDATE root:ALL`},
+		{`GRANT ALL ON DATABASE TEST TO bar`, `bar:ALL foo:INSERT,UPDATE root:ALL`},
+		{`GRANT ALL PRIVILEGES ON DATABASE TEST TO foo`, `bar:ALL foo:ALL root:ALL`},
+		// Adding permissions to root is a noop.
+		{`GRANT ALL ON DATABASE TEST TO root`, `bar:ALL foo:ALL root:ALL`},
 	}
-	if len(desc.Write) != 2 || desc.Write[0] != "foo" || desc.Write[1] != security.RootUser {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
-	}
-
-	// Grant ALL Permissions.
-	if _, err := sqlDB.Exec(`GRANT ALL ON DATABASE TEST TO bar`); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 2 || desc.Read[0] != "bar" || desc.Read[1] != security.RootUser {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
-	}
-	if len(desc.Write) != 3 || desc.Write[0] != "bar" || desc.Write[1] != "foo" || desc.Write[2] != security.RootUser {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
-	}
-
-	// Adding permissions to root is a noop.
-	if _, err := sqlDB.Exec(`GRANT ALL ON DATABASE TEST TO root`); err != nil {
-		t.Fatal(err)
+	for _, d := range testData {
+		if d.stmt != "" {
+			if _, err := sqlDB.Exec(d.stmt); err != nil {
+				t.Fatalf("%s: %v", d.stmt, err)
+			}
+		}
+		desc.Reset()
+		if err := kvDB.GetProto(descKey, &desc); err != nil {
+			t.Fatal(err)
+		}
+		if s := privilegesString(t, desc.PrivilegeDescriptor); s != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.stmt, d.expected, s)
+		}
 	}
 }
@@ -0,0 +1,386 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// groupBy constructs a groupNode according to the GROUP BY and HAVING clauses
+// and the aggregate functions used by the query. If the query does not perform
+// aggregation nil is returned.
+//
+// Grouping works by rewriting the render targets of the selectNode, which then
+// renders the GROUP BY expressions followed by the arguments to the aggregate
+// functions. The groupNode buckets the rows by the values of the GROUP BY
+// expressions and renders the original targets for each bucket. Within the
+// original targets, aggregate functions and GROUP BY expressions are replaced
+// with ParenExpr nodes which are filled in with the values for the bucket (in
+// the same manner as column values are filled in).
+//
+// groupBy must be called before the selectNode expressions are initialized.
+func (p *planner) groupBy(n *parser.Select, s selectNode) (*groupNode, error) {
+	columns, render := s.getRender()
+	if n.GroupBy == nil && n.Having == nil && !containsAggregate(render) {
+		return nil, nil
+	}
+
+	group := &groupNode{
+		plan:    s,
+		columns: columns,
+		render:  render,
+	}
+
+	// Normalize the qualified names in the GROUP BY expressions so that they
+	// can be compared against the expressions we're rendering.
+	groupBy := make([]parser.Expr, len(n.GroupBy))
+	for i, e := range n.GroupBy {
+		var err error
+		if groupBy[i], err = s.normalizeQNames(e); err != nil {
+			return nil, err
+		}
+		if containsAggregate([]parser.Expr{groupBy[i]}) {
+			return nil, fmt.Errorf("aggregate functions are not allowed in GROUP BY")
+		}
+		group.groupStrs = append(group.groupStrs, groupBy[i].String())
+		group.keys = append(group.keys, &parser.ParenExpr{Expr: parser.DNull})
+	}
+
+	var args []parser.Expr
+	for i := range group.render {
+		var err error
+		if group.render[i], err = s.normalizeQNames(group.render[i]); err != nil {
+			return nil, err
+		}
+		if group.render[i], args, err = group.extractAggregates(group.render[i], args); err != nil {
+			return nil, err
+		}
+	}
+	if n.Having != nil {
+		having, err := s.normalizeQNames(n.Having.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if group.having, args, err = group.extractAggregates(having, args); err != nil {
+			return nil, err
+		}
+	}
+
+	// Replace the render targets of the selectNode with the GROUP BY expressions and
+	// the arguments to the aggregate functions.
+	render = append(groupBy, args...)
+	columns = make([]string, 0, len(render))
+	for i, e := range render {
+		columns = append(columns, e.String())
+		if i >= len(groupBy) {
+			group.funcs[i-len(groupBy)].argIndex = i
+		}
+	}
+	s.setRender(columns, render)
+	return group, nil
+}
+
+// A groupNode buckets the rows of a selectNode according to the GROUP BY
+// expressions and computes the aggregate functions for each bucket.
+type groupNode struct {
+	plan      selectNode
+	columns   []string
+	render    []parser.Expr
+	having    parser.Expr
+	groupStrs []string            // the normalized GROUP BY expressions
+	keys      []*parser.ParenExpr // the values of the GROUP BY expressions
+	funcs     []*aggregateFunc
+	buckets   []*groupBucket
+	bucketIdx int // the index of the next bucket to output
+	row       parser.DTuple
+	err       error
+}
+
+func (n *groupNode) Columns() []string {
+	return n.columns
+}
+
+func (n *groupNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *groupNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if n.buckets == nil && !n.computeAggregates() {
+		return false
+	}
+
+	for n.bucketIdx < len(n.buckets) {
+		b := n.buckets[n.bucketIdx]
+		n.bucketIdx++
+
+		// Fill in the values of the GROUP BY expressions and aggregate functions
+		// for the bucket.
+		for i, key := range n.keys {
+			key.Expr = b.key[i]
+		}
+		for i, f := range n.funcs {
+			if f.slot.Expr, n.err = b.funcs[i].Result(); n.err != nil {
+				return false
+			}
+		}
+
+		if n.having != nil {
+			var d parser.Datum
+			if d, n.err = parser.EvalExpr(n.having); n.err != nil {
+				return false
+			}
+			v, ok := d.(parser.DBool)
+			if !ok && d != parser.DNull {
+				n.err = fmt.Errorf("HAVING clause did not evaluate to a boolean")
+				return false
+			}
+			if !v {
+				continue
+			}
+		}
+
+		n.row = make(parser.DTuple, len(n.render))
+		for i, e := range n.render {
+			if n.row[i], n.err = parser.EvalExpr(e); n.err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (n *groupNode) Err() error {
+	return n.err
+}
+
+// addRender adds a render target for the specified expression, returning the
+// 1-based index of the target within the rendered row. The expression is
+// subject to the same restrictions as the other render targets: columns must
+// either appear in the GROUP BY clause or be used in an aggregate function.
+func (n *groupNode) addRender(expr parser.Expr) (int, error) {
+	expr, err := n.plan.normalizeQNames(expr)
+	if err != nil {
+		return 0, err
+	}
+	numFuncs := len(n.funcs)
+	expr, args, err := n.extractAggregates(expr, nil)
+	if err != nil {
+		return 0, err
+	}
+	for i, arg := range args {
+		index, err := n.plan.addRender(arg)
+		if err != nil {
+			return 0, err
+		}
+		n.funcs[numFuncs+i].argIndex = index - 1
+	}
+	n.columns = append(n.columns, expr.String())
+	n.render = append(n.render, expr)
+	return len(n.render), nil
+}
+
+// computeAggregates reads all of the rows from the selectNode and buckets them
+// according to the values of the GROUP BY expressions.
+func (n *groupNode) computeAggregates() bool {
+	n.buckets = []*groupBucket{}
+	bucketMap := map[string]*groupBucket{}
+	if len(n.keys) == 0 {
+		// Without a GROUP BY clause there is a single bucket, even if there
+		// are no rows.
+		n.buckets = append(n.buckets, n.newBucket(nil))
+		bucketMap[""] = n.buckets[0]
+	}
+
+	for n.plan.Next() {
+		values := n.plan.Values()
+		key := parser.DTuple(values[:len(n.keys)])
+		var k string
+		if len(key) > 0 {
+			k = key.String()
+		}
+		b, ok := bucketMap[k]
+		if !ok {
+			// The result from plan.Values() is only valid until the next call to
+			// plan.Next(), so make a copy.
+			keyCopy := make(parser.DTuple, len(key))
+			copy(keyCopy, key)
+			b = n.newBucket(keyCopy)
+			bucketMap[k] = b
+			n.buckets = append(n.buckets, b)
+		}
+		for i, f := range n.funcs {
+			if n.err = f.add(b, i, values[f.argIndex]); n.err != nil {
+				return false
+			}
+		}
+	}
+	n.err = n.plan.Err()
+	return n.err == nil
+}
+
+func (n *groupNode) newBucket(key parser.DTuple) *groupBucket {
+	b := &groupBucket{
+		key:   key,
+		funcs: make([]parser.AggregateFunc, len(n.funcs)),
+		seen:  make([]map[string]struct{}, len(n.funcs)),
+	}
+	for i, f := range n.funcs {
+		b.funcs[i] = f.create()
+		if f.distinct {
+			b.seen[i] = map[string]struct{}{}
+		}
+	}
+	return b
+}
+
+// extractAggregates replaces the aggregate functions and GROUP BY expressions
+// in expr with ParenExpr nodes. The arguments of any aggregate functions
+// found are appended to args.
+func (n *groupNode) extractAggregates(
+	expr parser.Expr, args []parser.Expr) (parser.Expr, []parser.Expr, error) {
+	v := extractAggregatesVisitor{groupNode: n, args: args}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.args, v.err
+}
+
+// A groupBucket holds the state of the aggregate functions for the rows
+// sharing the same values for the GROUP BY expressions.
+type groupBucket struct {
+	key   parser.DTuple
+	funcs []parser.AggregateFunc
+	seen  []map[string]struct{} // the distinct values seen by each function
+}
+
+// An aggregateFunc is an aggregate function used by the query. The value of
+// the argument to the function is rendered by the selectNode at argIndex.
+type aggregateFunc struct {
+	create   func() parser.AggregateFunc
+	distinct bool
+	argIndex int
+	slot     *parser.ParenExpr
+}
+
+func (f *aggregateFunc) add(b *groupBucket, i int, d parser.Datum) error {
+	if f.distinct && d != parser.DNull {
+		k := d.String()
+		if _, ok := b.seen[i][k]; ok {
+			return nil
+		}
+		b.seen[i][k] = struct{}{}
+	}
+	return b.funcs[i].Add(d)
+}
+
+type extractAggregatesVisitor struct {
+	*groupNode
+	args []parser.Expr
+	err  error
+}
+
+var _ parser.Visitor = &extractAggregatesVisitor{}
+
+func (v *extractAggregatesVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+
+	// An expression which matches a GROUP BY expression is replaced by the
+	// value of the GROUP BY expression for the bucket.
+	if _, ok := expr.(parser.Datum); !ok {
+		s := expr.String()
+		for i, g := range v.groupStrs {
+			if s == g {
+				return v.keys[i]
+			}
+		}
+	}
+
+	switch t := expr.(type) {
+	case *parser.QualifiedName:
+		v.err = fmt.Errorf("column \"%s\" must appear in the GROUP BY clause or be used in an aggregate function", t)
+		return expr
+
+	case *parser.FuncExpr:
+		name := strings.ToLower(t.Name.String())
+		create, ok := parser.Aggregates[name]
+		if !ok {
+			return expr
+		}
+		if len(t.Exprs) != 1 {
+			v.err = fmt.Errorf("aggregate function %s takes exactly one argument", t.Name)
+			return expr
+		}
+		arg := t.Exprs[0]
+		if qname, ok := arg.(*parser.QualifiedName); ok {
+			if v.err = qname.NormalizeColumnName(); v.err != nil {
+				return expr
+			}
+			if qname.IsStar() {
+				if name != "count" {
+					v.err = fmt.Errorf("%s(*) is not supported", t.Name)
+					return expr
+				}
+				// COUNT(*) counts rows, so any non-NULL argument will do.
+				arg = parser.DInt(1)
+			}
+		}
+		if containsAggregate([]parser.Expr{arg}) {
+			v.err = fmt.Errorf("aggregate function calls cannot be nested")
+			return expr
+		}
+		f := &aggregateFunc{
+			create:   create,
+			distinct: t.Distinct,
+			slot:     &parser.ParenExpr{Expr: parser.DNull},
+		}
+		v.funcs = append(v.funcs, f)
+		v.args = append(v.args, arg)
+		return f.slot
+	}
+	return expr
+}
+
+type isAggregateVisitor struct {
+	aggregate bool
+}
+
+var _ parser.Visitor = &isAggregateVisitor{}
+
+func (v *isAggregateVisitor) Visit(expr parser.Expr) parser.Expr {
+	if t, ok := expr.(*parser.FuncExpr); ok {
+		if _, ok := parser.Aggregates[strings.ToLower(t.Name.String())]; ok {
+			v.aggregate = true
+		}
+	}
+	return expr
+}
+
+// containsAggregate returns true if any of the expressions contains an
+// aggregate function.
+func containsAggregate(exprs []parser.Expr) bool {
+	v := isAggregateVisitor{}
+	for _, e := range exprs {
+		parser.WalkExpr(&v, e)
+	}
+	return v.aggregate
+}
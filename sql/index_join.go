@@ -0,0 +1,153 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// indexJoinBatchSize is the number of rows retrieved from the primary index
+// with a single batch of requests.
+const indexJoinBatchSize = 100
+
+// makeIndexJoin constructs an indexJoinNode from a scanNode over a secondary
+// index. The scan is converted into a scan of the primary index which
+// retrieves the rows for the primary keys read from the secondary index, while
+// still filtering and rendering the rows as before. The spans of the scan are
+// transferred to the scan of the secondary index.
+func makeIndexJoin(table *scanNode) (*indexJoinNode, error) {
+	index := &scanNode{
+		txn:              table.txn,
+		desc:             table.desc,
+		index:            table.index,
+		visibleCols:      table.visibleCols,
+		isSecondaryIndex: true,
+		spans:            table.spans,
+	}
+	// The secondary index scan renders the primary key of each row.
+	for _, name := range table.desc.PrimaryIndex.ColumnNames {
+		index.columns = append(index.columns, name)
+		index.render = append(index.render, &parser.QualifiedName{Base: parser.Name(name)})
+	}
+	if !index.initExprs() {
+		return nil, index.err
+	}
+
+	n := &indexJoinNode{
+		index:            index,
+		table:            table,
+		ordering:         table.ordering(),
+		primaryKeyPrefix: structured.MakeIndexKeyPrefix(table.desc.ID, table.desc.PrimaryIndex.ID),
+	}
+	table.index = &table.desc.PrimaryIndex
+	table.isSecondaryIndex = false
+	table.spans = nil
+	return n, nil
+}
+
+// An indexJoinNode retrieves rows using a secondary index. The primary keys of
+// the rows are read from the secondary index and the rows are then retrieved
+// from the primary index in batches. The rows are returned in the order of the
+// secondary index.
+type indexJoinNode struct {
+	index            *scanNode
+	table            *scanNode
+	ordering         []int // the ordering of the rows, as provided by the secondary index
+	primaryKeyPrefix []byte
+	started          bool // a batch of rows has been requested from the table
+	err              error
+}
+
+func (n *indexJoinNode) Columns() []string {
+	return n.table.Columns()
+}
+
+func (n *indexJoinNode) Values() parser.DTuple {
+	return n.table.Values()
+}
+
+func (n *indexJoinNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	for {
+		if n.started {
+			if n.table.Next() {
+				return true
+			}
+			if n.err = n.table.Err(); n.err != nil {
+				return false
+			}
+		}
+
+		// Retrieve the next batch of primary keys from the secondary index.
+		var spans []span
+		for len(spans) < indexJoinBatchSize && n.index.Next() {
+			key := append([]byte(nil), n.primaryKeyPrefix...)
+			for _, d := range n.index.Values() {
+				if key, n.err = encodeTableKey(key, d); n.err != nil {
+					return false
+				}
+			}
+			spans = append(spans, span{start: proto.Key(key), end: proto.Key(key).PrefixEnd()})
+		}
+		if n.err = n.index.Err(); n.err != nil {
+			return false
+		}
+		if len(spans) == 0 {
+			return false
+		}
+		n.table.setSpans(spans)
+		n.started = true
+	}
+}
+
+func (n *indexJoinNode) Err() error {
+	return n.err
+}
+
+// The remaining methods are used while planning the query and apply to the
+// rows retrieved from the table.
+
+func (n *indexJoinNode) addRender(expr parser.Expr) (int, error) {
+	return n.table.addRender(expr)
+}
+
+func (n *indexJoinNode) expandStar(qname *parser.QualifiedName) ([]string, []parser.Expr, error) {
+	return n.table.expandStar(qname)
+}
+
+func (n *indexJoinNode) getRender() ([]string, []parser.Expr) {
+	return n.table.getRender()
+}
+
+func (n *indexJoinNode) setRender(columns []string, render []parser.Expr) {
+	n.table.setRender(columns, render)
+}
+
+func (n *indexJoinNode) setFilter(filter parser.Expr) {
+	n.table.setFilter(filter)
+}
+
+func (n *indexJoinNode) initExprs() bool {
+	return n.table.initExprs()
+}
+
+func (n *indexJoinNode) normalizeQNames(expr parser.Expr) (parser.Expr, error) {
+	return n.table.normalizeQNames(expr)
+}
@@ -0,0 +1,489 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// maxIndexSpans limits the number of spans generated for an index. Beyond this
+// number the index is not considered constrained.
+const maxIndexSpans = 1000
+
+// selectIndex analyzes the filter of a scan and restricts the scan to the key
+// spans of the index which allows the cheapest retrieval of the matching rows.
+// The primary index and the secondary indexes are considered. A secondary
+// index which does not contain all of the columns used by the query requires
+// an index join and is only used if the filter restricts it. If the query
+// specified the index to use (i.e. "table@index") only that index is
+// considered. The filter is still applied to the scanned rows, so the spans
+// only need to contain all of the matching rows.
+//
+// The ordering is the ordering of the render targets required by the ORDER BY
+// clause, if any. When otherwise equally constrained, an index providing the
+// ordering is preferred.
+//
+// selectIndex must be called after the scan expressions are initialized.
+func (p *planner) selectIndex(s *scanNode, ordering []int) error {
+	if s.desc == nil {
+		return nil
+	}
+
+	constraints := s.analyzeFilter()
+
+	var candidates []*structured.IndexDescriptor
+	if s.indexHint {
+		candidates = append(candidates, s.index)
+	} else {
+		candidates = append(candidates, &s.desc.PrimaryIndex)
+		for i := range s.desc.Indexes {
+			if !s.hasNullableColumns(&s.desc.Indexes[i]) {
+				candidates = append(candidates, &s.desc.Indexes[i])
+			}
+		}
+	}
+
+	var best *indexInfo
+	for _, index := range candidates {
+		info, err := s.makeIndexInfo(index, constraints, ordering)
+		if err != nil {
+			return err
+		}
+		if info.indexJoin && !s.indexHint && info.exactPrefix == 0 && !info.rangeColumn {
+			// Scanning all of the index and then looking up each row in the primary
+			// index is more expensive than scanning the primary index.
+			continue
+		}
+		if best == nil || info.better(best) {
+			best = info
+		}
+	}
+
+	s.index = best.index
+	s.isSecondaryIndex = best.index.ID != s.desc.PrimaryIndex.ID
+	s.spans = best.spans
+	return nil
+}
+
+// A columnConstraint restricts the values of a column. The values are either
+// restricted to a set of points (by "=" or "IN") or to a range. Either end of
+// the range may be unbounded.
+type columnConstraint struct {
+	points         []parser.Datum
+	start, end     parser.Datum
+	startInclusive bool
+	endInclusive   bool
+}
+
+func (c *columnConstraint) isRange() bool {
+	return c.start != nil || c.end != nil
+}
+
+// bounds returns the bounds of the range.
+func (c *columnConstraint) bounds() []parser.Datum {
+	var bounds []parser.Datum
+	for _, d := range []parser.Datum{c.start, c.end} {
+		if d != nil {
+			bounds = append(bounds, d)
+		}
+	}
+	return bounds
+}
+
+// intersectStart restricts the start of the range to d.
+func (c *columnConstraint) intersectStart(d parser.Datum, inclusive bool) {
+	if c.start != nil {
+		if cmp := c.start.Compare(d); cmp > 0 || (cmp == 0 && !c.startInclusive) {
+			return
+		}
+	}
+	c.start, c.startInclusive = d, inclusive
+}
+
+// intersectEnd restricts the end of the range to d.
+func (c *columnConstraint) intersectEnd(d parser.Datum, inclusive bool) {
+	if c.end != nil {
+		if cmp := c.end.Compare(d); cmp < 0 || (cmp == 0 && !c.endInclusive) {
+			return
+		}
+	}
+	c.end, c.endInclusive = d, inclusive
+}
+
+// analyzeFilter determines the constraints on the columns of the table
+// implied by the filter. Only the conjuncts of the filter which compare a
+// column against a constant expression are considered.
+func (n *scanNode) analyzeFilter() map[structured.ColumnID]*columnConstraint {
+	constraints := map[structured.ColumnID]*columnConstraint{}
+	get := func(id structured.ColumnID) *columnConstraint {
+		c := constraints[id]
+		if c == nil {
+			c = &columnConstraint{}
+			constraints[id] = c
+		}
+		return c
+	}
+
+	for _, e := range splitAnd(n.filter) {
+		switch t := e.(type) {
+		case *parser.ComparisonExpr:
+			id, op, d, ok := n.columnComparison(t)
+			if !ok {
+				continue
+			}
+			c := get(id)
+			switch op {
+			case parser.EQ:
+				if c.points == nil {
+					c.points = []parser.Datum{d}
+				}
+			case parser.In:
+				tuple, ok := d.(parser.DTuple)
+				if !ok || c.points != nil {
+					continue
+				}
+				c.points = []parser.Datum{}
+				for _, v := range tuple {
+					if v != parser.DNull {
+						c.points = append(c.points, v)
+					}
+				}
+			case parser.LT, parser.LE:
+				c.intersectEnd(d, op == parser.LE)
+			case parser.GT, parser.GE:
+				c.intersectStart(d, op == parser.GE)
+			}
+
+		case *parser.RangeCond:
+			if t.Not {
+				continue
+			}
+			// BETWEEN is equivalent to a pair of comparisons.
+			from := &parser.ComparisonExpr{Operator: parser.GE, Left: t.Left, Right: t.From}
+			to := &parser.ComparisonExpr{Operator: parser.LE, Left: t.Left, Right: t.To}
+			if id, _, d, ok := n.columnComparison(from); ok {
+				get(id).intersectStart(d, true)
+			}
+			if id, _, d, ok := n.columnComparison(to); ok {
+				get(id).intersectEnd(d, true)
+			}
+		}
+	}
+	return constraints
+}
+
+// columnComparison checks whether the comparison compares a column against a
+// constant expression, returning the column, the comparison operator (as if
+// the column were on the left side) and the value of the constant expression.
+func (n *scanNode) columnComparison(
+	c *parser.ComparisonExpr) (structured.ColumnID, parser.ComparisonOp, parser.Datum, bool) {
+	op := c.Operator
+	left, right := c.Left, c.Right
+	if _, ok := n.qvalColumn(left); !ok && op != parser.In {
+		// Try the comparison the other way around.
+		left, right = right, left
+		switch op {
+		case parser.LT:
+			op = parser.GT
+		case parser.LE:
+			op = parser.GE
+		case parser.GT:
+			op = parser.LT
+		case parser.GE:
+			op = parser.LE
+		}
+	}
+	switch op {
+	case parser.EQ, parser.LT, parser.LE, parser.GT, parser.GE, parser.In:
+	default:
+		return 0, 0, nil, false
+	}
+
+	id, ok := n.qvalColumn(left)
+	if !ok || !n.isConstant(right) {
+		return 0, 0, nil, false
+	}
+	d, err := parser.EvalExpr(right)
+	if err != nil || d == parser.DNull {
+		return 0, 0, nil, false
+	}
+	return id, op, d, true
+}
+
+// qvalColumn returns the column whose value is held by expr, if any.
+func (n *scanNode) qvalColumn(expr parser.Expr) (structured.ColumnID, bool) {
+	if paren, ok := expr.(*parser.ParenExpr); ok {
+		for id, qval := range n.qvals {
+			if qval == paren {
+				return id, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// isConstant returns true if expr does not refer to any column values.
+func (n *scanNode) isConstant(expr parser.Expr) bool {
+	v := isConstantVisitor{qvals: n.qvals, constant: true}
+	parser.WalkExpr(&v, expr)
+	return v.constant
+}
+
+type isConstantVisitor struct {
+	qvals    qvalMap
+	constant bool
+}
+
+var _ parser.Visitor = &isConstantVisitor{}
+
+func (v *isConstantVisitor) Visit(expr parser.Expr) parser.Expr {
+	switch t := expr.(type) {
+	case *parser.ParenExpr:
+		for _, qval := range v.qvals {
+			if qval == t {
+				v.constant = false
+			}
+		}
+	case *parser.QualifiedName, parser.ValArg:
+		v.constant = false
+	}
+	return expr
+}
+
+// covers returns true if all of the columns used by the scan are present in
+// the index. The primary key columns are present in every index.
+func (n *scanNode) covers(index *structured.IndexDescriptor) bool {
+	if index.ID == n.desc.PrimaryIndex.ID {
+		return true
+	}
+	indexCols := map[structured.ColumnID]struct{}{}
+	for _, id := range index.ColumnIDs {
+		indexCols[id] = struct{}{}
+	}
+	for _, id := range n.desc.PrimaryIndex.ColumnIDs {
+		indexCols[id] = struct{}{}
+	}
+	for id := range n.qvals {
+		if _, ok := indexCols[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// hasNullableColumns returns true if any of the columns of the index are
+// nullable.
+//
+// TODO(pmattis): NULL values are not distinguishable from other values in
+// index keys, so only indexes over NOT NULL columns are selected
+// automatically.
+func (n *scanNode) hasNullableColumns(index *structured.IndexDescriptor) bool {
+	for _, id := range index.ColumnIDs {
+		col, err := n.desc.FindColumnByID(id)
+		if err != nil || col.Nullable {
+			return true
+		}
+	}
+	return false
+}
+
+// indexInfo describes a scan of an index restricted by column constraints.
+type indexInfo struct {
+	index *structured.IndexDescriptor
+	spans []span
+	// The number of leading index columns restricted to points, and whether the
+	// following column is restricted to a range.
+	exactPrefix int
+	rangeColumn bool
+	// The scan retrieves at most a single row per span.
+	pointLookup bool
+	// The index does not contain all of the columns used by the query, so the
+	// rows need to be looked up in the primary index.
+	indexJoin bool
+	// The scan provides the ordering required by the query.
+	ordered bool
+}
+
+// better returns true if the scan of index i is cheaper than that of index j.
+func (i *indexInfo) better(j *indexInfo) bool {
+	if i.pointLookup != j.pointLookup {
+		return i.pointLookup
+	}
+	if i.pointLookup {
+		if len(i.spans) != len(j.spans) {
+			return len(i.spans) < len(j.spans)
+		}
+		if i.indexJoin != j.indexJoin {
+			return !i.indexJoin
+		}
+	}
+	if i.exactPrefix != j.exactPrefix {
+		return i.exactPrefix > j.exactPrefix
+	}
+	if i.rangeColumn != j.rangeColumn {
+		return i.rangeColumn
+	}
+	if i.indexJoin != j.indexJoin {
+		return !i.indexJoin
+	}
+	return i.ordered && !j.ordered
+}
+
+// makeIndexInfo computes the spans of the index satisfying the column
+// constraints.
+func (n *scanNode) makeIndexInfo(index *structured.IndexDescriptor,
+	constraints map[structured.ColumnID]*columnConstraint, ordering []int) (*indexInfo, error) {
+	info := &indexInfo{index: index, indexJoin: !n.covers(index)}
+
+	if ordering != nil {
+		savedIndex, savedSecondary := n.index, n.isSecondaryIndex
+		n.index, n.isSecondaryIndex = index, index.ID != n.desc.PrimaryIndex.ID
+		info.ordered = orderingPrefix(ordering, n.ordering())
+		n.index, n.isSecondaryIndex = savedIndex, savedSecondary
+	}
+
+	vals, err := makeIndexKeyVals(n.desc, *index)
+	if err != nil {
+		// The index contains a column of a type which cannot be decoded.
+		return info, nil
+	}
+
+	indexPrefix := structured.MakeIndexKeyPrefix(n.desc.ID, index.ID)
+	prefixes := [][]byte{indexPrefix}
+	// The range constraint on the column following the exact prefix, if any.
+	var c *columnConstraint
+	for i, id := range index.ColumnIDs {
+		constraint := constraints[id]
+		if constraint == nil {
+			break
+		}
+		if constraint.points == nil {
+			if constraint.isRange() && sameType(constraint.bounds(), vals[i]) {
+				c = constraint
+			}
+			break
+		}
+		if len(prefixes)*len(constraint.points) > maxIndexSpans ||
+			!sameType(constraint.points, vals[i]) {
+			break
+		}
+		var next [][]byte
+		for _, prefix := range prefixes {
+			for _, d := range constraint.points {
+				key, err := encodeTableKey(append([]byte(nil), prefix...), d)
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, key)
+			}
+		}
+		prefixes = next
+		info.exactPrefix++
+	}
+	info.rangeColumn = c != nil
+
+	if info.exactPrefix == 0 && !info.rangeColumn {
+		// The index is unconstrained.
+		return info, nil
+	}
+
+	for _, prefix := range prefixes {
+		sp := span{start: proto.Key(prefix), end: proto.Key(prefix).PrefixEnd()}
+		if c != nil {
+			if c.start != nil {
+				key, err := encodeTableKey(append([]byte(nil), prefix...), c.start)
+				if err != nil {
+					return nil, err
+				}
+				sp.start = proto.Key(key)
+				if !c.startInclusive {
+					sp.start = sp.start.PrefixEnd()
+				}
+			}
+			if c.end != nil {
+				key, err := encodeTableKey(append([]byte(nil), prefix...), c.end)
+				if err != nil {
+					return nil, err
+				}
+				sp.end = proto.Key(key)
+				if c.endInclusive {
+					sp.end = sp.end.PrefixEnd()
+				}
+			}
+			if bytes.Compare(sp.start, sp.end) >= 0 {
+				continue
+			}
+		}
+		info.spans = append(info.spans, sp)
+	}
+	sort.Sort(spans(info.spans))
+	info.spans = dedupSpans(info.spans)
+
+	info.pointLookup = !info.rangeColumn && info.exactPrefix == len(index.ColumnIDs) &&
+		(index.Unique || index.ID == n.desc.PrimaryIndex.ID)
+	if info.spans == nil {
+		// None of the rows can match. Use an empty span so that the scan is still
+		// restricted.
+		info.spans = []span{{start: proto.Key(indexPrefix), end: proto.Key(indexPrefix)}}
+	}
+	return info, nil
+}
+
+// sameType returns true if all of the values have the same type as the
+// column value.
+func sameType(values []parser.Datum, col parser.Datum) bool {
+	for _, d := range values {
+		if d.Type() != col.Type() {
+			return false
+		}
+	}
+	return true
+}
+
+// spans implements sort.Interface, sorting by start key.
+type spans []span
+
+func (s spans) Len() int {
+	return len(s)
+}
+
+func (s spans) Less(i, j int) bool {
+	return bytes.Compare(s[i].start, s[j].start) < 0
+}
+
+func (s spans) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// dedupSpans removes duplicate spans from a sorted slice of spans. The spans
+// generated for an index do not otherwise overlap.
+func dedupSpans(s []span) []span {
+	if len(s) == 0 {
+		return s
+	}
+	result := s[:1]
+	for _, sp := range s[1:] {
+		if !sp.start.Equal(result[len(result)-1].start) {
+			result = append(result, sp)
+		}
+	}
+	return result
+}
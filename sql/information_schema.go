@@ -0,0 +1,458 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// informationSchemaName is the name of the virtual database containing the
+// information_schema tables.
+const informationSchemaName = "information_schema"
+
+// informationSchemaID is the ID of the information_schema database. The
+// descriptors of virtual tables are never written, so they use IDs from the
+// reserved range: the virtual tables are numbered down from the database ID.
+const informationSchemaID = structured.MaxReservedDescID
+
+// A virtualTable is a read-only table whose rows are generated on the fly
+// when it is scanned. The rows are encoded into key/value pairs the same way
+// as the rows of a regular table so that they can be scanned, filtered and
+// joined like any other table.
+type virtualTable struct {
+	schema string
+	// populate calls addRow with the values of each row of the table, in the
+	// order of the table columns.
+	populate func(p *planner, addRow func(...parser.Datum) error) error
+	desc     structured.TableDescriptor
+}
+
+// informationSchemaTables is initialized by init() to break the
+// initialization cycle between the virtual tables and the functions
+// generating their rows.
+var informationSchemaTables []*virtualTable
+
+func init() {
+	informationSchemaTables = []*virtualTable{
+		{
+			schema: `
+CREATE TABLE information_schema.schemata (
+  schema_name TEXT PRIMARY KEY
+)`,
+			populate: populateSchemata,
+		},
+		{
+			schema: `
+CREATE TABLE information_schema.tables (
+  table_schema TEXT,
+  table_name   TEXT,
+  table_type   TEXT,
+  PRIMARY KEY (table_schema, table_name)
+)`,
+			populate: populateTables,
+		},
+		{
+			schema: `
+CREATE TABLE information_schema.columns (
+  table_schema     TEXT,
+  table_name       TEXT,
+  column_name      TEXT,
+  ordinal_position INT,
+  column_default   TEXT,
+  is_nullable      TEXT,
+  data_type        TEXT,
+  PRIMARY KEY (table_schema, table_name, ordinal_position)
+)`,
+			populate: populateColumns,
+		},
+		{
+			schema: `
+CREATE TABLE information_schema.statistics (
+  table_schema TEXT,
+  table_name   TEXT,
+  index_name   TEXT,
+  seq_in_index INT,
+  column_name  TEXT,
+  non_unique   BOOLEAN,
+  PRIMARY KEY (table_schema, table_name, index_name, seq_in_index)
+)`,
+			populate: populateStatistics,
+		},
+		{
+			schema: `
+CREATE TABLE information_schema.table_privileges (
+  table_schema   TEXT,
+  table_name     TEXT,
+  grantee        TEXT,
+  privilege_type TEXT,
+  PRIMARY KEY (table_schema, table_name, grantee, privilege_type)
+)`,
+			populate: populateTablePrivileges,
+		},
+	}
+
+	for i, vt := range informationSchemaTables {
+		stmts, err := parser.Parse(vt.schema)
+		if err != nil {
+			panic(err)
+		}
+		create := stmts[0].(*parser.CreateTable)
+		if err := create.Table.NormalizeTableName(""); err != nil {
+			panic(err)
+		}
+		desc, err := makeTableDesc(create)
+		if err != nil {
+			panic(err)
+		}
+		desc.ID = informationSchemaID - structured.ID(i+1)
+		if err := desc.AllocateIDs(); err != nil {
+			panic(err)
+		}
+		vt.desc = desc
+	}
+}
+
+// isVirtualDatabase returns whether name refers to a virtual database.
+func isVirtualDatabase(name string) bool {
+	return strings.EqualFold(name, informationSchemaName)
+}
+
+// makeVirtualDatabaseDesc returns the descriptor of the information_schema
+// database. Its privileges are empty: no user can create, drop or alter the
+// virtual tables.
+func makeVirtualDatabaseDesc() *structured.DatabaseDescriptor {
+	return &structured.DatabaseDescriptor{
+		Name: informationSchemaName,
+		ID:   informationSchemaID,
+	}
+}
+
+// getVirtualTableDesc returns a copy of the descriptor of the named virtual
+// table, or nil if there is no such table.
+func getVirtualTableDesc(name string) *structured.TableDescriptor {
+	for _, vt := range informationSchemaTables {
+		if strings.EqualFold(vt.desc.Name, name) {
+			desc := vt.desc
+			desc.Columns = append([]structured.ColumnDescriptor(nil), vt.desc.Columns...)
+			return &desc
+		}
+	}
+	return nil
+}
+
+// getVirtualTableNames returns the qualified names of the virtual tables.
+func getVirtualTableNames() (parser.QualifiedNames, error) {
+	var names []string
+	for _, vt := range informationSchemaTables {
+		names = append(names, vt.desc.Name)
+	}
+	sort.Strings(names)
+	var qualifiedNames parser.QualifiedNames
+	for _, name := range names {
+		qname := &parser.QualifiedName{
+			Base:     parser.Name(informationSchemaName),
+			Indirect: parser.Indirection{parser.NameIndirection(name)},
+		}
+		if err := qname.NormalizeTableName(""); err != nil {
+			return nil, err
+		}
+		qualifiedNames = append(qualifiedNames, qname)
+	}
+	return qualifiedNames, nil
+}
+
+// findVirtualTable returns the virtual table with the specified descriptor
+// ID, or nil if the ID does not refer to a virtual table.
+func findVirtualTable(id structured.ID) *virtualTable {
+	for _, vt := range informationSchemaTables {
+		if vt.desc.ID == id {
+			return vt
+		}
+	}
+	return nil
+}
+
+// getKVs generates the rows of the virtual table and returns them encoded as
+// the key/value pairs of its primary index, sorted by key.
+func (vt *virtualTable) getKVs(p *planner) ([]client.KeyValue, error) {
+	desc := &vt.desc
+	colIDtoRowIndex := map[structured.ColumnID]int{}
+	for i, col := range desc.Columns {
+		colIDtoRowIndex[col.ID] = i
+	}
+	primaryKeyCols := map[structured.ColumnID]struct{}{}
+	for _, id := range desc.PrimaryIndex.ColumnIDs {
+		primaryKeyCols[id] = struct{}{}
+	}
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID)
+
+	var kvs []client.KeyValue
+	addRow := func(values ...parser.Datum) error {
+		if len(values) != len(desc.Columns) {
+			return fmt.Errorf("%s: expected %d values, but found %d",
+				desc.Name, len(desc.Columns), len(values))
+		}
+		primaryIndexKey, _, err := encodeIndexKey(desc.PrimaryIndex.ColumnIDs,
+			colIDtoRowIndex, values, primaryIndexKeyPrefix)
+		if err != nil {
+			return err
+		}
+		// The row sentinel.
+		kvs = append(kvs, client.KeyValue{Key: primaryIndexKey})
+		for i, col := range desc.Columns {
+			if _, ok := primaryKeyCols[col.ID]; ok {
+				continue
+			}
+			if values[i] == parser.DNull {
+				// NULL values are not encoded.
+				continue
+			}
+			var value interface{}
+			switch t := values[i].(type) {
+			case parser.DString:
+				value = []byte(t)
+			case parser.DInt:
+				v := int64(t)
+				value = &v
+			case parser.DBool:
+				v := int64(0)
+				if t {
+					v = 1
+				}
+				value = &v
+			default:
+				return fmt.Errorf("%s: unsupported value type %s", desc.Name, values[i].Type())
+			}
+			kvs = append(kvs, client.KeyValue{
+				Key:   structured.MakeColumnKey(col.ID, primaryIndexKey),
+				Value: value,
+			})
+		}
+		return nil
+	}
+	if err := vt.populate(p, addRow); err != nil {
+		return nil, err
+	}
+	sort.Sort(keyValues(kvs))
+	return kvs, nil
+}
+
+type keyValues []client.KeyValue
+
+func (kvs keyValues) Len() int {
+	return len(kvs)
+}
+
+func (kvs keyValues) Less(i, j int) bool {
+	return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0
+}
+
+func (kvs keyValues) Swap(i, j int) {
+	kvs[i], kvs[j] = kvs[j], kvs[i]
+}
+
+// hasAnyPrivilege returns whether the user holds at least one privilege on
+// the descriptor.
+func (p *planner) hasAnyPrivilege(descriptor descriptorProto) bool {
+	for _, privilege := range parser.Privileges {
+		if descriptor.HasPrivilege(p.user, p.roles, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// A schemaDatabase is a database along with its tables visible to the user.
+type schemaDatabase struct {
+	desc   *structured.DatabaseDescriptor
+	tables []*structured.TableDescriptor
+}
+
+// getSchemaDatabases returns the databases and tables visible to the user,
+// ordered by name. A table is visible if the user holds a privilege on it and
+// a database is visible if the user holds a privilege on it or on one of its
+// tables. The information_schema database and its tables are always visible.
+func (p *planner) getSchemaDatabases() ([]schemaDatabase, error) {
+	names, err := p.getDatabaseNames()
+	if err != nil {
+		return nil, err
+	}
+	var dbs []schemaDatabase
+	for _, name := range names {
+		dbDesc, err := p.getDatabaseDesc(name)
+		if err != nil {
+			return nil, err
+		}
+		virtual := isVirtualDatabase(name)
+		tableNames, err := p.getTableNames(dbDesc)
+		if err != nil {
+			return nil, err
+		}
+		db := schemaDatabase{desc: dbDesc}
+		for _, tableName := range tableNames {
+			tableDesc, err := p.getTableDesc(tableName)
+			if err != nil {
+				return nil, err
+			}
+			if virtual || p.hasAnyPrivilege(tableDesc) {
+				db.tables = append(db.tables, tableDesc)
+			}
+		}
+		if virtual || len(db.tables) > 0 || p.hasAnyPrivilege(dbDesc) {
+			dbs = append(dbs, db)
+		}
+	}
+	return dbs, nil
+}
+
+func populateSchemata(p *planner, addRow func(...parser.Datum) error) error {
+	dbs, err := p.getSchemaDatabases()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		if err := addRow(parser.DString(db.desc.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func populateTables(p *planner, addRow func(...parser.Datum) error) error {
+	dbs, err := p.getSchemaDatabases()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		tableType := parser.DString("BASE TABLE")
+		if isVirtualDatabase(db.desc.Name) {
+			tableType = parser.DString("SYSTEM VIEW")
+		}
+		for _, table := range db.tables {
+			if err := addRow(
+				parser.DString(db.desc.Name),
+				parser.DString(table.Name),
+				tableType,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func populateColumns(p *planner, addRow func(...parser.Datum) error) error {
+	dbs, err := p.getSchemaDatabases()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		for _, table := range db.tables {
+			for i, col := range table.Columns {
+				var colDefault parser.Datum = parser.DNull
+				if col.DefaultExpr != nil {
+					colDefault = parser.DString(*col.DefaultExpr)
+				}
+				isNullable := parser.DString("NO")
+				if col.Nullable {
+					isNullable = parser.DString("YES")
+				}
+				if err := addRow(
+					parser.DString(db.desc.Name),
+					parser.DString(table.Name),
+					parser.DString(col.Name),
+					parser.DInt(i+1),
+					colDefault,
+					isNullable,
+					parser.DString(col.Type.SQLString()),
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func populateStatistics(p *planner, addRow func(...parser.Datum) error) error {
+	dbs, err := p.getSchemaDatabases()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		for _, table := range db.tables {
+			indexes := append([]structured.IndexDescriptor{table.PrimaryIndex}, table.Indexes...)
+			for _, index := range indexes {
+				for j, col := range index.ColumnNames {
+					if err := addRow(
+						parser.DString(db.desc.Name),
+						parser.DString(table.Name),
+						parser.DString(index.Name),
+						parser.DInt(j+1),
+						parser.DString(col),
+						parser.DBool(!index.Unique),
+					); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func populateTablePrivileges(p *planner, addRow func(...parser.Datum) error) error {
+	dbs, err := p.getSchemaDatabases()
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		for _, table := range db.tables {
+			userPrivileges, err := table.Show()
+			if err != nil {
+				return err
+			}
+			for _, userPriv := range userPrivileges {
+				privileges := userPriv.Privileges
+				for _, privilege := range privileges {
+					if privilege == parser.PrivilegeAll {
+						// List the privileges implied by ALL individually.
+						privileges = parser.Privileges
+						break
+					}
+				}
+				for _, privilege := range privileges {
+					if err := addRow(
+						parser.DString(db.desc.Name),
+						parser.DString(table.Name),
+						parser.DString(userPriv.User),
+						parser.DString(privilege.String()),
+					); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -29,7 +29,7 @@ import (
 )
 
 // Insert inserts rows into the database.
-// Privileges: WRITE on table
+// Privileges: INSERT on table
 //   Notes: postgres requires INSERT. No "on duplicate key update" option.
 //          mysql requires INSERT. Also requires UPDATE on "ON DUPLICATE KEY UPDATE".
 func (p *planner) Insert(n *parser.Insert) (planNode, error) {
@@ -38,9 +38,8 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
-	if !tableDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-			p.user, parser.PrivilegeWrite, tableDesc.Name)
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeInsert); err != nil {
+		return nil, err
 	}
 
 	// Determine which columns we're inserting into.
@@ -49,6 +48,32 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
+	// Add the columns which are not being inserted into but have a default
+	// value, as well as the columns which are being added to the table by a
+	// schema change. The latter are not visible yet, but are written along with
+	// the rest of the row so that they do not need to be backfilled.
+	numInputCols := len(cols)
+	for _, col := range tableDesc.Columns {
+		if col.DefaultExpr != nil && !containsColumn(cols, col.ID) {
+			cols = append(cols, col)
+		}
+	}
+	for _, m := range tableDesc.Mutations {
+		if m.Direction == structured.DescriptorMutation_ADD {
+			cols = append(cols, *m.Column)
+		}
+	}
+	defaultExprs, err := makeDefaultExprs(cols)
+	if err != nil {
+		return nil, err
+	}
+	checks, err := makeCheckHelper(tableDesc)
+	if err != nil {
+		return nil, err
+	}
+	fks := p.makeFKHelper(tableDesc)
+	var written [][]parser.Datum
+
 	// Construct a map from column ID to the index the value appears at within a
 	// row.
 	colIDtoRowIndex := map[structured.ColumnID]int{}
@@ -74,13 +99,34 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 
 	primaryIndex := tableDesc.PrimaryIndex
 	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	indexes := writeIndexes(tableDesc)
 
 	b := client.Batch{}
 
 	for rows.Next() {
 		values := rows.Values()
-		for range cols[len(values):] {
-			values = append(values, parser.DNull)
+		if len(values) > numInputCols {
+			return nil, fmt.Errorf("INSERT has more expressions than target columns: %d/%d",
+				len(values), numInputCols)
+		}
+		for _, expr := range defaultExprs[len(values):] {
+			if expr == nil {
+				values = append(values, parser.DNull)
+				continue
+			}
+			d, err := parser.EvalExpr(expr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, d)
+		}
+
+		// Convert the values to the types of their columns, so that they are
+		// encoded in keys the same way as the values read back from the table.
+		for i, val := range values {
+			if values[i], err = coerceDatum(cols[i], val); err != nil {
+				return nil, err
+			}
 		}
 
 		for _, col := range tableDesc.Columns {
@@ -90,6 +136,12 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 				}
 			}
 		}
+		if err := checks.check(colIDtoRowIndex, values); err != nil {
+			return nil, err
+		}
+		if len(tableDesc.ForeignKeys) > 0 {
+			written = append(written, append([]parser.Datum(nil), values...))
+		}
 
 		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
 		if err != nil {
@@ -98,7 +150,7 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
 
 		// Write the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
+		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
 		if err != nil {
 			return nil, err
 		}
@@ -156,10 +208,42 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		}
 		return nil, err
 	}
+	for _, values := range written {
+		if err := fks.checkReferences(colIDtoRowIndex, values, nil); err != nil {
+			return nil, err
+		}
+	}
 	// TODO(tamird/pmattis): return the number of affected rows
 	return &valuesNode{}, nil
 }
 
+// makeDefaultExprs returns the parsed default expressions of the specified
+// columns. The expression of a column without a default value is nil.
+func makeDefaultExprs(cols []structured.ColumnDescriptor) ([]parser.Expr, error) {
+	defaultExprs := make([]parser.Expr, 0, len(cols))
+	for _, col := range cols {
+		if col.DefaultExpr == nil {
+			defaultExprs = append(defaultExprs, nil)
+			continue
+		}
+		expr, err := parser.ParseExpr(*col.DefaultExpr)
+		if err != nil {
+			return nil, err
+		}
+		defaultExprs = append(defaultExprs, expr)
+	}
+	return defaultExprs, nil
+}
+
+func containsColumn(cols []structured.ColumnDescriptor, id structured.ColumnID) bool {
+	for _, col := range cols {
+		if col.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *planner) processColumns(tableDesc *structured.TableDescriptor,
 	node parser.QualifiedNames) ([]structured.ColumnDescriptor, error) {
 	if node == nil {
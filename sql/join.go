@@ -0,0 +1,754 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// join constructs a joinNode for the tables in the FROM clause. The tables are
+// joined from left to right: the rows of each table are joined to the rows
+// produced by the tables to its left. Tables separated by commas are cross
+// joined.
+func (p *planner) join(from parser.TableExprs) (*joinNode, error) {
+	n := &joinNode{}
+	for _, t := range from {
+		if err := p.addJoinTables(n, t); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+func (p *planner) addJoinTables(n *joinNode, expr parser.TableExpr) error {
+	switch t := expr.(type) {
+	case *parser.AliasedTableExpr:
+		_, err := p.addJoinTable(n, t)
+		return err
+
+	case *parser.ParenTableExpr:
+		return p.addJoinTables(n, t.Expr)
+
+	case *parser.JoinTableExpr:
+		var outer bool
+		switch t.Join {
+		case parser.AstJoin, parser.AstInnerJoin, parser.AstCrossJoin:
+		case parser.AstLeftJoin:
+			outer = true
+		default:
+			return fmt.Errorf("unsupported JOIN type: %s", t.Join)
+		}
+
+		if err := p.addJoinTables(n, t.Left); err != nil {
+			return err
+		}
+
+		// The tables are joined from left to right, so the right side of a join
+		// must be a single table.
+		right := t.Right
+		for {
+			paren, ok := right.(*parser.ParenTableExpr)
+			if !ok {
+				break
+			}
+			right = paren.Expr
+		}
+		ate, ok := right.(*parser.AliasedTableExpr)
+		if !ok {
+			return fmt.Errorf("unsupported JOIN operand: %s", t.Right)
+		}
+		table, err := p.addJoinTable(n, ate)
+		if err != nil {
+			return err
+		}
+		table.outer = outer
+
+		switch cond := t.Cond.(type) {
+		case nil:
+		case *parser.OnJoinCond:
+			table.cond = cond.Expr
+		case *parser.UsingJoinCond:
+			if table.cond, err = n.usingCond(cond.Cols); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported JOIN condition: %s", cond)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported FROM: %s", expr)
+	}
+}
+
+func (p *planner) addJoinTable(n *joinNode, ate *parser.AliasedTableExpr) (*joinTable, error) {
+	scan, err := p.tableScan(ate)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range n.tables {
+		if strings.EqualFold(t.scan.desc.Alias, scan.desc.Alias) {
+			return nil, fmt.Errorf("table name \"%s\" specified more than once", scan.desc.Alias)
+		}
+	}
+
+	// The scan renders all of the visible columns of the table.
+	for _, col := range scan.visibleCols {
+		scan.columns = append(scan.columns, col.Name)
+		scan.render = append(scan.render, &parser.QualifiedName{Base: parser.Name(col.Name)})
+	}
+
+	t := &joinTable{scan: scan}
+	n.tables = append(n.tables, t)
+	return t, nil
+}
+
+// A joinNode joins the rows of several tables and filters and renders the
+// joined rows. The rows of the first table are scanned and for each table to
+// the right, the rows matching the join condition are retrieved either by
+// looking them up in an index of the table (if the join condition contains
+// equality comparisons against a prefix of the index columns) or by looping
+// over all of the rows of the table (a nested-loop join).
+type joinNode struct {
+	tables  []*joinTable
+	level   int // the index of the table being iterated over
+	columns []string
+	render  []parser.Expr
+	filter  parser.Expr
+	row     parser.DTuple
+	started bool
+	err     error
+}
+
+// A joinTable is a table within a joinNode.
+type joinTable struct {
+	scan   *scanNode       // the scan rendering the visible columns of the table
+	input  planNode        // the scan, or an index join if it uses a secondary index
+	outer  bool            // if true, rows of the left side without a match are NULL extended
+	cond   parser.Expr     // the join condition; nil for a cross join
+	qvals  qvalMap         // the values of the columns in the current row
+	lookup *indexLookup    // the index used to lookup matching rows, if any
+	rows   []parser.DTuple // all of the rows of the table, for nested-loop joins
+
+	// Iteration state for the rows matching the current row of the tables to
+	// the left.
+	useRows bool // iterate over rows instead of scan
+	rowIdx  int
+	matched bool // a row matching the join condition has been found
+	nulled  bool // the NULL-extended row has been output
+}
+
+func (n *joinNode) Columns() []string {
+	return n.columns
+}
+
+func (n *joinNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *joinNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.started {
+		n.started = true
+		n.start(0)
+	}
+
+	for n.level >= 0 {
+		if !n.advance(n.level) {
+			if n.err != nil {
+				return false
+			}
+			n.level--
+			continue
+		}
+		if n.level < len(n.tables)-1 {
+			n.level++
+			if !n.start(n.level) {
+				return false
+			}
+			continue
+		}
+
+		// We have a joined row. Check to see if it matches the filter before
+		// rendering it.
+		if n.filter != nil {
+			var match bool
+			if match, n.err = evalBool(n.filter, "WHERE"); n.err != nil {
+				return false
+			}
+			if !match {
+				continue
+			}
+		}
+
+		if n.row == nil {
+			n.row = make(parser.DTuple, len(n.render))
+		}
+		for i, e := range n.render {
+			if n.row[i], n.err = parser.EvalExpr(e); n.err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (n *joinNode) Err() error {
+	return n.err
+}
+
+// start prepares the iteration over the rows of the table at index i which
+// match the current rows of the tables to its left.
+func (n *joinNode) start(i int) bool {
+	t := n.tables[i]
+	t.rowIdx = 0
+	t.matched = false
+	t.nulled = false
+	t.useRows = false
+
+	if i == 0 {
+		return true
+	}
+	if t.lookup != nil {
+		var ok bool
+		if ok, n.err = t.lookup.start(t.scan); n.err != nil {
+			return false
+		}
+		if ok {
+			return true
+		}
+		// The values could not be looked up in the index. Fall back to looping
+		// over all of the rows of the table.
+	}
+
+	t.useRows = true
+	if t.rows == nil {
+		t.rows = []parser.DTuple{}
+		for t.input.Next() {
+			// The result from input.Values() is only valid until the next call to
+			// input.Next(), so make a copy.
+			values := t.input.Values()
+			valuesCopy := make(parser.DTuple, len(values))
+			copy(valuesCopy, values)
+			t.rows = append(t.rows, valuesCopy)
+		}
+		if n.err = t.input.Err(); n.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// advance advances the table at index i to the next row matching the join
+// condition, filling in the values of the columns of the table. Returns false
+// if there are no more matching rows or an error occurred.
+func (n *joinNode) advance(i int) bool {
+	t := n.tables[i]
+	for {
+		var row parser.DTuple
+		if t.useRows {
+			if t.rowIdx < len(t.rows) {
+				row = t.rows[t.rowIdx]
+				t.rowIdx++
+			}
+		} else if t.input.Next() {
+			row = t.input.Values()
+		} else if n.err = t.input.Err(); n.err != nil {
+			return false
+		}
+
+		if row == nil {
+			if t.outer && !t.matched && !t.nulled {
+				// There was no row matching the join condition. Output a row with
+				// NULL values for all of the columns of the table.
+				t.nulled = true
+				for _, qval := range t.qvals {
+					qval.Expr = parser.DNull
+				}
+				return true
+			}
+			return false
+		}
+
+		for j, col := range t.scan.visibleCols {
+			if qval := t.qvals[col.ID]; qval != nil {
+				qval.Expr = row[j]
+			}
+		}
+
+		if t.cond != nil {
+			var match bool
+			if match, n.err = evalBool(t.cond, "ON"); n.err != nil {
+				return false
+			}
+			if !match {
+				continue
+			}
+		}
+		t.matched = true
+		return true
+	}
+}
+
+// evalBool evaluates a filtering expression. A NULL result does not match.
+func evalBool(expr parser.Expr, clause string) (bool, error) {
+	d, err := parser.EvalExpr(expr)
+	if err != nil {
+		return false, err
+	}
+	if d == parser.DNull {
+		return false, nil
+	}
+	v, ok := d.(parser.DBool)
+	if !ok {
+		return false, fmt.Errorf("%s clause did not evaluate to a boolean", clause)
+	}
+	return bool(v), nil
+}
+
+func (n *joinNode) expandStar(qname *parser.QualifiedName) ([]string, []parser.Expr, error) {
+	tableName := qname.Table()
+	var columns []string
+	var exprs []parser.Expr
+	found := false
+	for _, t := range n.tables {
+		alias := t.scan.desc.Alias
+		if tableName != "" && !strings.EqualFold(alias, tableName) {
+			continue
+		}
+		found = true
+		// Qualify the column names as the same name might be present in several
+		// tables.
+		for _, col := range t.scan.visibleCols {
+			columns = append(columns, col.Name)
+			exprs = append(exprs, &parser.QualifiedName{
+				Base:     parser.Name(alias),
+				Indirect: parser.Indirection{parser.NameIndirection(col.Name)},
+			})
+		}
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("table \"%s\" not found", tableName)
+	}
+	return columns, exprs, nil
+}
+
+func (n *joinNode) getRender() ([]string, []parser.Expr) {
+	return n.columns, n.render
+}
+
+func (n *joinNode) setRender(columns []string, render []parser.Expr) {
+	n.columns = columns
+	n.render = render
+}
+
+func (n *joinNode) setFilter(filter parser.Expr) {
+	n.filter = filter
+}
+
+// initExprs initializes the render, filter and join condition expressions,
+// replacing QualifiedName nodes with ParenExpr nodes for which the wrapped
+// expression is changed for each joined row. The join condition of a table
+// may only refer to that table and the tables to its left. Once the
+// expressions are initialized the index lookups for the join conditions are
+// determined.
+func (n *joinNode) initExprs() bool {
+	for _, t := range n.tables {
+		t.qvals = make(qvalMap)
+		if !t.scan.initExprs() {
+			n.err = t.scan.err
+			return false
+		}
+		t.input = t.scan
+		if t.scan.isSecondaryIndex && !t.scan.covers(t.scan.index) {
+			var join *indexJoinNode
+			if join, n.err = makeIndexJoin(t.scan); n.err != nil {
+				return false
+			}
+			t.input = join
+		}
+	}
+	for i, t := range n.tables {
+		if t.cond, n.err = n.extractQVals(t.cond, i+1); n.err != nil {
+			return false
+		}
+	}
+	for i := range n.render {
+		if n.render[i], n.err = n.extractQVals(n.render[i], len(n.tables)); n.err != nil {
+			return false
+		}
+	}
+	if n.filter, n.err = n.extractQVals(n.filter, len(n.tables)); n.err != nil {
+		return false
+	}
+	for i, t := range n.tables {
+		if i > 0 && t.cond != nil {
+			t.lookup = n.planLookup(i)
+		}
+	}
+	return true
+}
+
+// addRender adds a render target for the specified expression, returning the
+// 1-based index of the target within the rendered row. If the expression is a
+// column that is already being rendered the existing target is reused.
+func (n *joinNode) addRender(expr parser.Expr) (int, error) {
+	expr, err := n.extractQVals(expr, len(n.tables))
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := expr.(*parser.ParenExpr); ok {
+		for i, e := range n.render {
+			if e == expr {
+				return i + 1, nil
+			}
+		}
+	}
+	n.columns = append(n.columns, expr.String())
+	n.render = append(n.render, expr)
+	return len(n.render), nil
+}
+
+// findColumn finds the column referred to by qname within the first
+// numTables tables. An unqualified column name must refer to a column in
+// exactly one of the tables.
+func (n *joinNode) findColumn(
+	qname *parser.QualifiedName, numTables int) (*joinTable, *structured.ColumnDescriptor, error) {
+	tableName := qname.Table()
+	name := qname.Column()
+	var table *joinTable
+	var column *structured.ColumnDescriptor
+	for _, t := range n.tables[:numTables] {
+		if tableName != "" && !strings.EqualFold(t.scan.desc.Alias, tableName) {
+			continue
+		}
+		for i := range t.scan.visibleCols {
+			col := &t.scan.visibleCols[i]
+			if !strings.EqualFold(name, col.Name) {
+				continue
+			}
+			if table != nil {
+				return nil, nil, fmt.Errorf("column reference \"%s\" is ambiguous", name)
+			}
+			table, column = t, col
+			break
+		}
+	}
+	if table == nil {
+		if tableName == "" {
+			return nil, nil, fmt.Errorf("qualified name \"%s\" not found", name)
+		}
+		return nil, nil, fmt.Errorf("qualified name \"%s\" not found", qname)
+	}
+	return table, column, nil
+}
+
+func (n *joinNode) extractQVals(expr parser.Expr, numTables int) (parser.Expr, error) {
+	if expr == nil {
+		return expr, nil
+	}
+	v := joinQNameVisitor{joinNode: n, numTables: numTables}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.err
+}
+
+func (n *joinNode) normalizeQNames(expr parser.Expr) (parser.Expr, error) {
+	if expr == nil {
+		return expr, nil
+	}
+	v := joinQNameVisitor{joinNode: n, numTables: len(n.tables), normalizeOnly: true}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.err
+}
+
+type joinQNameVisitor struct {
+	*joinNode
+	numTables     int
+	normalizeOnly bool
+	err           error
+}
+
+var _ parser.Visitor = &joinQNameVisitor{}
+
+func (v *joinQNameVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	qname, ok := expr.(*parser.QualifiedName)
+	if !ok {
+		return expr
+	}
+
+	v.err = qname.NormalizeColumnName()
+	if v.err != nil {
+		return expr
+	}
+	if qname.IsStar() {
+		// A "*" can only appear as the argument to a function (e.g. COUNT(*)) at
+		// this point. It is left as-is and rejected when the function is evaluated.
+		return expr
+	}
+
+	t, col, err := v.findColumn(qname, v.numTables)
+	if err != nil {
+		if !v.normalizeOnly {
+			v.err = err
+		}
+		return expr
+	}
+	if qname.Base == "" {
+		qname.Base = parser.Name(t.scan.desc.Alias)
+	}
+	if v.normalizeOnly {
+		return expr
+	}
+
+	paren := t.qvals[col.ID]
+	if paren == nil {
+		paren = &parser.ParenExpr{Expr: parser.DNull}
+		t.qvals[col.ID] = paren
+	}
+	return paren
+}
+
+// usingCond constructs the join condition for a USING clause. Each of the
+// columns must be present in the right table (the most recently added one)
+// and in exactly one of the tables to its left.
+func (n *joinNode) usingCond(cols parser.NameList) (parser.Expr, error) {
+	right := n.tables[len(n.tables)-1]
+	var cond parser.Expr
+	for _, col := range cols {
+		name := &parser.QualifiedName{Base: parser.Name(col)}
+		if err := name.NormalizeColumnName(); err != nil {
+			return nil, err
+		}
+		left, _, err := n.findColumn(name, len(n.tables)-1)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := right.scan.desc.FindColumnByName(col); err != nil {
+			return nil, fmt.Errorf("column \"%s\" specified in USING clause does not exist in right table", col)
+		}
+		eq := &parser.ComparisonExpr{
+			Operator: parser.EQ,
+			Left: &parser.QualifiedName{
+				Base:     parser.Name(left.scan.desc.Alias),
+				Indirect: parser.Indirection{parser.NameIndirection(col)},
+			},
+			Right: &parser.QualifiedName{
+				Base:     parser.Name(right.scan.desc.Alias),
+				Indirect: parser.Indirection{parser.NameIndirection(col)},
+			},
+		}
+		if cond == nil {
+			cond = eq
+		} else {
+			cond = &parser.AndExpr{Left: cond, Right: eq}
+		}
+	}
+	return cond, nil
+}
+
+// planLookup determines whether the rows of the table at index i matching its
+// join condition can be looked up in an index. This is possible if the join
+// condition contains equality comparisons between a prefix of the columns of
+// the index and expressions only referring to the tables to the left. The
+// primary index is preferred over a secondary index using as many columns.
+func (n *joinNode) planLookup(i int) *indexLookup {
+	t := n.tables[i]
+	if t.input != planNode(t.scan) || t.scan.isSecondaryIndex {
+		// The table is being read via a secondary index specified by the query,
+		// either joined back to the primary index or covering the query.
+		return nil
+	}
+
+	// Determine the table owning each of the column values.
+	owners := map[*parser.ParenExpr]int{}
+	colIDs := map[*parser.ParenExpr]structured.ColumnID{}
+	for j, table := range n.tables {
+		for id, qval := range table.qvals {
+			owners[qval] = j
+			colIDs[qval] = id
+		}
+	}
+	leftOnly := func(expr parser.Expr) bool {
+		v := qvalOwnerVisitor{owners: owners, max: -1}
+		parser.WalkExpr(&v, expr)
+		return v.max < i
+	}
+
+	// Find the equality comparisons between a column of the table and an
+	// expression over the tables to the left.
+	eqExprs := map[structured.ColumnID]parser.Expr{}
+	for _, e := range splitAnd(t.cond) {
+		c, ok := e.(*parser.ComparisonExpr)
+		if !ok || c.Operator != parser.EQ {
+			continue
+		}
+		for _, sides := range [][2]parser.Expr{{c.Left, c.Right}, {c.Right, c.Left}} {
+			qval, ok := sides[0].(*parser.ParenExpr)
+			if !ok {
+				continue
+			}
+			if owner, ok := owners[qval]; !ok || owner != i {
+				continue
+			}
+			if leftOnly(sides[1]) {
+				eqExprs[colIDs[qval]] = sides[1]
+				break
+			}
+		}
+	}
+	if len(eqExprs) == 0 {
+		return nil
+	}
+
+	desc := t.scan.desc
+	var best *indexLookup
+	indexes := append([]structured.IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...)
+	for j := range indexes {
+		index := &indexes[j]
+		var exprs []parser.Expr
+		for _, id := range index.ColumnIDs {
+			expr, ok := eqExprs[id]
+			if !ok {
+				break
+			}
+			exprs = append(exprs, expr)
+		}
+		if len(exprs) > 0 && (best == nil || len(exprs) > len(best.exprs)) {
+			best = &indexLookup{
+				txn:   t.scan.txn,
+				desc:  desc,
+				index: index,
+				exprs: exprs,
+			}
+		}
+	}
+	return best
+}
+
+type qvalOwnerVisitor struct {
+	owners map[*parser.ParenExpr]int
+	max    int
+}
+
+var _ parser.Visitor = &qvalOwnerVisitor{}
+
+func (v *qvalOwnerVisitor) Visit(expr parser.Expr) parser.Expr {
+	if paren, ok := expr.(*parser.ParenExpr); ok {
+		if owner, ok := v.owners[paren]; ok && owner > v.max {
+			v.max = owner
+		}
+	}
+	return expr
+}
+
+// splitAnd splits an expression into the expressions which are AND-ed
+// together.
+func splitAnd(expr parser.Expr) []parser.Expr {
+	switch t := expr.(type) {
+	case *parser.AndExpr:
+		return append(splitAnd(t.Left), splitAnd(t.Right)...)
+	case nil:
+		return nil
+	}
+	return []parser.Expr{expr}
+}
+
+// An indexLookup looks up the rows of a table using the values of a prefix of
+// the columns of an index. Lookups in a secondary index retrieve the primary
+// keys of the matching rows which are then looked up in the primary index.
+type indexLookup struct {
+	txn   *client.Txn
+	desc  *structured.TableDescriptor
+	index *structured.IndexDescriptor
+	exprs []parser.Expr // the values of the index columns to lookup
+}
+
+// start restricts scan (a scan of the primary index) to the rows matching the
+// current values of the lookup expressions. Returns false if the values
+// cannot be used for a lookup (because their types do not match the types of
+// the index columns).
+func (l *indexLookup) start(scan *scanNode) (bool, error) {
+	vals, err := makeIndexKeyVals(l.desc, *l.index)
+	if err != nil {
+		return false, err
+	}
+	key := structured.MakeIndexKeyPrefix(l.desc.ID, l.index.ID)
+	for i, e := range l.exprs {
+		d, err := parser.EvalExpr(e)
+		if err != nil {
+			return false, err
+		}
+		if d == parser.DNull {
+			// NULL is not equal to anything, so there are no matching rows. An
+			// empty span causes the scan to return no rows.
+			scan.setSpans([]span{{start: proto.Key(key), end: proto.Key(key)}})
+			return true, nil
+		}
+		if d.Type() != vals[i].Type() {
+			return false, nil
+		}
+		if key, err = encodeTableKey(key, d); err != nil {
+			return false, err
+		}
+	}
+
+	start := proto.Key(key)
+	if l.index.ID == l.desc.PrimaryIndex.ID {
+		scan.setSpans([]span{{start: start, end: start.PrefixEnd()}})
+		return true, nil
+	}
+
+	// Retrieve the primary keys of the matching rows from the secondary index.
+	kvs, err := l.txn.Scan(start, start.PrefixEnd(), 0)
+	if err != nil {
+		return false, err
+	}
+	vals = vals[:len(l.index.ColumnIDs)]
+	var spans []span
+	primaryPrefix := structured.MakeIndexKeyPrefix(l.desc.ID, l.desc.PrimaryIndex.ID)
+	for _, kv := range kvs {
+		// The primary key suffix follows the index columns in the key for
+		// non-unique indexes (and unique indexes containing NULL values) and is
+		// stored in the value for unique indexes.
+		suffix, err := decodeIndexKey(l.desc, *l.index, vals, kv.Key)
+		if err != nil {
+			return false, err
+		}
+		if len(suffix) == 0 {
+			suffix = kv.ValueBytes()
+		}
+		primaryKey := proto.Key(append(append([]byte(nil), primaryPrefix...), suffix...))
+		spans = append(spans, span{start: primaryKey, end: primaryKey.PrefixEnd()})
+	}
+	if len(spans) == 0 {
+		// There are no matching rows.
+		spans = append(spans, span{start: start, end: start})
+	}
+	scan.setSpans(spans)
+	return true, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// kvBatchSize is the maximum number of key/value pairs retrieved from a span
+// by a single scan request. It is a variable so that tests can exercise the
+// retrieval of multiple batches.
+var kvBatchSize int64 = 10000
+
+// A kvFetcher retrieves the key/value pairs within a set of key spans in
+// batches of bounded size. Each batch is retrieved with a single request
+// containing a scan for each of the first remaining spans, which share the
+// limit of the batch. The key/value pairs are returned in span order, so the
+// pairs retrieved from spans following the first span which was not
+// completely scanned are discarded and retrieved again with the next batch.
+type kvFetcher struct {
+	txn        *client.Txn
+	spans      []span // the spans which have not been completely scanned
+	firstBatch int64  // the limit for the first batch; 0 for kvBatchSize
+	fetched    bool   // a batch has been retrieved
+	kvs        []client.KeyValue
+	kvIndex    int
+}
+
+// makeKVFetcher returns a kvFetcher for the specified spans. Empty spans are
+// ignored. If firstBatch is non-zero and smaller than kvBatchSize, the first
+// batch is limited to firstBatch key/value pairs which is useful when only a
+// limited number of rows is needed.
+func makeKVFetcher(txn *client.Txn, spans []span, firstBatch int64) *kvFetcher {
+	f := &kvFetcher{txn: txn, firstBatch: firstBatch}
+	for _, sp := range spans {
+		if sp.start.Less(sp.end) {
+			f.spans = append(f.spans, sp)
+		}
+	}
+	return f
+}
+
+// makeVirtualKVFetcher returns a kvFetcher over the key/value pairs of a
+// virtual table which fall within the specified spans. The key/value pairs
+// must be sorted by key. Empty spans are ignored.
+func makeVirtualKVFetcher(kvs []client.KeyValue, spans []span) *kvFetcher {
+	f := &kvFetcher{fetched: true}
+	for _, sp := range spans {
+		i := sort.Search(len(kvs), func(i int) bool {
+			return !proto.Key(kvs[i].Key).Less(sp.start)
+		})
+		for ; i < len(kvs) && proto.Key(kvs[i].Key).Less(sp.end); i++ {
+			f.kvs = append(f.kvs, kvs[i])
+		}
+	}
+	return f
+}
+
+// nextKV returns the next key/value pair. The returned boolean is false once
+// all of the key/value pairs have been returned or an error occurred.
+func (f *kvFetcher) nextKV() (bool, client.KeyValue, error) {
+	for f.kvIndex == len(f.kvs) {
+		if len(f.spans) == 0 {
+			return false, client.KeyValue{}, nil
+		}
+		if err := f.fetch(); err != nil {
+			return false, client.KeyValue{}, err
+		}
+	}
+	f.kvIndex++
+	return true, f.kvs[f.kvIndex-1], nil
+}
+
+// fetch retrieves the next batch of key/value pairs.
+func (f *kvFetcher) fetch() error {
+	limit := kvBatchSize
+	if !f.fetched && f.firstBatch > 0 && f.firstBatch < limit {
+		limit = f.firstBatch
+	}
+	f.fetched = true
+
+	// The limit is split evenly among the spans scanned by the batch, which
+	// are at most limit spans, so that the batch retrieves at most limit
+	// key/value pairs.
+	spans := f.spans
+	if int64(len(spans)) > limit {
+		spans = spans[:limit]
+	}
+	spanLimit := limit / int64(len(spans))
+
+	b := f.txn.NewBatch()
+	for _, sp := range spans {
+		b.Scan(sp.start, sp.end, spanLimit)
+	}
+	if err := f.txn.Run(b); err != nil {
+		return err
+	}
+
+	f.kvs = f.kvs[:0]
+	f.kvIndex = 0
+	for i, result := range b.Results {
+		f.kvs = append(f.kvs, result.Rows...)
+		if n := len(result.Rows); int64(n) == spanLimit {
+			// The span might contain more key/value pairs. Resume the scan of the
+			// span after the last key retrieved.
+			f.spans[i].start = proto.Key(result.Rows[n-1].Key).Next()
+			f.spans = f.spans[i:]
+			return nil
+		}
+	}
+	f.spans = f.spans[len(spans):]
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestKVFetcher(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+
+	key := func(i int) proto.Key {
+		return proto.Key(fmt.Sprintf("t%03d", i))
+	}
+	for i := 0; i < 20; i++ {
+		if err := s.DB.Put(key(i), i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defer func(batchSize int64) { kvBatchSize = batchSize }(kvBatchSize)
+	kvBatchSize = 3
+
+	testData := []struct {
+		spans      []span
+		firstBatch int64
+		expected   []int
+	}{
+		{[]span{{key(0), key(5)}}, 0, []int{0, 1, 2, 3, 4}},
+		{[]span{{key(0), key(6)}}, 0, []int{0, 1, 2, 3, 4, 5}},
+		{[]span{{key(0), key(5)}}, 1, []int{0, 1, 2, 3, 4}},
+		{[]span{{key(17), key(30)}, {key(30), key(40)}}, 0, []int{17, 18, 19}},
+		{[]span{{key(2), key(3)}, {key(5), key(5)}, {key(8), key(15)}, {key(18), key(19)}}, 0,
+			[]int{2, 8, 9, 10, 11, 12, 13, 14, 18}},
+		{[]span{{key(0), key(1)}, {key(1), key(2)}, {key(2), key(3)}, {key(3), key(4)}, {key(4), key(6)}}, 0,
+			[]int{0, 1, 2, 3, 4, 5}},
+		{[]span{{key(0), key(3)}, {key(5), key(8)}}, 2, []int{0, 1, 2, 5, 6, 7}},
+		{[]span{{key(4), key(4)}}, 0, nil},
+		{nil, 0, nil},
+	}
+	for i, d := range testData {
+		var keys []int
+		if err := s.DB.Txn(func(txn *client.Txn) error {
+			keys = nil
+			f := makeKVFetcher(txn, d.spans, d.firstBatch)
+			for {
+				ok, kv, err := f.nextKV()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+				if n := int64(len(f.kvs)); n > kvBatchSize {
+					return fmt.Errorf("retrieved a batch of %d key/value pairs", n)
+				}
+				var k int
+				if _, err := fmt.Sscanf(string(kv.Key), "t%03d", &k); err != nil {
+					return err
+				}
+				keys = append(keys, k)
+			}
+		}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(d.expected, keys) {
+			t.Errorf("%d: expected %v, but found %v", i, d.expected, keys)
+		}
+	}
+}
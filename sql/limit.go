@@ -0,0 +1,127 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// limit constructs a limitNode based on the LIMIT and OFFSET clauses. If the
+// rows of plan are read directly from a scan without sorting or filtering, the
+// limit is also pushed down into the scan so that only the required rows are
+// retrieved.
+func (p *planner) limit(limit *parser.Limit, plan planNode) (planNode, error) {
+	if limit == nil {
+		return plan, nil
+	}
+
+	var count, offset int64
+
+	data := []struct {
+		name       string
+		src        parser.Expr
+		dst        *int64
+		defaultVal int64
+	}{
+		{"LIMIT", limit.Count, &count, math.MaxInt64},
+		{"OFFSET", limit.Offset, &offset, 0},
+	}
+
+	for _, datum := range data {
+		if datum.src == nil {
+			*datum.dst = datum.defaultVal
+			continue
+		}
+
+		d, err := parser.EvalExpr(datum.src)
+		if err != nil {
+			return nil, err
+		}
+		if d == parser.DNull {
+			*datum.dst = datum.defaultVal
+			continue
+		}
+		v, ok := d.(parser.DInt)
+		if !ok {
+			return nil, fmt.Errorf("argument of %s must be type int, not type %s",
+				datum.name, d.Type())
+		}
+		if v < 0 {
+			return nil, fmt.Errorf("argument of %s must not be negative", datum.name)
+		}
+		*datum.dst = int64(v)
+	}
+
+	if count != math.MaxInt64 && count+offset > 0 && count <= math.MaxInt64-offset {
+		if s := unsortedScan(plan); s != nil && s.filter == nil {
+			s.maxRows = count + offset
+		}
+	}
+
+	return &limitNode{plan: plan, count: count, offset: offset}, nil
+}
+
+// unsortedScan returns the scanNode that directly produces the rows of plan or
+// nil if the rows are sorted after being scanned.
+func unsortedScan(plan planNode) *scanNode {
+	switch t := plan.(type) {
+	case *scanNode:
+		return t
+	case *sortNode:
+		if !t.needSort {
+			return unsortedScan(t.plan)
+		}
+	}
+	return nil
+}
+
+// A limitNode skips the first offset rows of its input plan and then returns
+// at most count rows.
+type limitNode struct {
+	plan   planNode
+	count  int64
+	offset int64
+}
+
+func (n *limitNode) Columns() []string {
+	return n.plan.Columns()
+}
+
+func (n *limitNode) Values() parser.DTuple {
+	return n.plan.Values()
+}
+
+func (n *limitNode) Next() bool {
+	// Skip rows until the offset is reached.
+	for n.offset > 0 {
+		if !n.plan.Next() {
+			return false
+		}
+		n.offset--
+	}
+	if n.count <= 0 {
+		return false
+	}
+	n.count--
+	return n.plan.Next()
+}
+
+func (n *limitNode) Err() error {
+	return n.plan.Err()
+}
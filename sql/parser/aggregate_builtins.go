@@ -0,0 +1,188 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// AggregateFunc accumulates the values of an aggregate function over the rows
+// of a group. NULL values are ignored by all of the aggregate functions.
+type AggregateFunc interface {
+	// Add accumulates the specified value.
+	Add(Datum) error
+	// Result returns the result of the aggregation over the values added so
+	// far.
+	Result() (Datum, error)
+}
+
+// Aggregates maps (lowercase) aggregate function names to constructors for
+// the aggregate functions. Keep the list of functions sorted please.
+var Aggregates = map[string]func() AggregateFunc{
+	"avg":   func() AggregateFunc { return &avgAggregate{} },
+	"count": func() AggregateFunc { return &countAggregate{} },
+	"max":   func() AggregateFunc { return &maxAggregate{} },
+	"min":   func() AggregateFunc { return &minAggregate{} },
+	"sum":   func() AggregateFunc { return &sumAggregate{} },
+}
+
+type avgAggregate struct {
+	sum   sumAggregate
+	count int
+}
+
+func (a *avgAggregate) Add(datum Datum) error {
+	if datum == DNull {
+		return nil
+	}
+	if err := a.sum.add("avg", datum); err != nil {
+		return err
+	}
+	a.count++
+	return nil
+}
+
+func (a *avgAggregate) Result() (Datum, error) {
+	sum, err := a.sum.Result()
+	if err != nil || sum == DNull {
+		return sum, err
+	}
+	switch t := sum.(type) {
+	case DInt:
+		return DFloat(t) / DFloat(a.count), nil
+	case DFloat:
+		return t / DFloat(a.count), nil
+	case DDecimal:
+		return t.quo(decimalFromInt(DInt(a.count)))
+	}
+	return DNull, fmt.Errorf("unexpected SUM result type: %s", sum.Type())
+}
+
+type countAggregate struct {
+	count int
+}
+
+func (a *countAggregate) Add(datum Datum) error {
+	if datum == DNull {
+		return nil
+	}
+	a.count++
+	return nil
+}
+
+func (a *countAggregate) Result() (Datum, error) {
+	return DInt(a.count), nil
+}
+
+type maxAggregate struct {
+	max Datum
+}
+
+func (a *maxAggregate) Add(datum Datum) error {
+	if datum == DNull {
+		return nil
+	}
+	if a.max == nil || a.max.Compare(datum) < 0 {
+		a.max = datum
+	}
+	return nil
+}
+
+func (a *maxAggregate) Result() (Datum, error) {
+	if a.max == nil {
+		return DNull, nil
+	}
+	return a.max, nil
+}
+
+type minAggregate struct {
+	min Datum
+}
+
+func (a *minAggregate) Add(datum Datum) error {
+	if datum == DNull {
+		return nil
+	}
+	if a.min == nil || a.min.Compare(datum) > 0 {
+		a.min = datum
+	}
+	return nil
+}
+
+func (a *minAggregate) Result() (Datum, error) {
+	if a.min == nil {
+		return DNull, nil
+	}
+	return a.min, nil
+}
+
+type sumAggregate struct {
+	sum Datum
+}
+
+func (a *sumAggregate) Add(datum Datum) error {
+	if datum == DNull {
+		return nil
+	}
+	return a.add("sum", datum)
+}
+
+// add accumulates datum into the sum. The sum of integers is an integer. Once
+// a decimal is added the sum becomes a decimal and once a float is added the
+// sum becomes a float.
+func (a *sumAggregate) add(name string, datum Datum) error {
+	if a.sum == nil {
+		a.sum = DInt(0)
+	}
+	switch t := datum.(type) {
+	case DInt:
+		switch s := a.sum.(type) {
+		case DInt:
+			a.sum = s + t
+		case DFloat:
+			a.sum = s + DFloat(t)
+		case DDecimal:
+			a.sum = s.add(decimalFromInt(t))
+		}
+		return nil
+	case DFloat:
+		switch s := a.sum.(type) {
+		case DInt:
+			a.sum = DFloat(s) + t
+		case DFloat:
+			a.sum = s + t
+		case DDecimal:
+			a.sum = DFloat(s.Float64()) + t
+		}
+		return nil
+	case DDecimal:
+		switch s := a.sum.(type) {
+		case DInt:
+			a.sum = decimalFromInt(s).add(t)
+		case DFloat:
+			a.sum = s + DFloat(t.Float64())
+		case DDecimal:
+			a.sum = s.add(t)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown signature for %s: %s(%s)", name, name, datum.Type())
+}
+
+func (a *sumAggregate) Result() (Datum, error) {
+	if a.sum == nil {
+		return DNull, nil
+	}
+	return a.sum, nil
+}
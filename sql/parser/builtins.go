@@ -24,6 +24,8 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 type typeList []reflect.Type
@@ -116,6 +118,24 @@ var builtins = map[string][]builtin{
 		},
 	},
 
+	"current_date": {
+		builtin{
+			types: typeList{},
+			fn: func(args DTuple) (Datum, error) {
+				return dateFromTime(time.Now()), nil
+			},
+		},
+	},
+
+	"now": {
+		builtin{
+			types: typeList{},
+			fn: func(args DTuple) (Datum, error) {
+				return DTimestamp{Time: time.Now().UTC()}, nil
+			},
+		},
+	},
+
 	"random": {
 		builtin{
 			types: typeList{},
@@ -124,6 +144,48 @@ var builtins = map[string][]builtin{
 			},
 		},
 	},
+
+	// unique_rowid returns a unique integer which increases with the time at
+	// which it is generated. It is meant to be used as the default value of
+	// a primary key column when there is no natural key.
+	"unique_rowid": {
+		builtin{
+			types: typeList{},
+			fn: func(args DTuple) (Datum, error) {
+				return DInt(uniqueRowIDs.next()), nil
+			},
+		},
+	},
+}
+
+// uniqueRowIDBits is the number of low-order bits of a unique row ID which
+// identify the process generating it. The remaining bits hold the time in
+// units of 10 microseconds, which fit into an int64 until 2059.
+const uniqueRowIDBits = 15
+
+// uniqueRowIDGenerator generates the values returned by unique_rowid. The
+// IDs combine the current time with a randomly chosen process ID, which
+// makes them unique across processes with high probability. The IDs
+// generated by a process are strictly increasing.
+type uniqueRowIDGenerator struct {
+	sync.Mutex
+	processID int64
+	last      int64
+}
+
+var uniqueRowIDs = uniqueRowIDGenerator{
+	processID: rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(1 << uniqueRowIDBits),
+}
+
+func (g *uniqueRowIDGenerator) next() int64 {
+	g.Lock()
+	defer g.Unlock()
+	units := time.Now().UnixNano() / int64(10*time.Microsecond)
+	if units <= g.last {
+		units = g.last + 1
+	}
+	g.last = units
+	return units<<uniqueRowIDBits | g.processID
 }
 
 var substringImpls = []builtin{
@@ -199,6 +261,9 @@ func datumToRawString(datum Datum) (string, error) {
 		}
 		return "f", nil
 
+	case DDecimal, DDate, DTimestamp, DInterval:
+		return d.String(), nil
+
 	case dNull:
 		return "", nil
 
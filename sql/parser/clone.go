@@ -0,0 +1,248 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+// CloneStmt returns a copy of the statement which can be modified by
+// FillArgs and by planning without modifying the original, so that a parsed
+// statement can be executed repeatedly. The expressions, table expressions
+// and names of queries and of INSERT, UPDATE and DELETE statements are
+// copied. Other statements are returned as-is: planning them only
+// normalizes their table names, which has the same result every time for a
+// given database.
+func CloneStmt(stmt Statement) Statement {
+	switch t := stmt.(type) {
+	case *Delete:
+		c := *t
+		c.Table = cloneTableExpr(t.Table)
+		c.Where = cloneWhere(t.Where)
+		return &c
+	case *Explain:
+		c := *t
+		c.Statement = CloneStmt(t.Statement)
+		return &c
+	case *Insert:
+		c := *t
+		c.Table = cloneQualifiedName(t.Table)
+		if t.Columns != nil {
+			c.Columns = make(QualifiedNames, len(t.Columns))
+			for i, col := range t.Columns {
+				c.Columns[i] = cloneQualifiedName(col)
+			}
+		}
+		c.Rows = CloneStmt(t.Rows).(SelectStatement)
+		return &c
+	case *ParenSelect:
+		c := *t
+		c.Select = CloneStmt(t.Select).(SelectStatement)
+		return &c
+	case *Select:
+		c := *t
+		if t.Exprs != nil {
+			c.Exprs = make(SelectExprs, len(t.Exprs))
+			for i, e := range t.Exprs {
+				c.Exprs[i] = SelectExpr{Expr: CloneExpr(e.Expr), As: e.As}
+			}
+		}
+		if t.From != nil {
+			c.From = make(TableExprs, len(t.From))
+			for i, e := range t.From {
+				c.From[i] = cloneTableExpr(e)
+			}
+		}
+		c.Where = cloneWhere(t.Where)
+		if t.GroupBy != nil {
+			c.GroupBy = make(GroupBy, len(t.GroupBy))
+			for i, e := range t.GroupBy {
+				c.GroupBy[i] = CloneExpr(e)
+			}
+		}
+		c.Having = cloneWhere(t.Having)
+		c.OrderBy = cloneOrderBy(t.OrderBy)
+		c.Limit = cloneLimit(t.Limit)
+		return &c
+	case *Union:
+		c := *t
+		c.Left = CloneStmt(t.Left).(SelectStatement)
+		c.Right = CloneStmt(t.Right).(SelectStatement)
+		c.OrderBy = cloneOrderBy(t.OrderBy)
+		c.Limit = cloneLimit(t.Limit)
+		return &c
+	case *Update:
+		c := *t
+		c.Table = cloneTableExpr(t.Table)
+		if t.Exprs != nil {
+			c.Exprs = make(UpdateExprs, len(t.Exprs))
+			for i, e := range t.Exprs {
+				c.Exprs[i] = &UpdateExpr{Name: cloneQualifiedName(e.Name), Expr: CloneExpr(e.Expr)}
+			}
+		}
+		c.Where = cloneWhere(t.Where)
+		return &c
+	case Values:
+		c := make(Values, len(t))
+		for i, tuple := range t {
+			c[i] = CloneExpr(tuple).(Tuple)
+		}
+		return c
+	}
+	return stmt
+}
+
+// CloneExpr returns a deep copy of the expression.
+func CloneExpr(expr Expr) Expr {
+	if expr == nil {
+		return nil
+	}
+	return WalkExpr(cloneVisitor{}, expr)
+}
+
+// cloneVisitor replaces each node by a shallow copy, whose children are
+// then replaced by WalkExpr.
+type cloneVisitor struct{}
+
+var _ Visitor = cloneVisitor{}
+
+func (cloneVisitor) Visit(expr Expr) Expr {
+	switch t := expr.(type) {
+	case *AndExpr:
+		c := *t
+		return &c
+	case *OrExpr:
+		c := *t
+		return &c
+	case *NotExpr:
+		c := *t
+		return &c
+	case *ParenExpr:
+		c := *t
+		return &c
+	case *ComparisonExpr:
+		c := *t
+		return &c
+	case *RangeCond:
+		c := *t
+		return &c
+	case *NullCheck:
+		c := *t
+		return &c
+	case *ExistsExpr:
+		return &ExistsExpr{Subquery: cloneSubquery(t.Subquery)}
+	case *QualifiedName:
+		return cloneQualifiedName(t)
+	case Row:
+		return append(Row(nil), t...)
+	case Tuple:
+		return append(Tuple(nil), t...)
+	case DTuple:
+		return append(DTuple(nil), t...)
+	case *Subquery:
+		return cloneSubquery(t)
+	case *BinaryExpr:
+		c := *t
+		return &c
+	case *UnaryExpr:
+		c := *t
+		return &c
+	case *FuncExpr:
+		c := *t
+		c.Name = cloneQualifiedName(t.Name)
+		c.Exprs = append(Exprs(nil), t.Exprs...)
+		return &c
+	case *CaseExpr:
+		c := *t
+		c.Whens = make([]*When, len(t.Whens))
+		for i, w := range t.Whens {
+			cw := *w
+			c.Whens[i] = &cw
+		}
+		return &c
+	case *CastExpr:
+		c := *t
+		return &c
+	}
+	// The remaining expressions are values which are not modified.
+	return expr
+}
+
+func cloneSubquery(s *Subquery) *Subquery {
+	if s == nil {
+		return nil
+	}
+	return &Subquery{Select: CloneStmt(s.Select).(SelectStatement)}
+}
+
+func cloneQualifiedName(n *QualifiedName) *QualifiedName {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	// Normalization appends to the indirection, which must not share the
+	// underlying array with the original.
+	c.Indirect = append(Indirection(nil), n.Indirect...)
+	return &c
+}
+
+func cloneTableExpr(expr TableExpr) TableExpr {
+	switch t := expr.(type) {
+	case *AliasedTableExpr:
+		c := *t
+		switch e := t.Expr.(type) {
+		case *QualifiedName:
+			c.Expr = cloneQualifiedName(e)
+		case *Subquery:
+			c.Expr = cloneSubquery(e)
+		}
+		return &c
+	case *ParenTableExpr:
+		return &ParenTableExpr{Expr: cloneTableExpr(t.Expr)}
+	case *JoinTableExpr:
+		c := *t
+		c.Left = cloneTableExpr(t.Left)
+		c.Right = cloneTableExpr(t.Right)
+		if on, ok := t.Cond.(*OnJoinCond); ok {
+			c.Cond = &OnJoinCond{Expr: CloneExpr(on.Expr)}
+		}
+		return &c
+	}
+	return expr
+}
+
+func cloneWhere(w *Where) *Where {
+	if w == nil {
+		return nil
+	}
+	return &Where{Type: w.Type, Expr: CloneExpr(w.Expr)}
+}
+
+func cloneOrderBy(orderBy OrderBy) OrderBy {
+	if orderBy == nil {
+		return nil
+	}
+	c := make(OrderBy, len(orderBy))
+	for i, o := range orderBy {
+		co := *o
+		co.Expr = CloneExpr(o.Expr)
+		c[i] = &co
+	}
+	return c
+}
+
+func cloneLimit(l *Limit) *Limit {
+	if l == nil {
+		return nil
+	}
+	return &Limit{Offset: CloneExpr(l.Offset), Count: CloneExpr(l.Count)}
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "testing"
+
+// TestCloneStmt verifies that filling the arguments of and normalizing the
+// names in a cloned statement leaves the original statement unchanged.
+func TestCloneStmt(t *testing.T) {
+	testData := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT a, $1 FROM t WHERE b = $1 + 1 ORDER BY c LIMIT $1`,
+			`SELECT a, 1 FROM d.t WHERE b = 1 + 1 ORDER BY c LIMIT 1`},
+		{`SELECT CASE WHEN a = $1 THEN f($1) END FROM t@i JOIN u ON t.a = u.b`,
+			`SELECT CASE WHEN a = 1 THEN f(1) END FROM d.t@i JOIN d.u ON t.a = u.b`},
+		{`SELECT * FROM t WHERE a IN (SELECT b FROM u WHERE c = 2)`,
+			`SELECT * FROM d.t WHERE a IN (SELECT b FROM d.u WHERE c = 2)`},
+		{`SELECT a FROM t UNION SELECT $1`,
+			`SELECT a FROM d.t UNION SELECT 1`},
+		{`INSERT INTO t (a, b) VALUES ($1, $1 + 1), (2, 3)`,
+			`INSERT INTO d.t(a, b) VALUES (1, 1 + 1), (2, 3)`},
+		{`UPDATE t SET a = $1 WHERE b = $1`,
+			`UPDATE d.t SET a = 1 WHERE b = 1`},
+		{`DELETE FROM t WHERE a = $1 OR NOT b BETWEEN $1 AND 3`,
+			`DELETE FROM d.t WHERE a = 1 OR NOT b BETWEEN 1 AND 3`},
+		{`EXPLAIN SELECT $1`,
+			`EXPLAIN SELECT 1`},
+	}
+	for _, d := range testData {
+		stmts, err := Parse(d.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		orig := stmts[0].String()
+		clone := CloneStmt(stmts[0])
+		if err := FillArgs(clone, mapArgs{1: DInt(1)}); err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		normalizeTables(clone)
+		if s := clone.String(); s != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.sql, d.expected, s)
+		}
+		if s := stmts[0].String(); s != orig {
+			t.Errorf("%s: original statement changed to %s", d.sql, s)
+		}
+	}
+}
+
+// normalizeTables normalizes the table names of the statement, including
+// those of its subqueries, as planning the statement does.
+func normalizeTables(stmt Statement) {
+	switch t := stmt.(type) {
+	case *Delete:
+		normalizeTableExpr(t.Table)
+		WalkStmt(subqueryNormalizer{}, t)
+	case *Explain:
+		normalizeTables(t.Statement)
+	case *Insert:
+		_ = t.Table.NormalizeTableName("d")
+		normalizeTables(t.Rows)
+	case *ParenSelect:
+		normalizeTables(t.Select)
+	case *Select:
+		for _, e := range t.From {
+			normalizeTableExpr(e)
+		}
+		WalkStmt(subqueryNormalizer{}, t)
+	case *Union:
+		normalizeTables(t.Left)
+		normalizeTables(t.Right)
+	case *Update:
+		normalizeTableExpr(t.Table)
+		WalkStmt(subqueryNormalizer{}, t)
+	}
+}
+
+func normalizeTableExpr(expr TableExpr) {
+	switch t := expr.(type) {
+	case *AliasedTableExpr:
+		if qname, ok := t.Expr.(*QualifiedName); ok {
+			_ = qname.NormalizeTableName("d")
+		}
+	case *JoinTableExpr:
+		normalizeTableExpr(t.Left)
+		normalizeTableExpr(t.Right)
+	}
+}
+
+type subqueryNormalizer struct{}
+
+func (subqueryNormalizer) Visit(expr Expr) Expr {
+	if s, ok := expr.(*Subquery); ok {
+		normalizeTables(s.Select)
+	}
+	return expr
+}
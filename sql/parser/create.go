@@ -44,16 +44,18 @@ func (node *CreateDatabase) String() string {
 	return buf.String()
 }
 
-// TableDef represents a column or index definition within a CREATE TABLE
-// statement.
+// TableDef represents a column, index or constraint definition within a
+// CREATE TABLE statement.
 type TableDef interface {
 	// Placeholder function to ensure that only desired types (*TableDef) conform
 	// to the TableDef interface.
 	tableDef()
 }
 
-func (*ColumnTableDef) tableDef() {}
-func (*IndexTableDef) tableDef()  {}
+func (*ColumnTableDef) tableDef()               {}
+func (*IndexTableDef) tableDef()                {}
+func (*CheckConstraintTableDef) tableDef()      {}
+func (*ForeignKeyConstraintTableDef) tableDef() {}
 
 // TableDefs represents a list of table definitions.
 type TableDefs []TableDef
@@ -87,6 +89,12 @@ type ColumnTableDef struct {
 	Nullable   Nullability
 	PrimaryKey bool
 	Unique     bool
+	// DefaultExpr is nil if the column does not have a default value.
+	DefaultExpr Expr
+	// CheckExprs are the CHECK constraints of the column.
+	CheckExprs []*ColumnCheckConstraint
+	// References is nil if the column does not reference another table.
+	References *ColumnFKConstraint
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
@@ -106,6 +114,12 @@ func newColumnTableDef(name Name, typ ColumnType,
 			d.PrimaryKey = true
 		case UniqueConstraint:
 			d.Unique = true
+		case *DefaultConstraint:
+			d.DefaultExpr = c.(*DefaultConstraint).Expr
+		case *ColumnCheckConstraint:
+			d.CheckExprs = append(d.CheckExprs, c.(*ColumnCheckConstraint))
+		case *ColumnFKConstraint:
+			d.References = c.(*ColumnFKConstraint)
 		}
 	}
 	return d
@@ -120,11 +134,30 @@ func (node *ColumnTableDef) String() string {
 	case NotNull:
 		_, _ = buf.WriteString(" NOT NULL")
 	}
+	if node.DefaultExpr != nil {
+		fmt.Fprintf(&buf, " DEFAULT %s", node.DefaultExpr)
+	}
 	if node.PrimaryKey {
 		_, _ = buf.WriteString(" PRIMARY KEY")
 	} else if node.Unique {
 		_, _ = buf.WriteString(" UNIQUE")
 	}
+	for _, c := range node.CheckExprs {
+		if c.Name != "" {
+			fmt.Fprintf(&buf, " CONSTRAINT %s", c.Name)
+		}
+		fmt.Fprintf(&buf, " CHECK (%s)", c.Expr)
+	}
+	if c := node.References; c != nil {
+		if c.Name != "" {
+			fmt.Fprintf(&buf, " CONSTRAINT %s", c.Name)
+		}
+		fmt.Fprintf(&buf, " REFERENCES %s", c.Table)
+		if c.Col != "" {
+			fmt.Fprintf(&buf, " (%s)", c.Col)
+		}
+		_, _ = buf.WriteString(c.OnDelete.clause())
+	}
 	return buf.String()
 }
 
@@ -133,10 +166,13 @@ type ColumnConstraint interface {
 	columnConstraint()
 }
 
-func (NotNullConstraint) columnConstraint()    {}
-func (NullConstraint) columnConstraint()       {}
-func (PrimaryKeyConstraint) columnConstraint() {}
-func (UniqueConstraint) columnConstraint()     {}
+func (NotNullConstraint) columnConstraint()      {}
+func (NullConstraint) columnConstraint()         {}
+func (PrimaryKeyConstraint) columnConstraint()   {}
+func (UniqueConstraint) columnConstraint()       {}
+func (*DefaultConstraint) columnConstraint()     {}
+func (*ColumnCheckConstraint) columnConstraint() {}
+func (*ColumnFKConstraint) columnConstraint()    {}
 
 // NotNullConstraint represents NOT NULL on a column.
 type NotNullConstraint struct{}
@@ -150,6 +186,56 @@ type PrimaryKeyConstraint struct{}
 // UniqueConstraint represents UNIQUE on a column.
 type UniqueConstraint struct{}
 
+// DefaultConstraint represents DEFAULT on a column.
+type DefaultConstraint struct {
+	Expr Expr
+}
+
+// ColumnCheckConstraint represents CHECK on a column.
+type ColumnCheckConstraint struct {
+	Name Name
+	Expr Expr
+}
+
+// ReferenceAction is the action taken on the referencing rows of a foreign
+// key when the referenced row is deleted.
+type ReferenceAction int
+
+// The values for ReferenceAction.
+const (
+	NoAction ReferenceAction = iota
+	Restrict
+	Cascade
+)
+
+var referenceActionName = [...]string{
+	NoAction: "NO ACTION",
+	Restrict: "RESTRICT",
+	Cascade:  "CASCADE",
+}
+
+func (a ReferenceAction) String() string {
+	return referenceActionName[a]
+}
+
+// clause returns the ON DELETE clause for the action, which is empty for
+// the default action.
+func (a ReferenceAction) clause() string {
+	if a == NoAction {
+		return ""
+	}
+	return fmt.Sprintf(" ON DELETE %s", a)
+}
+
+// ColumnFKConstraint represents REFERENCES on a column.
+type ColumnFKConstraint struct {
+	Name  Name
+	Table *QualifiedName
+	// Col is empty if the primary key of the referenced table is referenced.
+	Col      Name
+	OnDelete ReferenceAction
+}
+
 // IndexTableDef represents an index definition within a CREATE TABLE
 // statement.
 type IndexTableDef struct {
@@ -175,6 +261,73 @@ func (node *IndexTableDef) String() string {
 	return buf.String()
 }
 
+// CheckConstraintTableDef represents a CHECK constraint within a CREATE
+// TABLE statement.
+type CheckConstraintTableDef struct {
+	Name Name
+	Expr Expr
+}
+
+func (node *CheckConstraintTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "CHECK (%s)", node.Expr)
+	return buf.String()
+}
+
+// ForeignKeyConstraintTableDef represents a FOREIGN KEY constraint within a
+// CREATE TABLE statement.
+type ForeignKeyConstraintTableDef struct {
+	Name     Name
+	FromCols NameList
+	Table    *QualifiedName
+	// ToCols is empty if the primary key of the referenced table is
+	// referenced.
+	ToCols   NameList
+	OnDelete ReferenceAction
+}
+
+func (node *ForeignKeyConstraintTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "FOREIGN KEY (%s) REFERENCES %s", node.FromCols, node.Table)
+	if len(node.ToCols) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.ToCols)
+	}
+	_, _ = buf.WriteString(node.OnDelete.clause())
+	return buf.String()
+}
+
+// CreateIndex represents a CREATE INDEX statement.
+type CreateIndex struct {
+	Name        Name
+	Table       *QualifiedName
+	Unique      bool
+	IfNotExists bool
+	Columns     NameList
+}
+
+func (node *CreateIndex) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE ")
+	if node.Unique {
+		_, _ = buf.WriteString("UNIQUE ")
+	}
+	_, _ = buf.WriteString("INDEX ")
+	if node.IfNotExists {
+		_, _ = buf.WriteString("IF NOT EXISTS ")
+	}
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "%s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "ON %s (%s)", node.Table, node.Columns)
+	return buf.String()
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
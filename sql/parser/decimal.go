@@ -0,0 +1,271 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// minDivScale is the minimum number of digits after the decimal point of the
+// quotient of a decimal division.
+const minDivScale = 16
+
+var errDivByZero = errors.New("division by zero")
+
+var bigTen = big.NewInt(10)
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// ParseDDecimal parses a decimal such as "-12.345" or "1.5e3".
+func ParseDDecimal(s string) (DDecimal, error) {
+	orig := s
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(s[i+1:], 10, 32); err != nil {
+			return DDecimal{}, fmt.Errorf("invalid decimal value %q", orig)
+		}
+		s = s[:i]
+	}
+	var scale int64
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = int64(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+	if s == "" || s == "-" || s == "+" || strings.ContainsAny(s[1:], "+-") {
+		return DDecimal{}, fmt.Errorf("invalid decimal value %q", orig)
+	}
+	coeff, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return DDecimal{}, fmt.Errorf("invalid decimal value %q", orig)
+	}
+	scale -= exp
+	if scale < 0 {
+		coeff.Mul(coeff, pow10(int32(-scale)))
+		scale = 0
+	}
+	return DDecimal{Coeff: coeff, Scale: int32(scale)}, nil
+}
+
+// decimalFromInt returns the decimal equal to i.
+func decimalFromInt(i DInt) DDecimal {
+	return DDecimal{Coeff: big.NewInt(int64(i))}
+}
+
+// decimalFromFloat returns the decimal closest to f that is formatted by
+// strconv with the fewest digits.
+func decimalFromFloat(f DFloat) (DDecimal, error) {
+	return ParseDDecimal(strconv.FormatFloat(float64(f), 'e', -1, 64))
+}
+
+// coeff returns the unscaled value of the decimal. The zero DDecimal has a
+// nil coefficient and represents 0.
+func (d DDecimal) coeff() *big.Int {
+	if d.Coeff == nil {
+		return new(big.Int)
+	}
+	return d.Coeff
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the decimal.
+func (d DDecimal) Sign() int {
+	return d.coeff().Sign()
+}
+
+// Rescale returns the decimal rounded (half away from zero) or extended with
+// zeros to the given number of digits after the decimal point.
+func (d DDecimal) Rescale(scale int32) DDecimal {
+	c := d.coeff()
+	switch {
+	case scale == d.Scale:
+		return DDecimal{Coeff: c, Scale: scale}
+	case scale > d.Scale:
+		return DDecimal{Coeff: new(big.Int).Mul(c, pow10(scale-d.Scale)), Scale: scale}
+	}
+	div := pow10(d.Scale - scale)
+	q, r := new(big.Int).QuoRem(c, div, new(big.Int))
+	// Round half away from zero: compare 2*|r| with the divisor.
+	r.Abs(r)
+	r.Lsh(r, 1)
+	if r.Cmp(div) >= 0 {
+		if c.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return DDecimal{Coeff: q, Scale: scale}
+}
+
+// Normalize returns the decimal without trailing zeros after the decimal
+// point.
+func (d DDecimal) Normalize() DDecimal {
+	c := new(big.Int).Set(d.coeff())
+	scale := d.Scale
+	r := new(big.Int)
+	for scale > 0 && c.Sign() != 0 {
+		q, m := new(big.Int).QuoRem(c, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		c = q
+		scale--
+	}
+	if c.Sign() == 0 {
+		scale = 0
+	}
+	return DDecimal{Coeff: c, Scale: scale}
+}
+
+// Limit rounds the decimal to the given scale and checks that the result has
+// at most prec digits, as required by a column of type DECIMAL(prec, scale).
+// A zero precision places no limit on the decimal.
+func (d DDecimal) Limit(prec, scale int) (DDecimal, error) {
+	if prec <= 0 {
+		return d, nil
+	}
+	r := d.Rescale(int32(scale))
+	digits := new(big.Int).Abs(r.coeff()).String()
+	if r.Sign() != 0 && len(digits) > prec {
+		return DDecimal{}, fmt.Errorf("decimal value %s out of range for DECIMAL(%d,%d)", d, prec, scale)
+	}
+	return r, nil
+}
+
+// Float64 returns the float closest to the decimal.
+func (d DDecimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Int64 returns the decimal rounded to an integer. An error is returned if
+// the result does not fit into an int64.
+func (d DDecimal) Int64() (int64, error) {
+	r := d.Rescale(0).coeff()
+	if r.BitLen() > 63 {
+		return 0, fmt.Errorf("decimal value %s out of range for int", d)
+	}
+	return r.Int64(), nil
+}
+
+// alignDecimals returns the coefficients of l and r scaled to the larger of
+// their scales, along with that scale.
+func alignDecimals(l, r DDecimal) (*big.Int, *big.Int, int32) {
+	lc, rc := l.coeff(), r.coeff()
+	switch {
+	case l.Scale < r.Scale:
+		return new(big.Int).Mul(lc, pow10(r.Scale-l.Scale)), rc, r.Scale
+	case l.Scale > r.Scale:
+		return lc, new(big.Int).Mul(rc, pow10(l.Scale-r.Scale)), l.Scale
+	}
+	return lc, rc, l.Scale
+}
+
+func (d DDecimal) cmp(other DDecimal) int {
+	l, r, _ := alignDecimals(d, other)
+	return l.Cmp(r)
+}
+
+func (d DDecimal) neg() DDecimal {
+	return DDecimal{Coeff: new(big.Int).Neg(d.coeff()), Scale: d.Scale}
+}
+
+func (d DDecimal) add(other DDecimal) DDecimal {
+	l, r, scale := alignDecimals(d, other)
+	return DDecimal{Coeff: new(big.Int).Add(l, r), Scale: scale}
+}
+
+func (d DDecimal) sub(other DDecimal) DDecimal {
+	l, r, scale := alignDecimals(d, other)
+	return DDecimal{Coeff: new(big.Int).Sub(l, r), Scale: scale}
+}
+
+func (d DDecimal) mul(other DDecimal) DDecimal {
+	return DDecimal{
+		Coeff: new(big.Int).Mul(d.coeff(), other.coeff()),
+		Scale: d.Scale + other.Scale,
+	}
+}
+
+// quo returns the quotient rounded to at least minDivScale digits after the
+// decimal point. Trailing zeros beyond the scales of the operands are
+// removed, so that 6 / 2 is 3 and not 3.0000000000000000.
+func (d DDecimal) quo(other DDecimal) (DDecimal, error) {
+	if other.Sign() == 0 {
+		return DDecimal{}, errDivByZero
+	}
+	scale := int32(minDivScale)
+	if d.Scale > scale {
+		scale = d.Scale
+	}
+	if other.Scale > scale {
+		scale = other.Scale
+	}
+	// Compute the quotient with one extra digit and round it.
+	num := new(big.Int).Mul(d.coeff(), pow10(scale+1+other.Scale-d.Scale))
+	q := DDecimal{Coeff: num.Quo(num, other.coeff()), Scale: scale + 1}.Rescale(scale)
+
+	minScale := d.Scale
+	if other.Scale > minScale {
+		minScale = other.Scale
+	}
+	n := q.Normalize()
+	if n.Scale < minScale {
+		return n.Rescale(minScale), nil
+	}
+	return n, nil
+}
+
+// mod returns the remainder of the truncated division of the decimals. The
+// remainder has the sign of the dividend.
+func (d DDecimal) mod(other DDecimal) (DDecimal, error) {
+	if other.Sign() == 0 {
+		return DDecimal{}, errZeroModulus
+	}
+	l, r, scale := alignDecimals(d, other)
+	return DDecimal{Coeff: new(big.Int).Rem(l, r), Scale: scale}, nil
+}
+
+// String formats the decimal with exactly Scale digits after the decimal
+// point.
+func (d DDecimal) String() string {
+	c := d.coeff()
+	digits := new(big.Int).Abs(c).String()
+	var buf bytes.Buffer
+	if c.Sign() < 0 {
+		_ = buf.WriteByte('-')
+	}
+	if d.Scale <= 0 {
+		_, _ = buf.WriteString(digits)
+		return buf.String()
+	}
+	scale := int(d.Scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	_, _ = buf.WriteString(digits[:len(digits)-scale])
+	_ = buf.WriteByte('.')
+	_, _ = buf.WriteString(digits[len(digits)-scale:])
+	return buf.String()
+}
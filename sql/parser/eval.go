@@ -22,31 +22,40 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var errZeroModulus = errors.New("zero modulus")
 
 // TODO(pmattis):
 //
-// - Support decimal arithmetic.
-//
 // - Allow partial expression evaluation to simplify expressions before being
 //   used in where clauses. Make Datum implement Expr and change EvalExpr to
 //   return an Expr.
 
-// A Datum holds either a bool, int64, float64, string or []Datum.
+// A Datum holds either a bool, int64, float64, string, decimal, date,
+// timestamp, interval or []Datum.
 type Datum interface {
 	Expr
 	Type() string
+	// Compare returns -1 if the receiver is less than other, 0 if receiver is
+	// equal to other and +1 if receiver is greater than other. NULL is less
+	// than every other value.
+	Compare(other Datum) int
 }
 
 var _ Datum = DBool(false)
 var _ Datum = DInt(0)
 var _ Datum = DFloat(0)
 var _ Datum = DString("")
+var _ Datum = DDecimal{}
+var _ Datum = DDate(0)
+var _ Datum = DTimestamp{}
+var _ Datum = DInterval{}
 var _ Datum = DTuple{}
 var _ Datum = dNull{}
 
@@ -65,6 +74,21 @@ func (d DBool) Type() string {
 	return "bool"
 }
 
+// Compare implements the Datum interface.
+func (d DBool) Compare(other Datum) int {
+	v, ok := other.(DBool)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if !d && v {
+		return -1
+	}
+	if d && !v {
+		return 1
+	}
+	return 0
+}
+
 func (d DBool) String() string {
 	return BoolVal(d).String()
 }
@@ -77,6 +101,21 @@ func (d DInt) Type() string {
 	return "int"
 }
 
+// Compare implements the Datum interface.
+func (d DInt) Compare(other Datum) int {
+	v, ok := other.(DInt)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
 func (d DInt) String() string {
 	return strconv.FormatInt(int64(d), 10)
 }
@@ -89,6 +128,21 @@ func (d DFloat) Type() string {
 	return "float"
 }
 
+// Compare implements the Datum interface.
+func (d DFloat) Compare(other Datum) int {
+	v, ok := other.(DFloat)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
 func (d DFloat) String() string {
 	return strconv.FormatFloat(float64(d), 'g', -1, 64)
 }
@@ -101,10 +155,196 @@ func (d DString) Type() string {
 	return "string"
 }
 
+// Compare implements the Datum interface.
+func (d DString) Compare(other Datum) int {
+	v, ok := other.(DString)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
 func (d DString) String() string {
 	return StrVal(d).String()
 }
 
+// DDecimal is the decimal Datum. It holds the exact value Coeff*10^-Scale;
+// the zero DDecimal is 0. Decimals are immutable: operations return new
+// coefficients rather than modifying existing ones.
+type DDecimal struct {
+	Coeff *big.Int
+	Scale int32
+}
+
+// Type implements the Datum interface.
+func (d DDecimal) Type() string {
+	return "decimal"
+}
+
+// Compare implements the Datum interface.
+func (d DDecimal) Compare(other Datum) int {
+	v, ok := other.(DDecimal)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	return d.cmp(v)
+}
+
+const (
+	secondsInDay    = 24 * 60 * 60
+	dateFormat      = "2006-01-02"
+	timestampFormat = "2006-01-02 15:04:05.999999999-07:00"
+)
+
+// timestampFormats are the formats accepted when parsing a timestamp. The
+// fractional seconds are optional in all of them and the time zone defaults
+// to UTC.
+var timestampFormats = []string{
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	dateFormat,
+}
+
+// DDate is the date Datum, represented as the number of days since the Unix
+// epoch.
+type DDate int64
+
+// ParseDDate parses a date such as "2015-08-30". The time of a timestamp is
+// accepted and truncated.
+func ParseDDate(s string) (DDate, error) {
+	t, err := ParseDTimestamp(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date value %q", s)
+	}
+	return dateFromTime(t.Time), nil
+}
+
+// dateFromTime returns the date of the day containing t in UTC.
+func dateFromTime(t time.Time) DDate {
+	secs := t.Unix()
+	days := secs / secondsInDay
+	if secs%secondsInDay < 0 {
+		days--
+	}
+	return DDate(days)
+}
+
+// Type implements the Datum interface.
+func (d DDate) Type() string {
+	return "date"
+}
+
+// Compare implements the Datum interface.
+func (d DDate) Compare(other Datum) int {
+	v, ok := other.(DDate)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
+// Time returns the timestamp of midnight UTC of the date.
+func (d DDate) Time() time.Time {
+	return time.Unix(int64(d)*secondsInDay, 0).UTC()
+}
+
+func (d DDate) String() string {
+	return d.Time().Format(dateFormat)
+}
+
+// DTimestamp is the timestamp Datum.
+type DTimestamp struct {
+	time.Time
+}
+
+// ParseDTimestamp parses a timestamp such as "2015-08-30 03:34:45.345" or
+// "2015-08-30T03:34:45.345-04:00". Timestamps without a time zone are in UTC.
+func ParseDTimestamp(s string) (DTimestamp, error) {
+	for _, format := range timestampFormats {
+		if t, err := time.Parse(format, s); err == nil {
+			return DTimestamp{Time: t.UTC()}, nil
+		}
+	}
+	return DTimestamp{}, fmt.Errorf("invalid timestamp value %q", s)
+}
+
+// Type implements the Datum interface.
+func (d DTimestamp) Type() string {
+	return "timestamp"
+}
+
+// Compare implements the Datum interface.
+func (d DTimestamp) Compare(other Datum) int {
+	v, ok := other.(DTimestamp)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d.Before(v.Time) {
+		return -1
+	}
+	if v.Before(d.Time) {
+		return 1
+	}
+	return 0
+}
+
+func (d DTimestamp) String() string {
+	return d.UTC().Format(timestampFormat)
+}
+
+// DInterval is the interval Datum.
+type DInterval struct {
+	time.Duration
+}
+
+// ParseDInterval parses an interval such as "1h30m" or "-1.5s". See
+// time.ParseDuration for the accepted units.
+func ParseDInterval(s string) (DInterval, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return DInterval{}, fmt.Errorf("invalid interval value %q", s)
+	}
+	return DInterval{Duration: d}, nil
+}
+
+// Type implements the Datum interface.
+func (d DInterval) Type() string {
+	return "interval"
+}
+
+// Compare implements the Datum interface.
+func (d DInterval) Compare(other Datum) int {
+	v, ok := other.(DInterval)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	if d.Duration < v.Duration {
+		return -1
+	}
+	if d.Duration > v.Duration {
+		return 1
+	}
+	return 0
+}
+
+func (d DInterval) String() string {
+	return d.Duration.String()
+}
+
 // DTuple is the tuple Datum.
 type DTuple []Datum
 
@@ -113,6 +353,30 @@ func (d DTuple) Type() string {
 	return "tuple"
 }
 
+// Compare implements the Datum interface.
+func (d DTuple) Compare(other Datum) int {
+	v, ok := other.(DTuple)
+	if !ok {
+		return compareMismatched(d, other)
+	}
+	n := len(d)
+	if n > len(v) {
+		n = len(v)
+	}
+	for i := 0; i < n; i++ {
+		if c := d[i].Compare(v[i]); c != 0 {
+			return c
+		}
+	}
+	if len(d) < len(v) {
+		return -1
+	}
+	if len(d) > len(v) {
+		return 1
+	}
+	return 0
+}
+
 func (d DTuple) String() string {
 	var buf bytes.Buffer
 	_ = buf.WriteByte('(')
@@ -136,17 +400,71 @@ func (d dNull) Type() string {
 	return "NULL"
 }
 
+// Compare implements the Datum interface.
+func (d dNull) Compare(other Datum) int {
+	if other == DNull {
+		return 0
+	}
+	return -1
+}
+
 func (d dNull) String() string {
 	return "NULL"
 }
 
+// compareMismatched compares two datums of differing types. NULL sorts before
+// all other values, ints, floats and decimals are compared numerically and
+// dates are compared with timestamps as midnight UTC. Any other combination of
+// types is ordered by type name so that the result is at least deterministic.
+func compareMismatched(d, other Datum) int {
+	if other == DNull {
+		return 1
+	}
+	switch t := d.(type) {
+	case DInt:
+		switch v := other.(type) {
+		case DFloat:
+			return DFloat(t).Compare(v)
+		case DDecimal:
+			return decimalFromInt(t).cmp(v)
+		}
+	case DFloat:
+		switch v := other.(type) {
+		case DInt:
+			return t.Compare(DFloat(v))
+		case DDecimal:
+			return t.Compare(DFloat(v.Float64()))
+		}
+	case DDecimal:
+		switch v := other.(type) {
+		case DInt:
+			return t.cmp(decimalFromInt(v))
+		case DFloat:
+			return DFloat(t.Float64()).Compare(v)
+		}
+	case DDate:
+		if v, ok := other.(DTimestamp); ok {
+			return DTimestamp{Time: t.Time()}.Compare(v)
+		}
+	case DTimestamp:
+		if v, ok := other.(DDate); ok {
+			return t.Compare(DTimestamp{Time: v.Time()})
+		}
+	}
+	return DString(d.Type()).Compare(DString(other.Type()))
+}
+
 var (
-	boolType   = reflect.TypeOf(DBool(false))
-	intType    = reflect.TypeOf(DInt(0))
-	floatType  = reflect.TypeOf(DFloat(0))
-	stringType = reflect.TypeOf(DString(""))
-	tupleType  = reflect.TypeOf(DTuple{})
-	nullType   = reflect.TypeOf(DNull)
+	boolType      = reflect.TypeOf(DBool(false))
+	intType       = reflect.TypeOf(DInt(0))
+	floatType     = reflect.TypeOf(DFloat(0))
+	stringType    = reflect.TypeOf(DString(""))
+	decimalType   = reflect.TypeOf(DDecimal{})
+	dateType      = reflect.TypeOf(DDate(0))
+	timestampType = reflect.TypeOf(DTimestamp{})
+	intervalType  = reflect.TypeOf(DInterval{})
+	tupleType     = reflect.TypeOf(DTuple{})
+	nullType      = reflect.TypeOf(DNull)
 )
 
 type unaryArgs struct {
@@ -163,6 +481,12 @@ var unaryOps = map[unaryArgs]func(Datum) (Datum, error){
 	unaryArgs{UnaryPlus, floatType}: func(d Datum) (Datum, error) {
 		return d, nil
 	},
+	unaryArgs{UnaryPlus, decimalType}: func(d Datum) (Datum, error) {
+		return d, nil
+	},
+	unaryArgs{UnaryPlus, intervalType}: func(d Datum) (Datum, error) {
+		return d, nil
+	},
 
 	unaryArgs{UnaryMinus, intType}: func(d Datum) (Datum, error) {
 		return -d.(DInt), nil
@@ -170,6 +494,12 @@ var unaryOps = map[unaryArgs]func(Datum) (Datum, error){
 	unaryArgs{UnaryMinus, floatType}: func(d Datum) (Datum, error) {
 		return -d.(DFloat), nil
 	},
+	unaryArgs{UnaryMinus, decimalType}: func(d Datum) (Datum, error) {
+		return d.(DDecimal).neg(), nil
+	},
+	unaryArgs{UnaryMinus, intervalType}: func(d Datum) (Datum, error) {
+		return DInterval{Duration: -d.(DInterval).Duration}, nil
+	},
 
 	unaryArgs{UnaryComplement, intType}: func(d Datum) (Datum, error) {
 		return ^d.(DInt), nil
@@ -208,6 +538,36 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 	binArgs{Plus, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DFloat) + right.(DFloat), nil
 	},
+	binArgs{Plus, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).add(right.(DDecimal)), nil
+	},
+	binArgs{Plus, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).add(decimalFromInt(right.(DInt))), nil
+	},
+	binArgs{Plus, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return decimalFromInt(left.(DInt)).add(right.(DDecimal)), nil
+	},
+	binArgs{Plus, dateType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDate) + DDate(right.(DInt)), nil
+	},
+	binArgs{Plus, intType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DDate(left.(DInt)) + right.(DDate), nil
+	},
+	binArgs{Plus, dateType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: left.(DDate).Time().Add(right.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: right.(DDate).Time().Add(left.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, timestampType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: left.(DTimestamp).Add(right.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: right.(DTimestamp).Add(left.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{Duration: left.(DInterval).Duration + right.(DInterval).Duration}, nil
+	},
 
 	binArgs{Minus, intType, intType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DInt) - right.(DInt), nil
@@ -215,6 +575,33 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 	binArgs{Minus, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DFloat) - right.(DFloat), nil
 	},
+	binArgs{Minus, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).sub(right.(DDecimal)), nil
+	},
+	binArgs{Minus, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).sub(decimalFromInt(right.(DInt))), nil
+	},
+	binArgs{Minus, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return decimalFromInt(left.(DInt)).sub(right.(DDecimal)), nil
+	},
+	binArgs{Minus, dateType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDate) - DDate(right.(DInt)), nil
+	},
+	binArgs{Minus, dateType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DInt(left.(DDate) - right.(DDate)), nil
+	},
+	binArgs{Minus, dateType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: left.(DDate).Time().Add(-right.(DInterval).Duration)}, nil
+	},
+	binArgs{Minus, timestampType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{Time: left.(DTimestamp).Add(-right.(DInterval).Duration)}, nil
+	},
+	binArgs{Minus, timestampType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{Duration: left.(DTimestamp).Sub(right.(DTimestamp).Time)}, nil
+	},
+	binArgs{Minus, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{Duration: left.(DInterval).Duration - right.(DInterval).Duration}, nil
+	},
 
 	binArgs{Mult, intType, intType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DInt) * right.(DInt), nil
@@ -222,6 +609,21 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 	binArgs{Mult, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DFloat) * right.(DFloat), nil
 	},
+	binArgs{Mult, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).mul(right.(DDecimal)), nil
+	},
+	binArgs{Mult, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).mul(decimalFromInt(right.(DInt))), nil
+	},
+	binArgs{Mult, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return decimalFromInt(left.(DInt)).mul(right.(DDecimal)), nil
+	},
+	binArgs{Mult, intervalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{Duration: left.(DInterval).Duration * time.Duration(right.(DInt))}, nil
+	},
+	binArgs{Mult, intType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{Duration: time.Duration(left.(DInt)) * right.(DInterval).Duration}, nil
+	},
 
 	binArgs{Div, intType, intType}: func(left Datum, right Datum) (Datum, error) {
 		return DFloat(left.(DInt)) / DFloat(right.(DInt)), nil
@@ -229,6 +631,22 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 	binArgs{Div, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DFloat) / right.(DFloat), nil
 	},
+	binArgs{Div, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).quo(right.(DDecimal))
+	},
+	binArgs{Div, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).quo(decimalFromInt(right.(DInt)))
+	},
+	binArgs{Div, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return decimalFromInt(left.(DInt)).quo(right.(DDecimal))
+	},
+	binArgs{Div, intervalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		r := right.(DInt)
+		if r == 0 {
+			return nil, errDivByZero
+		}
+		return DInterval{Duration: left.(DInterval).Duration / time.Duration(r)}, nil
+	},
 
 	binArgs{Mod, intType, intType}: func(left Datum, right Datum) (Datum, error) {
 		r := right.(DInt)
@@ -240,6 +658,15 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 	binArgs{Mod, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return DFloat(math.Mod(float64(left.(DFloat)), float64(right.(DFloat)))), nil
 	},
+	binArgs{Mod, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).mod(right.(DDecimal))
+	},
+	binArgs{Mod, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DDecimal).mod(decimalFromInt(right.(DInt)))
+	},
+	binArgs{Mod, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return decimalFromInt(left.(DInt)).mod(right.(DDecimal))
+	},
 
 	binArgs{Concat, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DString) + right.(DString), nil
@@ -285,6 +712,36 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{EQ, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DFloat) == right.(DFloat)), nil
 	},
+	cmpArgs{EQ, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, decimalType, floatType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, floatType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, dateType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, timestampType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, dateType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, timestampType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
+	cmpArgs{EQ, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) == 0), nil
+	},
 
 	cmpArgs{LT, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DString) < right.(DString)), nil
@@ -298,6 +755,36 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{LT, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DFloat) < right.(DFloat)), nil
 	},
+	cmpArgs{LT, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, decimalType, floatType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, floatType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, dateType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, timestampType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, dateType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, timestampType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
+	cmpArgs{LT, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) < 0), nil
+	},
 
 	cmpArgs{LE, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DString) <= right.(DString)), nil
@@ -311,6 +798,36 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{LE, floatType, floatType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DFloat) <= right.(DFloat)), nil
 	},
+	cmpArgs{LE, decimalType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, decimalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, intType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, decimalType, floatType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, floatType, decimalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, dateType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, timestampType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, dateType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, timestampType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
+	cmpArgs{LE, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.Compare(right) <= 0), nil
+	},
 }
 
 func init() {
@@ -323,6 +840,10 @@ func init() {
 	cmpOps[cmpArgs{In, intType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, floatType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, stringType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, decimalType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, dateType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, timestampType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, intervalType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, tupleType, tupleType}] = evalTupleIN
 }
 
@@ -650,9 +1171,20 @@ func evalUnaryExpr(expr *UnaryExpr) (Datum, error) {
 
 func evalFuncExpr(expr *FuncExpr) (Datum, error) {
 	name := strings.ToLower(expr.Name.String())
+	if len(expr.Name.Indirect) == 0 {
+		// Unqualified names are not quoted, as they are for function names which
+		// are keywords such as current_date.
+		name = strings.ToLower(string(expr.Name.Base))
+	}
 
 	candidates, ok := builtins[name]
 	if !ok {
+		if _, ok := Aggregates[name]; ok {
+			// Aggregate functions are replaced during query planning. Encountering
+			// one here indicates it was used in a context where aggregation is not
+			// performed (e.g. the WHERE clause).
+			return DNull, fmt.Errorf("aggregate function %s is not allowed here", expr.Name)
+		}
 		return DNull, fmt.Errorf("unknown function %s", expr.Name)
 	}
 
@@ -838,6 +1370,12 @@ func evalCastExpr(expr *CastExpr) (Datum, error) {
 			return d, nil
 		case DFloat:
 			return DInt(v), nil
+		case DDecimal:
+			i, err := v.Int64()
+			if err != nil {
+				return DNull, err
+			}
+			return DInt(i), nil
 		case DString:
 			i, err := strconv.ParseInt(string(v), 0, 64)
 			if err != nil {
@@ -857,6 +1395,8 @@ func evalCastExpr(expr *CastExpr) (Datum, error) {
 			return DFloat(v), nil
 		case DFloat:
 			return d, nil
+		case DDecimal:
+			return DFloat(v.Float64()), nil
 		case DString:
 			f, err := strconv.ParseFloat(string(v), 64)
 			if err != nil {
@@ -868,7 +1408,7 @@ func evalCastExpr(expr *CastExpr) (Datum, error) {
 	case *CharType, *TextType, *BlobType:
 		var s DString
 		switch d.(type) {
-		case DBool, DInt, DFloat, dNull:
+		case DBool, DInt, DFloat, DDecimal, DDate, DTimestamp, DInterval, dNull:
 			s = DString(d.String())
 		case DString:
 			s = d.(DString)
@@ -882,12 +1422,61 @@ func evalCastExpr(expr *CastExpr) (Datum, error) {
 		}
 		return s, nil
 
+	case *DecimalType:
+		var dec DDecimal
+		switch v := d.(type) {
+		case DInt:
+			dec = decimalFromInt(v)
+		case DFloat:
+			if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+				return DNull, fmt.Errorf("cannot convert %s to decimal", v)
+			}
+			if dec, err = decimalFromFloat(v); err != nil {
+				return DNull, err
+			}
+		case DDecimal:
+			dec = v
+		case DString:
+			if dec, err = ParseDDecimal(string(v)); err != nil {
+				return DNull, err
+			}
+		default:
+			return DNull, fmt.Errorf("invalid cast: %s -> %s", d.Type(), expr.Type)
+		}
+		t := expr.Type.(*DecimalType)
+		return dec.Limit(t.Prec, t.Scale)
+
+	case *DateType:
+		switch v := d.(type) {
+		case DString:
+			return ParseDDate(string(v))
+		case DDate:
+			return d, nil
+		case DTimestamp:
+			return dateFromTime(v.Time), nil
+		}
+
+	case *TimestampType:
+		switch v := d.(type) {
+		case DString:
+			return ParseDTimestamp(string(v))
+		case DDate:
+			return DTimestamp{Time: v.Time()}, nil
+		case DTimestamp:
+			return d, nil
+		}
+
+	case *IntervalType:
+		switch v := d.(type) {
+		case DString:
+			return ParseDInterval(string(v))
+		case DInterval:
+			return d, nil
+		}
+
 		// TODO(pmattis): unimplemented.
 		// case *BitType:
-		// case *DecimalType:
-		// case *DateType:
 		// case *TimeType:
-		// case *TimestampType:
 	}
 
 	return DNull, fmt.Errorf("invalid cast: %s -> %s", d.Type(), expr.Type)
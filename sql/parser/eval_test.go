@@ -18,8 +18,10 @@
 package parser
 
 import (
+	"math/big"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/testutils"
 )
@@ -178,6 +180,52 @@ func TestEvalExpr(t *testing.T) {
 		{`'hello'::text`, `'hello'`},
 		{`CAST('123' AS int) + 1`, `124`},
 		{`'hello'::char(2)`, `'he'`},
+		// Decimals.
+		{`'1.50'::decimal`, `1.50`},
+		{`1.5::decimal`, `1.5`},
+		{`15::decimal(4,2)`, `15.00`},
+		{`'-1.005'::decimal(10,2)`, `-1.01`},
+		{`'1.5e3'::decimal`, `1500`},
+		{`DECIMAL '0.001'`, `0.001`},
+		{`1.10::decimal + 2.205::decimal`, `3.305`},
+		{`1.5::decimal - 2`, `-0.5`},
+		{`2 * 1.25::decimal`, `2.50`},
+		{`1::decimal / 3`, `0.3333333333333333`},
+		{`6::decimal / 2`, `3`},
+		{`7.5::decimal % 2`, `1.5`},
+		{`-(1.5::decimal)`, `-1.5`},
+		{`1.5::decimal::int`, `2`},
+		{`1.5::decimal::float`, `1.5`},
+		{`length(1.50::decimal::text)`, `3`},
+		{`1.50::decimal = 1.5::decimal`, `true`},
+		{`1.0::decimal = 1`, `true`},
+		{`0.1::decimal < 1`, `true`},
+		{`1.5::decimal = 1.5`, `true`},
+		{`0.25 < 0.3::decimal`, `true`},
+		{`1.5::decimal IN (1, 1.50::decimal)`, `true`},
+		// Dates, timestamps and intervals.
+		{`DATE '2015-08-30'`, `2015-08-30`},
+		{`'2015-08-30 03:34:45'::date`, `2015-08-30`},
+		{`'1969-12-31'::date + 1`, `1970-01-01`},
+		{`DATE '2015-08-30' - 31`, `2015-07-30`},
+		{`DATE '2015-08-30' - DATE '2015-01-01'`, `241`},
+		{`DATE '2015-08-30' < DATE '2015-08-31'`, `true`},
+		{`TIMESTAMP '2015-08-30 03:34:45.34567'`, `2015-08-30 03:34:45.34567+00:00`},
+		{`'2015-08-30T03:34:45-04:00'::timestamp`, `2015-08-30 07:34:45+00:00`},
+		{`DATE '2015-08-30'::timestamp`, `2015-08-30 00:00:00+00:00`},
+		{`TIMESTAMP '2015-08-30 03:34:45'::date`, `2015-08-30`},
+		{`TIMESTAMP '2015-08-30 03:34:45' + INTERVAL '1h30m'`, `2015-08-30 05:04:45+00:00`},
+		{`TIMESTAMP '2015-08-30 03:34:45' - INTERVAL '1h'`, `2015-08-30 02:34:45+00:00`},
+		{`TIMESTAMP '2015-08-30 03:34:45' - TIMESTAMP '2015-08-29 03:34:45'`, `24h0m0s`},
+		{`DATE '2015-08-30' + INTERVAL '12h'`, `2015-08-30 12:00:00+00:00`},
+		{`DATE '2015-08-30' = TIMESTAMP '2015-08-30 00:00:00'`, `true`},
+		{`TIMESTAMP '2015-08-30 00:00:01' > DATE '2015-08-30'`, `true`},
+		{`INTERVAL '1h' + INTERVAL '30m'`, `1h30m0s`},
+		{`INTERVAL '1h' * 3`, `3h0m0s`},
+		{`INTERVAL '1h' / 4`, `15m0s`},
+		{`-INTERVAL '1h'`, `-1h0m0s`},
+		{`INTERVAL '90m' = INTERVAL '1h30m'`, `true`},
+		{`length(DATE '2015-08-30'::text)`, `10`},
 	}
 	for _, d := range testData {
 		q, err := Parse("SELECT " + d.expr)
@@ -217,7 +265,17 @@ func TestEvalExprError(t *testing.T) {
 		{`lower(1, 2)`, `unknown signature for lower: lower(int, int)`},
 		{`lower(1)`, `unknown signature for lower: lower(int)`},
 		{`1::bit`, `invalid cast: int -> BIT`},
-		{`1::decimal`, `invalid cast: int -> DECIMAL`},
+		{`true::decimal`, `invalid cast: bool -> DECIMAL`},
+		{`'1.2.3'::decimal`, `invalid decimal value "1.2.3"`},
+		{`123.456::decimal(4,2)`, `decimal value 123.456 out of range for DECIMAL(4,2)`},
+		{`1.0::decimal / 0`, `division by zero`},
+		{`1.0::decimal % 0`, `zero modulus`},
+		{`'2015-13-01'::date`, `invalid date value "2015-13-01"`},
+		{`'yesterday'::timestamp`, `invalid timestamp value "yesterday"`},
+		{`'1 day'::interval`, `invalid interval value "1 day"`},
+		{`DATE '2015-08-30' + 1.5`, `unsupported binary operator: <date> + <float>`},
+		{`DATE '2015-08-30' = '2015-08-30'`, `unsupported comparison operator: <date> = <string>`},
+		{`INTERVAL '1h' / 0`, `division by zero`},
 		{`1::date`, `invalid cast: int -> DATE`},
 		{`1::time`, `invalid cast: int -> TIME`},
 		{`1::timestamp`, `invalid cast: int -> TIMESTAMP`},
@@ -235,3 +293,87 @@ func TestEvalExprError(t *testing.T) {
 		}
 	}
 }
+
+func TestDatumCompare(t *testing.T) {
+	testData := []struct {
+		left, right Datum
+		expected    int
+	}{
+		{DNull, DNull, 0},
+		{DNull, DInt(1), -1},
+		{DInt(1), DNull, 1},
+		{DBool(false), DBool(true), -1},
+		{DBool(true), DBool(true), 0},
+		{DInt(1), DInt(2), -1},
+		{DInt(2), DInt(1), 1},
+		{DInt(1), DFloat(1.5), -1},
+		{DFloat(2.5), DInt(2), 1},
+		{DFloat(1.5), DFloat(1.5), 0},
+		{DString("a"), DString("b"), -1},
+		{DString("b"), DString("a"), 1},
+		{DDecimal{Coeff: big.NewInt(150), Scale: 2}, DDecimal{Coeff: big.NewInt(15), Scale: 1}, 0},
+		{DDecimal{Coeff: big.NewInt(15), Scale: 1}, DInt(2), -1},
+		{DInt(2), DDecimal{Coeff: big.NewInt(15), Scale: 1}, 1},
+		{DDecimal{}, DFloat(0.5), -1},
+		{DDate(1), DDate(2), -1},
+		{DDate(1), DTimestamp{Time: time.Unix(secondsInDay, 0)}, 0},
+		{DTimestamp{Time: time.Unix(1, 0)}, DTimestamp{Time: time.Unix(0, 1)}, 1},
+		{DInterval{Duration: time.Second}, DInterval{Duration: time.Minute}, -1},
+		{DTuple{DInt(1), DInt(2)}, DTuple{DInt(1), DInt(3)}, -1},
+		{DTuple{DInt(1)}, DTuple{DInt(1), DInt(3)}, -1},
+		{DTuple{DInt(1), DString("a")}, DTuple{DInt(1), DString("a")}, 0},
+	}
+	for _, d := range testData {
+		if c := d.left.Compare(d.right); c != d.expected {
+			t.Errorf("%s <=> %s: expected %d, but found %d", d.left, d.right, d.expected, c)
+		}
+	}
+}
+
+func TestEvalTimeBuiltins(t *testing.T) {
+	start := time.Now()
+	expr, err := ParseExpr(`now()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := EvalExpr(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts, ok := d.(DTimestamp); !ok || ts.Before(start) || ts.After(time.Now()) {
+		t.Errorf("expected the current time, but found %s", d)
+	}
+
+	expr, err = ParseExpr(`CURRENT_DATE`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err = EvalExpr(expr); err != nil {
+		t.Fatal(err)
+	}
+	if date, ok := d.(DDate); !ok || date < dateFromTime(start) || date > dateFromTime(time.Now()) {
+		t.Errorf("expected the current date, but found %s", d)
+	}
+}
+
+func TestUniqueRowID(t *testing.T) {
+	expr, err := ParseExpr(`unique_rowid()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var last DInt
+	for i := 0; i < 1000; i++ {
+		d, err := EvalExpr(expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := d.(DInt)
+		if id <= last {
+			t.Fatalf("expected an ID greater than %d, but found %d", last, id)
+		}
+		if id&(1<<uniqueRowIDBits-1) != DInt(uniqueRowIDs.processID) {
+			t.Fatalf("expected the process ID %d in %d", uniqueRowIDs.processID, id)
+		}
+		last = id
+	}
+}
@@ -57,6 +57,10 @@ func (DBool) expr()           {}
 func (DInt) expr()            {}
 func (DFloat) expr()          {}
 func (DString) expr()         {}
+func (DDecimal) expr()        {}
+func (DDate) expr()           {}
+func (DTimestamp) expr()      {}
+func (DInterval) expr()       {}
 func (DTuple) expr()          {}
 func (dNull) expr()           {}
 
@@ -262,14 +266,14 @@ var StarExpr = &QualifiedName{Indirect: Indirection{unqualifiedStar}}
 // valid table name (e.g. it contains an array indirection). The incoming
 // qualified name should have one of the following forms:
 //
-//   table
-//   database.table
-//   table@index
-//   database.table@index
+//	table
+//	database.table
+//	table@index
+//	database.table@index
 //
 // On successful normalization, the qualified name will have the form:
 //
-//   database.table@index
+//	database.table@index
 func (n *QualifiedName) NormalizeTableName(database string) error {
 	if n == nil || n.Base == "" {
 		return fmt.Errorf("empty table name: %s", n)
@@ -321,19 +325,19 @@ func (n *QualifiedName) NormalizeTableName(database string) error {
 // explicit table was specified. The incoming qualified name should have one of
 // the following forms:
 //
-//   *
-//   table.*
-//   column
-//   column[array-indirection]
-//   table.column
-//   table.column[array-indirection]
+//	*
+//	table.*
+//	column
+//	column[array-indirection]
+//	table.column
+//	table.column[array-indirection]
 //
 // Note that "table" may be the empty string. On successful normalization the
 // qualified name will have one of the forms:
 //
-//   table.*
-//   table.column
-//   table.column[array-indirection]
+//	table.*
+//	table.column
+//	table.column[array-indirection]
 func (n *QualifiedName) NormalizeColumnName() error {
 	if n == nil {
 		return fmt.Errorf("empty column name: %s", n)
@@ -26,6 +26,7 @@ package parser
 import (
 	"bytes"
 	"fmt"
+	"strings"
 )
 
 // Grant represents a GRANT statement.
@@ -49,6 +50,12 @@ func (pt PrivilegeType) String() string {
 	return privilegeNames[pt]
 }
 
+// Mask returns the bit representing the privilege in a bit field of
+// privileges.
+func (pt PrivilegeType) Mask() uint32 {
+	return 1 << uint32(pt)
+}
+
 // PrivilegeList represents a list of privileges.
 type PrivilegeList []PrivilegeType
 
@@ -70,13 +77,39 @@ func (pl PrivilegeList) Join(sep string) string {
 	return buf.String()
 }
 
+// ToBitField returns the bit field of the privileges in the list.
+func (pl PrivilegeList) ToBitField() uint32 {
+	var bits uint32
+	for _, p := range pl {
+		bits |= p.Mask()
+	}
+	return bits
+}
+
+// PrivilegesFromBitField returns the list of privileges in a bit field, in
+// the order of their declaration.
+func PrivilegesFromBitField(bits uint32) PrivilegeList {
+	var pl PrivilegeList
+	for p := range privilegeNames {
+		if pt := PrivilegeType(p); bits&pt.Mask() != 0 {
+			pl = append(pl, pt)
+		}
+	}
+	return pl
+}
+
 // Enums for target and privilege types.
 const (
 	TargetDatabase TargetType = iota
 
 	PrivilegeAll PrivilegeType = iota
-	PrivilegeRead
-	PrivilegeWrite
+	PrivilegeCreate
+	PrivilegeDrop
+	PrivilegeGrant
+	PrivilegeSelect
+	PrivilegeInsert
+	PrivilegeDelete
+	PrivilegeUpdate
 )
 
 var (
@@ -85,12 +118,43 @@ var (
 	}
 
 	privilegeNames = [...]string{
-		PrivilegeAll:   "ALL",
-		PrivilegeRead:  "READ",
-		PrivilegeWrite: "WRITE",
+		PrivilegeAll:    "ALL",
+		PrivilegeCreate: "CREATE",
+		PrivilegeDrop:   "DROP",
+		PrivilegeGrant:  "GRANT",
+		PrivilegeSelect: "SELECT",
+		PrivilegeInsert: "INSERT",
+		PrivilegeDelete: "DELETE",
+		PrivilegeUpdate: "UPDATE",
+	}
+
+	// Privileges lists all the privileges implied by ALL.
+	Privileges = PrivilegeList{
+		PrivilegeCreate, PrivilegeDrop, PrivilegeGrant,
+		PrivilegeSelect, PrivilegeInsert, PrivilegeDelete, PrivilegeUpdate,
 	}
 )
 
+// parsePrivileges converts a list of privilege names, which are matched
+// case-insensitively, into a PrivilegeList.
+func parsePrivileges(names []string) (PrivilegeList, error) {
+	privs := make(PrivilegeList, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, p := range Privileges {
+			if strings.ToUpper(name) == p.String() {
+				privs = append(privs, p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown privilege %q", name)
+		}
+	}
+	return privs, nil
+}
+
 // TargetList represents a list of targets.
 // Only one field may be non-nil.
 type TargetList struct {
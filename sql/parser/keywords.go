@@ -317,6 +317,7 @@ var keywords = map[string]int{
 	"RETURNS":           RETURNS,
 	"REVOKE":            REVOKE,
 	"RIGHT":             RIGHT,
+	"ROLE":              ROLE,
 	"ROLLBACK":          ROLLBACK,
 	"ROLLUP":            ROLLUP,
 	"ROW":               ROW,
@@ -388,6 +389,7 @@ var keywords = map[string]int{
 	"UNTIL":             UNTIL,
 	"UPDATE":            UPDATE,
 	"USER":              USER,
+	"USERS":             USERS,
 	"USING":             USING,
 	"VACUUM":            VACUUM,
 	"VALID":             VALID,
@@ -26,6 +26,7 @@ package parser
 import (
 	"bytes"
 	"errors"
+	"fmt"
 )
 
 //go:generate make
@@ -52,3 +53,20 @@ func Parse(sql string) (StatementList, error) {
 	}
 	return s.stmts, nil
 }
+
+// ParseExpr parses a single SQL scalar expression, such as the default
+// expression of a column stored in a table descriptor.
+func ParseExpr(expr string) (Expr, error) {
+	stmts, err := Parse("SELECT " + expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("expected 1 statement, but found %d", len(stmts))
+	}
+	sel, ok := stmts[0].(*Select)
+	if !ok || sel.From != nil || len(sel.Exprs) != 1 {
+		return nil, fmt.Errorf("expected an expression, but found %s", stmts[0])
+	}
+	return sel.Exprs[0].Expr, nil
+}
@@ -33,6 +33,12 @@ func TestParse(t *testing.T) {
 		{``},
 		{`VALUES ("")`},
 
+		{`BEGIN TRANSACTION`},
+		{`BEGIN TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
+		{`COMMIT TRANSACTION`},
+		{`ROLLBACK TRANSACTION`},
+
 		{`CREATE DATABASE a`},
 		{`CREATE DATABASE IF NOT EXISTS a`},
 		{`CREATE TABLE a ()`},
@@ -52,7 +58,25 @@ func TestParse(t *testing.T) {
 		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d INDEX (b, c))`},
 		{`CREATE TABLE a (b INT, UNIQUE (b))`},
 		{`CREATE TABLE a.b (b INT)`},
+		{`CREATE INDEX a ON b (c)`},
+		{`CREATE INDEX a ON b.c (d)`},
+		{`CREATE INDEX ON a (b)`},
+		{`CREATE UNIQUE INDEX a ON b (c)`},
+		{`CREATE UNIQUE INDEX a ON b.c (d)`},
+		{`CREATE INDEX IF NOT EXISTS a ON b (c, d)`},
 		{`CREATE TABLE IF NOT EXISTS a (b INT)`},
+		{`CREATE TABLE a (b INT DEFAULT 1)`},
+		{`CREATE TABLE a (b INT NOT NULL DEFAULT 'c')`},
+		{`CREATE TABLE a (b INT DEFAULT unique_rowid() PRIMARY KEY)`},
+		{`CREATE TABLE a (b TIMESTAMP DEFAULT now())`},
+		{`CREATE TABLE a (b INT CHECK (b > 0))`},
+		{`CREATE TABLE a (b INT NOT NULL CONSTRAINT c CHECK (b > 0) CHECK (b < 10))`},
+		{`CREATE TABLE a (b INT, c INT, CHECK (b < c))`},
+		{`CREATE TABLE a (b INT, c INT, CONSTRAINT d CHECK (b < c))`},
+		{`CREATE TABLE a (b INT REFERENCES c)`},
+		{`CREATE TABLE a (b INT CONSTRAINT c REFERENCES d (e) ON DELETE CASCADE)`},
+		{`CREATE TABLE a (b INT, c INT, FOREIGN KEY (b, c) REFERENCES d.e (f, g) ON DELETE RESTRICT)`},
+		{`CREATE TABLE a (b INT, CONSTRAINT c FOREIGN KEY (b) REFERENCES d)`},
 
 		{`DELETE FROM a`},
 		{`DELETE FROM a.b`},
@@ -64,6 +88,9 @@ func TestParse(t *testing.T) {
 		{`DROP TABLE a.b`},
 		{`DROP TABLE a, b`},
 		{`DROP TABLE IF EXISTS a`},
+		{`DROP INDEX a@b`},
+		{`DROP INDEX a.b@c, d@f`},
+		{`DROP INDEX IF EXISTS a@b`},
 
 		{`EXPLAIN SELECT 1`},
 		{`EXPLAIN (DEBUG) SELECT 1`},
@@ -90,22 +117,34 @@ func TestParse(t *testing.T) {
 
 		// Tables are the default, but can also be specified with
 		// GRANT x ON TABLE y. However, the stringer does not output TABLE.
-		{`GRANT READ ON foo TO root`},
-		{`GRANT WRITE, READ ON foo, db.foo TO root, bar`},
-		{`GRANT READ ON DATABASE foo TO root`},
+		{`GRANT SELECT ON foo TO root`},
+		{`GRANT INSERT, SELECT ON foo, db.foo TO root, bar`},
+		{`GRANT CREATE ON DATABASE foo TO root`},
 		{`GRANT ALL ON DATABASE foo TO root, test`},
-		{`GRANT READ, WRITE ON DATABASE bar TO foo, bar, baz`},
-		{`GRANT READ, WRITE ON DATABASE db1, db2 TO foo, bar, baz`},
-		{`GRANT READ, WRITE ON DATABASE db1, db2 TO "test-user"`},
+		{`GRANT DROP, GRANT ON DATABASE bar TO foo, bar, baz`},
+		{`GRANT UPDATE, DELETE ON DATABASE db1, db2 TO foo, bar, baz`},
+		{`GRANT SELECT, INSERT ON DATABASE db1, db2 TO "test-user"`},
 
 		// Tables are the default, but can also be specified with
 		// REVOKE x ON TABLE y. However, the stringer does not output TABLE.
-		{`REVOKE READ ON foo FROM root`},
-		{`REVOKE WRITE, READ ON foo, db.foo FROM root, bar`},
-		{`REVOKE READ ON DATABASE foo FROM root`},
+		{`REVOKE SELECT ON foo FROM root`},
+		{`REVOKE INSERT, SELECT ON foo, db.foo FROM root, bar`},
+		{`REVOKE CREATE ON DATABASE foo FROM root`},
 		{`REVOKE ALL ON DATABASE foo FROM root, test`},
-		{`REVOKE READ, WRITE ON DATABASE bar FROM foo, bar, baz`},
-		{`REVOKE READ, WRITE ON DATABASE db1, db2 FROM foo, bar, baz`},
+		{`REVOKE DROP, GRANT ON DATABASE bar FROM foo, bar, baz`},
+		{`REVOKE UPDATE, DELETE ON DATABASE db1, db2 FROM foo, bar, baz`},
+
+		{`CREATE USER foo`},
+		{`CREATE USER foo WITH PASSWORD 'bar'`},
+		{`ALTER USER foo WITH PASSWORD 'bar'`},
+		{`DROP USER foo`},
+		{`CREATE ROLE foo`},
+		{`DROP ROLE foo`},
+		{`GRANT foo TO bar`},
+		{`GRANT foo, bar TO baz, "test-user"`},
+		{`REVOKE foo FROM bar`},
+		{`REVOKE foo, bar FROM baz`},
+		{`SHOW USERS`},
 
 		{`INSERT INTO a VALUES (1)`},
 		{`INSERT INTO a.b VALUES (1)`},
@@ -234,12 +273,20 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t WHERE a = COUNT(*)`},
 		{`SELECT (a.b) FROM t WHERE (b.c) = 2`},
 
+		{`SELECT FROM t GROUP BY a`},
+		{`SELECT FROM t GROUP BY a, b + 1`},
+		{`SELECT a, COUNT(DISTINCT b) FROM t GROUP BY a HAVING COUNT(*) > 1`},
 		{`SELECT FROM t HAVING a = b`},
 
 		{`SELECT FROM t UNION SELECT 1 FROM t`},
 		{`SELECT FROM t UNION SELECT 1 FROM t UNION SELECT 1 FROM t`},
 		{`SELECT FROM t EXCEPT SELECT 1 FROM t`},
 		{`SELECT FROM t INTERSECT SELECT 1 FROM t`},
+		{`SELECT FROM t UNION ALL SELECT 1 FROM t`},
+		{`SELECT FROM t EXCEPT ALL SELECT 1 FROM t`},
+		{`SELECT FROM t INTERSECT ALL SELECT 1 FROM t`},
+		{`SELECT a FROM t UNION SELECT b FROM t ORDER BY a LIMIT 1`},
+		{`(SELECT a FROM t ORDER BY a) UNION SELECT b FROM t`},
 
 		{`SELECT FROM t1 JOIN t2 ON a = b`},
 		{`SELECT FROM t1 JOIN t2 USING (a)`},
@@ -255,10 +302,18 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t OFFSET b`},
 		{`SELECT FROM t LIMIT a OFFSET b`},
 
+		{`SELECT FROM t ORDER BY a`},
+		{`SELECT FROM t ORDER BY a ASC`},
+		{`SELECT FROM t ORDER BY a DESC`},
+		{`SELECT FROM t ORDER BY a, b DESC`},
+		{`SELECT FROM t ORDER BY a + b DESC LIMIT 1 OFFSET 2`},
+
 		{`SET a = 3`},
 		{`SET a = 3, 4`},
 		{`SET a = '3'`},
 		{`SET a = 3.0`},
+		{`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
 
 		// TODO(pmattis): Is this a postgres extension?
 		{`TABLE a`}, // Shorthand for: SELECT * FROM a
@@ -276,6 +331,17 @@ func TestParse(t *testing.T) {
 		{`SELECT * FROM "0" JOIN "0" USING (id, "0")`}, // last "0" lost its quotes.
 
 		{`ALTER DATABASE a RENAME TO b`},
+		{`ALTER TABLE a RENAME b TO c`},
+		{`ALTER TABLE a RENAME COLUMN b TO c`},
+		{`ALTER TABLE IF EXISTS a.b RENAME COLUMN c TO d`},
+
+		{`ALTER TABLE a ADD b INT`},
+		{`ALTER TABLE a ADD COLUMN b INT`},
+		{`ALTER TABLE a ADD COLUMN b INT NOT NULL DEFAULT 1`},
+		{`ALTER TABLE IF EXISTS a ADD COLUMN b INT, ADD COLUMN c TEXT DEFAULT 'd'`},
+		{`ALTER TABLE a DROP b`},
+		{`ALTER TABLE a DROP COLUMN b`},
+		{`ALTER TABLE a DROP COLUMN IF EXISTS b, DROP c`},
 	}
 	for _, d := range testData {
 		stmts, err := Parse(d.sql)
@@ -334,8 +400,8 @@ func TestParse2(t *testing.T) {
 			`SELECT FROM t1 LEFT JOIN t2 ON a = b`},
 		{`SELECT FROM t1 RIGHT OUTER JOIN t2 ON a = b`,
 			`SELECT FROM t1 RIGHT JOIN t2 ON a = b`},
-		// TODO(pmattis): Handle UNION ALL.
-		{`SELECT FROM t UNION ALL SELECT 1 FROM t`,
+		// DISTINCT is the default for set operations.
+		{`SELECT FROM t UNION DISTINCT SELECT 1 FROM t`,
 			`SELECT FROM t UNION SELECT 1 FROM t`},
 		// We allow OFFSET before LIMIT, but always output LIMIT first.
 		{`SELECT FROM t OFFSET a LIMIT b`,
@@ -343,6 +409,25 @@ func TestParse2(t *testing.T) {
 		// Shorthand type cast.
 		{`SELECT '1'::INT`,
 			`SELECT CAST('1' AS INT)`},
+		{`SELECT DATE '2015-08-30'`,
+			`SELECT CAST('2015-08-30' AS DATE)`},
+		{`SELECT DECIMAL '1.50'`,
+			`SELECT CAST('1.50' AS DECIMAL)`},
+		{`SELECT INTERVAL '1h30m'`,
+			`SELECT CAST('1h30m' AS INTERVAL)`},
+		{`SELECT '1h'::INTERVAL`,
+			`SELECT CAST('1h' AS INTERVAL)`},
+		// CURRENT_DATE and CURRENT_TIMESTAMP are functions.
+		{`SELECT CURRENT_DATE`,
+			`SELECT "current_date"()`},
+		{`SELECT CURRENT_TIMESTAMP`,
+			`SELECT now()`},
+		// NO ACTION is the default reference action and only ON DELETE actions
+		// are kept.
+		{`CREATE TABLE a (b INT REFERENCES c MATCH SIMPLE ON DELETE NO ACTION)`,
+			`CREATE TABLE a (b INT REFERENCES c)`},
+		{`CREATE TABLE a (b INT REFERENCES c ON UPDATE RESTRICT ON DELETE CASCADE)`,
+			`CREATE TABLE a (b INT REFERENCES c ON DELETE CASCADE)`},
 		// Double negation. See #1800.
 		{`SELECT *,-/* comment */-5`,
 			`SELECT *, - - 5`},
@@ -351,6 +436,26 @@ func TestParse2(t *testing.T) {
 		{`SELECT -0.-/*test*/-1`,
 			`SELECT - 0. - - 1`,
 		},
+		// Transaction statements have several equivalent forms. The isolation
+		// levels weaker than snapshot isolation are upgraded.
+		{`BEGIN`, `BEGIN TRANSACTION`},
+		{`START TRANSACTION`, `BEGIN TRANSACTION`},
+		{`BEGIN WORK ISOLATION LEVEL READ COMMITTED, READ WRITE`,
+			`BEGIN TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`BEGIN ISOLATION LEVEL SNAPSHOT ISOLATION LEVEL SERIALIZABLE`,
+			`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
+		{`SET TRANSACTION ISOLATION LEVEL REPEATABLE READ`,
+			`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`COMMIT`, `COMMIT TRANSACTION`},
+		{`END`, `COMMIT TRANSACTION`},
+		{`ROLLBACK WORK`, `ROLLBACK TRANSACTION`},
+		{`ABORT`, `ROLLBACK TRANSACTION`},
+		// Privilege names are case-insensitive.
+		{`GRANT select, Insert ON foo TO bar`, `GRANT SELECT, INSERT ON foo TO bar`},
+		{`GRANT ALL PRIVILEGES ON foo TO bar`, `GRANT ALL ON foo TO bar`},
+		{`REVOKE ALL PRIVILEGES ON DATABASE foo FROM bar`, `REVOKE ALL ON DATABASE foo FROM bar`},
+		{`CREATE USER foo PASSWORD 'bar'`, `CREATE USER foo WITH PASSWORD 'bar'`},
+		{`ALTER USER foo PASSWORD 'bar'`, `ALTER USER foo WITH PASSWORD 'bar'`},
 	}
 	for _, d := range testData {
 		stmts, err := Parse(d.sql)
@@ -382,15 +487,6 @@ func TestParseSyntax(t *testing.T) {
 		{`SELECT ((1)) FROM t WHERE ((a)) IN (((1))) AND ((a, b)) IN ((((1, 1))), ((2, 2)))`},
 		{`SELECT e'\'\"\b\n\r\t\\' FROM t`},
 		{`SELECT '\x' FROM t`},
-		{`SELECT 1 FROM t GROUP BY a`},
-		{`SELECT 1 FROM t ORDER BY a`},
-		{`SELECT 1 FROM t ORDER BY a ASC`},
-		{`SELECT 1 FROM t ORDER BY a DESC`},
-		{`CREATE INDEX a ON b (c)`},
-		{`CREATE INDEX a ON b.c (d)`},
-		{`CREATE INDEX ON a (b)`},
-		{`CREATE UNIQUE INDEX a ON b (c)`},
-		{`CREATE UNIQUE INDEX a ON b.c (d)`},
 		{`CREATE UNIQUE INDEX a ON b USING foo (c)`},
 		{`DROP INDEX a`},
 		{`DROP INDEX IF EXISTS a`},
@@ -459,6 +555,31 @@ SELECT 1e-
 			`syntax error at or near ""
 SELECT foo''
           ^
+`},
+		{`BEGIN TRANSACTION READ ONLY`,
+			`READ ONLY transactions are not supported at or near "ONLY"
+BEGIN TRANSACTION READ ONLY
+                       ^
+`},
+		{`CREATE TABLE a (b INT REFERENCES c (d, e))`,
+			`a column REFERENCES constraint must reference a single column at or near ")"
+CREATE TABLE a (b INT REFERENCES c (d, e))
+                                         ^
+`},
+		{`CREATE TABLE a (b INT REFERENCES c ON DELETE SET NULL)`,
+			`SET NULL actions are not supported at or near "NULL"
+CREATE TABLE a (b INT REFERENCES c ON DELETE SET NULL)
+                                                 ^
+`},
+		{`CREATE TABLE a (b INT REFERENCES c ON UPDATE CASCADE)`,
+			`ON UPDATE CASCADE is not supported at or near "CASCADE"
+CREATE TABLE a (b INT REFERENCES c ON UPDATE CASCADE)
+                                             ^
+`},
+		{`GRANT SELECT, foo ON bar TO baz`,
+			`unknown privilege "foo" at or near "ON"
+GRANT SELECT, foo ON bar TO baz
+                  ^
 `},
 	}
 	for _, d := range testData {
@@ -23,7 +23,10 @@
 
 package parser
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+)
 
 func (*RenameDatabase) statement() {}
 
@@ -37,6 +40,32 @@ func (node *RenameDatabase) String() string {
 	return fmt.Sprintf("ALTER DATABASE %s RENAME TO %s", node.Name, node.NewName)
 }
 
+func (*RenameColumn) statement() {}
+
+// RenameColumn represents a RENAME COLUMN statement.
+type RenameColumn struct {
+	Table    *QualifiedName
+	Name     Name
+	NewName  Name
+	IfExists bool
+	// Is 'COLUMN' keyword present?
+	columnKeyword bool
+}
+
+func (node *RenameColumn) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER TABLE ")
+	if node.IfExists {
+		_, _ = buf.WriteString("IF EXISTS ")
+	}
+	fmt.Fprintf(&buf, "%s RENAME ", node.Table)
+	if node.columnKeyword {
+		_, _ = buf.WriteString("COLUMN ")
+	}
+	fmt.Fprintf(&buf, "%s TO %s", node.Name, node.NewName)
+	return buf.String()
+}
+
 // TODO(tschottdorf): This isn't even referenced from the grammar yet.
 func (*RenameTable) statement() {}
 
@@ -186,13 +186,13 @@ type JoinTableExpr struct {
 
 // JoinTableExpr.Join
 const (
-	astJoin        = "JOIN"
-	astFullJoin    = "FULL JOIN"
-	astLeftJoin    = "LEFT JOIN"
-	astRightJoin   = "RIGHT JOIN"
-	astCrossJoin   = "CROSS JOIN"
-	astNaturalJoin = "NATURAL JOIN"
-	astInnerJoin   = "INNER JOIN"
+	AstJoin        = "JOIN"
+	AstFullJoin    = "FULL JOIN"
+	AstLeftJoin    = "LEFT JOIN"
+	AstRightJoin   = "RIGHT JOIN"
+	AstCrossJoin   = "CROSS JOIN"
+	AstNaturalJoin = "NATURAL JOIN"
+	AstInnerJoin   = "INNER JOIN"
 )
 
 func (node *JoinTableExpr) String() string {
@@ -284,20 +284,42 @@ func (node OrderBy) String() string {
 	return buf.String()
 }
 
+// Direction for ordering results.
+type Direction int
+
+// Direction values.
+const (
+	DefaultDirection Direction = iota
+	Ascending
+	Descending
+)
+
+var directionName = [...]string{
+	DefaultDirection: "",
+	Ascending:        "ASC",
+	Descending:       "DESC",
+}
+
+func (d Direction) String() string {
+	if d < 0 || d > Direction(len(directionName)-1) {
+		return fmt.Sprintf("Direction(%d)", d)
+	}
+	return directionName[d]
+}
+
 // Order represents an ordering expression.
 type Order struct {
 	Expr      Expr
-	Direction string
+	Direction Direction
 }
 
-// Order.Direction
-const (
-	astAsc  = " ASC"
-	astDesc = " DESC"
-)
-
 func (node *Order) String() string {
-	return fmt.Sprintf("%s%s", node.Expr, node.Direction)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s", node.Expr)
+	if node.Direction != DefaultDirection {
+		fmt.Fprintf(&buf, " %s", node.Direction)
+	}
+	return buf.String()
 }
 
 // Limit represents a LIMIT clause.
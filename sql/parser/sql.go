@@ -11,6 +11,7 @@ type sqlSymType struct {
 	id             int
 	pos            int
 	empty          struct{}
+	boolVal        bool
 	ival           int
 	str            string
 	strs           []string
@@ -24,6 +25,8 @@ type sqlSymType struct {
 	tblDefs        []TableDef
 	colConstraint  ColumnConstraint
 	colConstraints []ColumnConstraint
+	alterTableCmd  AlterTableCmd
+	alterTableCmds AlterTableCmds
 	colType        ColumnType
 	expr           Expr
 	exprs          Exprs
@@ -36,11 +39,16 @@ type sqlSymType struct {
 	whens          []*When
 	updateExpr     *UpdateExpr
 	updateExprs    []*UpdateExpr
+	groupBy        GroupBy
+	orderBy        OrderBy
+	order          *Order
+	dir            Direction
 	limit          *Limit
 	targetList     TargetList
 	targetListPtr  *TargetList
-	privilegeType  PrivilegeType
 	privilegeList  PrivilegeList
+	isoLevel       IsolationLevel
+	refAction      ReferenceAction
 }
 
 const IDENT = 57346
@@ -355,105 +363,107 @@ const RETURNING = 57654
 const RETURNS = 57655
 const REVOKE = 57656
 const RIGHT = 57657
-const ROLLBACK = 57658
-const ROLLUP = 57659
-const ROW = 57660
-const ROWS = 57661
-const RULE = 57662
-const SAVEPOINT = 57663
-const SCROLL = 57664
-const SEARCH = 57665
-const SECOND = 57666
-const SECURITY = 57667
-const SELECT = 57668
-const SEQUENCE = 57669
-const SEQUENCES = 57670
-const SERIALIZABLE = 57671
-const SERVER = 57672
-const SESSION = 57673
-const SESSION_USER = 57674
-const SET = 57675
-const SETS = 57676
-const SETOF = 57677
-const SHARE = 57678
-const SHOW = 57679
-const SIMILAR = 57680
-const SIMPLE = 57681
-const SKIP = 57682
-const SMALLINT = 57683
-const SNAPSHOT = 57684
-const SOME = 57685
-const SQL = 57686
-const STABLE = 57687
-const STANDALONE = 57688
-const START = 57689
-const STATEMENT = 57690
-const STATISTICS = 57691
-const STDIN = 57692
-const STDOUT = 57693
-const STRICT = 57694
-const STRIP = 57695
-const SUBSTRING = 57696
-const SYMMETRIC = 57697
-const SYSID = 57698
-const SYSTEM = 57699
-const TABLE = 57700
-const TABLES = 57701
-const TEXT = 57702
-const THEN = 57703
-const TIME = 57704
-const TIMESTAMP = 57705
-const TO = 57706
-const TRAILING = 57707
-const TRANSACTION = 57708
-const TRANSFORM = 57709
-const TREAT = 57710
-const TRIGGER = 57711
-const TRIM = 57712
-const TRUE = 57713
-const TRUNCATE = 57714
-const TRUSTED = 57715
-const TYPE = 57716
-const TYPES = 57717
-const UNBOUNDED = 57718
-const UNCOMMITTED = 57719
-const UNENCRYPTED = 57720
-const UNION = 57721
-const UNIQUE = 57722
-const UNKNOWN = 57723
-const UNLISTEN = 57724
-const UNLOGGED = 57725
-const UNTIL = 57726
-const UPDATE = 57727
-const USER = 57728
-const USING = 57729
-const VACUUM = 57730
-const VALID = 57731
-const VALIDATE = 57732
-const VALUE = 57733
-const VALUES = 57734
-const VARCHAR = 57735
-const VARIADIC = 57736
-const VARYING = 57737
-const VERBOSE = 57738
-const VERSION = 57739
-const WHEN = 57740
-const WHERE = 57741
-const WINDOW = 57742
-const WITH = 57743
-const WITHIN = 57744
-const WITHOUT = 57745
-const WORK = 57746
-const WRAPPER = 57747
-const WRITE = 57748
-const YEAR = 57749
-const YES = 57750
-const ZONE = 57751
-const NOT_LA = 57752
-const NULLS_LA = 57753
-const WITH_LA = 57754
-const POSTFIXOP = 57755
-const UMINUS = 57756
+const ROLE = 57658
+const ROLLBACK = 57659
+const ROLLUP = 57660
+const ROW = 57661
+const ROWS = 57662
+const RULE = 57663
+const SAVEPOINT = 57664
+const SCROLL = 57665
+const SEARCH = 57666
+const SECOND = 57667
+const SECURITY = 57668
+const SELECT = 57669
+const SEQUENCE = 57670
+const SEQUENCES = 57671
+const SERIALIZABLE = 57672
+const SERVER = 57673
+const SESSION = 57674
+const SESSION_USER = 57675
+const SET = 57676
+const SETS = 57677
+const SETOF = 57678
+const SHARE = 57679
+const SHOW = 57680
+const SIMILAR = 57681
+const SIMPLE = 57682
+const SKIP = 57683
+const SMALLINT = 57684
+const SNAPSHOT = 57685
+const SOME = 57686
+const SQL = 57687
+const STABLE = 57688
+const STANDALONE = 57689
+const START = 57690
+const STATEMENT = 57691
+const STATISTICS = 57692
+const STDIN = 57693
+const STDOUT = 57694
+const STRICT = 57695
+const STRIP = 57696
+const SUBSTRING = 57697
+const SYMMETRIC = 57698
+const SYSID = 57699
+const SYSTEM = 57700
+const TABLE = 57701
+const TABLES = 57702
+const TEXT = 57703
+const THEN = 57704
+const TIME = 57705
+const TIMESTAMP = 57706
+const TO = 57707
+const TRAILING = 57708
+const TRANSACTION = 57709
+const TRANSFORM = 57710
+const TREAT = 57711
+const TRIGGER = 57712
+const TRIM = 57713
+const TRUE = 57714
+const TRUNCATE = 57715
+const TRUSTED = 57716
+const TYPE = 57717
+const TYPES = 57718
+const UNBOUNDED = 57719
+const UNCOMMITTED = 57720
+const UNENCRYPTED = 57721
+const UNION = 57722
+const UNIQUE = 57723
+const UNKNOWN = 57724
+const UNLISTEN = 57725
+const UNLOGGED = 57726
+const UNTIL = 57727
+const UPDATE = 57728
+const USER = 57729
+const USERS = 57730
+const USING = 57731
+const VACUUM = 57732
+const VALID = 57733
+const VALIDATE = 57734
+const VALUE = 57735
+const VALUES = 57736
+const VARCHAR = 57737
+const VARIADIC = 57738
+const VARYING = 57739
+const VERBOSE = 57740
+const VERSION = 57741
+const WHEN = 57742
+const WHERE = 57743
+const WINDOW = 57744
+const WITH = 57745
+const WITHIN = 57746
+const WITHOUT = 57747
+const WORK = 57748
+const WRAPPER = 57749
+const WRITE = 57750
+const YEAR = 57751
+const YES = 57752
+const ZONE = 57753
+const NOT_LA = 57754
+const NULLS_LA = 57755
+const WITH_LA = 57756
+const POSTFIXOP = 57757
+const UMINUS = 57758
 
 var sqlToknames = [...]string{
 	"$end",
@@ -771,6 +781,7 @@ var sqlToknames = [...]string{
 	"RETURNS",
 	"REVOKE",
 	"RIGHT",
+	"ROLE",
 	"ROLLBACK",
 	"ROLLUP",
 	"ROW",
@@ -842,6 +853,7 @@ var sqlToknames = [...]string{
 	"UNTIL",
 	"UPDATE",
 	"USER",
+	"USERS",
 	"USING",
 	"VACUUM",
 	"VALID",
@@ -899,3752 +911,3852 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlMaxDepth = 200
 
-//line sql.y:3906
+//line sql.y:4268
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
 	1, 19,
-	433, 19,
-	-2, 394,
+	435, 19,
+	-2, 408,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 33,
-	1, 363,
-	258, 363,
-	312, 363,
-	401, 363,
-	431, 363,
-	433, 363,
-	-2, 375,
-	-1, 48,
-	1, 366,
-	258, 366,
-	312, 366,
-	401, 366,
-	431, 366,
-	433, 366,
-	-2, 374,
-	-1, 57,
+	-1, 36,
+	1, 377,
+	258, 377,
+	312, 377,
+	403, 377,
+	433, 377,
+	435, 377,
+	-2, 389,
+	-1, 51,
+	1, 380,
+	258, 380,
+	312, 380,
+	403, 380,
+	433, 380,
+	435, 380,
+	-2, 388,
+	-1, 60,
 	1, 19,
-	433, 19,
-	-2, 394,
-	-1, 91,
-	1, 150,
-	433, 150,
-	-2, 1041,
-	-1, 420,
-	150, 405,
-	155, 405,
-	218, 405,
-	256, 405,
-	-2, 370,
-	-1, 423,
-	150, 404,
-	155, 404,
-	218, 404,
-	256, 404,
-	-2, 367,
-	-1, 537,
-	150, 404,
-	155, 404,
-	218, 404,
-	256, 404,
-	-2, 371,
-	-1, 603,
-	430, 890,
-	-2, 885,
-	-1, 604,
-	430, 891,
-	-2, 886,
-	-1, 610,
-	6, 577,
-	430, 577,
-	-2, 1176,
-	-1, 633,
-	6, 543,
-	-2, 1159,
-	-1, 634,
-	6, 569,
-	430, 569,
-	-2, 1160,
-	-1, 635,
-	6, 550,
-	-2, 1161,
-	-1, 636,
-	6, 569,
-	61, 569,
-	430, 569,
-	-2, 1162,
-	-1, 637,
-	6, 569,
-	61, 569,
-	430, 569,
-	-2, 1163,
-	-1, 638,
-	6, 572,
-	-2, 1165,
-	-1, 639,
-	6, 539,
-	-2, 1166,
-	-1, 640,
-	6, 539,
-	-2, 1167,
-	-1, 641,
-	6, 552,
-	-2, 1170,
-	-1, 642,
-	6, 540,
-	-2, 1174,
-	-1, 643,
-	6, 541,
+	435, 19,
+	-2, 408,
+	-1, 400,
+	1, 165,
+	435, 165,
+	-2, 1055,
+	-1, 433,
+	150, 419,
+	155, 419,
+	218, 419,
+	256, 419,
+	-2, 384,
+	-1, 436,
+	150, 418,
+	155, 418,
+	218, 418,
+	256, 418,
+	-2, 381,
+	-1, 559,
+	150, 418,
+	155, 418,
+	218, 418,
+	256, 418,
+	-2, 385,
+	-1, 625,
+	432, 904,
+	-2, 899,
+	-1, 626,
+	432, 905,
+	-2, 900,
+	-1, 632,
+	6, 591,
+	432, 591,
+	-2, 1192,
+	-1, 655,
+	6, 557,
 	-2, 1175,
-	-1, 644,
-	6, 539,
+	-1, 656,
+	6, 583,
+	432, 583,
+	-2, 1176,
+	-1, 657,
+	6, 564,
+	-2, 1177,
+	-1, 658,
+	6, 583,
+	61, 583,
+	432, 583,
+	-2, 1178,
+	-1, 659,
+	6, 583,
+	61, 583,
+	432, 583,
+	-2, 1179,
+	-1, 660,
+	6, 586,
+	-2, 1181,
+	-1, 661,
+	6, 553,
 	-2, 1182,
-	-1, 645,
-	6, 544,
-	-2, 1187,
-	-1, 646,
-	6, 542,
+	-1, 662,
+	6, 553,
+	-2, 1183,
+	-1, 663,
+	6, 566,
+	-2, 1186,
+	-1, 664,
+	6, 554,
 	-2, 1190,
-	-1, 647,
-	6, 580,
-	-2, 1192,
-	-1, 648,
-	6, 580,
-	-2, 1193,
-	-1, 649,
-	6, 567,
-	61, 567,
-	430, 567,
-	-2, 1197,
-	-1, 931,
-	138, 375,
-	150, 375,
-	155, 375,
-	199, 375,
-	218, 375,
-	256, 375,
-	263, 375,
-	379, 375,
-	-2, 689,
-	-1, 941,
-	430, 869,
-	-2, 863,
-	-1, 1036,
-	430, 276,
-	-2, 976,
-	-1, 1179,
+	-1, 665,
+	6, 555,
+	-2, 1191,
+	-1, 666,
+	6, 553,
+	-2, 1198,
+	-1, 667,
+	6, 558,
+	-2, 1203,
+	-1, 668,
+	6, 556,
+	-2, 1206,
+	-1, 669,
+	6, 594,
+	-2, 1208,
+	-1, 670,
+	6, 594,
+	-2, 1209,
+	-1, 671,
+	6, 581,
+	61, 581,
+	432, 581,
+	-2, 1213,
+	-1, 962,
+	138, 389,
+	150, 389,
+	155, 389,
+	199, 389,
+	218, 389,
+	256, 389,
+	263, 389,
+	380, 389,
+	-2, 703,
+	-1, 972,
+	432, 883,
+	-2, 877,
+	-1, 1068,
+	432, 290,
+	-2, 990,
+	-1, 1212,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 613,
-	-1, 1180,
+	416, 0,
+	417, 0,
+	-2, 627,
+	-1, 1213,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 614,
-	-1, 1181,
+	416, 0,
+	417, 0,
+	-2, 628,
+	-1, 1214,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 615,
-	-1, 1183,
+	416, 0,
+	417, 0,
+	-2, 629,
+	-1, 1216,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 617,
-	-1, 1184,
+	416, 0,
+	417, 0,
+	-2, 631,
+	-1, 1217,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 618,
-	-1, 1185,
+	416, 0,
+	417, 0,
+	-2, 632,
+	-1, 1218,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 619,
-	-1, 1188,
+	416, 0,
+	417, 0,
+	-2, 633,
+	-1, 1221,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 624,
-	-1, 1226,
-	268, 764,
-	-2, 767,
-	-1, 1431,
-	90, 479,
-	161, 479,
-	191, 479,
-	205, 479,
-	215, 479,
-	240, 479,
-	315, 479,
-	-2, 375,
-	-1, 1445,
+	339, 0,
+	412, 0,
+	-2, 638,
+	-1, 1259,
+	268, 778,
+	-2, 781,
+	-1, 1464,
+	90, 493,
+	161, 493,
+	191, 493,
+	205, 493,
+	215, 493,
+	240, 493,
+	315, 493,
+	-2, 389,
+	-1, 1478,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 626,
-	-1, 1450,
+	339, 0,
+	412, 0,
+	-2, 640,
+	-1, 1483,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 628,
-	-1, 1474,
-	268, 763,
-	-2, 766,
-	-1, 1658,
+	339, 0,
+	412, 0,
+	-2, 642,
+	-1, 1507,
+	268, 777,
+	-2, 780,
+	-1, 1690,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 625,
-	-1, 1660,
+	339, 0,
+	412, 0,
+	-2, 639,
+	-1, 1692,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 630,
-	-1, 1666,
+	339, 0,
+	412, 0,
+	-2, 644,
+	-1, 1698,
 	203, 0,
-	-2, 641,
-	-1, 1676,
-	268, 765,
-	-2, 768,
-	-1, 1716,
+	-2, 655,
+	-1, 1708,
+	268, 779,
+	-2, 782,
+	-1, 1748,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 670,
-	-1, 1717,
+	416, 0,
+	417, 0,
+	-2, 684,
+	-1, 1749,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 671,
-	-1, 1718,
+	416, 0,
+	417, 0,
+	-2, 685,
+	-1, 1750,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 672,
-	-1, 1720,
+	416, 0,
+	417, 0,
+	-2, 686,
+	-1, 1752,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 674,
-	-1, 1721,
+	416, 0,
+	417, 0,
+	-2, 688,
+	-1, 1753,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 675,
-	-1, 1722,
+	416, 0,
+	417, 0,
+	-2, 689,
+	-1, 1754,
 	13, 0,
 	14, 0,
 	15, 0,
-	413, 0,
-	414, 0,
 	415, 0,
-	-2, 676,
-	-1, 1802,
-	432, 1128,
-	-2, 532,
-	-1, 1861,
+	416, 0,
+	417, 0,
+	-2, 690,
+	-1, 1834,
+	434, 1143,
+	-2, 546,
+	-1, 1893,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 627,
-	-1, 1865,
+	339, 0,
+	412, 0,
+	-2, 641,
+	-1, 1897,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 629,
-	-1, 1866,
+	339, 0,
+	412, 0,
+	-2, 643,
+	-1, 1898,
 	203, 0,
-	-2, 642,
-	-1, 1870,
+	-2, 656,
+	-1, 1902,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 645,
-	-1, 1871,
+	339, 0,
+	412, 0,
+	-2, 659,
+	-1, 1903,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 647,
-	-1, 1976,
+	339, 0,
+	412, 0,
+	-2, 661,
+	-1, 2008,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 631,
-	-1, 1977,
+	339, 0,
+	412, 0,
+	-2, 645,
+	-1, 2009,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 646,
-	-1, 1978,
+	339, 0,
+	412, 0,
+	-2, 660,
+	-1, 2010,
 	45, 0,
 	182, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 648,
-	-1, 1986,
+	339, 0,
+	412, 0,
+	-2, 662,
+	-1, 2018,
 	203, 0,
-	-2, 677,
-	-1, 2040,
+	-2, 691,
+	-1, 2072,
 	203, 0,
-	-2, 678,
-	-1, 2100,
+	-2, 692,
+	-1, 2129,
 	45, 0,
 	217, 0,
-	338, 0,
-	410, 0,
-	-2, 1158,
+	339, 0,
+	412, 0,
+	-2, 1174,
 }
 
-const sqlNprod = 1293
+const sqlNprod = 1309
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 33642
+const sqlLast = 34647
 
 var sqlAct = [...]int{
 
-	588, 2099, 2077, 2093, 2125, 1900, 996, 2079, 1400, 1368,
-	1115, 1130, 848, 2047, 2078, 1953, 2098, 1046, 922, 1003,
-	1332, 1696, 2005, 1853, 2002, 1901, 1222, 1832, 1917, 1667,
-	1089, 424, 2012, 1434, 1847, 93, 727, 605, 1771, 1603,
-	1789, 756, 1756, 405, 412, 1569, 512, 1846, 1838, 1373,
-	1936, 1366, 435, 435, 678, 1137, 445, 446, 13, 1086,
-	1609, 445, 93, 456, 93, 934, 92, 689, 734, 1828,
-	1534, 1420, 602, 663, 1079, 1344, 1343, 1304, 1627, 1533,
-	489, 445, 445, 978, 984, 93, 93, 67, 1477, 1239,
-	1438, 937, 429, 32, 1430, 1083, 1636, 1423, 1038, 1328,
-	1412, 500, 563, 533, 1031, 668, 1408, 1281, 1243, 1004,
-	1205, 967, 930, 1123, 971, 1233, 1202, 1128, 431, 47,
-	1106, 32, 1125, 725, 522, 697, 1084, 883, 455, 18,
-	455, 10, 6, 1072, 601, 699, 858, 889, 423, 856,
-	573, 564, 96, 83, 461, 735, 434, 47, 25, 65,
-	32, 454, 544, 543, 594, 859, 857, 545, 69, 650,
-	68, 70, 48, 1124, 688, 723, 76, 89, 49, 451,
-	1918, 503, 1236, 997, 844, 666, 47, 71, 557, 664,
-	666, 57, 665, 428, 664, 2133, 680, 665, 1993, 2096,
-	2073, 1080, 1965, 1869, 2067, 2063, 2042, 1119, 1993, 1869,
-	2030, 72, 72, 1965, 2029, 428, 53, 1119, 1313, 465,
-	421, 499, 462, 1469, 2019, 458, 420, 1019, 1994, 442,
-	1979, 1993, 1968, 1869, 452, 1969, 466, 892, 495, 497,
-	1967, 1964, 1962, 1965, 1965, 1119, 1921, 1914, 55, 1119,
-	1915, 1237, 890, 1913, 1894, 492, 1119, 1469, 1873, 1868,
-	1816, 1469, 1869, 1817, 1768, 894, 1766, 1119, 890, 1119,
-	1671, 917, 501, 1469, 1591, 1568, 1564, 1592, 1019, 1019,
-	1559, 2025, 56, 1469, 1284, 1549, 1547, 1546, 1550, 1469,
-	1469, 51, 1955, 1545, 1474, 893, 1469, 1469, 1473, 1471,
-	1470, 1469, 52, 907, 1472, 1469, 1363, 1120, 1238, 1119,
-	1119, 1235, 995, 504, 684, 994, 891, 685, 432, 1728,
-	50, 1675, 1361, 1024, 1593, 1410, 1019, 1364, 1119, 679,
-	1218, 1113, 1065, 1783, 558, 488, 441, 2060, 2014, 2050,
-	737, 1594, 1782, 513, 1044, 980, 53, 980, 750, 750,
-	750, 1001, 2097, 2037, 979, 1972, 979, 1947, 1897, 1895,
-	1886, 1615, 502, 1885, 1476, 1880, 1879, 1878, 892, 1877,
-	1860, 436, 977, 1750, 981, 1329, 1741, 1738, 55, 1737,
-	1736, 1679, 1648, 1214, 1626, 1601, 1469, 892, 1556, 908,
-	909, 910, 1555, 1552, 1551, 1541, 894, 1532, 1507, 1504,
-	1240, 1502, 1500, 1499, 1498, 1497, 1487, 911, 918, 845,
-	1481, 1362, 56, 1329, 1300, 894, 985, 938, 1577, 50,
-	847, 917, 527, 557, 1069, 445, 893, 93, 944, 535,
-	556, 1047, 2095, 1017, 1698, 2049, 2035, 1988, 1958, 1950,
-	1367, 1910, 1905, 913, 435, 893, 1892, 1845, 53, 53,
-	50, 1843, 681, 907, 1812, 445, 1327, 1330, 1665, 1650,
-	445, 445, 538, 675, 1644, 1508, 1857, 1522, 1523, 1524,
-	1641, 892, 1581, 1579, 565, 565, 1779, 1531, 1495, 1494,
-	55, 55, 1486, 1234, 669, 1864, 1465, 1464, 1508, 1459,
-	1522, 1523, 1524, 531, 1207, 1215, 972, 93, 445, 894,
-	1045, 975, 717, 1437, 892, 445, 1442, 1326, 1863, 658,
-	1289, 1248, 1118, 987, 56, 56, 965, 964, 963, 537,
-	962, 662, 2036, 51, 51, 93, 1313, 445, 93, 893,
-	93, 1521, 894, 843, 52, 52, 841, 1614, 1974, 666,
-	961, 1973, 891, 664, 960, 1749, 665, 1047, 959, 1508,
-	958, 656, 50, 66, 1521, 746, 957, 956, 918, 955,
-	954, 953, 893, 687, 915, 952, 53, 951, 942, 435,
-	907, 1401, 888, 940, 1508, 679, 1522, 1523, 1524, 916,
-	1780, 939, 50, 870, 447, 875, 561, 1859, 530, 1652,
-	539, 540, 884, 913, 1670, 550, 846, 938, 55, 751,
-	1653, 737, 659, 1931, 421, 923, 924, 925, 926, 927,
-	420, 718, 1753, 1314, 1435, 932, 980, 1554, 1553, 1443,
-	520, 508, 77, 452, 720, 979, 507, 949, 711, 671,
-	397, 2094, 56, 1508, 718, 1090, 914, 947, 912, 74,
-	1521, 51, 1374, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 52, 1916, 1668, 404, 1818, 1525, 465, 465,
-	1829, 1047, 521, 1490, 941, 693, 754, 997, 396, 714,
-	1000, 2000, 445, 1699, 1244, 466, 466, 968, 1310, 1333,
-	1525, 2057, 739, 755, 1992, 445, 2112, 1009, 93, 2111,
-	93, 837, 834, 1012, 93, 838, 1378, 839, 2091, 2059,
-	1785, 445, 888, 993, 854, 855, 867, 93, 868, 860,
-	1059, 1029, 413, 399, 915, 885, 445, 1933, 93, 1101,
-	1356, 445, 1932, 421, 445, 1597, 421, 421, 1596, 879,
-	1595, 58, 880, 881, 78, 418, 397, 1052, 1485, 1484,
-	1294, 999, 982, 1483, 1040, 1482, 989, 1508, 738, 1105,
-	990, 992, 559, 67, 455, 1600, 1011, 1446, 969, 970,
-	455, 1104, 1193, 887, 1025, 973, 1525, 1508, 1020, 976,
-	1028, 721, 1022, 1018, 396, 32, 897, 898, 899, 901,
-	902, 900, 903, 525, 1050, 983, 914, 32, 1293, 904,
-	905, 906, 1169, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 486, 415, 986, 1301, 2043, 401, 1040, 1204,
-	59, 1041, 1302, 47, 1204, 1297, 674, 754, 754, 1056,
-	1240, 1055, 866, 1991, 69, 1946, 68, 70, 695, 1060,
-	505, 1110, 1945, 1521, 755, 755, 1071, 1026, 465, 1010,
-	1015, 462, 2122, 71, 1014, 1013, 1305, 1073, 1070, 2011,
-	1023, 1074, 945, 1211, 445, 466, 696, 1082, 93, 864,
-	1209, 680, 1095, 933, 427, 1107, 1108, 1518, 1519, 1520,
-	1357, 1509, 1510, 1511, 1512, 1513, 1515, 1516, 1514, 1517,
-	596, 506, 901, 902, 900, 903, 1856, 1057, 397, 445,
-	1518, 1519, 1520, 1021, 1509, 1510, 1511, 1512, 1513, 1515,
-	1516, 1514, 1517, 754, 93, 2121, 1121, 1075, 77, 1820,
-	895, 896, 897, 898, 899, 901, 902, 900, 903, 1092,
-	755, 1819, 966, 1111, 1088, 426, 396, 866, 565, 1984,
-	2081, 1244, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
-	1188, 1103, 865, 32, 2128, 2111, 1112, 1511, 1512, 1513,
-	1515, 1516, 1514, 1517, 864, 928, 1493, 744, 732, 743,
-	1168, 1688, 737, 1133, 1191, 1637, 1518, 1519, 1520, 79,
-	1509, 1510, 1511, 1512, 1513, 1515, 1516, 1514, 1517, 428,
-	416, 1685, 1253, 1649, 1265, 1236, 1275, 1277, 1282, 1285,
-	60, 863, 1098, 1298, 1097, 428, 1212, 1944, 1621, 738,
-	1105, 445, 419, 1311, 1216, 862, 567, 1076, 989, 445,
-	78, 1355, 553, 554, 1099, 989, 2080, 1051, 888, 1073,
-	445, 669, 2120, 1320, 1306, 1132, 1322, 1598, 1029, 2110,
-	1223, 1325, 2082, 1135, 1515, 1516, 1514, 1517, 1686, 1335,
-	1336, 1267, 1338, 1340, 1341, 1134, 1062, 865, 1583, 2070,
-	445, 80, 523, 1240, 1237, 1348, 1349, 1350, 1063, 1073,
-	2108, 1369, 1951, 754, 747, 1316, 1821, 1375, 1309, 1213,
-	1308, 657, 516, 494, 547, 2071, 1315, 487, 548, 1032,
-	755, 1064, 878, 1923, 1256, 1922, 1263, 1908, 445, 2137,
-	1376, 1054, 93, 62, 1016, 425, 863, 1377, 2083, 1778,
-	1303, 1192, 853, 700, 1723, 849, 1726, 681, 1370, 701,
-	2076, 1238, 394, 652, 1235, 1684, 1448, 651, 1381, 873,
-	1407, 1203, 1617, 700, 2048, 1422, 1426, 1429, 1422, 701,
-	1240, 1380, 2126, 1383, 719, 1624, 1189, 1909, 1334, 1331,
-	884, 1312, 546, 1509, 1510, 1511, 1512, 1513, 1515, 1516,
-	1514, 1517, 1318, 749, 1323, 1572, 1100, 1762, 1371, 388,
-	1321, 1210, 1841, 1509, 1510, 1511, 1512, 1513, 1515, 1516,
-	1514, 1517, 450, 1037, 748, 64, 547, 1403, 1632, 1346,
-	548, 542, 1631, 1351, 1354, 1359, 1259, 1604, 503, 1358,
-	754, 389, 1353, 1625, 1440, 1372, 426, 1424, 1415, 1133,
-	2127, 1571, 1133, 1240, 2106, 1763, 547, 755, 465, 874,
-	702, 32, 1049, 1219, 1224, 1225, 1445, 1228, 609, 1419,
-	1450, 654, 2129, 1379, 81, 466, 1724, 2136, 1418, 1585,
-	702, 1575, 534, 1777, 1276, 1725, 1078, 47, 1286, 1287,
-	1288, 1382, 1635, 1433, 546, 1468, 982, 1432, 1405, 1427,
-	1404, 1406, 1416, 1584, 390, 1260, 1478, 1190, 653, 1441,
-	1475, 1132, 1299, 1618, 1132, 1077, 606, 1814, 973, 705,
-	976, 1491, 391, 1628, 546, 1496, 1409, 970, 969, 501,
-	1247, 1134, 548, 1987, 1134, 1888, 1234, 1693, 1199, 705,
-	1201, 1535, 1664, 1503, 1458, 1436, 1058, 890, 1557, 932,
-	519, 517, 1035, 1449, 514, 1282, 1282, 1282, 1447, 1889,
-	1813, 1891, 1261, 1197, 445, 1258, 1762, 449, 93, 1565,
-	504, 1455, 1757, 1457, 1536, 706, 542, 708, 888, 1560,
-	1467, 888, 565, 950, 1580, 1755, 707, 861, 836, 1758,
-	1246, 669, 1620, 1759, 1589, 706, 1453, 708, 63, 1587,
-	1317, 1489, 1417, 1573, 1096, 1091, 707, 713, 710, 683,
-	682, 677, 1602, 1899, 1763, 1029, 1116, 551, 1612, 502,
-	738, 733, 2112, 722, 510, 1825, 445, 439, 741, 745,
-	1761, 604, 1902, 1043, 687, 1562, 1538, 1539, 1540, 1040,
-	1040, 709, 1623, 1588, 1764, 1590, 1033, 1607, 852, 1613,
-	1042, 1039, 1563, 1918, 1262, 1558, 1941, 1352, 1561, 2013,
-	1195, 709, 715, 2039, 1194, 704, 95, 985, 1629, 1200,
-	1567, 1372, 1640, 1566, 95, 95, 1642, 1611, 1426, 1422,
-	1570, 1578, 1422, 95, 95, 704, 555, 95, 2026, 892,
-	1890, 1971, 95, 95, 95, 95, 392, 1451, 464, 393,
-	1117, 1599, 1456, 1616, 716, 1940, 718, 1002, 3, 886,
-	1605, 95, 95, 95, 1938, 552, 95, 95, 850, 1655,
-	1657, 1658, 703, 1660, 1760, 440, 892, 1439, 2134, 511,
-	2135, 1508, 1462, 1133, 892, 1666, 1133, 1257, 73, 1975,
-	1466, 1672, 703, 1937, 1697, 1647, 1677, 893, 1758, 1424,
-	1068, 1858, 1759, 1677, 894, 1479, 1480, 1742, 1691, 1656,
-	1681, 1682, 1683, 1638, 1639, 1634, 448, 1694, 1548, 1574,
-	82, 1067, 1576, 1646, 1630, 1645, 1360, 1633, 1066, 1296,
-	1703, 1654, 1067, 1705, 893, 1295, 1196, 1136, 1292, 1761,
-	1291, 1216, 1290, 1252, 1251, 1132, 1198, 1530, 1132, 1250,
-	1249, 1241, 1875, 1764, 1810, 876, 1692, 943, 1543, 528,
-	526, 524, 1733, 1734, 1678, 1134, 414, 1939, 1134, 1452,
-	75, 1740, 835, 1687, 1689, 1690, 1767, 515, 888, 1454,
-	1773, 1882, 888, 2069, 1700, 1704, 395, 1492, 1786, 1983,
-	1787, 1774, 1952, 1245, 948, 26, 1804, 1805, 1806, 1807,
-	1808, 1809, 1781, 1784, 1731, 1772, 580, 1754, 1606, 1085,
-	742, 731, 1792, 518, 1732, 1082, 93, 1815, 726, 2075,
-	1255, 1827, 655, 1751, 398, 607, 400, 402, 403, 1140,
-	1793, 1796, 608, 1760, 1811, 1791, 1743, 1746, 1141, 1744,
-	1826, 1745, 888, 1752, 1848, 1850, 974, 595, 460, 1422,
-	1005, 1429, 1133, 1208, 1242, 1830, 1833, 32, 1488, 946,
-	579, 585, 1854, 584, 1220, 1770, 1970, 1852, 1999, 1788,
-	1803, 576, 1610, 694, 1324, 1824, 87, 88, 1307, 1748,
-	998, 872, 1823, 1855, 1102, 869, 1861, 61, 1586, 1822,
-	1865, 1866, 1849, 851, 417, 1867, 1870, 1871, 1505, 1883,
-	1274, 1266, 1874, 1264, 1133, 1133, 1254, 1876, 1133, 32,
-	877, 541, 1651, 549, 1132, 842, 1790, 1834, 1831, 529,
-	1851, 989, 1881, 1133, 1342, 667, 1884, 1324, 1006, 562,
-	1122, 560, 882, 437, 1134, 754, 438, 754, 1608, 1081,
-	1673, 509, 1061, 672, 919, 1836, 1837, 1093, 1109, 1842,
-	1773, 2056, 755, 1582, 755, 1893, 1906, 54, 93, 17,
-	16, 1774, 15, 1143, 14, 445, 1132, 1132, 12, 1769,
-	1132, 11, 1402, 1776, 9, 1887, 8, 7, 24, 23,
-	892, 22, 5, 21, 20, 1132, 1134, 1134, 19, 4,
-	1134, 575, 2, 95, 1, 0, 95, 0, 95, 0,
-	95, 0, 1920, 1919, 0, 1134, 1729, 0, 894, 1925,
-	0, 1903, 0, 445, 0, 95, 1898, 1739, 1612, 0,
-	0, 1934, 1133, 0, 1907, 0, 95, 0, 0, 0,
-	1954, 95, 95, 1844, 95, 1930, 1844, 0, 893, 1647,
-	0, 1927, 1928, 1929, 888, 0, 1850, 443, 0, 1924,
-	0, 0, 443, 1440, 0, 1508, 0, 1522, 1523, 1524,
-	0, 0, 932, 0, 0, 1942, 0, 1959, 95, 95,
-	0, 0, 490, 443, 1949, 1948, 95, 1611, 1943, 0,
-	1935, 0, 0, 0, 1132, 0, 1957, 464, 464, 1976,
-	1977, 1978, 0, 1963, 0, 753, 95, 0, 95, 95,
-	1143, 95, 0, 1995, 1134, 0, 0, 95, 1982, 0,
-	0, 0, 0, 0, 1133, 0, 1773, 2004, 93, 93,
-	93, 1521, 1989, 0, 0, 1998, 0, 1774, 0, 0,
-	0, 0, 669, 2017, 2018, 2003, 0, 1997, 0, 0,
-	95, 1772, 2009, 95, 0, 0, 0, 0, 0, 1996,
-	1792, 1773, 445, 1143, 2001, 1960, 2023, 0, 0, 888,
-	0, 0, 1774, 0, 0, 1268, 1848, 0, 1793, 1796,
-	1429, 0, 2016, 1791, 0, 0, 1132, 2015, 0, 2022,
-	0, 1854, 1833, 2027, 2006, 2008, 2006, 2021, 1133, 0,
-	2020, 0, 0, 2034, 1773, 0, 1134, 0, 0, 2032,
-	2031, 2045, 2033, 0, 0, 1774, 1158, 0, 93, 1143,
-	1157, 0, 445, 0, 93, 2038, 2051, 0, 2041, 2044,
-	2053, 0, 0, 1133, 0, 0, 1954, 0, 0, 0,
-	0, 0, 2062, 0, 0, 1961, 1848, 1961, 0, 2054,
-	0, 2065, 1133, 95, 445, 2064, 753, 753, 2066, 0,
-	1132, 2004, 2061, 0, 0, 93, 95, 0, 95, 95,
-	2086, 95, 2087, 2074, 95, 95, 1133, 464, 2089, 2003,
-	1134, 2092, 95, 95, 2052, 2085, 2084, 2088, 95, 0,
-	2058, 2105, 95, 2068, 2090, 1132, 2107, 95, 2104, 95,
-	0, 0, 95, 0, 2109, 95, 1773, 2103, 2103, 2114,
-	2116, 2118, 2119, 2115, 1132, 1134, 0, 1774, 0, 0,
-	933, 1142, 2117, 0, 1160, 0, 0, 2130, 2132, 0,
-	0, 2006, 0, 2131, 1134, 0, 0, 2103, 1132, 0,
-	0, 0, 753, 0, 0, 0, 2139, 2138, 0, 0,
-	0, 0, 0, 1158, 0, 2140, 1702, 1157, 1134, 0,
-	2028, 1159, 0, 1706, 0, 0, 0, 0, 0, 1139,
-	0, 2103, 0, 0, 0, 0, 0, 0, 1133, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1508, 1735, 1522, 1523, 1524, 895, 896, 897, 898,
-	899, 901, 902, 900, 903, 0, 1158, 0, 0, 0,
-	1157, 1669, 1411, 0, 0, 0, 443, 0, 0, 1268,
-	1268, 0, 0, 0, 1411, 0, 0, 0, 95, 0,
-	0, 0, 95, 0, 0, 95, 0, 0, 95, 95,
-	1132, 0, 0, 95, 0, 0, 660, 0, 1795, 0,
-	0, 443, 673, 0, 1460, 1461, 0, 1521, 1142, 0,
-	1134, 1160, 1158, 0, 0, 0, 1157, 1518, 1519, 1520,
-	95, 1509, 1510, 1511, 1512, 1513, 1515, 1516, 1514, 1517,
-	0, 0, 0, 1415, 0, 95, 1268, 1268, 1268, 692,
-	0, 0, 0, 0, 0, 1415, 692, 0, 1159, 0,
-	0, 0, 0, 0, 0, 0, 1139, 0, 0, 0,
-	0, 1142, 753, 1418, 1160, 0, 0, 0, 692, 0,
-	0, 1527, 1528, 1529, 2072, 1418, 0, 1413, 581, 33,
-	0, 0, 0, 0, 0, 892, 0, 1416, 0, 1413,
-	1143, 0, 0, 0, 0, 0, 0, 0, 0, 1416,
-	0, 1159, 0, 0, 0, 700, 0, 33, 0, 1139,
-	0, 701, 1414, 894, 0, 0, 0, 1142, 0, 0,
-	1160, 0, 0, 0, 1414, 0, 422, 0, 0, 430,
-	1840, 0, 1143, 1525, 0, 0, 33, 0, 0, 1143,
-	0, 0, 95, 893, 95, 430, 0, 0, 0, 0,
-	95, 907, 0, 0, 0, 0, 0, 1159, 0, 95,
-	95, 95, 0, 0, 95, 1139, 0, 95, 1143, 95,
-	0, 0, 95, 0, 0, 1508, 0, 1522, 1523, 1524,
-	95, 95, 0, 95, 95, 95, 0, 1417, 0, 753,
-	1926, 95, 0, 0, 0, 0, 95, 95, 95, 1417,
-	95, 0, 0, 0, 0, 0, 0, 464, 0, 0,
-	1862, 0, 702, 988, 1268, 1268, 0, 0, 0, 0,
-	0, 0, 0, 0, 1143, 0, 1007, 0, 0, 95,
-	0, 0, 0, 95, 0, 0, 0, 0, 95, 0,
-	0, 1521, 692, 0, 0, 0, 0, 0, 0, 1661,
-	1662, 0, 0, 1966, 0, 1966, 0, 1048, 0, 1839,
-	0, 95, 1053, 0, 0, 443, 95, 95, 0, 95,
-	0, 705, 0, 0, 1980, 0, 1268, 1268, 1268, 1268,
-	1268, 1268, 1268, 1268, 1268, 1268, 1268, 1268, 1268, 1268,
-	1268, 1268, 0, 1268, 0, 0, 1143, 1508, 0, 1522,
-	1523, 1524, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1707, 1708, 1709, 1710, 1711, 1712, 1713, 1714, 1715,
-	1716, 1717, 1718, 1719, 1720, 1721, 1722, 706, 1727, 708,
-	0, 0, 0, 1158, 0, 0, 1795, 1157, 707, 0,
-	0, 0, 0, 1518, 1519, 1520, 1526, 1509, 1510, 1511,
-	1512, 1513, 1515, 1516, 1514, 1517, 0, 0, 0, 0,
-	0, 0, 0, 1521, 0, 0, 0, 1525, 0, 0,
-	0, 0, 0, 0, 0, 1158, 0, 0, 0, 1157,
-	0, 700, 1158, 0, 0, 0, 1157, 701, 0, 1347,
-	0, 0, 0, 709, 0, 443, 0, 0, 0, 0,
+	626, 2152, 1433, 2108, 2128, 1148, 2079, 2109, 1027, 2110,
+	2127, 1401, 1317, 1034, 1878, 1985, 878, 2034, 1728, 2037,
+	1949, 1123, 1804, 437, 1885, 953, 1467, 1864, 1114, 2044,
+	1635, 624, 755, 86, 1699, 86, 1879, 1803, 86, 627,
+	1602, 1821, 784, 1788, 532, 1365, 86, 86, 1870, 1641,
+	1406, 1120, 1163, 1156, 1399, 86, 86, 762, 1170, 86,
+	965, 459, 699, 1860, 86, 86, 86, 86, 86, 86,
+	1968, 480, 1113, 685, 1567, 1376, 1337, 1015, 712, 1659,
+	1453, 1566, 1471, 1510, 1463, 1445, 968, 1272, 1456, 1668,
+	520, 1117, 1361, 1009, 1063, 555, 1070, 690, 585, 401,
+	1035, 1314, 1276, 1441, 1078, 961, 1238, 998, 442, 35,
+	1235, 1002, 1161, 1266, 13, 914, 444, 50, 18, 1158,
+	10, 1139, 6, 753, 721, 542, 1118, 1377, 723, 715,
+	595, 920, 889, 763, 586, 887, 436, 392, 477, 471,
+	35, 27, 69, 466, 1157, 565, 71, 447, 50, 567,
+	73, 566, 72, 751, 74, 890, 597, 888, 738, 711,
+	51, 464, 80, 572, 923, 1269, 473, 523, 445, 35,
+	76, 688, 688, 75, 579, 686, 686, 50, 687, 687,
+	52, 701, 76, 1028, 398, 441, 1950, 441, 872, 921,
+	1032, 2145, 925, 2125, 1152, 2104, 1997, 1502, 1901, 2098,
+	87, 921, 1152, 455, 478, 514, 1255, 2074, 465, 2062,
+	1901, 434, 1997, 2061, 2055, 456, 1152, 1152, 1346, 2057,
+	456, 468, 924, 2051, 449, 672, 700, 1987, 56, 2026,
+	938, 433, 2025, 2011, 1270, 2000, 1901, 1999, 2001, 923,
+	1997, 1996, 1994, 1953, 1997, 1152, 1152, 1946, 1945, 1926,
+	1947, 1152, 1502, 1905, 1900, 504, 1502, 1901, 521, 1848,
+	58, 1800, 1849, 1798, 1152, 1703, 1152, 925, 1502, 1624,
+	922, 481, 1625, 948, 1601, 1597, 1592, 700, 700, 1502,
+	623, 1582, 1580, 1579, 1583, 1502, 1502, 1578, 1504, 1894,
+	1502, 1271, 1760, 1505, 1268, 59, 482, 924, 1507, 524,
+	1506, 1502, 1503, 1502, 54, 938, 1396, 1502, 1153, 1152,
+	1026, 1152, 1815, 1025, 707, 55, 1707, 708, 1053, 1056,
+	1443, 1814, 700, 1397, 1152, 705, 505, 1251, 1146, 1100,
+	580, 454, 2092, 53, 2046, 1626, 2082, 56, 60, 765,
+	533, 1011, 778, 778, 1011, 778, 2126, 2069, 522, 2004,
+	1010, 1049, 1627, 1010, 1979, 1362, 1929, 1247, 1509, 1927,
+	1502, 1918, 1917, 1076, 1912, 1911, 1910, 1909, 1008, 58,
+	1892, 1012, 1362, 1773, 1782, 923, 1770, 1769, 1768, 1711,
+	1680, 1658, 1634, 1589, 1273, 1647, 1588, 86, 86, 86,
+	86, 1585, 1584, 1574, 86, 86, 1565, 1540, 1610, 1104,
+	1537, 1535, 1533, 925, 59, 1532, 56, 1531, 56, 1530,
+	949, 1520, 1514, 873, 1395, 1360, 2068, 1333, 975, 969,
+	56, 53, 1016, 86, 579, 578, 86, 1730, 86, 86,
+	86, 877, 86, 924, 2095, 2081, 2067, 702, 58, 2020,
+	58, 1990, 53, 1400, 1942, 944, 1937, 86, 1924, 1889,
+	1877, 1875, 58, 1858, 1844, 1811, 1079, 560, 86, 1363,
+	923, 1891, 1697, 86, 86, 1346, 86, 1682, 1267, 1676,
+	1248, 1673, 1614, 59, 1612, 59, 1564, 922, 1528, 1527,
+	1519, 505, 54, 1498, 54, 1497, 2006, 59, 925, 1492,
+	745, 1475, 1240, 55, 1003, 55, 54, 1006, 1470, 1359,
+	1322, 1281, 1151, 86, 86, 86, 86, 55, 923, 1018,
+	996, 1031, 995, 53, 86, 86, 680, 559, 924, 684,
+	1077, 519, 994, 993, 992, 70, 480, 480, 923, 553,
+	991, 515, 517, 510, 781, 86, 925, 86, 86, 871,
+	86, 990, 989, 988, 508, 456, 86, 688, 1781, 2005,
+	678, 686, 1541, 987, 687, 986, 925, 86, 1684, 985,
+	1812, 774, 700, 1646, 984, 616, 924, 946, 1079, 983,
+	550, 982, 926, 927, 928, 929, 930, 932, 933, 931,
+	934, 86, 456, 973, 86, 1541, 924, 971, 970, 53,
+	460, 583, 969, 561, 938, 562, 1685, 681, 1963, 527,
+	765, 1785, 710, 575, 576, 434, 1347, 465, 1011, 876,
+	746, 541, 918, 964, 682, 581, 1468, 1010, 1434, 456,
+	695, 748, 1587, 1586, 693, 433, 1476, 540, 528, 980,
+	413, 1028, 1124, 746, 739, 735, 1407, 1948, 1850, 1861,
+	945, 2032, 1079, 1731, 742, 1523, 1277, 926, 927, 928,
+	929, 930, 932, 933, 931, 934, 999, 587, 587, 420,
+	468, 779, 1343, 2089, 1366, 897, 2140, 691, 717, 412,
+	468, 972, 431, 741, 1411, 2122, 61, 2141, 2024, 2091,
+	767, 417, 1817, 1093, 86, 1390, 424, 781, 781, 415,
+	1965, 1964, 1630, 468, 1629, 1327, 865, 86, 1628, 86,
+	1518, 86, 895, 1517, 86, 86, 86, 86, 1516, 480,
+	875, 1515, 899, 891, 86, 86, 885, 898, 886, 862,
+	589, 86, 866, 1326, 867, 86, 481, 481, 916, 386,
+	86, 897, 86, 434, 782, 86, 434, 434, 86, 1202,
+	1479, 413, 1226, 1052, 618, 1057, 1054, 1050, 1700, 766,
+	1138, 482, 482, 910, 1072, 62, 911, 912, 1020, 783,
+	545, 740, 1137, 413, 1013, 1633, 1000, 1001, 895, 502,
+	749, 1134, 426, 1237, 1004, 901, 1060, 906, 1007, 781,
+	412, 2075, 1042, 1072, 915, 928, 929, 930, 932, 933,
+	931, 934, 1051, 1014, 1088, 1237, 896, 954, 955, 956,
+	957, 958, 412, 35, 525, 1044, 473, 963, 719, 1030,
+	1017, 387, 1143, 1330, 1021, 1023, 35, 696, 2023, 893,
+	1273, 1720, 71, 1073, 50, 1105, 73, 2101, 72, 978,
+	74, 1082, 1978, 1087, 1977, 923, 1094, 720, 1084, 1616,
+	1019, 2149, 478, 1096, 976, 894, 1045, 1047, 65, 75,
+	1046, 1058, 1244, 1038, 2102, 1097, 526, 1041, 1055, 1242,
+	468, 1391, 896, 925, 2112, 86, 1140, 1141, 701, 86,
+	468, 1888, 86, 932, 933, 931, 934, 86, 86, 1099,
+	1338, 2043, 1089, 1794, 506, 428, 1080, 782, 782, 1269,
+	997, 1085, 1098, 924, 456, 1024, 2148, 1717, 1091, 2016,
+	1300, 938, 410, 959, 1526, 1144, 1106, 1669, 2155, 481,
+	86, 894, 783, 783, 1273, 1277, 926, 927, 928, 929,
+	930, 932, 933, 931, 934, 86, 1681, 441, 429, 1154,
+	64, 1795, 1976, 1109, 482, 1653, 926, 927, 928, 929,
+	930, 932, 933, 931, 934, 724, 63, 2140, 1132, 403,
+	432, 725, 781, 1166, 1718, 2111, 427, 2139, 1270, 2137,
+	1695, 1136, 1544, 1545, 1546, 1548, 1549, 1547, 1550, 440,
+	1083, 1541, 1402, 1555, 1556, 1557, 2113, 1122, 1859, 782,
+	570, 404, 1252, 1257, 1258, 388, 1261, 1389, 1145, 35,
+	1852, 1896, 1064, 523, 1107, 1126, 1341, 1127, 1548, 1549,
+	1547, 1550, 1851, 1309, 783, 674, 679, 1319, 1320, 1321,
+	766, 1138, 536, 389, 513, 1271, 1110, 1224, 1268, 1131,
+	1618, 1130, 503, 2147, 1201, 909, 1331, 543, 456, 1955,
+	439, 1332, 86, 1954, 86, 1249, 1940, 1554, 2163, 1020,
+	86, 1128, 1048, 2114, 405, 1617, 1020, 1273, 1631, 884,
+	86, 86, 726, 673, 86, 86, 1256, 1168, 86, 569,
+	86, 68, 406, 86, 564, 1790, 468, 879, 1605, 1791,
+	1810, 86, 86, 2107, 86, 86, 86, 1086, 456, 1245,
+	781, 1289, 86, 1296, 521, 1649, 1069, 86, 86, 86,
+	1246, 1481, 1716, 86, 441, 2080, 2153, 1414, 772, 760,
+	771, 480, 747, 765, 66, 1349, 1793, 569, 1273, 1941,
+	1342, 729, 1367, 1236, 1604, 463, 1364, 631, 1348, 904,
+	1796, 1656, 86, 1336, 702, 524, 86, 568, 86, 587,
+	86, 1133, 67, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
+	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
+	1220, 1221, 782, 86, 1225, 569, 1873, 1853, 86, 86,
+	1664, 86, 1636, 1558, 2154, 570, 1345, 730, 1663, 732,
+	1541, 1243, 439, 1416, 522, 568, 2162, 783, 731, 1657,
+	1351, 2135, 1232, 1292, 1234, 1354, 1356, 2156, 468, 1222,
+	1081, 1166, 1267, 1286, 1166, 1298, 468, 1308, 1310, 1315,
+	1318, 1792, 1165, 1608, 1809, 775, 1755, 1230, 1758, 905,
+	1379, 438, 556, 570, 1384, 1067, 1466, 1112, 1393, 1388,
+	1846, 1405, 1387, 568, 1392, 1404, 1650, 1111, 1660, 1403,
+	1473, 1442, 691, 733, 1973, 1339, 1554, 407, 1378, 1457,
+	409, 1280, 1452, 926, 927, 928, 929, 930, 932, 933,
+	931, 934, 1293, 1495, 1279, 2019, 1920, 1412, 728, 1568,
+	35, 1499, 1415, 1845, 1696, 408, 1436, 1536, 50, 1921,
+	1438, 1923, 1437, 773, 1439, 1465, 1512, 1513, 1398, 1460,
+	782, 1491, 1469, 1972, 1408, 1508, 1092, 921, 539, 1013,
+	1474, 907, 1970, 537, 777, 1448, 534, 462, 1004, 1569,
+	1007, 481, 564, 981, 1228, 783, 1001, 1000, 1227, 1294,
+	1065, 1223, 1291, 1233, 1622, 776, 727, 892, 1563, 456,
+	864, 1969, 1620, 676, 1794, 1451, 482, 1409, 1756, 1576,
+	1789, 86, 1482, 1350, 1129, 1480, 1125, 1757, 737, 1667,
+	1488, 734, 1490, 1787, 1413, 706, 704, 86, 703, 1449,
+	1725, 86, 1931, 915, 1598, 1149, 1500, 675, 573, 2141,
+	750, 86, 452, 530, 86, 1486, 1857, 86, 769, 1934,
+	1075, 1596, 1795, 1645, 923, 1551, 1552, 1553, 1522, 1542,
+	1543, 1544, 1545, 1546, 1548, 1549, 1547, 1550, 1072, 1607,
+	923, 743, 1609, 1072, 1950, 1971, 2045, 628, 86, 1074,
+	1922, 86, 1295, 1016, 1071, 2071, 3, 1661, 577, 78,
+	86, 2058, 2003, 1571, 1572, 1573, 1687, 1595, 925, 1655,
+	1648, 1639, 744, 1033, 917, 880, 1472, 2160, 77, 1478,
+	2161, 1229, 924, 1483, 1621, 1591, 1623, 1594, 1541, 1150,
+	1165, 1231, 923, 1165, 391, 1600, 1405, 1599, 924, 1450,
+	710, 411, 1643, 1603, 1651, 86, 574, 1611, 1501, 86,
+	453, 86, 86, 390, 2007, 86, 1484, 461, 531, 1511,
+	1101, 1489, 1444, 1103, 1102, 1166, 1890, 1774, 1166, 746,
+	1723, 1688, 1632, 1683, 1524, 1581, 1290, 1167, 1529, 1637,
+	1102, 1394, 414, 1329, 416, 418, 419, 1662, 1328, 1325,
+	1665, 1324, 1323, 1038, 1285, 1284, 1790, 1283, 1282, 1274,
+	1791, 1705, 963, 766, 761, 1907, 1842, 1724, 1315, 1315,
+	1315, 1386, 1090, 1679, 974, 548, 546, 86, 544, 425,
+	874, 863, 535, 1666, 1914, 1713, 1714, 1715, 2100, 1670,
+	1671, 1457, 1593, 1448, 1525, 587, 1678, 1793, 2015, 1677,
+	1984, 1278, 979, 29, 691, 602, 1686, 1786, 1638, 1119,
+	770, 1796, 759, 538, 754, 2106, 1606, 1288, 677, 629,
+	1173, 630, 1174, 1451, 1249, 1005, 617, 1761, 1542, 1543,
+	1544, 1545, 1546, 1548, 1549, 1547, 1550, 1446, 1771, 1485,
+	476, 1036, 1710, 1241, 1275, 1521, 977, 1449, 601, 1487,
+	456, 607, 606, 456, 1719, 1721, 1722, 1253, 1802, 86,
+	2002, 86, 1732, 1884, 1444, 86, 2031, 598, 1642, 718,
+	1736, 86, 1447, 86, 396, 397, 781, 1833, 781, 86,
+	86, 86, 86, 86, 86, 1340, 1780, 1029, 903, 1801,
+	1135, 1763, 1792, 1808, 1166, 900, 1619, 86, 86, 1764,
+	430, 1847, 1824, 1538, 1307, 1299, 1297, 1843, 1287, 1825,
+	908, 563, 571, 870, 1822, 1806, 1828, 1777, 1176, 1823,
+	1866, 1778, 1169, 1863, 86, 1784, 86, 86, 549, 1775,
+	1375, 86, 1776, 1689, 1690, 1448, 1692, 689, 1037, 584,
+	1155, 1881, 582, 1932, 913, 1166, 1166, 1450, 1698, 1166,
+	450, 1820, 1876, 610, 1704, 1876, 35, 1887, 451, 1709,
+	1301, 1640, 1783, 1115, 1166, 1451, 1709, 1868, 1869, 1854,
+	529, 1874, 1813, 1816, 1165, 1167, 1095, 1165, 1167, 1446,
+	1726, 86, 1899, 694, 950, 1886, 81, 881, 81, 1449,
+	1142, 402, 2088, 1735, 1615, 57, 1737, 1855, 17, 421,
+	423, 16, 15, 1020, 14, 1835, 12, 35, 448, 448,
+	11, 1883, 458, 1856, 1447, 1435, 9, 458, 458, 469,
+	402, 474, 475, 8, 7, 1765, 1766, 28, 26, 25,
+	24, 23, 1872, 5, 1772, 22, 21, 20, 86, 19,
+	86, 4, 2, 1, 0, 0, 0, 86, 0, 0,
+	0, 0, 0, 0, 1933, 0, 1176, 0, 0, 0,
+	1357, 1919, 0, 1166, 0, 0, 0, 0, 0, 0,
+	456, 456, 0, 0, 456, 0, 782, 1951, 782, 0,
+	1833, 0, 0, 0, 1806, 0, 0, 0, 0, 1450,
+	0, 0, 1930, 0, 0, 86, 0, 1935, 0, 0,
+	86, 783, 0, 783, 0, 1966, 0, 0, 0, 86,
+	86, 1176, 86, 0, 0, 1357, 1679, 1862, 1865, 1962,
+	1956, 0, 0, 1961, 0, 0, 86, 0, 86, 0,
+	964, 1959, 1960, 0, 0, 0, 0, 1982, 1473, 1939,
+	0, 0, 1995, 1165, 0, 0, 0, 0, 1893, 1975,
+	0, 1643, 1897, 1898, 1993, 1166, 1993, 1981, 1902, 1903,
+	1980, 0, 0, 1871, 1906, 1541, 1176, 1974, 1989, 1908,
+	0, 0, 0, 1967, 0, 0, 0, 1992, 0, 1191,
+	0, 0, 2014, 0, 1913, 86, 0, 0, 1916, 0,
+	0, 0, 0, 0, 1165, 1165, 0, 0, 1165, 86,
+	86, 86, 86, 1944, 0, 0, 1301, 1301, 0, 2021,
+	0, 2030, 0, 1165, 1833, 86, 86, 1925, 0, 0,
+	0, 2041, 0, 0, 2035, 0, 0, 1190, 0, 1166,
+	0, 0, 2028, 0, 0, 2033, 0, 0, 0, 1824,
+	0, 86, 0, 0, 0, 0, 1825, 0, 86, 0,
+	1806, 468, 2054, 1828, 0, 2048, 1823, 2047, 0, 1167,
+	2053, 0, 1167, 0, 1952, 2059, 1983, 2052, 0, 2060,
+	0, 1957, 0, 1301, 1301, 1301, 2066, 2065, 2064, 2056,
+	456, 0, 0, 1166, 2063, 2077, 0, 0, 0, 2070,
+	86, 1175, 0, 2083, 86, 0, 86, 2085, 2076, 2038,
+	2040, 2038, 0, 0, 1886, 2073, 0, 1166, 86, 0,
+	0, 0, 1165, 2086, 0, 0, 2094, 1191, 86, 0,
+	0, 0, 0, 2096, 963, 2093, 86, 2097, 1806, 1991,
+	0, 0, 2099, 86, 2103, 0, 2117, 86, 2118, 2105,
+	507, 458, 458, 512, 0, 0, 86, 402, 402, 0,
+	2116, 2008, 2009, 2010, 2120, 2123, 2119, 0, 2035, 0,
+	0, 0, 0, 2133, 2134, 1190, 0, 0, 2138, 2136,
+	0, 0, 1191, 0, 2124, 2143, 547, 2144, 0, 458,
+	0, 551, 552, 402, 2146, 557, 1493, 1494, 0, 2084,
+	0, 0, 0, 2157, 691, 2090, 2158, 0, 2159, 2029,
+	448, 0, 0, 0, 1165, 0, 0, 0, 0, 0,
+	0, 458, 0, 0, 0, 2164, 458, 458, 0, 697,
+	1190, 0, 0, 0, 0, 0, 0, 1191, 1167, 1175,
+	0, 0, 0, 0, 0, 0, 2038, 0, 0, 0,
+	0, 0, 724, 923, 1865, 939, 940, 941, 725, 0,
+	2087, 1301, 1301, 1560, 1561, 1562, 402, 458, 716, 81,
+	0, 0, 0, 0, 0, 0, 0, 458, 716, 0,
+	0, 925, 0, 0, 0, 1190, 0, 948, 1165, 1167,
+	1167, 0, 1038, 1167, 1175, 0, 0, 1176, 402, 0,
+	458, 402, 0, 402, 0, 0, 0, 1193, 1167, 869,
+	0, 924, 0, 0, 0, 0, 0, 0, 0, 938,
+	883, 0, 0, 1301, 1301, 1301, 1301, 1301, 1301, 1301,
+	1301, 1301, 1301, 1301, 1301, 1301, 1301, 1301, 1301, 1176,
+	1301, 1192, 1165, 1734, 448, 0, 1176, 919, 2115, 1175,
+	1738, 0, 0, 0, 0, 0, 2121, 0, 0, 726,
+	0, 0, 0, 0, 0, 0, 1165, 0, 2132, 2132,
+	0, 0, 0, 0, 923, 1176, 939, 940, 941, 1767,
+	0, 1172, 0, 1542, 1543, 1544, 1545, 1546, 1548, 1549,
+	1547, 1550, 0, 0, 942, 0, 2132, 0, 0, 0,
+	0, 0, 925, 0, 0, 0, 0, 1167, 948, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 729, 0,
+	0, 0, 0, 0, 949, 0, 0, 2132, 0, 0,
+	0, 1176, 924, 0, 0, 1827, 0, 0, 0, 0,
+	938, 1693, 1694, 0, 0, 1193, 0, 458, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 944,
+	458, 0, 1040, 0, 458, 0, 0, 458, 1043, 402,
+	402, 0, 0, 0, 730, 0, 732, 458, 919, 1192,
+	0, 0, 0, 0, 402, 731, 0, 0, 1061, 0,
+	0, 0, 0, 458, 0, 402, 0, 0, 458, 1167,
+	1193, 458, 1176, 1739, 1740, 1741, 1742, 1743, 1744, 1745,
+	1746, 1747, 1748, 1749, 1750, 1751, 1752, 1753, 1754, 1172,
+	1759, 0, 0, 0, 1541, 0, 1555, 1556, 1557, 0,
+	0, 603, 36, 0, 1192, 0, 1385, 0, 0, 0,
+	733, 0, 0, 0, 1895, 949, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1193, 0, 0, 1191, 0,
+	0, 0, 0, 36, 0, 728, 947, 0, 0, 0,
+	0, 0, 0, 1167, 1172, 0, 1301, 0, 0, 0,
+	944, 946, 435, 0, 0, 443, 0, 0, 0, 1192,
+	1554, 0, 36, 0, 0, 0, 0, 0, 724, 0,
+	1191, 0, 443, 0, 725, 0, 1190, 1191, 1541, 0,
+	1555, 1556, 1557, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1176, 727, 0, 943, 1958, 1167, 716, 1172,
+	0, 0, 1108, 0, 1176, 458, 1191, 0, 0, 0,
+	1116, 402, 0, 0, 0, 0, 0, 0, 1190, 0,
+	0, 1167, 0, 0, 945, 1190, 0, 935, 936, 937,
+	0, 926, 927, 928, 929, 930, 932, 933, 931, 934,
+	1175, 0, 0, 458, 1554, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1190, 1176, 0, 1176, 402, 1998,
+	0, 1998, 1191, 0, 0, 0, 0, 0, 0, 0,
+	0, 1301, 946, 0, 0, 726, 1176, 0, 0, 0,
+	2012, 0, 1175, 0, 0, 0, 1558, 0, 0, 1175,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1176,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1158, 0, 0, 0, 1157, 1143, 704, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1143, 0,
-	692, 0, 0, 0, 0, 0, 0, 0, 1142, 95,
-	0, 1160, 443, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 95, 0, 0, 0, 95,
-	0, 0, 0, 0, 0, 0, 0, 1158, 0, 95,
-	0, 1157, 95, 0, 703, 95, 0, 0, 1159, 1143,
-	1142, 1143, 0, 1160, 0, 0, 1139, 1142, 702, 1525,
-	1160, 895, 896, 897, 898, 899, 901, 902, 900, 903,
-	1143, 0, 0, 95, 0, 0, 95, 0, 0, 95,
-	422, 0, 0, 0, 0, 0, 1142, 95, 0, 1160,
-	1159, 0, 0, 1143, 0, 0, 0, 1159, 1139, 0,
-	1268, 0, 0, 0, 0, 1139, 0, 0, 0, 1158,
-	0, 0, 0, 1157, 0, 0, 0, 705, 0, 0,
-	0, 0, 692, 0, 0, 0, 1159, 0, 0, 0,
-	692, 0, 1143, 95, 1139, 1911, 0, 95, 0, 95,
-	95, 1319, 1142, 95, 0, 1160, 0, 1518, 1519, 1520,
-	0, 1509, 1510, 1511, 1512, 1513, 1515, 1516, 1514, 1517,
-	0, 0, 0, 0, 1397, 1398, 1399, 0, 0, 0,
-	0, 1345, 0, 706, 0, 708, 0, 0, 0, 0,
-	0, 0, 1159, 0, 707, 0, 0, 0, 0, 1143,
-	1139, 0, 892, 0, 908, 909, 910, 0, 0, 422,
-	0, 0, 422, 422, 0, 95, 0, 0, 0, 1365,
-	0, 0, 911, 0, 1142, 0, 0, 1160, 0, 0,
-	894, 0, 0, 929, 0, 1268, 917, 931, 1396, 1158,
-	0, 935, 936, 1157, 0, 712, 0, 0, 0, 709,
-	0, 1158, 0, 0, 0, 1157, 0, 0, 0, 0,
-	893, 443, 0, 0, 1159, 0, 0, 0, 907, 0,
-	1986, 0, 1139, 704, 0, 0, 0, 0, 0, 1518,
-	1519, 1520, 0, 1509, 1510, 1511, 1512, 1513, 1515, 1516,
-	1514, 1517, 0, 0, 0, 0, 0, 95, 0, 95,
-	0, 0, 1158, 95, 1158, 0, 1157, 0, 1157, 95,
-	892, 95, 0, 0, 753, 1801, 753, 95, 95, 95,
-	95, 95, 95, 1158, 0, 0, 0, 1157, 0, 0,
-	703, 33, 0, 0, 1268, 0, 95, 95, 894, 0,
-	0, 0, 95, 33, 1142, 0, 1158, 1160, 0, 0,
-	1157, 0, 0, 0, 0, 0, 1142, 0, 0, 1160,
-	0, 0, 0, 95, 0, 95, 95, 0, 893, 2040,
-	95, 0, 0, 918, 0, 0, 907, 0, 0, 0,
-	0, 0, 0, 0, 1159, 1158, 0, 0, 0, 1157,
-	0, 0, 1139, 0, 916, 0, 1159, 0, 0, 0,
-	0, 0, 0, 0, 1139, 0, 0, 1142, 913, 1142,
-	1160, 0, 1160, 0, 0, 0, 0, 0, 0, 0,
-	95, 0, 0, 0, 0, 0, 0, 0, 1142, 0,
-	892, 1160, 0, 0, 0, 1663, 0, 0, 0, 0,
-	0, 0, 1158, 0, 0, 1007, 1157, 1159, 0, 1159,
-	0, 1142, 0, 912, 1160, 1139, 0, 1139, 894, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1159, 0,
-	0, 0, 0, 0, 0, 0, 1139, 95, 892, 95,
-	908, 909, 910, 0, 0, 0, 95, 0, 893, 0,
-	1142, 1159, 0, 1160, 0, 0, 907, 0, 892, 1139,
-	908, 909, 910, 0, 0, 0, 894, 1619, 0, 33,
-	0, 0, 917, 0, 0, 0, 0, 0, 911, 1801,
-	0, 0, 0, 0, 0, 0, 894, 0, 0, 915,
-	1159, 0, 917, 0, 95, 0, 893, 0, 1139, 95,
-	0, 0, 443, 0, 907, 443, 0, 1142, 0, 1127,
-	1160, 95, 0, 0, 0, 1659, 893, 0, 0, 0,
-	0, 0, 0, 0, 907, 95, 0, 95, 892, 0,
-	908, 909, 910, 0, 1393, 1394, 1395, 1206, 1384, 1385,
-	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1159, 911, 0,
-	0, 0, 0, 0, 0, 1139, 894, 0, 0, 0,
-	0, 914, 917, 0, 904, 905, 906, 0, 895, 896,
-	897, 898, 899, 901, 902, 900, 903, 0, 0, 0,
-	0, 0, 0, 0, 95, 1544, 893, 0, 892, 0,
-	908, 909, 910, 0, 907, 0, 0, 0, 95, 95,
-	95, 95, 0, 0, 0, 0, 0, 0, 911, 918,
-	0, 0, 0, 1801, 95, 95, 894, 0, 0, 430,
-	0, 0, 917, 0, 0, 0, 0, 0, 0, 918,
-	916, 0, 0, 95, 0, 0, 0, 0, 892, 0,
-	95, 0, 0, 0, 913, 0, 893, 95, 0, 0,
-	916, 0, 0, 0, 907, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 913, 0, 894, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 895, 896, 897, 898,
-	899, 901, 902, 900, 903, 0, 0, 0, 0, 95,
-	0, 0, 0, 95, 0, 95, 893, 0, 0, 918,
-	0, 0, 0, 0, 907, 0, 0, 95, 0, 912,
-	892, 0, 908, 909, 910, 0, 0, 95, 0, 0,
-	916, 0, 0, 443, 443, 95, 0, 443, 0, 0,
-	911, 0, 95, 0, 913, 0, 95, 33, 894, 0,
-	0, 0, 0, 0, 917, 1428, 0, 0, 1431, 0,
-	0, 0, 0, 0, 892, 0, 908, 909, 910, 918,
-	0, 0, 0, 1444, 0, 915, 0, 0, 893, 0,
-	0, 0, 0, 0, 911, 0, 907, 0, 0, 912,
-	916, 0, 894, 0, 0, 915, 0, 0, 917, 0,
-	0, 0, 0, 0, 913, 0, 895, 896, 897, 898,
-	899, 901, 902, 900, 903, 0, 0, 0, 0, 0,
-	0, 1206, 893, 0, 0, 0, 0, 0, 0, 0,
-	907, 0, 0, 0, 0, 0, 931, 1463, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 914, 0, 912,
-	904, 905, 906, 0, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 0, 0, 915, 1912, 914, 0, 0,
-	904, 905, 906, 0, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 0, 0, 0, 0, 0, 2113, 0,
-	0, 918, 0, 0, 0, 892, 0, 908, 909, 910,
-	0, 931, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 916, 0, 692, 911, 0, 0, 0, 0,
-	0, 0, 0, 894, 0, 915, 913, 0, 0, 917,
-	0, 0, 0, 0, 0, 918, 0, 914, 0, 0,
-	904, 905, 906, 443, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 893, 0, 0, 916, 0, 2046, 0,
-	0, 907, 0, 1149, 0, 1164, 1144, 1156, 0, 0,
-	913, 912, 0, 0, 0, 0, 0, 1166, 1165, 0,
-	0, 0, 0, 700, 0, 0, 0, 0, 0, 701,
-	0, 0, 0, 0, 0, 0, 0, 914, 0, 0,
-	904, 905, 906, 0, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 0, 0, 912, 0, 892, 2010, 908,
-	909, 910, 1161, 0, 0, 1154, 1153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 911, 0, 1127,
-	0, 0, 1127, 0, 1152, 894, 0, 915, 0, 0,
-	0, 917, 0, 2024, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 0, 0, 0, 918, 0, 0, 0,
-	1151, 0, 0, 0, 0, 893, 0, 892, 0, 908,
-	909, 910, 0, 907, 0, 0, 0, 916, 0, 0,
-	702, 915, 0, 931, 0, 0, 0, 911, 0, 0,
-	0, 913, 0, 0, 0, 894, 0, 0, 0, 0,
-	0, 917, 0, 2055, 1146, 1147, 0, 749, 0, 914,
-	0, 0, 904, 905, 906, 0, 895, 896, 897, 898,
-	899, 901, 902, 900, 903, 893, 0, 0, 0, 0,
-	1990, 0, 0, 907, 0, 1007, 912, 0, 0, 705,
+	1190, 0, 0, 0, 0, 0, 0, 0, 1175, 0,
+	0, 724, 0, 0, 0, 0, 1943, 725, 0, 0,
+	0, 0, 0, 1191, 729, 0, 0, 0, 1176, 0,
+	0, 0, 1827, 0, 0, 945, 0, 0, 935, 936,
+	937, 0, 926, 927, 928, 929, 930, 932, 933, 931,
+	934, 0, 0, 0, 1334, 458, 0, 1344, 0, 0,
+	1301, 1335, 0, 458, 1175, 0, 0, 0, 0, 0,
+	0, 1190, 0, 919, 716, 0, 0, 1352, 1353, 0,
+	730, 1355, 732, 1061, 0, 1176, 1358, 0, 0, 0,
+	0, 731, 0, 0, 1368, 1369, 0, 1371, 1373, 1374,
+	923, 0, 939, 940, 941, 458, 0, 0, 0, 0,
+	1381, 1382, 1383, 0, 0, 0, 716, 0, 726, 0,
+	942, 0, 0, 0, 0, 0, 0, 0, 925, 0,
+	0, 0, 0, 0, 948, 1175, 1193, 0, 0, 0,
+	0, 2018, 1380, 1191, 0, 458, 733, 0, 0, 402,
+	0, 458, 0, 1410, 0, 1191, 0, 0, 924, 0,
+	0, 0, 0, 0, 0, 0, 938, 0, 0, 0,
+	1192, 728, 0, 0, 0, 0, 1440, 729, 1193, 0,
+	0, 1455, 1459, 1462, 1455, 1193, 0, 0, 1551, 1552,
+	1553, 1190, 1542, 1543, 1544, 1545, 1546, 1548, 1549, 1547,
+	1550, 0, 0, 1190, 0, 0, 1191, 0, 1191, 0,
+	1172, 0, 1192, 0, 1193, 0, 0, 0, 0, 1192,
+	0, 0, 0, 0, 0, 0, 0, 1191, 0, 727,
+	0, 0, 0, 730, 0, 732, 435, 0, 0, 0,
+	2072, 0, 0, 724, 731, 0, 0, 0, 1192, 725,
+	1191, 0, 1172, 0, 1190, 1175, 1190, 0, 0, 1172,
+	0, 0, 0, 0, 0, 0, 0, 1175, 0, 0,
+	1193, 949, 1551, 1552, 1553, 1190, 1542, 1543, 1544, 1545,
+	1546, 1548, 1549, 1547, 1550, 0, 0, 0, 1172, 1191,
+	0, 0, 947, 0, 0, 736, 0, 0, 1190, 733,
+	0, 0, 0, 0, 1192, 0, 944, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1175, 923,
+	1175, 0, 0, 0, 728, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1190, 0, 1175,
+	0, 1193, 0, 0, 1172, 0, 1191, 925, 0, 0,
+	726, 943, 0, 0, 1590, 0, 0, 0, 0, 0,
+	0, 0, 1175, 0, 435, 0, 0, 435, 435, 0,
+	458, 0, 0, 0, 402, 1192, 0, 924, 0, 0,
+	0, 0, 727, 0, 919, 938, 0, 919, 960, 0,
+	1613, 0, 962, 0, 1190, 0, 966, 967, 0, 0,
+	0, 1175, 0, 0, 0, 0, 0, 0, 0, 729,
+	0, 0, 0, 0, 0, 1172, 0, 0, 0, 0,
+	0, 1061, 0, 923, 1644, 939, 940, 941, 946, 0,
+	0, 0, 0, 1652, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 942, 1691, 0, 0, 0, 0, 0,
+	0, 925, 0, 0, 0, 0, 0, 948, 1175, 0,
+	0, 1193, 0, 0, 0, 730, 0, 732, 0, 0,
+	0, 0, 0, 1193, 0, 0, 731, 0, 1672, 0,
+	0, 924, 1674, 0, 1459, 1455, 36, 0, 1455, 938,
+	0, 0, 0, 0, 0, 1192, 0, 0, 0, 36,
+	0, 945, 0, 0, 935, 936, 937, 1192, 926, 927,
+	928, 929, 930, 932, 933, 931, 934, 0, 923, 0,
+	939, 940, 941, 0, 1193, 1577, 1193, 722, 0, 0,
+	0, 733, 0, 0, 0, 1172, 0, 0, 942, 0,
+	0, 0, 0, 0, 0, 1193, 925, 1172, 0, 0,
+	1729, 0, 948, 0, 0, 0, 728, 0, 1192, 0,
+	1192, 0, 0, 0, 0, 0, 0, 0, 1193, 0,
+	0, 0, 0, 0, 0, 0, 924, 0, 0, 1192,
+	0, 0, 0, 0, 938, 0, 0, 0, 0, 0,
+	0, 0, 0, 923, 949, 939, 940, 941, 1172, 0,
+	1172, 0, 1192, 0, 0, 0, 0, 1193, 0, 0,
+	0, 0, 0, 942, 727, 947, 0, 0, 0, 1172,
+	0, 925, 0, 0, 0, 0, 0, 948, 0, 944,
+	0, 0, 1799, 0, 919, 0, 1805, 0, 919, 0,
+	0, 1192, 1172, 0, 1818, 0, 1819, 0, 0, 0,
+	0, 924, 1836, 1837, 1838, 1839, 1840, 1841, 0, 938,
+	0, 0, 0, 0, 1193, 0, 0, 0, 0, 0,
+	1116, 402, 36, 0, 943, 0, 0, 0, 0, 0,
+	0, 1172, 0, 0, 0, 0, 0, 0, 0, 949,
+	0, 0, 0, 0, 0, 0, 0, 919, 1192, 1880,
+	1882, 0, 0, 0, 1455, 0, 1462, 0, 0, 0,
+	947, 0, 0, 1160, 0, 0, 1541, 0, 1555, 1556,
+	1557, 0, 0, 0, 944, 0, 0, 926, 927, 928,
+	929, 930, 932, 933, 931, 934, 1702, 0, 1172, 0,
+	0, 1239, 0, 0, 0, 0, 0, 0, 0, 923,
+	0, 946, 0, 0, 1915, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 949, 0, 0, 0, 0, 943,
+	0, 0, 0, 0, 0, 0, 0, 925, 0, 0,
+	0, 0, 1554, 0, 923, 947, 939, 940, 941, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 944,
+	0, 0, 0, 0, 942, 1805, 0, 924, 0, 0,
+	0, 1938, 925, 402, 0, 938, 0, 0, 948, 0,
+	458, 0, 0, 443, 945, 0, 0, 935, 936, 937,
+	0, 926, 927, 928, 929, 930, 932, 933, 931, 934,
+	0, 0, 924, 0, 943, 2142, 946, 0, 0, 0,
+	938, 0, 923, 0, 939, 940, 941, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 458, 0,
+	0, 0, 942, 1644, 1477, 0, 0, 0, 0, 0,
+	925, 0, 919, 458, 0, 1986, 948, 1541, 0, 1555,
+	1556, 1557, 0, 0, 0, 0, 0, 0, 0, 919,
+	0, 1882, 1541, 0, 1555, 1556, 1557, 1701, 1558, 0,
+	924, 0, 0, 0, 0, 0, 0, 0, 938, 945,
+	0, 946, 935, 936, 937, 0, 926, 927, 928, 929,
+	930, 932, 933, 931, 934, 0, 1430, 1431, 1432, 0,
+	2078, 0, 0, 36, 0, 0, 0, 0, 0, 0,
+	0, 1461, 0, 1554, 1464, 949, 0, 0, 2027, 0,
+	0, 0, 0, 1541, 0, 1555, 1556, 1557, 1554, 0,
+	0, 1805, 2036, 402, 402, 402, 947, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2049, 2050,
+	944, 0, 0, 0, 945, 0, 0, 935, 936, 937,
+	1429, 926, 927, 928, 929, 930, 932, 933, 931, 934,
+	0, 0, 0, 0, 919, 2042, 0, 1239, 0, 0,
+	0, 1880, 0, 949, 0, 1462, 0, 0, 0, 1554,
+	0, 0, 962, 1496, 0, 943, 0, 0, 923, 0,
+	939, 940, 941, 0, 947, 0, 0, 0, 0, 1805,
+	0, 0, 0, 0, 0, 0, 0, 0, 944, 0,
+	0, 0, 0, 402, 0, 0, 925, 458, 0, 402,
+	0, 0, 948, 1559, 0, 0, 0, 0, 0, 1558,
+	923, 1986, 939, 940, 941, 0, 0, 0, 0, 0,
+	0, 1880, 0, 0, 1558, 0, 924, 962, 0, 458,
+	942, 0, 0, 943, 938, 0, 2036, 0, 925, 0,
+	402, 0, 946, 923, 948, 939, 940, 941, 0, 919,
+	1551, 1552, 1553, 0, 1542, 1543, 1544, 1545, 1546, 1548,
+	1549, 1547, 1550, 942, 0, 0, 0, 0, 924, 0,
+	0, 925, 0, 0, 0, 0, 938, 948, 0, 923,
+	0, 939, 940, 941, 0, 1558, 0, 926, 927, 928,
+	929, 930, 932, 933, 931, 934, 0, 0, 0, 942,
+	0, 924, 0, 0, 0, 0, 0, 925, 0, 938,
+	946, 0, 0, 948, 0, 945, 0, 0, 935, 936,
+	937, 0, 926, 927, 928, 929, 930, 932, 933, 931,
+	934, 0, 0, 0, 0, 0, 2022, 924, 0, 949,
+	0, 0, 0, 0, 0, 938, 0, 0, 0, 0,
+	0, 923, 0, 939, 940, 941, 0, 0, 0, 0,
+	947, 0, 0, 0, 0, 1160, 0, 0, 1160, 0,
+	0, 942, 0, 0, 944, 0, 0, 0, 0, 925,
+	0, 949, 0, 945, 0, 948, 935, 936, 937, 0,
+	926, 927, 928, 929, 930, 932, 933, 931, 934, 0,
+	0, 0, 947, 0, 2017, 0, 0, 0, 0, 924,
+	0, 0, 0, 0, 949, 0, 944, 938, 0, 962,
+	0, 1551, 1552, 1553, 0, 1542, 1543, 1544, 1545, 1546,
+	1548, 1549, 1547, 1550, 0, 947, 1551, 1552, 1553, 0,
+	1542, 1543, 1544, 1545, 1546, 1548, 1549, 1547, 1550, 944,
+	949, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 943, 0, 0, 0, 0, 0, 0, 1426, 1427,
+	1428, 947, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 0, 0, 0, 0, 944, 0, 0, 0, 0,
+	0, 0, 0, 0, 943, 0, 946, 1551, 1552, 1553,
+	0, 1542, 1543, 1544, 1545, 1546, 1548, 1549, 1547, 1550,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 36,
+	0, 0, 949, 0, 0, 0, 0, 0, 0, 0,
+	943, 0, 0, 0, 0, 0, 0, 0, 946, 0,
+	0, 0, 0, 947, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 945,
+	36, 946, 935, 936, 937, 0, 926, 927, 928, 929,
+	930, 932, 933, 931, 934, 1160, 1160, 0, 0, 1160,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 914, 0, 0, 904, 905, 906, 1155,
-	895, 896, 897, 898, 899, 901, 902, 900, 903, 0,
-	0, 0, 0, 0, 1985, 0, 0, 0, 918, 0,
-	0, 0, 0, 33, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 706, 0, 708, 0, 916,
-	0, 0, 1150, 0, 0, 0, 707, 0, 0, 0,
-	0, 0, 915, 913, 0, 0, 0, 0, 0, 0,
+	0, 0, 943, 0, 0, 0, 0, 946, 0, 0,
+	0, 945, 0, 0, 935, 936, 937, 0, 926, 927,
+	928, 929, 930, 932, 933, 931, 934, 0, 0, 0,
+	0, 0, 2013, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 945, 0, 0, 935, 936, 937,
+	0, 926, 927, 928, 929, 930, 932, 933, 931, 934,
+	0, 0, 0, 0, 0, 1928, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 946,
+	945, 0, 0, 935, 936, 937, 0, 926, 927, 928,
+	929, 930, 932, 933, 931, 934, 0, 0, 0, 0,
+	0, 1904, 0, 0, 0, 0, 0, 1936, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 33, 0, 0, 918, 0,
-	0, 0, 1138, 0, 0, 0, 0, 0, 1148, 0,
-	1127, 1127, 0, 0, 1127, 0, 0, 698, 912, 916,
-	0, 709, 0, 0, 0, 0, 0, 1145, 0, 1163,
-	1162, 0, 0, 913, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 914, 704, 0, 904, 905, 906,
-	0, 895, 896, 897, 898, 899, 901, 902, 900, 903,
-	1167, 0, 0, 0, 0, 1981, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 912, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
-	0, 0, 703, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1904, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 945, 0, 0, 935, 936, 937, 0, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 0, 0,
+	0, 0, 0, 1797, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 962,
+	0, 0, 0, 0, 0, 1160, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 0, 0, 0, 0, 0, 1896, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 931, 0, 0, 0, 0, 0,
-	1127, 0, 0, 0, 0, 0, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 0, 1800, 732, 1794, 0, 1872, 737, 0,
-	0, 0, 1397, 1398, 1399, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 757, 105, 106, 107, 758, 759,
-	760, 761, 762, 763, 764, 108, 109, 765, 110, 111,
-	468, 112, 113, 114, 931, 1149, 469, 1164, 1144, 1156,
-	766, 115, 116, 117, 118, 119, 767, 768, 120, 1166,
-	1165, 121, 769, 122, 123, 124, 125, 0, 770, 470,
-	771, 126, 127, 128, 129, 130, 1396, 471, 131, 132,
-	133, 772, 134, 135, 136, 137, 138, 139, 773, 472,
-	140, 141, 142, 774, 775, 776, 777, 778, 779, 143,
-	144, 145, 146, 147, 1161, 148, 149, 1154, 1153, 150,
-	780, 151, 781, 152, 153, 154, 155, 156, 782, 157,
-	158, 159, 783, 784, 160, 161, 632, 163, 164, 785,
-	165, 166, 167, 786, 168, 169, 170, 787, 171, 172,
-	173, 174, 0, 175, 176, 177, 0, 788, 178, 789,
-	179, 180, 1151, 181, 790, 182, 791, 183, 473, 792,
-	474, 184, 185, 186, 793, 187, 188, 0, 794, 0,
-	189, 795, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 796, 199, 200, 201, 202, 203, 204, 797, 205,
-	475, 0, 206, 207, 208, 209, 1146, 1147, 798, 749,
-	799, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	800, 801, 217, 0, 478, 218, 479, 802, 219, 220,
-	406, 803, 804, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 0, 480,
-	0, 235, 236, 0, 805, 237, 238, 239, 806, 0,
-	240, 1155, 241, 242, 243, 807, 244, 808, 809, 245,
-	246, 810, 811, 247, 0, 481, 248, 482, 0, 249,
-	250, 251, 252, 253, 254, 255, 812, 256, 257, 0,
-	258, 0, 261, 259, 260, 813, 262, 263, 264, 265,
-	266, 267, 268, 269, 1150, 270, 271, 272, 273, 814,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 815, 285, 286, 483, 287, 288, 0, 289, 290,
-	291, 292, 293, 294, 295, 816, 296, 297, 298, 299,
-	408, 817, 300, 301, 1797, 302, 303, 484, 304, 305,
-	1148, 306, 818, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 0, 819, 317, 318, 820, 319, 1802,
-	821, 1163, 1162, 822, 823, 409, 321, 0, 322, 0,
-	824, 323, 324, 325, 326, 327, 328, 329, 825, 826,
-	330, 331, 332, 333, 334, 827, 828, 335, 336, 337,
-	338, 0, 1167, 829, 339, 485, 340, 830, 831, 832,
-	833, 341, 342, 343, 344, 345, 346, 347, 348, 0,
-	0, 0, 1393, 1394, 1395, 752, 1798, 1799, 1386, 1387,
-	1388, 1389, 1390, 1391, 1392, 0, 0, 0, 97, 98,
-	99, 100, 101, 102, 103, 104, 757, 105, 106, 107,
-	758, 759, 760, 761, 762, 763, 764, 108, 109, 765,
-	110, 111, 468, 112, 113, 114, 349, 350, 469, 351,
-	0, 352, 766, 115, 116, 117, 118, 119, 767, 768,
-	120, 353, 354, 121, 769, 122, 123, 124, 125, 355,
-	770, 470, 771, 126, 127, 128, 129, 130, 0, 471,
-	131, 132, 133, 772, 134, 135, 136, 137, 138, 139,
-	773, 472, 140, 141, 142, 774, 775, 776, 777, 778,
-	779, 143, 144, 145, 146, 147, 356, 148, 149, 357,
-	358, 150, 780, 151, 781, 152, 153, 154, 155, 156,
-	782, 157, 158, 159, 783, 784, 160, 161, 162, 163,
-	164, 785, 165, 166, 167, 786, 168, 169, 170, 787,
-	171, 172, 173, 174, 359, 175, 176, 177, 360, 788,
-	178, 789, 179, 180, 361, 181, 790, 182, 791, 183,
-	473, 792, 474, 184, 185, 186, 793, 187, 188, 362,
-	794, 363, 189, 795, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 796, 199, 200, 201, 202, 203, 204,
-	797, 205, 475, 364, 206, 207, 208, 209, 365, 366,
-	798, 367, 799, 210, 476, 211, 477, 212, 213, 214,
-	215, 216, 800, 801, 217, 368, 478, 218, 479, 802,
-	219, 220, 406, 803, 804, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 407,
-	369, 480, 370, 235, 236, 371, 805, 237, 238, 239,
-	806, 372, 240, 373, 241, 242, 243, 807, 244, 808,
-	809, 245, 246, 810, 811, 247, 374, 481, 248, 482,
-	375, 249, 250, 251, 252, 253, 254, 255, 812, 256,
-	257, 376, 258, 377, 261, 259, 260, 813, 262, 263,
-	264, 265, 266, 267, 268, 269, 378, 270, 271, 272,
-	273, 814, 274, 275, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 815, 285, 286, 483, 287, 288, 379,
-	289, 290, 291, 292, 293, 294, 295, 816, 296, 297,
-	298, 299, 408, 817, 300, 301, 380, 302, 303, 484,
-	304, 305, 381, 306, 818, 307, 308, 309, 310, 311,
-	312, 313, 314, 315, 316, 382, 819, 317, 318, 820,
-	319, 320, 821, 410, 383, 822, 823, 409, 321, 384,
-	322, 385, 824, 323, 324, 325, 326, 327, 328, 329,
-	825, 826, 330, 331, 332, 333, 334, 827, 828, 335,
-	336, 337, 338, 386, 387, 829, 339, 485, 340, 830,
-	831, 832, 833, 341, 342, 343, 344, 345, 346, 347,
-	348, 752, 0, 0, 0, 0, 0, 0, 0, 0,
-	991, 0, 0, 0, 97, 98, 99, 100, 101, 102,
-	103, 104, 757, 105, 106, 107, 758, 759, 760, 761,
-	762, 763, 764, 108, 109, 765, 110, 111, 468, 112,
-	113, 114, 349, 350, 469, 351, 0, 352, 766, 115,
-	116, 117, 118, 119, 767, 768, 120, 353, 354, 121,
-	769, 122, 123, 124, 125, 355, 770, 470, 771, 126,
-	127, 128, 129, 130, 0, 471, 131, 132, 133, 772,
-	134, 135, 136, 137, 138, 139, 773, 472, 140, 141,
-	142, 774, 775, 776, 777, 778, 779, 143, 144, 145,
-	146, 147, 356, 148, 149, 357, 358, 150, 780, 151,
-	781, 152, 153, 154, 155, 156, 782, 157, 158, 159,
-	783, 784, 160, 161, 162, 163, 164, 785, 165, 166,
-	167, 786, 168, 169, 170, 787, 171, 172, 173, 174,
-	359, 175, 176, 177, 360, 788, 178, 789, 179, 180,
-	361, 181, 790, 182, 791, 183, 473, 792, 474, 184,
-	185, 186, 793, 187, 188, 362, 794, 363, 189, 795,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 796,
-	199, 200, 201, 202, 203, 204, 797, 205, 475, 364,
-	206, 207, 208, 209, 365, 366, 798, 367, 799, 210,
-	476, 211, 477, 212, 213, 214, 215, 216, 800, 801,
-	217, 368, 478, 218, 479, 802, 219, 220, 406, 803,
-	804, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 407, 369, 480, 370, 235,
-	236, 371, 805, 237, 238, 239, 806, 372, 240, 373,
-	241, 242, 243, 807, 244, 808, 809, 245, 246, 810,
-	811, 247, 374, 481, 248, 482, 375, 249, 250, 251,
-	252, 253, 254, 255, 812, 256, 257, 376, 258, 377,
-	261, 259, 260, 813, 262, 263, 264, 265, 266, 267,
-	268, 269, 378, 270, 271, 272, 273, 814, 274, 275,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 815,
-	285, 286, 483, 287, 288, 379, 289, 290, 291, 292,
-	293, 294, 295, 816, 296, 297, 298, 299, 408, 817,
-	300, 301, 380, 302, 303, 484, 304, 305, 381, 306,
-	818, 307, 308, 309, 310, 311, 312, 313, 314, 315,
-	316, 382, 819, 317, 318, 820, 319, 320, 821, 410,
-	383, 822, 823, 409, 321, 384, 322, 385, 824, 323,
-	324, 325, 326, 327, 328, 329, 825, 826, 330, 331,
-	332, 333, 334, 827, 828, 335, 336, 337, 338, 386,
-	387, 829, 339, 485, 340, 830, 831, 832, 833, 341,
-	342, 343, 344, 345, 346, 347, 348, 603, 590, 591,
-	592, 593, 589, 577, 0, 0, 0, 0, 0, 0,
-	97, 98, 99, 100, 101, 102, 103, 104, 0, 105,
-	106, 107, 0, 0, 0, 0, 583, 0, 0, 108,
-	109, 0, 110, 111, 468, 112, 113, 114, 349, 633,
-	469, 634, 0, 635, 0, 115, 116, 117, 118, 119,
-	600, 621, 120, 636, 637, 121, 0, 122, 123, 124,
-	125, 629, 0, 611, 0, 126, 127, 128, 129, 130,
-	0, 471, 131, 132, 133, 0, 134, 135, 136, 137,
-	138, 139, 0, 472, 140, 141, 142, 0, 612, 617,
-	613, 614, 618, 143, 144, 145, 146, 147, 638, 148,
-	149, 639, 640, 150, 0, 151, 0, 152, 153, 154,
-	155, 156, 0, 157, 158, 159, 0, 0, 160, 161,
-	632, 163, 164, 0, 165, 166, 167, 0, 168, 169,
-	170, 0, 171, 172, 173, 174, 582, 175, 176, 177,
-	622, 598, 178, 0, 179, 180, 641, 181, 0, 182,
-	0, 183, 473, 0, 474, 184, 185, 186, 0, 187,
-	188, 630, 0, 586, 189, 0, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 0, 199, 200, 201, 202,
-	203, 204, 0, 205, 475, 364, 206, 207, 208, 209,
-	642, 643, 0, 610, 0, 210, 476, 211, 477, 212,
-	213, 214, 215, 216, 0, 0, 217, 631, 478, 218,
-	479, 0, 219, 220, 406, 615, 616, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 407, 369, 480, 370, 235, 236, 371, 571, 237,
-	238, 239, 599, 628, 240, 644, 241, 242, 243, 0,
-	244, 0, 0, 245, 246, 0, 0, 247, 374, 481,
-	248, 482, 623, 249, 250, 251, 252, 253, 254, 255,
-	0, 256, 257, 624, 258, 377, 261, 259, 260, 0,
-	262, 263, 264, 265, 266, 267, 268, 269, 645, 270,
-	271, 272, 273, 0, 274, 275, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 0, 285, 286, 483, 287,
-	288, 587, 289, 290, 291, 292, 293, 294, 295, 53,
-	296, 297, 298, 299, 408, 619, 300, 301, 380, 302,
-	303, 484, 304, 305, 646, 306, 0, 307, 308, 309,
-	310, 311, 312, 313, 314, 315, 316, 625, 0, 317,
-	318, 55, 319, 320, 0, 647, 648, 0, 0, 409,
-	321, 626, 322, 627, 597, 323, 324, 325, 326, 327,
-	328, 329, 0, 574, 330, 331, 332, 333, 334, 620,
-	0, 335, 336, 337, 338, 467, 649, 0, 339, 485,
-	340, 0, 0, 0, 51, 341, 342, 343, 344, 345,
-	346, 347, 348, 572, 0, 52, 0, 0, 0, 0,
-	568, 569, 603, 590, 591, 592, 593, 589, 577, 0,
-	570, 0, 0, 578, 1956, 97, 98, 99, 100, 101,
-	102, 103, 104, 1230, 105, 106, 107, 0, 0, 0,
-	0, 583, 0, 0, 108, 109, 0, 110, 111, 468,
-	112, 113, 114, 349, 633, 469, 634, 0, 635, 0,
-	115, 116, 117, 118, 119, 600, 621, 120, 636, 637,
-	121, 0, 122, 123, 124, 125, 629, 0, 611, 0,
-	126, 127, 128, 129, 130, 0, 471, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 472, 140,
-	141, 142, 0, 612, 617, 613, 614, 618, 143, 144,
-	145, 146, 147, 638, 148, 149, 639, 640, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 1231, 0, 160, 161, 632, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 582, 175, 176, 177, 622, 598, 178, 0, 179,
-	180, 641, 181, 0, 182, 0, 183, 473, 0, 474,
-	184, 185, 186, 0, 187, 188, 630, 0, 586, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 475,
-	364, 206, 207, 208, 209, 642, 643, 0, 610, 0,
-	210, 476, 211, 477, 212, 213, 214, 215, 216, 0,
-	0, 217, 631, 478, 218, 479, 0, 219, 220, 406,
-	615, 616, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 480, 370,
-	235, 236, 371, 571, 237, 238, 239, 599, 628, 240,
-	644, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 481, 248, 482, 623, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 624, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 645, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 483, 287, 288, 587, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	619, 300, 301, 380, 302, 303, 484, 304, 305, 646,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 625, 0, 317, 318, 0, 319, 320, 0,
-	647, 648, 0, 0, 409, 321, 626, 322, 627, 597,
-	323, 324, 325, 326, 327, 328, 329, 0, 574, 330,
-	331, 332, 333, 334, 620, 0, 335, 336, 337, 338,
-	386, 649, 1229, 339, 485, 340, 0, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 572, 0,
-	0, 0, 0, 0, 0, 568, 569, 1232, 603, 590,
-	591, 592, 593, 589, 577, 570, 0, 0, 578, 1227,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 583, 0, 0,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	633, 469, 634, 0, 635, 0, 115, 116, 117, 118,
-	119, 600, 621, 120, 636, 637, 121, 0, 122, 123,
-	124, 125, 629, 0, 611, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 612,
-	617, 613, 614, 618, 143, 144, 145, 146, 147, 638,
-	148, 149, 639, 640, 150, 670, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 632, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 582, 175, 176,
-	177, 622, 598, 178, 0, 179, 180, 641, 181, 0,
-	182, 0, 183, 473, 0, 474, 184, 185, 186, 0,
-	187, 188, 630, 0, 586, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 642, 643, 0, 610, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 0, 217, 631, 478,
-	218, 479, 0, 219, 220, 406, 615, 616, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 571,
-	237, 238, 239, 599, 628, 240, 644, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 623, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 624, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 645,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 587, 289, 290, 291, 292, 293, 294, 295,
-	53, 296, 297, 298, 299, 408, 619, 300, 301, 380,
-	302, 303, 484, 304, 305, 646, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 625, 0,
-	317, 318, 55, 319, 320, 0, 647, 648, 0, 0,
-	409, 321, 626, 322, 627, 597, 323, 324, 325, 326,
-	327, 328, 329, 0, 574, 330, 331, 332, 333, 334,
-	620, 0, 335, 336, 337, 338, 467, 649, 0, 339,
-	485, 340, 0, 0, 0, 51, 341, 342, 343, 344,
-	345, 346, 347, 348, 572, 0, 52, 0, 0, 0,
-	0, 568, 569, 603, 590, 591, 592, 593, 589, 577,
-	0, 570, 0, 0, 578, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 583, 0, 0, 108, 109, 0, 110, 111,
-	468, 112, 113, 114, 349, 633, 469, 634, 0, 635,
-	0, 115, 116, 117, 118, 119, 600, 621, 120, 636,
-	637, 121, 0, 122, 123, 124, 125, 629, 0, 611,
-	0, 126, 127, 128, 129, 130, 0, 471, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 472,
-	140, 141, 142, 0, 612, 617, 613, 614, 618, 143,
-	144, 145, 146, 147, 638, 148, 149, 639, 640, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 632, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 582, 175, 176, 177, 622, 598, 178, 0,
-	179, 180, 641, 181, 0, 182, 0, 183, 473, 0,
-	474, 184, 185, 186, 0, 187, 188, 630, 0, 586,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	475, 364, 206, 207, 208, 209, 642, 643, 0, 610,
-	0, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	0, 0, 217, 631, 478, 218, 479, 0, 219, 220,
-	406, 615, 616, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 480,
-	370, 235, 236, 371, 571, 237, 238, 239, 599, 628,
-	240, 644, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 481, 248, 482, 623, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 624,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 645, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 483, 287, 288, 587, 289, 290,
-	291, 292, 293, 294, 295, 53, 296, 297, 298, 299,
-	408, 619, 300, 301, 380, 302, 303, 484, 304, 305,
-	646, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 625, 0, 317, 318, 55, 319, 320,
-	0, 647, 648, 0, 0, 409, 321, 626, 322, 627,
-	597, 323, 324, 325, 326, 327, 328, 329, 0, 574,
-	330, 331, 332, 333, 334, 620, 0, 335, 336, 337,
-	338, 467, 649, 0, 339, 485, 340, 0, 0, 0,
-	51, 341, 342, 343, 344, 345, 346, 347, 348, 572,
-	0, 52, 0, 0, 0, 0, 568, 569, 603, 590,
-	591, 592, 593, 589, 577, 0, 570, 0, 0, 578,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 583, 0, 0,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	633, 469, 634, 0, 635, 1278, 115, 116, 117, 118,
-	119, 600, 621, 120, 636, 637, 121, 0, 122, 123,
-	124, 125, 629, 0, 611, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 612,
-	617, 613, 614, 618, 143, 144, 145, 146, 147, 638,
-	148, 149, 639, 640, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 632, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 582, 175, 176,
-	177, 622, 598, 178, 0, 179, 180, 641, 181, 0,
-	182, 0, 183, 473, 1283, 474, 184, 185, 186, 0,
-	187, 188, 630, 0, 586, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 642, 643, 0, 610, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 1279, 217, 631, 478,
-	218, 479, 0, 219, 220, 406, 615, 616, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 571,
-	237, 238, 239, 599, 628, 240, 644, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 623, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 624, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 645,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 587, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 619, 300, 301, 380,
-	302, 303, 484, 304, 305, 646, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 625, 0,
-	317, 318, 0, 319, 320, 0, 647, 648, 0, 1280,
-	409, 321, 626, 322, 627, 597, 323, 324, 325, 326,
-	327, 328, 329, 0, 574, 330, 331, 332, 333, 334,
-	620, 0, 335, 336, 337, 338, 386, 649, 0, 339,
-	485, 340, 0, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 572, 0, 0, 0, 0, 0,
-	0, 568, 569, 603, 590, 591, 592, 593, 589, 577,
-	0, 570, 0, 0, 578, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 583, 0, 0, 108, 109, 0, 110, 111,
-	468, 112, 113, 114, 349, 633, 469, 634, 0, 635,
-	0, 115, 116, 117, 118, 119, 600, 621, 120, 636,
-	637, 121, 0, 122, 123, 124, 125, 629, 0, 611,
-	0, 126, 127, 128, 129, 130, 0, 471, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 472,
-	140, 141, 142, 0, 612, 617, 613, 614, 618, 143,
-	144, 145, 146, 147, 638, 148, 149, 639, 640, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 632, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 582, 175, 176, 177, 622, 598, 178, 0,
-	179, 180, 641, 181, 0, 182, 0, 183, 473, 0,
-	474, 184, 185, 186, 0, 187, 188, 630, 0, 586,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	475, 364, 206, 207, 208, 209, 642, 643, 0, 610,
-	0, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	0, 0, 217, 631, 478, 218, 479, 0, 219, 220,
-	406, 615, 616, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 480,
-	370, 235, 236, 371, 571, 237, 238, 239, 599, 628,
-	240, 644, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 481, 248, 482, 623, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 624,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 645, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 483, 287, 288, 587, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 619, 300, 301, 380, 302, 303, 484, 304, 305,
-	646, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 625, 0, 317, 318, 0, 319, 320,
-	0, 647, 648, 0, 0, 409, 321, 626, 322, 627,
-	597, 323, 324, 325, 326, 327, 328, 329, 0, 574,
-	330, 331, 332, 333, 334, 620, 0, 335, 336, 337,
-	338, 386, 649, 0, 339, 485, 340, 0, 0, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 572,
-	0, 0, 0, 0, 0, 0, 568, 569, 603, 590,
-	591, 592, 593, 589, 577, 0, 570, 0, 0, 578,
-	1730, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 583, 0, 0,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	633, 469, 634, 0, 635, 0, 115, 116, 117, 118,
-	119, 600, 621, 120, 636, 637, 121, 0, 122, 123,
-	124, 125, 629, 0, 611, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 612,
-	617, 613, 614, 618, 143, 144, 145, 146, 147, 638,
-	148, 149, 639, 640, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 632, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 582, 175, 176,
-	177, 622, 598, 178, 0, 179, 180, 641, 181, 0,
-	182, 0, 183, 473, 0, 474, 184, 185, 186, 0,
-	187, 188, 630, 0, 586, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 642, 643, 0, 610, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 0, 217, 631, 478,
-	218, 479, 0, 219, 220, 406, 615, 616, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 571,
-	237, 238, 239, 599, 628, 240, 644, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 623, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 624, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 645,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 587, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 619, 300, 301, 380,
-	302, 303, 484, 304, 305, 646, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 625, 0,
-	317, 318, 0, 319, 320, 0, 647, 648, 0, 0,
-	409, 321, 626, 322, 627, 597, 323, 324, 325, 326,
-	327, 328, 329, 0, 574, 330, 331, 332, 333, 334,
-	620, 0, 335, 336, 337, 338, 386, 649, 0, 339,
-	485, 340, 0, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 572, 0, 0, 0, 0, 0,
-	0, 568, 569, 603, 590, 591, 592, 593, 589, 577,
-	0, 570, 0, 0, 578, 1674, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 583, 0, 0, 108, 109, 0, 110, 111,
-	468, 112, 113, 114, 349, 633, 469, 634, 0, 635,
-	0, 115, 116, 117, 118, 119, 600, 621, 120, 636,
-	637, 121, 0, 122, 123, 124, 125, 629, 0, 611,
-	0, 126, 127, 128, 129, 130, 0, 471, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 472,
-	140, 141, 142, 0, 612, 617, 613, 614, 618, 143,
-	144, 145, 146, 147, 638, 148, 149, 639, 640, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 632, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 582, 175, 176, 177, 622, 598, 178, 0,
-	179, 180, 641, 181, 0, 182, 0, 183, 473, 0,
-	474, 184, 185, 186, 0, 187, 188, 630, 0, 586,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	475, 364, 206, 207, 208, 209, 642, 643, 0, 610,
-	0, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	0, 0, 217, 631, 478, 218, 479, 0, 219, 220,
-	406, 615, 616, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 480,
-	370, 235, 236, 371, 571, 237, 238, 239, 599, 628,
-	240, 644, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 481, 248, 482, 623, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 624,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 645, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 483, 287, 288, 587, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 619, 300, 301, 380, 302, 303, 484, 304, 305,
-	646, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 625, 0, 317, 318, 0, 319, 320,
-	0, 647, 648, 0, 0, 409, 321, 626, 322, 627,
-	597, 323, 324, 325, 326, 327, 328, 329, 0, 574,
-	330, 331, 332, 333, 334, 620, 0, 335, 336, 337,
-	338, 386, 649, 0, 339, 485, 340, 0, 0, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 572,
-	0, 0, 0, 0, 0, 0, 568, 569, 603, 590,
-	591, 592, 593, 589, 577, 0, 570, 0, 0, 578,
-	1226, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 583, 0, 0,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	633, 469, 634, 0, 635, 0, 115, 116, 117, 118,
-	119, 600, 621, 120, 636, 637, 121, 0, 122, 123,
-	124, 125, 629, 0, 611, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 612,
-	617, 613, 614, 618, 143, 144, 145, 146, 147, 638,
-	148, 149, 639, 640, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 632, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 582, 175, 176,
-	177, 622, 598, 178, 0, 179, 180, 641, 181, 0,
-	182, 0, 183, 473, 0, 474, 184, 185, 186, 0,
-	187, 188, 630, 0, 586, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 642, 643, 0, 610, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 0, 217, 631, 478,
-	218, 479, 0, 219, 220, 406, 615, 616, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 571,
-	237, 238, 239, 599, 628, 240, 644, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 623, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 624, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 645,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 587, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 619, 300, 301, 380,
-	302, 303, 484, 304, 305, 646, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 625, 0,
-	317, 318, 0, 319, 320, 0, 647, 648, 0, 0,
-	409, 321, 626, 322, 627, 597, 323, 324, 325, 326,
-	327, 328, 329, 0, 574, 330, 331, 332, 333, 334,
-	620, 0, 335, 336, 337, 338, 386, 649, 0, 339,
-	485, 340, 0, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 572, 0, 0, 0, 0, 0,
-	0, 568, 569, 603, 590, 591, 592, 593, 589, 577,
-	0, 570, 938, 1221, 578, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 583, 0, 0, 108, 109, 0, 110, 111,
-	468, 112, 113, 114, 349, 633, 469, 634, 0, 635,
-	0, 115, 116, 117, 118, 119, 600, 621, 120, 636,
-	637, 121, 0, 122, 123, 124, 125, 629, 0, 611,
-	0, 126, 127, 128, 129, 130, 0, 471, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 472,
-	140, 141, 142, 0, 612, 617, 613, 614, 618, 143,
-	144, 145, 146, 147, 638, 148, 149, 639, 640, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 632, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 582, 175, 176, 177, 622, 598, 178, 0,
-	179, 180, 641, 181, 0, 182, 0, 183, 473, 0,
-	474, 184, 185, 186, 0, 187, 188, 630, 0, 586,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	475, 364, 206, 207, 208, 209, 642, 643, 0, 610,
-	0, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	0, 0, 217, 631, 478, 218, 479, 0, 219, 220,
-	406, 615, 616, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 480,
-	370, 235, 236, 371, 571, 237, 238, 239, 599, 628,
-	240, 644, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 481, 248, 482, 623, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 624,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 645, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 483, 287, 288, 587, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 619, 300, 301, 380, 302, 303, 484, 304, 305,
-	646, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 625, 0, 317, 318, 0, 319, 320,
-	0, 647, 648, 0, 0, 409, 321, 626, 322, 627,
-	597, 323, 324, 325, 326, 327, 328, 329, 0, 574,
-	330, 331, 332, 333, 334, 620, 0, 335, 336, 337,
-	338, 386, 649, 1680, 339, 485, 340, 0, 0, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 572,
-	0, 0, 0, 0, 0, 0, 568, 569, 603, 590,
-	591, 592, 593, 589, 577, 0, 570, 0, 0, 578,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 583, 0, 0,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	633, 469, 634, 0, 635, 0, 115, 116, 117, 118,
-	119, 600, 621, 120, 636, 637, 121, 0, 122, 123,
-	124, 125, 629, 0, 611, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 612,
-	617, 613, 614, 618, 143, 144, 145, 146, 147, 638,
-	148, 149, 639, 640, 150, 670, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 632, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 582, 175, 176,
-	177, 622, 598, 178, 0, 179, 180, 641, 181, 0,
-	182, 0, 183, 473, 0, 474, 184, 185, 186, 0,
-	187, 188, 630, 0, 586, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 642, 643, 0, 610, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 0, 217, 631, 478,
-	218, 479, 0, 219, 220, 406, 615, 616, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 571,
-	237, 238, 239, 599, 628, 240, 644, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 623, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 624, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 645,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 587, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 619, 300, 301, 380,
-	302, 303, 484, 304, 305, 646, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 625, 0,
-	317, 318, 0, 319, 320, 0, 647, 648, 0, 0,
-	409, 321, 626, 322, 627, 597, 323, 324, 325, 326,
-	327, 328, 329, 0, 574, 330, 331, 332, 333, 334,
-	620, 0, 335, 336, 337, 338, 386, 649, 0, 339,
-	485, 340, 0, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 572, 0, 0, 0, 0, 0,
-	0, 568, 569, 603, 590, 591, 592, 593, 589, 577,
-	0, 570, 0, 0, 578, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 583, 0, 0, 108, 109, 0, 110, 111,
-	468, 112, 113, 114, 349, 633, 469, 634, 0, 635,
-	0, 115, 116, 117, 118, 119, 600, 621, 120, 636,
-	637, 121, 0, 122, 123, 124, 125, 629, 0, 611,
-	0, 126, 127, 128, 129, 130, 0, 471, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 472,
-	140, 141, 142, 0, 612, 617, 613, 614, 618, 143,
-	144, 145, 146, 147, 638, 148, 149, 639, 640, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 632, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 582, 175, 176, 177, 622, 598, 178, 0,
-	179, 180, 641, 181, 0, 182, 0, 183, 473, 0,
-	474, 184, 185, 186, 0, 187, 188, 630, 0, 586,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	475, 364, 206, 207, 208, 209, 642, 643, 0, 610,
-	0, 210, 476, 211, 477, 212, 213, 214, 215, 216,
-	0, 0, 217, 631, 478, 218, 479, 0, 219, 220,
-	406, 615, 616, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 480,
-	370, 235, 236, 371, 571, 237, 238, 239, 599, 628,
-	240, 644, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 481, 248, 482, 623, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 624,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 645, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 483, 287, 288, 587, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 619, 300, 301, 380, 302, 303, 484, 304, 305,
-	646, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 625, 0, 317, 318, 0, 319, 320,
-	0, 647, 648, 0, 0, 409, 321, 626, 322, 627,
-	597, 323, 324, 325, 326, 327, 328, 329, 0, 574,
-	330, 331, 332, 333, 334, 620, 0, 335, 336, 337,
-	338, 386, 649, 0, 339, 485, 340, 0, 0, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 572,
-	0, 0, 0, 0, 0, 0, 568, 569, 566, 603,
-	590, 591, 592, 593, 589, 577, 570, 0, 0, 578,
-	0, 0, 97, 98, 99, 100, 101, 102, 103, 104,
-	0, 105, 106, 107, 0, 0, 0, 0, 583, 0,
-	0, 108, 109, 0, 110, 111, 468, 112, 113, 114,
-	349, 633, 469, 634, 0, 635, 0, 115, 116, 117,
-	118, 119, 600, 621, 120, 636, 637, 121, 0, 122,
-	123, 124, 125, 629, 0, 611, 0, 126, 127, 128,
-	129, 130, 0, 471, 131, 132, 133, 0, 134, 135,
-	136, 137, 138, 139, 0, 472, 140, 141, 142, 0,
-	612, 617, 613, 614, 618, 143, 144, 145, 146, 147,
-	638, 148, 149, 639, 640, 150, 0, 151, 0, 152,
-	153, 154, 155, 156, 0, 157, 158, 159, 0, 0,
-	160, 161, 632, 163, 164, 0, 165, 166, 167, 0,
-	168, 169, 170, 0, 171, 172, 173, 174, 582, 175,
-	176, 177, 622, 598, 178, 0, 179, 180, 641, 181,
-	0, 182, 0, 183, 473, 1283, 474, 184, 185, 186,
-	0, 187, 188, 630, 0, 586, 189, 0, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 0, 199, 200,
-	201, 202, 203, 204, 0, 205, 475, 364, 206, 207,
-	208, 209, 642, 643, 0, 610, 0, 210, 476, 211,
-	477, 212, 213, 214, 215, 216, 0, 0, 217, 631,
-	478, 218, 479, 0, 219, 220, 406, 615, 616, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 407, 369, 480, 370, 235, 236, 371,
-	571, 237, 238, 239, 599, 628, 240, 644, 241, 242,
-	243, 0, 244, 0, 0, 245, 246, 0, 0, 247,
-	374, 481, 248, 482, 623, 249, 250, 251, 252, 253,
-	254, 255, 0, 256, 257, 624, 258, 377, 261, 259,
-	260, 0, 262, 263, 264, 265, 266, 267, 268, 269,
-	645, 270, 271, 272, 273, 0, 274, 275, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 0, 285, 286,
-	483, 287, 288, 587, 289, 290, 291, 292, 293, 294,
-	295, 0, 296, 297, 298, 299, 408, 619, 300, 301,
-	380, 302, 303, 484, 304, 305, 646, 306, 0, 307,
-	308, 309, 310, 311, 312, 313, 314, 315, 316, 625,
-	0, 317, 318, 0, 319, 320, 0, 647, 648, 0,
-	0, 409, 321, 626, 322, 627, 597, 323, 324, 325,
-	326, 327, 328, 329, 0, 574, 330, 331, 332, 333,
-	334, 620, 0, 335, 336, 337, 338, 386, 649, 0,
-	339, 485, 340, 0, 0, 0, 0, 341, 342, 343,
-	344, 345, 346, 347, 348, 572, 0, 0, 0, 0,
-	0, 0, 568, 569, 603, 590, 591, 592, 593, 589,
-	577, 0, 570, 0, 0, 578, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 871, 105, 106, 107, 0,
-	0, 0, 0, 583, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 349, 633, 469, 634, 0,
-	635, 0, 115, 116, 117, 118, 119, 600, 621, 120,
-	636, 637, 121, 0, 122, 123, 124, 125, 629, 0,
-	611, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 142, 0, 612, 617, 613, 614, 618,
-	143, 144, 145, 146, 147, 638, 148, 149, 639, 640,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 632, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 582, 175, 176, 177, 622, 598, 178,
-	0, 179, 180, 641, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 630, 0,
-	586, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 642, 643, 0,
-	610, 0, 210, 476, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 631, 478, 218, 479, 0, 219,
-	220, 406, 615, 616, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 571, 237, 238, 239, 599,
-	628, 240, 644, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 623,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	624, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 645, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 587, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 619, 300, 301, 380, 302, 303, 484, 304,
-	305, 646, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 625, 0, 317, 318, 0, 319,
-	320, 0, 647, 648, 0, 0, 409, 321, 626, 322,
-	627, 597, 323, 324, 325, 326, 327, 328, 329, 0,
-	574, 330, 331, 332, 333, 334, 620, 0, 335, 336,
-	337, 338, 386, 649, 0, 339, 485, 340, 0, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	572, 0, 0, 0, 0, 0, 0, 568, 569, 603,
-	590, 591, 592, 593, 589, 577, 0, 570, 0, 0,
-	578, 0, 97, 98, 99, 100, 101, 102, 103, 104,
-	0, 105, 106, 107, 0, 0, 0, 0, 583, 0,
-	0, 108, 109, 0, 110, 111, 468, 112, 113, 114,
-	349, 633, 469, 634, 0, 635, 0, 115, 116, 117,
-	118, 119, 600, 621, 120, 636, 637, 121, 0, 122,
-	123, 124, 125, 629, 0, 611, 0, 126, 127, 128,
-	129, 130, 0, 471, 131, 132, 133, 0, 134, 135,
-	136, 137, 138, 139, 0, 472, 140, 141, 2102, 0,
-	612, 617, 613, 614, 618, 143, 144, 145, 146, 147,
-	638, 148, 149, 639, 640, 150, 0, 151, 0, 152,
-	153, 154, 155, 156, 0, 157, 158, 159, 0, 0,
-	160, 161, 632, 163, 164, 0, 165, 166, 167, 0,
-	168, 169, 170, 0, 171, 172, 173, 174, 582, 175,
-	176, 177, 622, 598, 178, 0, 179, 180, 641, 181,
-	0, 182, 0, 183, 473, 0, 474, 184, 185, 186,
-	0, 187, 188, 630, 0, 586, 189, 0, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 0, 199, 200,
-	201, 202, 203, 204, 0, 205, 475, 364, 206, 207,
-	208, 209, 642, 643, 0, 610, 0, 210, 476, 211,
-	477, 212, 213, 214, 215, 216, 0, 0, 217, 631,
-	478, 218, 479, 0, 219, 220, 406, 615, 616, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 407, 369, 480, 370, 235, 236, 371,
-	571, 237, 238, 239, 599, 628, 240, 644, 241, 242,
-	243, 0, 244, 0, 0, 245, 246, 0, 0, 247,
-	374, 481, 248, 482, 623, 249, 250, 251, 252, 253,
-	254, 255, 0, 256, 257, 624, 258, 377, 261, 259,
-	260, 0, 262, 263, 264, 265, 266, 267, 268, 269,
-	645, 270, 271, 272, 273, 0, 274, 275, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 0, 285, 286,
-	483, 287, 288, 587, 289, 290, 291, 292, 293, 294,
-	295, 0, 296, 297, 298, 299, 408, 619, 300, 301,
-	380, 302, 303, 484, 304, 305, 646, 306, 0, 307,
-	308, 309, 310, 311, 312, 313, 314, 315, 316, 625,
-	0, 317, 318, 0, 319, 320, 0, 647, 648, 0,
-	0, 409, 321, 626, 322, 627, 597, 323, 324, 325,
-	326, 2101, 328, 329, 0, 574, 330, 331, 332, 333,
-	334, 620, 0, 335, 336, 337, 338, 386, 649, 0,
-	339, 485, 340, 0, 0, 0, 0, 341, 342, 343,
-	344, 345, 346, 347, 348, 572, 0, 0, 0, 0,
-	0, 0, 568, 569, 603, 590, 591, 592, 593, 589,
-	577, 0, 570, 0, 0, 578, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 583, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 2100, 633, 469, 634, 0,
-	635, 0, 115, 116, 117, 118, 119, 600, 621, 120,
-	636, 637, 121, 0, 122, 123, 124, 125, 629, 0,
-	611, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 2102, 0, 612, 617, 613, 614, 618,
-	143, 144, 145, 146, 147, 638, 148, 149, 639, 640,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 632, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 582, 175, 176, 177, 622, 598, 178,
-	0, 179, 180, 641, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 630, 0,
-	586, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 642, 643, 0,
-	610, 0, 210, 476, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 631, 478, 218, 479, 0, 219,
-	220, 406, 615, 616, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 571, 237, 238, 239, 599,
-	628, 240, 644, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 623,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	624, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 645, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 587, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 619, 300, 301, 380, 302, 303, 484, 304,
-	305, 646, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 625, 0, 317, 318, 0, 319,
-	320, 0, 647, 648, 0, 0, 409, 321, 626, 322,
-	627, 597, 323, 324, 325, 326, 2101, 328, 329, 0,
-	574, 330, 331, 332, 333, 334, 620, 0, 335, 336,
-	337, 338, 386, 649, 0, 339, 485, 340, 0, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	572, 0, 0, 0, 0, 0, 0, 568, 569, 603,
-	590, 591, 592, 593, 589, 577, 0, 570, 0, 0,
-	578, 0, 97, 98, 99, 100, 101, 102, 103, 104,
-	0, 105, 106, 107, 0, 0, 0, 0, 583, 0,
-	0, 108, 109, 0, 110, 111, 468, 112, 113, 114,
-	349, 633, 469, 634, 0, 635, 0, 115, 116, 117,
-	118, 119, 600, 621, 120, 636, 637, 121, 0, 122,
-	123, 124, 125, 629, 0, 611, 0, 126, 127, 128,
-	129, 130, 0, 471, 131, 132, 133, 0, 134, 135,
-	136, 137, 138, 139, 0, 472, 140, 141, 142, 0,
-	612, 617, 613, 614, 618, 143, 144, 145, 146, 147,
-	638, 148, 149, 639, 640, 150, 0, 151, 0, 152,
-	153, 154, 155, 156, 0, 157, 158, 159, 0, 0,
-	160, 161, 632, 163, 164, 0, 165, 166, 167, 0,
-	168, 169, 170, 0, 171, 172, 173, 174, 582, 175,
-	176, 177, 622, 598, 178, 0, 179, 180, 641, 181,
-	0, 182, 0, 183, 473, 0, 474, 184, 185, 186,
-	0, 187, 188, 630, 0, 586, 189, 0, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 0, 199, 200,
-	201, 202, 203, 204, 0, 205, 475, 364, 206, 207,
-	208, 209, 642, 643, 0, 610, 0, 210, 476, 211,
-	477, 212, 213, 214, 215, 216, 0, 0, 217, 631,
-	478, 218, 479, 0, 219, 220, 406, 615, 616, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 407, 369, 480, 370, 235, 236, 371,
-	571, 237, 238, 239, 599, 628, 240, 644, 241, 242,
-	243, 0, 244, 0, 0, 245, 246, 0, 0, 247,
-	374, 481, 248, 482, 623, 249, 250, 251, 252, 253,
-	254, 255, 0, 256, 257, 624, 258, 377, 261, 259,
-	260, 0, 262, 263, 264, 265, 266, 267, 268, 269,
-	645, 270, 271, 272, 273, 0, 274, 275, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 0, 285, 286,
-	483, 287, 288, 587, 289, 290, 291, 292, 293, 294,
-	295, 0, 296, 297, 298, 299, 408, 619, 300, 301,
-	380, 302, 303, 484, 304, 305, 646, 306, 0, 307,
-	308, 309, 310, 311, 312, 313, 314, 315, 316, 625,
-	0, 317, 318, 0, 319, 320, 0, 647, 648, 0,
-	0, 409, 321, 626, 322, 627, 597, 323, 324, 325,
-	326, 327, 328, 329, 0, 574, 330, 331, 332, 333,
-	334, 620, 0, 335, 336, 337, 338, 386, 649, 0,
-	339, 485, 340, 0, 0, 0, 0, 341, 342, 343,
-	344, 345, 346, 347, 348, 572, 0, 0, 0, 0,
-	0, 0, 568, 569, 603, 590, 591, 592, 593, 589,
-	577, 0, 570, 0, 0, 578, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 583, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 349, 633, 469, 634, 0,
-	635, 0, 115, 116, 117, 118, 119, 600, 621, 120,
-	636, 637, 121, 0, 122, 123, 124, 125, 629, 0,
-	611, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 142, 0, 612, 617, 613, 614, 618,
-	143, 144, 145, 146, 147, 638, 148, 149, 639, 640,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 632, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 582, 175, 176, 177, 622, 598, 178,
-	0, 179, 180, 641, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 630, 0,
-	586, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 642, 643, 0,
-	610, 0, 210, 476, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 631, 478, 218, 479, 0, 219,
-	220, 406, 615, 616, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 571, 237, 238, 239, 599,
-	628, 240, 644, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 623,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	624, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 645, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 587, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 619, 300, 301, 380, 302, 303, 484, 304,
-	305, 646, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 625, 0, 317, 318, 0, 319,
-	320, 0, 647, 648, 0, 0, 409, 321, 626, 322,
-	627, 597, 323, 324, 325, 326, 327, 328, 329, 0,
-	574, 330, 331, 332, 333, 334, 620, 0, 335, 336,
-	337, 338, 386, 649, 0, 339, 485, 340, 0, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	572, 0, 0, 0, 0, 0, 0, 568, 569, 603,
-	590, 591, 592, 593, 589, 577, 0, 570, 0, 0,
-	1835, 0, 97, 98, 99, 100, 101, 102, 103, 104,
-	0, 105, 106, 107, 0, 0, 0, 0, 583, 0,
-	0, 108, 109, 0, 110, 111, 468, 112, 113, 114,
-	349, 633, 469, 634, 0, 635, 0, 115, 116, 117,
-	118, 119, 600, 621, 120, 636, 637, 121, 0, 122,
-	123, 124, 125, 629, 0, 611, 0, 126, 127, 128,
-	129, 130, 0, 471, 131, 132, 133, 0, 134, 135,
-	136, 137, 138, 139, 0, 472, 140, 141, 142, 0,
-	612, 617, 613, 614, 618, 143, 144, 145, 146, 147,
-	638, 148, 149, 639, 640, 150, 0, 151, 0, 152,
-	153, 154, 155, 156, 0, 157, 158, 159, 0, 0,
-	160, 161, 632, 163, 164, 0, 165, 166, 167, 0,
-	168, 169, 170, 0, 171, 172, 173, 174, 582, 175,
-	176, 177, 622, 598, 178, 0, 179, 180, 641, 181,
-	0, 182, 0, 183, 473, 0, 474, 184, 185, 186,
-	0, 187, 188, 630, 0, 586, 189, 0, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 0, 199, 200,
-	201, 202, 203, 204, 0, 205, 475, 364, 206, 207,
-	208, 209, 642, 643, 0, 610, 0, 210, 476, 211,
-	477, 212, 213, 214, 215, 216, 0, 0, 217, 631,
-	478, 218, 479, 0, 219, 220, 406, 615, 616, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 407, 369, 480, 370, 235, 236, 371,
-	0, 237, 238, 239, 599, 628, 240, 644, 241, 242,
-	243, 0, 244, 0, 0, 245, 246, 0, 0, 247,
-	374, 481, 248, 482, 623, 249, 250, 251, 252, 253,
-	254, 255, 0, 256, 257, 624, 258, 377, 261, 259,
-	260, 0, 262, 263, 264, 265, 266, 267, 268, 269,
-	645, 270, 271, 272, 273, 0, 274, 275, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 0, 285, 286,
-	483, 287, 288, 1273, 289, 290, 291, 292, 293, 294,
-	295, 0, 296, 297, 298, 299, 408, 619, 300, 301,
-	380, 302, 303, 484, 304, 305, 646, 306, 0, 307,
-	308, 309, 310, 311, 312, 313, 314, 315, 316, 625,
-	0, 317, 318, 0, 319, 320, 0, 647, 648, 0,
-	0, 409, 321, 626, 322, 627, 597, 323, 324, 325,
-	326, 327, 328, 329, 0, 0, 330, 331, 332, 333,
-	334, 620, 0, 335, 336, 337, 338, 386, 649, 0,
-	339, 485, 340, 0, 0, 0, 0, 341, 342, 343,
-	344, 345, 346, 347, 348, 0, 0, 0, 0, 0,
-	0, 0, 1269, 1270, 603, 590, 591, 592, 593, 589,
-	577, 0, 1271, 0, 0, 1272, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 583, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 0, 633, 469, 634, 0,
-	635, 0, 115, 116, 117, 118, 119, 600, 621, 120,
-	636, 637, 121, 0, 122, 123, 124, 125, 629, 0,
-	611, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 2102, 0, 612, 617, 613, 614, 618,
-	143, 144, 145, 146, 147, 638, 148, 149, 639, 640,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 632, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 582, 175, 176, 177, 622, 598, 178,
-	0, 179, 180, 641, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 630, 0,
-	586, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 642, 643, 0,
-	610, 0, 210, 0, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 631, 478, 218, 0, 0, 219,
-	220, 406, 615, 616, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 571, 237, 238, 239, 599,
-	628, 240, 644, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 623,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	624, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 645, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 587, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 619, 300, 301, 380, 302, 303, 0, 304,
-	305, 646, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 625, 0, 317, 318, 0, 319,
-	320, 0, 647, 648, 0, 0, 409, 321, 626, 322,
-	627, 597, 323, 324, 325, 326, 2101, 328, 329, 0,
-	574, 330, 331, 332, 333, 334, 620, 0, 335, 336,
-	337, 338, 386, 649, 0, 339, 485, 340, 0, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 0, 0, 0, 0, 0, 0, 568, 569, 603,
-	0, 0, 0, 0, 0, 0, 0, 570, 0, 0,
-	578, 0, 97, 98, 99, 100, 101, 102, 103, 104,
-	0, 105, 106, 107, 0, 0, 0, 0, 0, 0,
-	0, 108, 109, 0, 110, 111, 468, 112, 113, 114,
-	349, 350, 469, 351, 0, 352, 0, 115, 116, 117,
-	118, 119, 0, 621, 120, 353, 354, 121, 0, 122,
-	123, 124, 125, 629, 0, 611, 0, 126, 127, 128,
-	129, 130, 0, 471, 131, 132, 133, 0, 134, 135,
-	136, 137, 138, 139, 0, 472, 140, 141, 142, 0,
-	612, 617, 613, 614, 618, 143, 144, 145, 146, 147,
-	356, 148, 149, 357, 358, 150, 0, 151, 0, 152,
-	153, 154, 155, 156, 0, 157, 158, 159, 0, 0,
-	160, 161, 162, 163, 164, 0, 165, 166, 167, 0,
-	168, 169, 170, 0, 171, 172, 173, 174, 359, 175,
-	176, 177, 622, 0, 178, 0, 179, 180, 361, 181,
-	0, 182, 0, 183, 473, 0, 474, 184, 185, 186,
-	0, 187, 188, 630, 0, 363, 189, 0, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 0, 199, 200,
-	201, 202, 203, 204, 0, 205, 475, 364, 206, 207,
-	208, 209, 365, 366, 0, 367, 0, 210, 476, 211,
-	477, 212, 213, 214, 215, 216, 1126, 0, 217, 631,
-	478, 218, 479, 0, 219, 220, 406, 615, 616, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 407, 369, 480, 370, 235, 236, 371,
-	0, 237, 238, 239, 0, 628, 240, 373, 241, 242,
-	243, 0, 244, 0, 444, 245, 246, 0, 0, 247,
-	374, 481, 248, 482, 623, 249, 250, 251, 252, 253,
-	254, 255, 0, 256, 257, 624, 258, 377, 261, 259,
-	260, 0, 262, 263, 264, 265, 266, 267, 268, 269,
-	378, 270, 271, 272, 273, 0, 274, 275, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 0, 285, 286,
-	483, 287, 288, 379, 1131, 290, 291, 292, 293, 294,
-	295, 53, 296, 297, 298, 299, 408, 619, 300, 301,
-	380, 302, 303, 484, 304, 305, 381, 306, 0, 307,
-	308, 309, 310, 311, 312, 313, 314, 315, 316, 625,
-	0, 317, 318, 55, 319, 320, 0, 410, 383, 0,
-	0, 409, 321, 626, 322, 627, 0, 323, 324, 325,
-	326, 327, 328, 329, 0, 0, 330, 331, 332, 333,
-	334, 620, 0, 335, 336, 337, 338, 467, 387, 0,
-	339, 485, 340, 0, 0, 603, 51, 341, 342, 343,
-	344, 345, 346, 347, 348, 0, 0, 52, 97, 98,
-	99, 100, 101, 102, 103, 104, 0, 105, 106, 107,
-	0, 0, 0, 0, 0, 1129, 0, 108, 109, 0,
-	110, 111, 468, 112, 113, 114, 349, 350, 469, 351,
-	0, 352, 0, 115, 116, 117, 118, 119, 0, 621,
-	120, 353, 354, 121, 0, 122, 123, 124, 125, 629,
-	0, 611, 0, 126, 127, 128, 129, 130, 0, 471,
-	131, 132, 133, 0, 134, 135, 136, 137, 138, 139,
-	0, 472, 140, 141, 142, 0, 612, 617, 613, 614,
-	618, 143, 144, 145, 146, 147, 356, 148, 149, 357,
-	358, 150, 0, 151, 0, 152, 153, 154, 155, 156,
-	0, 157, 158, 159, 0, 0, 160, 161, 162, 163,
-	164, 0, 165, 166, 167, 0, 168, 169, 170, 0,
-	171, 172, 173, 174, 359, 175, 176, 177, 622, 0,
-	178, 0, 179, 180, 361, 181, 0, 182, 0, 183,
-	473, 0, 474, 184, 185, 186, 0, 187, 188, 630,
-	0, 363, 189, 0, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 0, 199, 200, 201, 202, 203, 204,
-	0, 205, 475, 364, 206, 207, 208, 209, 365, 366,
-	0, 367, 0, 210, 476, 211, 477, 212, 213, 214,
-	215, 216, 1126, 0, 217, 631, 478, 218, 479, 0,
-	219, 220, 406, 615, 616, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 407,
-	369, 480, 370, 235, 236, 371, 0, 237, 238, 239,
-	0, 628, 240, 373, 241, 242, 243, 0, 244, 0,
-	444, 245, 246, 0, 0, 247, 374, 481, 248, 482,
-	623, 249, 250, 251, 252, 253, 254, 255, 0, 256,
-	257, 624, 258, 377, 261, 259, 260, 0, 262, 263,
-	264, 265, 266, 267, 268, 269, 378, 270, 271, 272,
-	273, 0, 274, 275, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 0, 285, 286, 483, 287, 288, 379,
-	1131, 290, 291, 292, 293, 294, 295, 0, 296, 297,
-	298, 299, 408, 619, 300, 301, 380, 302, 303, 484,
-	304, 305, 381, 306, 0, 307, 308, 309, 310, 311,
-	312, 313, 314, 315, 316, 625, 0, 317, 318, 0,
-	319, 320, 0, 410, 383, 0, 0, 409, 321, 626,
-	322, 627, 0, 323, 324, 325, 326, 327, 328, 329,
-	0, 0, 330, 331, 332, 333, 334, 620, 0, 335,
-	336, 337, 338, 386, 387, 0, 339, 485, 340, 0,
-	603, 0, 0, 341, 342, 343, 344, 345, 346, 347,
-	348, 0, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 1129, 108, 109, 0, 110, 111, 468, 112, 113,
-	114, 349, 350, 469, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 621, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 629, 0, 611, 0, 126, 127,
-	128, 129, 130, 0, 471, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 472, 140, 141, 142,
-	0, 612, 617, 613, 614, 618, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 622, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 473, 0, 474, 184, 185,
-	186, 0, 187, 188, 630, 0, 363, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 475, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 476,
-	211, 477, 212, 213, 214, 215, 216, 0, 0, 217,
-	631, 478, 218, 479, 0, 219, 220, 406, 615, 616,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 480, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 628, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 481, 248, 482, 623, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 624, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 483, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 619, 300,
-	301, 380, 302, 303, 484, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	625, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 626, 322, 627, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 620, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 485, 340, 0, 603, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 0, 97, 98,
-	99, 100, 101, 102, 103, 104, 0, 105, 106, 107,
-	0, 0, 0, 0, 0, 0, 1775, 108, 109, 0,
-	110, 111, 468, 112, 113, 114, 349, 350, 469, 351,
-	0, 352, 0, 115, 116, 117, 118, 119, 0, 621,
-	120, 353, 354, 121, 0, 122, 123, 124, 125, 629,
-	0, 611, 0, 126, 127, 128, 129, 130, 0, 471,
-	131, 132, 133, 0, 134, 135, 136, 137, 138, 139,
-	0, 472, 140, 141, 142, 0, 612, 617, 613, 614,
-	618, 143, 144, 145, 146, 147, 356, 148, 149, 357,
-	358, 150, 0, 151, 0, 152, 153, 154, 155, 156,
-	0, 157, 158, 159, 0, 0, 160, 161, 162, 163,
-	164, 0, 165, 166, 167, 0, 168, 169, 170, 0,
-	171, 172, 173, 174, 359, 175, 176, 177, 622, 0,
-	178, 0, 179, 180, 361, 181, 0, 182, 0, 183,
-	473, 0, 474, 184, 185, 186, 0, 187, 188, 630,
-	0, 363, 189, 0, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 0, 199, 200, 201, 202, 203, 204,
-	0, 205, 475, 364, 206, 207, 208, 209, 365, 366,
-	0, 367, 0, 210, 476, 211, 477, 212, 213, 214,
-	215, 216, 0, 0, 217, 631, 478, 218, 479, 0,
-	219, 220, 406, 615, 616, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 407,
-	369, 480, 370, 235, 236, 371, 0, 237, 238, 239,
-	0, 628, 240, 373, 241, 242, 243, 0, 244, 0,
-	0, 245, 246, 0, 0, 247, 374, 481, 248, 482,
-	623, 249, 250, 251, 252, 253, 254, 255, 0, 256,
-	257, 624, 258, 377, 261, 259, 260, 0, 262, 263,
-	264, 265, 266, 267, 268, 269, 378, 270, 271, 272,
-	273, 0, 274, 275, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 0, 285, 286, 483, 287, 288, 379,
-	1131, 290, 291, 292, 293, 294, 295, 0, 296, 297,
-	298, 299, 408, 619, 300, 301, 380, 302, 303, 484,
-	304, 305, 381, 306, 0, 307, 308, 309, 310, 311,
-	312, 313, 314, 315, 316, 625, 0, 317, 318, 0,
-	319, 320, 0, 410, 383, 0, 0, 409, 321, 626,
-	322, 627, 0, 323, 324, 325, 326, 327, 328, 329,
-	0, 0, 330, 331, 332, 333, 334, 620, 0, 335,
-	336, 337, 338, 386, 387, 0, 339, 485, 340, 0,
-	463, 0, 0, 341, 342, 343, 344, 345, 346, 347,
-	348, 0, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 50, 108, 109, 0, 110, 111, 468, 112, 113,
-	114, 349, 350, 469, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 470, 0, 126, 127,
-	128, 129, 130, 0, 471, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 472, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 473, 0, 474, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 475, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 476,
-	211, 477, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 478, 218, 479, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 480, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 481, 248, 482, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 483, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 53, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 484, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 55, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 467, 387,
-	0, 339, 485, 340, 0, 0, 0, 51, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 0, 52, 0,
-	0, 0, 0, 0, 463, 732, 736, 0, 0, 737,
-	0, 0, 0, 0, 0, 0, 50, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 349, 350, 469, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	470, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 740, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 729, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 476, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 478, 218, 479, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 730, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 484, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 728, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 485, 340, 0, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	463, 732, 736, 0, 0, 737, 0, 738, 733, 0,
-	0, 0, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 468, 112, 113,
-	114, 349, 350, 469, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 470, 0, 126, 127,
-	128, 129, 130, 0, 471, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 472, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 724, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 729, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 473, 0, 474, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 475, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 476,
-	211, 477, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 478, 218, 479, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 480, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 730, 0, 245, 246, 0, 0,
-	247, 374, 481, 248, 482, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 483, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 484, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 728, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 485, 340, 0, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 463, 732, 736, 0,
-	0, 737, 0, 738, 733, 0, 0, 0, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 468, 112, 113, 114, 349, 350, 469,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 470, 0, 126, 127, 128, 129, 130, 0,
-	471, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 472, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	729, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 473, 0, 474, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 475, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 476, 211, 477, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 478, 218, 479,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 480, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	730, 0, 245, 246, 0, 0, 247, 374, 481, 248,
-	482, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 483, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 300, 301, 380, 302, 303,
-	484, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 728, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 485, 340,
-	0, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 94, 0, 0, 0, 0, 0, 0, 738,
-	733, 1397, 1398, 1399, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 1396, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 0, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 0,
-	0, 1393, 1394, 1395, 603, 1384, 1385, 1386, 1387, 1388,
-	1389, 1390, 1391, 1392, 0, 0, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 468, 112, 113, 114, 349, 350, 469, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 621, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 629, 0,
-	611, 0, 126, 127, 128, 129, 130, 0, 471, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	472, 140, 141, 142, 0, 612, 617, 613, 614, 618,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 622, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 473,
-	0, 474, 184, 185, 186, 0, 187, 188, 630, 0,
-	363, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 475, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 476, 211, 477, 212, 213, 214, 215,
-	216, 0, 0, 217, 631, 478, 218, 479, 0, 219,
-	220, 406, 615, 616, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	480, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	628, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 481, 248, 482, 623,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	624, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 483, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 619, 300, 301, 380, 302, 303, 484, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 625, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 626, 322,
-	627, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 620, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 485, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	53, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 55, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 467, 387, 0, 339,
-	0, 340, 0, 0, 0, 51, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 0, 52, 0, 0, 0,
-	0, 0, 94, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 50, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 1421, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 0, 0, 94, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 0,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 557,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 0, 94, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 0, 0, 1008, 108, 109, 0, 110, 111,
-	0, 112, 113, 114, 349, 350, 0, 351, 0, 352,
-	0, 115, 116, 117, 118, 119, 0, 0, 120, 353,
-	354, 121, 0, 122, 123, 124, 125, 355, 0, 0,
-	0, 126, 127, 128, 129, 130, 0, 0, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 0,
-	140, 141, 142, 0, 0, 0, 0, 0, 0, 143,
-	144, 145, 146, 147, 356, 148, 149, 357, 358, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 162, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 359, 175, 176, 177, 360, 0, 178, 0,
-	179, 180, 361, 181, 0, 182, 0, 183, 0, 0,
-	0, 184, 185, 186, 0, 187, 188, 362, 0, 363,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	0, 364, 206, 207, 208, 209, 365, 366, 0, 367,
-	0, 210, 0, 211, 0, 212, 213, 214, 215, 216,
-	0, 0, 217, 368, 0, 218, 0, 0, 219, 220,
-	406, 0, 0, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 0,
-	370, 235, 236, 371, 0, 237, 238, 239, 0, 372,
-	240, 373, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 0, 248, 0, 375, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 376,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 378, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 0, 287, 288, 379, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 0, 300, 301, 380, 302, 303, 0, 304, 305,
-	381, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 382, 0, 317, 318, 0, 319, 320,
-	0, 410, 383, 0, 0, 409, 321, 384, 322, 385,
-	0, 323, 324, 325, 326, 327, 328, 329, 0, 0,
-	330, 331, 332, 333, 334, 0, 0, 335, 336, 337,
-	338, 386, 387, 0, 339, 0, 340, 0, 94, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 0,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 1698,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 0, 94, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 0, 97, 98, 99, 100,
-	101, 102, 103, 104, 0, 105, 106, 107, 0, 0,
-	0, 0, 0, 0, 1643, 108, 109, 0, 110, 111,
-	0, 112, 113, 114, 349, 350, 0, 351, 0, 352,
-	0, 115, 116, 117, 118, 119, 0, 0, 120, 353,
-	354, 121, 0, 122, 123, 124, 125, 355, 0, 0,
-	0, 126, 127, 128, 129, 130, 0, 0, 131, 132,
-	133, 0, 134, 135, 136, 137, 138, 139, 0, 0,
-	140, 141, 142, 0, 0, 0, 0, 0, 0, 143,
-	144, 145, 146, 147, 356, 148, 149, 357, 358, 150,
-	0, 151, 0, 152, 153, 154, 155, 156, 0, 157,
-	158, 159, 0, 0, 160, 161, 162, 163, 164, 0,
-	165, 166, 167, 0, 168, 169, 170, 0, 171, 172,
-	173, 174, 359, 175, 176, 177, 360, 0, 178, 0,
-	179, 180, 361, 181, 0, 182, 0, 183, 0, 0,
-	0, 184, 185, 186, 0, 187, 188, 362, 0, 363,
-	189, 0, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 0, 199, 200, 201, 202, 203, 204, 0, 205,
-	0, 364, 206, 207, 208, 209, 365, 366, 0, 367,
-	0, 210, 0, 211, 0, 212, 213, 214, 215, 216,
-	0, 0, 217, 368, 0, 218, 0, 0, 219, 220,
-	406, 0, 0, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 407, 369, 0,
-	370, 235, 236, 371, 0, 237, 238, 239, 0, 372,
-	240, 373, 241, 242, 243, 0, 244, 0, 0, 245,
-	246, 0, 0, 247, 374, 0, 248, 0, 375, 249,
-	250, 251, 252, 253, 254, 255, 0, 256, 257, 376,
-	258, 377, 261, 259, 260, 0, 262, 263, 264, 265,
-	266, 267, 268, 269, 378, 270, 271, 272, 273, 0,
-	274, 275, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 0, 285, 286, 0, 287, 288, 379, 289, 290,
-	291, 292, 293, 294, 295, 0, 296, 297, 298, 299,
-	408, 0, 300, 301, 380, 302, 303, 0, 304, 305,
-	381, 306, 0, 307, 308, 309, 310, 311, 312, 313,
-	314, 315, 316, 382, 0, 317, 318, 0, 319, 320,
-	0, 410, 383, 0, 0, 409, 321, 384, 322, 385,
-	0, 323, 324, 325, 326, 327, 328, 329, 0, 0,
-	330, 331, 332, 333, 334, 0, 0, 335, 336, 337,
-	338, 386, 387, 0, 339, 0, 340, 0, 463, 0,
-	0, 341, 342, 343, 344, 345, 346, 347, 348, 0,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 661,
-	108, 109, 0, 110, 111, 468, 112, 113, 114, 349,
-	350, 469, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 470, 0, 126, 127, 128, 129,
-	130, 0, 471, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 472, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 473, 0, 474, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 475, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 476, 211, 477,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 478,
-	218, 479, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 480, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	481, 248, 482, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 483,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 484, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	485, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 1032, 122, 123, 124, 125, 355, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1832, 760,
+	1826, 0, 0, 765, 0, 0, 0, 1430, 1431, 1432,
+	0, 88, 89, 90, 91, 92, 93, 94, 95, 785,
+	96, 97, 98, 786, 787, 788, 789, 790, 791, 792,
+	99, 100, 793, 101, 102, 484, 103, 104, 105, 962,
+	1182, 485, 1197, 1177, 1189, 794, 106, 107, 108, 109,
+	110, 795, 796, 111, 1199, 1198, 112, 797, 113, 114,
+	115, 116, 0, 798, 486, 799, 117, 118, 119, 120,
+	121, 1429, 487, 122, 123, 124, 800, 125, 126, 127,
+	128, 129, 130, 801, 488, 131, 132, 133, 802, 803,
+	804, 805, 806, 807, 134, 135, 136, 137, 138, 1194,
+	139, 140, 1187, 1186, 141, 808, 142, 809, 143, 144,
+	145, 146, 147, 810, 148, 149, 150, 811, 812, 151,
+	152, 654, 154, 155, 813, 156, 157, 158, 814, 159,
+	160, 161, 815, 162, 163, 164, 165, 0, 166, 167,
+	168, 0, 816, 169, 817, 170, 171, 1184, 172, 818,
+	173, 819, 174, 489, 820, 490, 175, 176, 177, 821,
+	178, 179, 0, 822, 0, 180, 823, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 824, 190, 191, 192,
+	193, 194, 195, 825, 196, 491, 0, 197, 198, 199,
+	200, 1179, 1180, 826, 777, 827, 201, 492, 202, 493,
+	203, 204, 205, 206, 207, 828, 829, 208, 0, 494,
+	209, 495, 830, 210, 211, 212, 831, 832, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 0, 496, 0, 228, 229, 0, 833,
+	230, 231, 232, 834, 0, 233, 1188, 234, 235, 236,
+	835, 237, 836, 837, 238, 239, 838, 839, 240, 0,
+	497, 241, 498, 0, 242, 243, 244, 245, 246, 247,
+	248, 840, 249, 250, 0, 251, 0, 254, 252, 253,
+	841, 255, 256, 257, 258, 259, 260, 261, 262, 1183,
+	263, 264, 265, 266, 842, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 843, 278, 279, 499,
+	280, 281, 282, 0, 283, 284, 285, 286, 287, 288,
+	289, 844, 290, 291, 292, 293, 294, 845, 295, 296,
+	1829, 297, 298, 500, 299, 300, 1181, 301, 846, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 0,
+	847, 312, 313, 848, 314, 1834, 849, 1196, 1195, 850,
+	851, 316, 317, 0, 318, 0, 852, 319, 320, 321,
+	322, 323, 324, 325, 853, 854, 326, 327, 328, 329,
+	330, 855, 331, 856, 332, 333, 334, 335, 0, 1200,
+	857, 336, 501, 337, 858, 859, 860, 861, 338, 339,
+	340, 341, 342, 343, 344, 345, 0, 0, 0, 1426,
+	1427, 1428, 780, 1830, 1831, 1419, 1420, 1421, 1422, 1423,
+	1424, 1425, 0, 0, 0, 88, 89, 90, 91, 92,
+	93, 94, 95, 785, 96, 97, 98, 786, 787, 788,
+	789, 790, 791, 792, 99, 100, 793, 101, 102, 484,
+	103, 104, 105, 346, 347, 485, 348, 0, 349, 794,
+	106, 107, 108, 109, 110, 795, 796, 111, 350, 351,
+	112, 797, 113, 114, 115, 116, 352, 798, 486, 799,
+	117, 118, 119, 120, 121, 0, 487, 122, 123, 124,
+	800, 125, 126, 127, 128, 129, 130, 801, 488, 131,
+	132, 133, 802, 803, 804, 805, 806, 807, 134, 135,
+	136, 137, 138, 353, 139, 140, 354, 355, 141, 808,
+	142, 809, 143, 144, 145, 146, 147, 810, 148, 149,
+	150, 811, 812, 151, 152, 153, 154, 155, 813, 156,
+	157, 158, 814, 159, 160, 161, 815, 162, 163, 164,
+	165, 356, 166, 167, 168, 357, 816, 169, 817, 170,
+	171, 358, 172, 818, 173, 819, 174, 489, 820, 490,
+	175, 176, 177, 821, 178, 179, 359, 822, 360, 180,
+	823, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	824, 190, 191, 192, 193, 194, 195, 825, 196, 491,
+	361, 197, 198, 199, 200, 362, 363, 826, 364, 827,
+	201, 492, 202, 493, 203, 204, 205, 206, 207, 828,
+	829, 208, 365, 494, 209, 495, 830, 210, 211, 212,
+	831, 832, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 366, 496, 367,
+	228, 229, 368, 833, 230, 231, 232, 834, 369, 233,
+	370, 234, 235, 236, 835, 237, 836, 837, 238, 239,
+	838, 839, 240, 371, 497, 241, 498, 372, 242, 243,
+	244, 245, 246, 247, 248, 840, 249, 250, 373, 251,
+	374, 254, 252, 253, 841, 255, 256, 257, 258, 259,
+	260, 261, 262, 375, 263, 264, 265, 266, 842, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+	843, 278, 279, 499, 280, 281, 282, 376, 283, 284,
+	285, 286, 287, 288, 289, 844, 290, 291, 292, 293,
+	294, 845, 295, 296, 377, 297, 298, 500, 299, 300,
+	378, 301, 846, 302, 303, 304, 305, 306, 307, 308,
+	309, 310, 311, 379, 847, 312, 313, 848, 314, 315,
+	849, 380, 381, 850, 851, 316, 317, 382, 318, 383,
+	852, 319, 320, 321, 322, 323, 324, 325, 853, 854,
+	326, 327, 328, 329, 330, 855, 331, 856, 332, 333,
+	334, 335, 384, 385, 857, 336, 501, 337, 858, 859,
+	860, 861, 338, 339, 340, 341, 342, 343, 344, 345,
+	780, 0, 0, 0, 0, 0, 0, 0, 0, 1022,
+	0, 0, 0, 88, 89, 90, 91, 92, 93, 94,
+	95, 785, 96, 97, 98, 786, 787, 788, 789, 790,
+	791, 792, 99, 100, 793, 101, 102, 484, 103, 104,
+	105, 346, 347, 485, 348, 0, 349, 794, 106, 107,
+	108, 109, 110, 795, 796, 111, 350, 351, 112, 797,
+	113, 114, 115, 116, 352, 798, 486, 799, 117, 118,
+	119, 120, 121, 0, 487, 122, 123, 124, 800, 125,
+	126, 127, 128, 129, 130, 801, 488, 131, 132, 133,
+	802, 803, 804, 805, 806, 807, 134, 135, 136, 137,
+	138, 353, 139, 140, 354, 355, 141, 808, 142, 809,
+	143, 144, 145, 146, 147, 810, 148, 149, 150, 811,
+	812, 151, 152, 153, 154, 155, 813, 156, 157, 158,
+	814, 159, 160, 161, 815, 162, 163, 164, 165, 356,
+	166, 167, 168, 357, 816, 169, 817, 170, 171, 358,
+	172, 818, 173, 819, 174, 489, 820, 490, 175, 176,
+	177, 821, 178, 179, 359, 822, 360, 180, 823, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 824, 190,
+	191, 192, 193, 194, 195, 825, 196, 491, 361, 197,
+	198, 199, 200, 362, 363, 826, 364, 827, 201, 492,
+	202, 493, 203, 204, 205, 206, 207, 828, 829, 208,
+	365, 494, 209, 495, 830, 210, 211, 212, 831, 832,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 366, 496, 367, 228, 229,
+	368, 833, 230, 231, 232, 834, 369, 233, 370, 234,
+	235, 236, 835, 237, 836, 837, 238, 239, 838, 839,
+	240, 371, 497, 241, 498, 372, 242, 243, 244, 245,
+	246, 247, 248, 840, 249, 250, 373, 251, 374, 254,
+	252, 253, 841, 255, 256, 257, 258, 259, 260, 261,
+	262, 375, 263, 264, 265, 266, 842, 267, 268, 269,
+	270, 271, 272, 273, 274, 275, 276, 277, 843, 278,
+	279, 499, 280, 281, 282, 376, 283, 284, 285, 286,
+	287, 288, 289, 844, 290, 291, 292, 293, 294, 845,
+	295, 296, 377, 297, 298, 500, 299, 300, 378, 301,
+	846, 302, 303, 304, 305, 306, 307, 308, 309, 310,
+	311, 379, 847, 312, 313, 848, 314, 315, 849, 380,
+	381, 850, 851, 316, 317, 382, 318, 383, 852, 319,
+	320, 321, 322, 323, 324, 325, 853, 854, 326, 327,
+	328, 329, 330, 855, 331, 856, 332, 333, 334, 335,
+	384, 385, 857, 336, 501, 337, 858, 859, 860, 861,
+	338, 339, 340, 341, 342, 343, 344, 345, 625, 612,
+	613, 614, 615, 611, 599, 0, 0, 0, 0, 0,
+	0, 88, 89, 90, 91, 92, 93, 94, 95, 0,
+	96, 97, 98, 0, 0, 0, 0, 605, 0, 0,
+	99, 100, 0, 101, 102, 484, 103, 104, 105, 346,
+	655, 485, 656, 0, 657, 0, 106, 107, 108, 109,
+	110, 622, 643, 111, 658, 659, 112, 0, 113, 114,
+	115, 116, 651, 0, 633, 0, 117, 118, 119, 120,
+	121, 0, 487, 122, 123, 124, 0, 125, 126, 127,
+	128, 129, 130, 0, 488, 131, 132, 133, 0, 634,
+	639, 635, 636, 640, 134, 135, 136, 137, 138, 660,
+	139, 140, 661, 662, 141, 0, 142, 0, 143, 144,
+	145, 146, 147, 0, 148, 149, 150, 0, 0, 151,
+	152, 654, 154, 155, 0, 156, 157, 158, 0, 159,
+	160, 161, 0, 162, 163, 164, 165, 604, 166, 167,
+	168, 644, 620, 169, 0, 170, 171, 663, 172, 0,
+	173, 0, 174, 489, 0, 490, 175, 176, 177, 0,
+	178, 179, 652, 0, 608, 180, 0, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 0, 190, 191, 192,
+	193, 194, 195, 0, 196, 491, 361, 197, 198, 199,
+	200, 664, 665, 0, 632, 0, 201, 492, 202, 493,
+	203, 204, 205, 206, 207, 0, 0, 208, 653, 494,
+	209, 495, 0, 210, 211, 212, 637, 638, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 366, 496, 367, 228, 229, 368, 593,
+	230, 231, 232, 621, 650, 233, 666, 234, 235, 236,
+	0, 237, 0, 0, 238, 239, 0, 0, 240, 371,
+	497, 241, 498, 645, 242, 243, 244, 245, 246, 247,
+	248, 0, 249, 250, 646, 251, 374, 254, 252, 253,
+	0, 255, 256, 257, 258, 259, 260, 261, 262, 667,
+	263, 264, 265, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 0, 278, 279, 499,
+	280, 281, 282, 609, 283, 284, 285, 286, 287, 288,
+	289, 56, 290, 291, 292, 293, 294, 641, 295, 296,
+	377, 297, 298, 500, 299, 300, 668, 301, 0, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 647,
+	0, 312, 313, 58, 314, 315, 0, 669, 670, 0,
+	0, 316, 317, 648, 318, 649, 619, 319, 320, 321,
+	322, 323, 324, 325, 0, 596, 326, 327, 328, 329,
+	330, 642, 331, 0, 332, 333, 334, 335, 483, 671,
+	0, 336, 501, 337, 0, 0, 0, 54, 338, 339,
+	340, 341, 342, 343, 344, 345, 594, 0, 55, 0,
+	0, 0, 0, 590, 591, 625, 612, 613, 614, 615,
+	611, 599, 0, 592, 0, 0, 600, 1988, 88, 89,
+	90, 91, 92, 93, 94, 95, 1263, 96, 97, 98,
+	0, 0, 0, 0, 605, 0, 0, 99, 100, 0,
+	101, 102, 484, 103, 104, 105, 346, 655, 485, 656,
+	0, 657, 0, 106, 107, 108, 109, 110, 622, 643,
+	111, 658, 659, 112, 0, 113, 114, 115, 116, 651,
+	0, 633, 0, 117, 118, 119, 120, 121, 0, 487,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 488, 131, 132, 133, 0, 634, 639, 635, 636,
+	640, 134, 135, 136, 137, 138, 660, 139, 140, 661,
+	662, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 1264, 0, 151, 152, 654, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 604, 166, 167, 168, 644, 620,
+	169, 0, 170, 171, 663, 172, 0, 173, 0, 174,
+	489, 0, 490, 175, 176, 177, 0, 178, 179, 652,
+	0, 608, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 491, 361, 197, 198, 199, 200, 664, 665,
+	0, 632, 0, 201, 492, 202, 493, 203, 204, 205,
+	206, 207, 0, 0, 208, 653, 494, 209, 495, 0,
+	210, 211, 212, 637, 638, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 496, 367, 228, 229, 368, 593, 230, 231, 232,
+	621, 650, 233, 666, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 497, 241, 498,
+	645, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 646, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 667, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 499, 280, 281, 282,
+	609, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 641, 295, 296, 377, 297, 298,
+	500, 299, 300, 668, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 647, 0, 312, 313,
+	0, 314, 315, 0, 669, 670, 0, 0, 316, 317,
+	648, 318, 649, 619, 319, 320, 321, 322, 323, 324,
+	325, 0, 596, 326, 327, 328, 329, 330, 642, 331,
+	0, 332, 333, 334, 335, 384, 671, 1262, 336, 501,
+	337, 0, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 594, 0, 0, 0, 0, 0, 0,
+	590, 591, 1265, 625, 612, 613, 614, 615, 611, 599,
+	592, 0, 0, 600, 1260, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 605, 0, 0, 99, 100, 0, 101, 102,
+	484, 103, 104, 105, 346, 655, 485, 656, 0, 657,
+	0, 106, 107, 108, 109, 110, 622, 643, 111, 658,
+	659, 112, 0, 113, 114, 115, 116, 651, 0, 633,
+	0, 117, 118, 119, 120, 121, 0, 487, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 488,
+	131, 132, 133, 0, 634, 639, 635, 636, 640, 134,
+	135, 136, 137, 138, 660, 139, 140, 661, 662, 141,
+	692, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 654, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 604, 166, 167, 168, 644, 620, 169, 0,
+	170, 171, 663, 172, 0, 173, 0, 174, 489, 0,
+	490, 175, 176, 177, 0, 178, 179, 652, 0, 608,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	491, 361, 197, 198, 199, 200, 664, 665, 0, 632,
+	0, 201, 492, 202, 493, 203, 204, 205, 206, 207,
+	0, 0, 208, 653, 494, 209, 495, 0, 210, 211,
+	212, 637, 638, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 496,
+	367, 228, 229, 368, 593, 230, 231, 232, 621, 650,
+	233, 666, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 497, 241, 498, 645, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 646,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 667, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 499, 280, 281, 282, 609, 283,
+	284, 285, 286, 287, 288, 289, 56, 290, 291, 292,
+	293, 294, 641, 295, 296, 377, 297, 298, 500, 299,
+	300, 668, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 647, 0, 312, 313, 58, 314,
+	315, 0, 669, 670, 0, 0, 316, 317, 648, 318,
+	649, 619, 319, 320, 321, 322, 323, 324, 325, 0,
+	596, 326, 327, 328, 329, 330, 642, 331, 0, 332,
+	333, 334, 335, 483, 671, 0, 336, 501, 337, 0,
+	0, 0, 54, 338, 339, 340, 341, 342, 343, 344,
+	345, 594, 0, 55, 0, 0, 0, 0, 590, 591,
+	625, 612, 613, 614, 615, 611, 599, 0, 592, 0,
+	0, 600, 0, 88, 89, 90, 91, 92, 93, 94,
+	95, 0, 96, 97, 98, 0, 0, 0, 0, 605,
+	0, 0, 99, 100, 0, 101, 102, 484, 103, 104,
+	105, 346, 655, 485, 656, 0, 657, 0, 106, 107,
+	108, 109, 110, 622, 643, 111, 658, 659, 112, 0,
+	113, 114, 115, 116, 651, 0, 633, 0, 117, 118,
+	119, 120, 121, 0, 487, 122, 123, 124, 0, 125,
+	126, 127, 128, 129, 130, 0, 488, 131, 132, 133,
+	0, 634, 639, 635, 636, 640, 134, 135, 136, 137,
+	138, 660, 139, 140, 661, 662, 141, 0, 142, 0,
+	143, 144, 145, 146, 147, 0, 148, 149, 150, 0,
+	0, 151, 152, 654, 154, 155, 0, 156, 157, 158,
+	0, 159, 160, 161, 0, 162, 163, 164, 165, 604,
+	166, 167, 168, 644, 620, 169, 0, 170, 171, 663,
+	172, 0, 173, 0, 174, 489, 0, 490, 175, 176,
+	177, 0, 178, 179, 652, 0, 608, 180, 0, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 0, 190,
+	191, 192, 193, 194, 195, 0, 196, 491, 361, 197,
+	198, 199, 200, 664, 665, 0, 632, 0, 201, 492,
+	202, 493, 203, 204, 205, 206, 207, 0, 0, 208,
+	653, 494, 209, 495, 0, 210, 211, 212, 637, 638,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 366, 496, 367, 228, 229,
+	368, 593, 230, 231, 232, 621, 650, 233, 666, 234,
+	235, 236, 0, 237, 0, 0, 238, 239, 0, 0,
+	240, 371, 497, 241, 498, 645, 242, 243, 244, 245,
+	246, 247, 248, 0, 249, 250, 646, 251, 374, 254,
+	252, 253, 0, 255, 256, 257, 258, 259, 260, 261,
+	262, 667, 263, 264, 265, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 274, 275, 276, 277, 0, 278,
+	279, 499, 280, 281, 282, 609, 283, 284, 285, 286,
+	287, 288, 289, 56, 290, 291, 292, 293, 294, 641,
+	295, 296, 377, 297, 298, 500, 299, 300, 668, 301,
+	0, 302, 303, 304, 305, 306, 307, 308, 309, 310,
+	311, 647, 0, 312, 313, 58, 314, 315, 0, 669,
+	670, 0, 0, 316, 317, 648, 318, 649, 619, 319,
+	320, 321, 322, 323, 324, 325, 0, 596, 326, 327,
+	328, 329, 330, 642, 331, 0, 332, 333, 334, 335,
+	483, 671, 0, 336, 501, 337, 0, 0, 0, 54,
+	338, 339, 340, 341, 342, 343, 344, 345, 594, 0,
+	55, 0, 0, 0, 0, 590, 591, 625, 612, 613,
+	614, 615, 611, 599, 0, 592, 0, 0, 600, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 605, 0, 0, 99,
+	100, 0, 101, 102, 484, 103, 104, 105, 346, 655,
+	485, 656, 0, 657, 1311, 106, 107, 108, 109, 110,
+	622, 643, 111, 658, 659, 112, 0, 113, 114, 115,
+	116, 651, 0, 633, 0, 117, 118, 119, 120, 121,
+	0, 487, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 488, 131, 132, 133, 0, 634, 639,
+	635, 636, 640, 134, 135, 136, 137, 138, 660, 139,
+	140, 661, 662, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	654, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 604, 166, 167, 168,
+	644, 620, 169, 0, 170, 171, 663, 172, 0, 173,
+	0, 174, 489, 1316, 490, 175, 176, 177, 0, 178,
+	179, 652, 0, 608, 180, 0, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 491, 361, 197, 198, 199, 200,
+	664, 665, 0, 632, 0, 201, 492, 202, 493, 203,
+	204, 205, 206, 207, 0, 1312, 208, 653, 494, 209,
+	495, 0, 210, 211, 212, 637, 638, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 496, 367, 228, 229, 368, 593, 230,
+	231, 232, 621, 650, 233, 666, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 497,
+	241, 498, 645, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 646, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 667, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 499, 280,
+	281, 282, 609, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 641, 295, 296, 377,
+	297, 298, 500, 299, 300, 668, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 647, 0,
+	312, 313, 0, 314, 315, 0, 669, 670, 0, 1313,
+	316, 317, 648, 318, 649, 619, 319, 320, 321, 322,
+	323, 324, 325, 0, 596, 326, 327, 328, 329, 330,
+	642, 331, 0, 332, 333, 334, 335, 384, 671, 0,
+	336, 501, 337, 0, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 594, 0, 0, 0, 0,
+	0, 0, 590, 591, 625, 612, 613, 614, 615, 611,
+	599, 0, 592, 0, 0, 600, 0, 88, 89, 90,
+	91, 92, 93, 94, 95, 0, 96, 97, 98, 0,
+	0, 0, 0, 605, 0, 0, 99, 100, 0, 101,
+	102, 484, 103, 104, 105, 346, 655, 485, 656, 0,
+	657, 0, 106, 107, 108, 109, 110, 622, 643, 111,
+	658, 659, 112, 0, 113, 114, 115, 116, 651, 0,
+	633, 0, 117, 118, 119, 120, 121, 0, 487, 122,
+	123, 124, 0, 125, 126, 127, 128, 129, 130, 0,
+	488, 131, 132, 133, 0, 634, 639, 635, 636, 640,
+	134, 135, 136, 137, 138, 660, 139, 140, 661, 662,
+	141, 0, 142, 0, 143, 144, 145, 146, 147, 0,
+	148, 149, 150, 0, 0, 151, 152, 654, 154, 155,
+	0, 156, 157, 158, 0, 159, 160, 161, 0, 162,
+	163, 164, 165, 604, 166, 167, 168, 644, 620, 169,
+	0, 170, 171, 663, 172, 0, 173, 0, 174, 489,
+	0, 490, 175, 176, 177, 0, 178, 179, 652, 0,
+	608, 180, 0, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 0, 190, 191, 192, 193, 194, 195, 0,
+	196, 491, 361, 197, 198, 199, 200, 664, 665, 0,
+	632, 0, 201, 492, 202, 493, 203, 204, 205, 206,
+	207, 0, 0, 208, 653, 494, 209, 495, 0, 210,
+	211, 212, 637, 638, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 366,
+	496, 367, 228, 229, 368, 593, 230, 231, 232, 621,
+	650, 233, 666, 234, 235, 236, 0, 237, 0, 0,
+	238, 239, 0, 0, 240, 371, 497, 241, 498, 645,
+	242, 243, 244, 245, 246, 247, 248, 0, 249, 250,
+	646, 251, 374, 254, 252, 253, 0, 255, 256, 257,
+	258, 259, 260, 261, 262, 667, 263, 264, 265, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 274, 275,
+	276, 277, 0, 278, 279, 499, 280, 281, 282, 609,
+	283, 284, 285, 286, 287, 288, 289, 0, 290, 291,
+	292, 293, 294, 641, 295, 296, 377, 297, 298, 500,
+	299, 300, 668, 301, 0, 302, 303, 304, 305, 306,
+	307, 308, 309, 310, 311, 647, 0, 312, 313, 0,
+	314, 315, 0, 669, 670, 0, 0, 316, 317, 648,
+	318, 649, 619, 319, 320, 321, 322, 323, 324, 325,
+	0, 596, 326, 327, 328, 329, 330, 642, 331, 0,
+	332, 333, 334, 335, 384, 671, 0, 336, 501, 337,
+	0, 0, 0, 0, 338, 339, 340, 341, 342, 343,
+	344, 345, 594, 0, 0, 0, 0, 0, 0, 590,
+	591, 625, 612, 613, 614, 615, 611, 599, 0, 592,
+	0, 0, 600, 1762, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	605, 0, 0, 99, 100, 0, 101, 102, 484, 103,
+	104, 105, 346, 655, 485, 656, 0, 657, 0, 106,
+	107, 108, 109, 110, 622, 643, 111, 658, 659, 112,
+	0, 113, 114, 115, 116, 651, 0, 633, 0, 117,
+	118, 119, 120, 121, 0, 487, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 488, 131, 132,
+	133, 0, 634, 639, 635, 636, 640, 134, 135, 136,
+	137, 138, 660, 139, 140, 661, 662, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 654, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	604, 166, 167, 168, 644, 620, 169, 0, 170, 171,
+	663, 172, 0, 173, 0, 174, 489, 0, 490, 175,
+	176, 177, 0, 178, 179, 652, 0, 608, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 491, 361,
+	197, 198, 199, 200, 664, 665, 0, 632, 0, 201,
+	492, 202, 493, 203, 204, 205, 206, 207, 0, 0,
+	208, 653, 494, 209, 495, 0, 210, 211, 212, 637,
+	638, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 496, 367, 228,
+	229, 368, 593, 230, 231, 232, 621, 650, 233, 666,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 497, 241, 498, 645, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 646, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 667, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 499, 280, 281, 282, 609, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	641, 295, 296, 377, 297, 298, 500, 299, 300, 668,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 647, 0, 312, 313, 0, 314, 315, 0,
+	669, 670, 0, 0, 316, 317, 648, 318, 649, 619,
+	319, 320, 321, 322, 323, 324, 325, 0, 596, 326,
+	327, 328, 329, 330, 642, 331, 0, 332, 333, 334,
+	335, 384, 671, 0, 336, 501, 337, 0, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 594,
+	0, 0, 0, 0, 0, 0, 590, 591, 625, 612,
+	613, 614, 615, 611, 599, 0, 592, 0, 0, 600,
+	1706, 88, 89, 90, 91, 92, 93, 94, 95, 0,
+	96, 97, 98, 0, 0, 0, 0, 605, 0, 0,
+	99, 100, 0, 101, 102, 484, 103, 104, 105, 346,
+	655, 485, 656, 0, 657, 0, 106, 107, 108, 109,
+	110, 622, 643, 111, 658, 659, 112, 0, 113, 114,
+	115, 116, 651, 0, 633, 0, 117, 118, 119, 120,
+	121, 0, 487, 122, 123, 124, 0, 125, 126, 127,
+	128, 129, 130, 0, 488, 131, 132, 133, 0, 634,
+	639, 635, 636, 640, 134, 135, 136, 137, 138, 660,
+	139, 140, 661, 662, 141, 0, 142, 0, 143, 144,
+	145, 146, 147, 0, 148, 149, 150, 0, 0, 151,
+	152, 654, 154, 155, 0, 156, 157, 158, 0, 159,
+	160, 161, 0, 162, 163, 164, 165, 604, 166, 167,
+	168, 644, 620, 169, 0, 170, 171, 663, 172, 0,
+	173, 0, 174, 489, 0, 490, 175, 176, 177, 0,
+	178, 179, 652, 0, 608, 180, 0, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 0, 190, 191, 192,
+	193, 194, 195, 0, 196, 491, 361, 197, 198, 199,
+	200, 664, 665, 0, 632, 0, 201, 492, 202, 493,
+	203, 204, 205, 206, 207, 0, 0, 208, 653, 494,
+	209, 495, 0, 210, 211, 212, 637, 638, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 366, 496, 367, 228, 229, 368, 593,
+	230, 231, 232, 621, 650, 233, 666, 234, 235, 236,
+	0, 237, 0, 0, 238, 239, 0, 0, 240, 371,
+	497, 241, 498, 645, 242, 243, 244, 245, 246, 247,
+	248, 0, 249, 250, 646, 251, 374, 254, 252, 253,
+	0, 255, 256, 257, 258, 259, 260, 261, 262, 667,
+	263, 264, 265, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 0, 278, 279, 499,
+	280, 281, 282, 609, 283, 284, 285, 286, 287, 288,
+	289, 0, 290, 291, 292, 293, 294, 641, 295, 296,
+	377, 297, 298, 500, 299, 300, 668, 301, 0, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 647,
+	0, 312, 313, 0, 314, 315, 0, 669, 670, 0,
+	0, 316, 317, 648, 318, 649, 619, 319, 320, 321,
+	322, 323, 324, 325, 0, 596, 326, 327, 328, 329,
+	330, 642, 331, 0, 332, 333, 334, 335, 384, 671,
+	0, 336, 501, 337, 0, 0, 0, 0, 338, 339,
+	340, 341, 342, 343, 344, 345, 594, 0, 0, 0,
+	0, 0, 0, 590, 591, 625, 612, 613, 614, 615,
+	611, 599, 0, 592, 0, 0, 600, 1259, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 605, 0, 0, 99, 100, 0,
+	101, 102, 484, 103, 104, 105, 346, 655, 485, 656,
+	0, 657, 0, 106, 107, 108, 109, 110, 622, 643,
+	111, 658, 659, 112, 0, 113, 114, 115, 116, 651,
+	0, 633, 0, 117, 118, 119, 120, 121, 0, 487,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 488, 131, 132, 133, 0, 634, 639, 635, 636,
+	640, 134, 135, 136, 137, 138, 660, 139, 140, 661,
+	662, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 654, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 604, 166, 167, 168, 644, 620,
+	169, 0, 170, 171, 663, 172, 0, 173, 0, 174,
+	489, 0, 490, 175, 176, 177, 0, 178, 179, 652,
+	0, 608, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 491, 361, 197, 198, 199, 200, 664, 665,
+	0, 632, 0, 201, 492, 202, 493, 203, 204, 205,
+	206, 207, 0, 0, 208, 653, 494, 209, 495, 0,
+	210, 211, 212, 637, 638, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 496, 367, 228, 229, 368, 593, 230, 231, 232,
+	621, 650, 233, 666, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 497, 241, 498,
+	645, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 646, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 667, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 499, 280, 281, 282,
+	609, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 641, 295, 296, 377, 297, 298,
+	500, 299, 300, 668, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 647, 0, 312, 313,
+	0, 314, 315, 0, 669, 670, 0, 0, 316, 317,
+	648, 318, 649, 619, 319, 320, 321, 322, 323, 324,
+	325, 0, 596, 326, 327, 328, 329, 330, 642, 331,
+	0, 332, 333, 334, 335, 384, 671, 0, 336, 501,
+	337, 0, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 594, 0, 0, 0, 0, 0, 0,
+	590, 591, 625, 612, 613, 614, 615, 611, 599, 0,
+	592, 969, 1254, 600, 0, 88, 89, 90, 91, 92,
+	93, 94, 95, 0, 96, 97, 98, 0, 0, 0,
+	0, 605, 0, 0, 99, 100, 0, 101, 102, 484,
+	103, 104, 105, 346, 655, 485, 656, 0, 657, 0,
+	106, 107, 108, 109, 110, 622, 643, 111, 658, 659,
+	112, 0, 113, 114, 115, 116, 651, 0, 633, 0,
+	117, 118, 119, 120, 121, 0, 487, 122, 123, 124,
+	0, 125, 126, 127, 128, 129, 130, 0, 488, 131,
+	132, 133, 0, 634, 639, 635, 636, 640, 134, 135,
+	136, 137, 138, 660, 139, 140, 661, 662, 141, 0,
+	142, 0, 143, 144, 145, 146, 147, 0, 148, 149,
+	150, 0, 0, 151, 152, 654, 154, 155, 0, 156,
+	157, 158, 0, 159, 160, 161, 0, 162, 163, 164,
+	165, 604, 166, 167, 168, 644, 620, 169, 0, 170,
+	171, 663, 172, 0, 173, 0, 174, 489, 0, 490,
+	175, 176, 177, 0, 178, 179, 652, 0, 608, 180,
+	0, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	0, 190, 191, 192, 193, 194, 195, 0, 196, 491,
+	361, 197, 198, 199, 200, 664, 665, 0, 632, 0,
+	201, 492, 202, 493, 203, 204, 205, 206, 207, 0,
+	0, 208, 653, 494, 209, 495, 0, 210, 211, 212,
+	637, 638, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 366, 496, 367,
+	228, 229, 368, 593, 230, 231, 232, 621, 650, 233,
+	666, 234, 235, 236, 0, 237, 0, 0, 238, 239,
+	0, 0, 240, 371, 497, 241, 498, 645, 242, 243,
+	244, 245, 246, 247, 248, 0, 249, 250, 646, 251,
+	374, 254, 252, 253, 0, 255, 256, 257, 258, 259,
+	260, 261, 262, 667, 263, 264, 265, 266, 0, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+	0, 278, 279, 499, 280, 281, 282, 609, 283, 284,
+	285, 286, 287, 288, 289, 0, 290, 291, 292, 293,
+	294, 641, 295, 296, 377, 297, 298, 500, 299, 300,
+	668, 301, 0, 302, 303, 304, 305, 306, 307, 308,
+	309, 310, 311, 647, 0, 312, 313, 0, 314, 315,
+	0, 669, 670, 0, 0, 316, 317, 648, 318, 649,
+	619, 319, 320, 321, 322, 323, 324, 325, 0, 596,
+	326, 327, 328, 329, 330, 642, 331, 0, 332, 333,
+	334, 335, 384, 671, 1712, 336, 501, 337, 0, 0,
+	0, 0, 338, 339, 340, 341, 342, 343, 344, 345,
+	594, 0, 0, 0, 0, 0, 0, 590, 591, 625,
+	612, 613, 614, 615, 611, 599, 0, 592, 0, 0,
+	600, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 605, 0,
+	0, 99, 100, 0, 101, 102, 484, 103, 104, 105,
+	346, 655, 485, 656, 0, 657, 0, 106, 107, 108,
+	109, 110, 622, 643, 111, 658, 659, 112, 0, 113,
+	114, 115, 116, 651, 0, 633, 0, 117, 118, 119,
+	120, 121, 0, 487, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 488, 131, 132, 133, 0,
+	634, 639, 635, 636, 640, 134, 135, 136, 137, 138,
+	660, 139, 140, 661, 662, 141, 692, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 654, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 604, 166,
+	167, 168, 644, 620, 169, 0, 170, 171, 663, 172,
+	0, 173, 0, 174, 489, 0, 490, 175, 176, 177,
+	0, 178, 179, 652, 0, 608, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 491, 361, 197, 198,
+	199, 200, 664, 665, 0, 632, 0, 201, 492, 202,
+	493, 203, 204, 205, 206, 207, 0, 0, 208, 653,
+	494, 209, 495, 0, 210, 211, 212, 637, 638, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 496, 367, 228, 229, 368,
+	593, 230, 231, 232, 621, 650, 233, 666, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 497, 241, 498, 645, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 646, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	667, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	499, 280, 281, 282, 609, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 641, 295,
+	296, 377, 297, 298, 500, 299, 300, 668, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	647, 0, 312, 313, 0, 314, 315, 0, 669, 670,
+	0, 0, 316, 317, 648, 318, 649, 619, 319, 320,
+	321, 322, 323, 324, 325, 0, 596, 326, 327, 328,
+	329, 330, 642, 331, 0, 332, 333, 334, 335, 384,
+	671, 0, 336, 501, 337, 0, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 594, 0, 0,
+	0, 0, 0, 0, 590, 591, 625, 612, 613, 614,
+	615, 611, 599, 0, 592, 0, 0, 600, 0, 88,
+	89, 90, 91, 92, 93, 94, 95, 0, 96, 97,
+	98, 0, 0, 0, 0, 605, 0, 0, 99, 100,
+	0, 101, 102, 484, 103, 104, 105, 346, 655, 485,
+	656, 0, 657, 0, 106, 107, 108, 109, 110, 622,
+	643, 111, 658, 659, 112, 0, 113, 114, 115, 116,
+	651, 0, 633, 0, 117, 118, 119, 120, 121, 0,
+	487, 122, 123, 124, 0, 125, 126, 127, 128, 129,
+	130, 0, 488, 131, 132, 133, 0, 634, 639, 635,
+	636, 640, 134, 135, 136, 137, 138, 660, 139, 140,
+	661, 662, 141, 0, 142, 0, 143, 144, 145, 146,
+	147, 0, 148, 149, 150, 0, 0, 151, 152, 654,
+	154, 155, 0, 156, 157, 158, 0, 159, 160, 161,
+	0, 162, 163, 164, 165, 604, 166, 167, 168, 644,
+	620, 169, 0, 170, 171, 663, 172, 0, 173, 0,
+	174, 489, 0, 490, 175, 176, 177, 0, 178, 179,
+	652, 0, 608, 180, 0, 181, 182, 183, 184, 185,
+	186, 187, 188, 189, 0, 190, 191, 192, 193, 194,
+	195, 0, 196, 491, 361, 197, 198, 199, 200, 664,
+	665, 0, 632, 0, 201, 492, 202, 493, 203, 204,
+	205, 206, 207, 0, 0, 208, 653, 494, 209, 495,
+	0, 210, 211, 212, 637, 638, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 366, 496, 367, 228, 229, 368, 593, 230, 231,
+	232, 621, 650, 233, 666, 234, 235, 236, 0, 237,
+	0, 0, 238, 239, 0, 0, 240, 371, 497, 241,
+	498, 645, 242, 243, 244, 245, 246, 247, 248, 0,
+	249, 250, 646, 251, 374, 254, 252, 253, 0, 255,
+	256, 257, 258, 259, 260, 261, 262, 667, 263, 264,
+	265, 266, 0, 267, 268, 269, 270, 271, 272, 273,
+	274, 275, 276, 277, 0, 278, 279, 499, 280, 281,
+	282, 609, 283, 284, 285, 286, 287, 288, 289, 0,
+	290, 291, 292, 293, 294, 641, 295, 296, 377, 297,
+	298, 500, 299, 300, 668, 301, 0, 302, 303, 304,
+	305, 306, 307, 308, 309, 310, 311, 647, 0, 312,
+	313, 0, 314, 315, 0, 669, 670, 0, 0, 316,
+	317, 648, 318, 649, 619, 319, 320, 321, 322, 323,
+	324, 325, 0, 596, 326, 327, 328, 329, 330, 642,
+	331, 0, 332, 333, 334, 335, 384, 671, 0, 336,
+	501, 337, 0, 0, 0, 0, 338, 339, 340, 341,
+	342, 343, 344, 345, 594, 0, 0, 0, 0, 0,
+	0, 590, 591, 588, 625, 612, 613, 614, 615, 611,
+	599, 592, 0, 0, 600, 0, 0, 88, 89, 90,
+	91, 92, 93, 94, 95, 0, 96, 97, 98, 0,
+	0, 0, 0, 605, 0, 0, 99, 100, 0, 101,
+	102, 484, 103, 104, 105, 346, 655, 485, 656, 0,
+	657, 0, 106, 107, 108, 109, 110, 622, 643, 111,
+	658, 659, 112, 0, 113, 114, 115, 116, 651, 0,
+	633, 0, 117, 118, 119, 120, 121, 0, 487, 122,
+	123, 124, 0, 125, 126, 127, 128, 129, 130, 0,
+	488, 131, 132, 133, 0, 634, 639, 635, 636, 640,
+	134, 135, 136, 137, 138, 660, 139, 140, 661, 662,
+	141, 0, 142, 0, 143, 144, 145, 146, 147, 0,
+	148, 149, 150, 0, 0, 151, 152, 654, 154, 155,
+	0, 156, 157, 158, 0, 159, 160, 161, 0, 162,
+	163, 164, 165, 604, 166, 167, 168, 644, 620, 169,
+	0, 170, 171, 663, 172, 0, 173, 0, 174, 489,
+	1316, 490, 175, 176, 177, 0, 178, 179, 652, 0,
+	608, 180, 0, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 0, 190, 191, 192, 193, 194, 195, 0,
+	196, 491, 361, 197, 198, 199, 200, 664, 665, 0,
+	632, 0, 201, 492, 202, 493, 203, 204, 205, 206,
+	207, 0, 0, 208, 653, 494, 209, 495, 0, 210,
+	211, 212, 637, 638, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 366,
+	496, 367, 228, 229, 368, 593, 230, 231, 232, 621,
+	650, 233, 666, 234, 235, 236, 0, 237, 0, 0,
+	238, 239, 0, 0, 240, 371, 497, 241, 498, 645,
+	242, 243, 244, 245, 246, 247, 248, 0, 249, 250,
+	646, 251, 374, 254, 252, 253, 0, 255, 256, 257,
+	258, 259, 260, 261, 262, 667, 263, 264, 265, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 274, 275,
+	276, 277, 0, 278, 279, 499, 280, 281, 282, 609,
+	283, 284, 285, 286, 287, 288, 289, 0, 290, 291,
+	292, 293, 294, 641, 295, 296, 377, 297, 298, 500,
+	299, 300, 668, 301, 0, 302, 303, 304, 305, 306,
+	307, 308, 309, 310, 311, 647, 0, 312, 313, 0,
+	314, 315, 0, 669, 670, 0, 0, 316, 317, 648,
+	318, 649, 619, 319, 320, 321, 322, 323, 324, 325,
+	0, 596, 326, 327, 328, 329, 330, 642, 331, 0,
+	332, 333, 334, 335, 384, 671, 0, 336, 501, 337,
+	0, 0, 0, 0, 338, 339, 340, 341, 342, 343,
+	344, 345, 594, 0, 0, 0, 0, 0, 0, 590,
+	591, 625, 612, 613, 614, 615, 611, 599, 0, 592,
+	0, 0, 600, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 902, 96, 97, 98, 0, 0, 0, 0,
+	605, 0, 0, 99, 100, 0, 101, 102, 484, 103,
+	104, 105, 346, 655, 485, 656, 0, 657, 0, 106,
+	107, 108, 109, 110, 622, 643, 111, 658, 659, 112,
+	0, 113, 114, 115, 116, 651, 0, 633, 0, 117,
+	118, 119, 120, 121, 0, 487, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 488, 131, 132,
+	133, 0, 634, 639, 635, 636, 640, 134, 135, 136,
+	137, 138, 660, 139, 140, 661, 662, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 654, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	604, 166, 167, 168, 644, 620, 169, 0, 170, 171,
+	663, 172, 0, 173, 0, 174, 489, 0, 490, 175,
+	176, 177, 0, 178, 179, 652, 0, 608, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 491, 361,
+	197, 198, 199, 200, 664, 665, 0, 632, 0, 201,
+	492, 202, 493, 203, 204, 205, 206, 207, 0, 0,
+	208, 653, 494, 209, 495, 0, 210, 211, 212, 637,
+	638, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 496, 367, 228,
+	229, 368, 593, 230, 231, 232, 621, 650, 233, 666,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 497, 241, 498, 645, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 646, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 667, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 499, 280, 281, 282, 609, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	641, 295, 296, 377, 297, 298, 500, 299, 300, 668,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 647, 0, 312, 313, 0, 314, 315, 0,
+	669, 670, 0, 0, 316, 317, 648, 318, 649, 619,
+	319, 320, 321, 322, 323, 324, 325, 0, 596, 326,
+	327, 328, 329, 330, 642, 331, 0, 332, 333, 334,
+	335, 384, 671, 0, 336, 501, 337, 0, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 594,
+	0, 0, 0, 0, 0, 0, 590, 591, 625, 612,
+	613, 614, 615, 611, 599, 0, 592, 0, 0, 600,
+	0, 88, 89, 90, 91, 92, 93, 94, 95, 0,
+	96, 97, 98, 0, 0, 0, 0, 605, 0, 0,
+	99, 100, 0, 101, 102, 484, 103, 104, 105, 346,
+	655, 485, 656, 0, 657, 0, 106, 107, 108, 109,
+	110, 622, 643, 111, 658, 659, 112, 0, 113, 114,
+	115, 116, 651, 0, 633, 0, 117, 118, 119, 120,
+	121, 0, 487, 122, 123, 124, 0, 125, 126, 127,
+	128, 129, 130, 0, 488, 131, 132, 2131, 0, 634,
+	639, 635, 636, 640, 134, 135, 136, 137, 138, 660,
+	139, 140, 661, 662, 141, 0, 142, 0, 143, 144,
+	145, 146, 147, 0, 148, 149, 150, 0, 0, 151,
+	152, 654, 154, 155, 0, 156, 157, 158, 0, 159,
+	160, 161, 0, 162, 163, 164, 165, 604, 166, 167,
+	168, 644, 620, 169, 0, 170, 171, 663, 172, 0,
+	173, 0, 174, 489, 0, 490, 175, 176, 177, 0,
+	178, 179, 652, 0, 608, 180, 0, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 0, 190, 191, 192,
+	193, 194, 195, 0, 196, 491, 361, 197, 198, 199,
+	200, 664, 665, 0, 632, 0, 201, 492, 202, 493,
+	203, 204, 205, 206, 207, 0, 0, 208, 653, 494,
+	209, 495, 0, 210, 211, 212, 637, 638, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 366, 496, 367, 228, 229, 368, 593,
+	230, 231, 232, 621, 650, 233, 666, 234, 235, 236,
+	0, 237, 0, 0, 238, 239, 0, 0, 240, 371,
+	497, 241, 498, 645, 242, 243, 244, 245, 246, 247,
+	248, 0, 249, 250, 646, 251, 374, 254, 252, 253,
+	0, 255, 256, 257, 258, 259, 260, 261, 262, 667,
+	263, 264, 265, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 0, 278, 279, 499,
+	280, 281, 282, 609, 283, 284, 285, 286, 287, 288,
+	289, 0, 290, 291, 292, 293, 294, 641, 295, 296,
+	377, 297, 298, 500, 299, 300, 668, 301, 0, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 647,
+	0, 312, 313, 0, 314, 315, 0, 669, 670, 0,
+	0, 316, 317, 648, 318, 649, 619, 319, 320, 321,
+	322, 2130, 324, 325, 0, 596, 326, 327, 328, 329,
+	330, 642, 331, 0, 332, 333, 334, 335, 384, 671,
+	0, 336, 501, 337, 0, 0, 0, 0, 338, 339,
+	340, 341, 342, 343, 344, 345, 594, 0, 0, 0,
+	0, 0, 0, 590, 591, 625, 612, 613, 614, 615,
+	611, 599, 0, 592, 0, 0, 600, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 605, 0, 0, 99, 100, 0,
+	101, 102, 484, 103, 104, 105, 2129, 655, 485, 656,
+	0, 657, 0, 106, 107, 108, 109, 110, 622, 643,
+	111, 658, 659, 112, 0, 113, 114, 115, 116, 651,
+	0, 633, 0, 117, 118, 119, 120, 121, 0, 487,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 488, 131, 132, 2131, 0, 634, 639, 635, 636,
+	640, 134, 135, 136, 137, 138, 660, 139, 140, 661,
+	662, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 654, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 604, 166, 167, 168, 644, 620,
+	169, 0, 170, 171, 663, 172, 0, 173, 0, 174,
+	489, 0, 490, 175, 176, 177, 0, 178, 179, 652,
+	0, 608, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 491, 361, 197, 198, 199, 200, 664, 665,
+	0, 632, 0, 201, 492, 202, 493, 203, 204, 205,
+	206, 207, 0, 0, 208, 653, 494, 209, 495, 0,
+	210, 211, 212, 637, 638, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 496, 367, 228, 229, 368, 593, 230, 231, 232,
+	621, 650, 233, 666, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 497, 241, 498,
+	645, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 646, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 667, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 499, 280, 281, 282,
+	609, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 641, 295, 296, 377, 297, 298,
+	500, 299, 300, 668, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 647, 0, 312, 313,
+	0, 314, 315, 0, 669, 670, 0, 0, 316, 317,
+	648, 318, 649, 619, 319, 320, 321, 322, 2130, 324,
+	325, 0, 596, 326, 327, 328, 329, 330, 642, 331,
+	0, 332, 333, 334, 335, 384, 671, 0, 336, 501,
+	337, 0, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 594, 0, 0, 0, 0, 0, 0,
+	590, 591, 625, 612, 613, 614, 615, 611, 599, 0,
+	592, 0, 0, 600, 0, 88, 89, 90, 91, 92,
+	93, 94, 95, 0, 96, 97, 98, 0, 0, 0,
+	0, 605, 0, 0, 99, 100, 0, 101, 102, 484,
+	103, 104, 105, 346, 655, 485, 656, 0, 657, 0,
+	106, 107, 108, 109, 110, 622, 643, 111, 658, 659,
+	112, 0, 113, 114, 115, 116, 651, 0, 633, 0,
+	117, 118, 119, 120, 121, 0, 487, 122, 123, 124,
+	0, 125, 126, 127, 128, 129, 130, 0, 488, 131,
+	132, 133, 0, 634, 639, 635, 636, 640, 134, 135,
+	136, 137, 138, 660, 139, 140, 661, 662, 141, 0,
+	142, 0, 143, 144, 145, 146, 147, 0, 148, 149,
+	150, 0, 0, 151, 152, 654, 154, 155, 0, 156,
+	157, 158, 0, 159, 160, 161, 0, 162, 163, 164,
+	165, 604, 166, 167, 168, 644, 620, 169, 0, 170,
+	171, 663, 172, 0, 173, 0, 174, 489, 0, 490,
+	175, 176, 177, 0, 178, 179, 652, 0, 608, 180,
+	0, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	0, 190, 191, 192, 193, 194, 195, 0, 196, 491,
+	361, 197, 198, 199, 200, 664, 665, 0, 632, 0,
+	201, 492, 202, 493, 203, 204, 205, 206, 207, 0,
+	0, 208, 653, 494, 209, 495, 0, 210, 211, 212,
+	637, 638, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 366, 496, 367,
+	228, 229, 368, 593, 230, 231, 232, 621, 650, 233,
+	666, 234, 235, 236, 0, 237, 0, 0, 238, 239,
+	0, 0, 240, 371, 497, 241, 498, 645, 242, 243,
+	244, 245, 246, 247, 248, 0, 249, 250, 646, 251,
+	374, 254, 252, 253, 0, 255, 256, 257, 258, 259,
+	260, 261, 262, 667, 263, 264, 265, 266, 0, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+	0, 278, 279, 499, 280, 281, 282, 609, 283, 284,
+	285, 286, 287, 288, 289, 0, 290, 291, 292, 293,
+	294, 641, 295, 296, 377, 297, 298, 500, 299, 300,
+	668, 301, 0, 302, 303, 304, 305, 306, 307, 308,
+	309, 310, 311, 647, 0, 312, 313, 0, 314, 315,
+	0, 669, 670, 0, 0, 316, 317, 648, 318, 649,
+	619, 319, 320, 321, 322, 323, 324, 325, 0, 596,
+	326, 327, 328, 329, 330, 642, 331, 0, 332, 333,
+	334, 335, 384, 671, 0, 336, 501, 337, 0, 0,
+	0, 0, 338, 339, 340, 341, 342, 343, 344, 345,
+	594, 0, 0, 0, 0, 0, 0, 590, 591, 625,
+	612, 613, 614, 615, 611, 599, 0, 592, 0, 0,
+	600, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 605, 0,
+	0, 99, 100, 0, 101, 102, 484, 103, 104, 105,
+	346, 655, 485, 656, 0, 657, 0, 106, 107, 108,
+	109, 110, 622, 643, 111, 658, 659, 112, 0, 113,
+	114, 115, 116, 651, 0, 633, 0, 117, 118, 119,
+	120, 121, 0, 487, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 488, 131, 132, 133, 0,
+	634, 639, 635, 636, 640, 134, 135, 136, 137, 138,
+	660, 139, 140, 661, 662, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 654, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 604, 166,
+	167, 168, 644, 620, 169, 0, 170, 171, 663, 172,
+	0, 173, 0, 174, 489, 0, 490, 175, 176, 177,
+	0, 178, 179, 652, 0, 608, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 491, 361, 197, 198,
+	199, 200, 664, 665, 0, 632, 0, 201, 492, 202,
+	493, 203, 204, 205, 206, 207, 0, 0, 208, 653,
+	494, 209, 495, 0, 210, 211, 212, 637, 638, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 496, 367, 228, 229, 368,
+	593, 230, 231, 232, 621, 650, 233, 666, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 497, 241, 498, 645, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 646, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	667, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	499, 280, 281, 282, 609, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 641, 295,
+	296, 377, 297, 298, 500, 299, 300, 668, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	647, 0, 312, 313, 0, 314, 315, 0, 669, 670,
+	0, 0, 316, 317, 648, 318, 649, 619, 319, 320,
+	321, 322, 323, 324, 325, 0, 596, 326, 327, 328,
+	329, 330, 642, 331, 0, 332, 333, 334, 335, 384,
+	671, 0, 336, 501, 337, 0, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 594, 0, 0,
+	0, 0, 0, 0, 590, 591, 625, 612, 613, 614,
+	615, 611, 599, 0, 592, 0, 0, 1867, 0, 88,
+	89, 90, 91, 92, 93, 94, 95, 0, 96, 97,
+	98, 0, 0, 0, 0, 605, 0, 0, 99, 100,
+	0, 101, 102, 484, 103, 104, 105, 346, 655, 485,
+	656, 0, 657, 0, 106, 107, 108, 109, 110, 622,
+	643, 111, 658, 659, 112, 0, 113, 114, 115, 116,
+	651, 0, 633, 0, 117, 118, 119, 120, 121, 0,
+	487, 122, 123, 124, 0, 125, 126, 127, 128, 129,
+	130, 0, 488, 131, 132, 133, 0, 634, 639, 635,
+	636, 640, 134, 135, 136, 137, 138, 660, 139, 140,
+	661, 662, 141, 0, 142, 0, 143, 144, 145, 146,
+	147, 0, 148, 149, 150, 0, 0, 151, 152, 654,
+	154, 155, 0, 156, 157, 158, 0, 159, 160, 161,
+	0, 162, 163, 164, 165, 604, 166, 167, 168, 644,
+	620, 169, 0, 170, 171, 663, 172, 0, 173, 0,
+	174, 489, 0, 490, 175, 176, 177, 0, 178, 179,
+	652, 0, 608, 180, 0, 181, 182, 183, 184, 185,
+	186, 187, 188, 189, 0, 190, 191, 192, 193, 194,
+	195, 0, 196, 491, 361, 197, 198, 199, 200, 664,
+	665, 0, 632, 0, 201, 492, 202, 493, 203, 204,
+	205, 206, 207, 0, 0, 208, 653, 494, 209, 495,
+	0, 210, 211, 212, 637, 638, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 366, 496, 367, 228, 229, 368, 0, 230, 231,
+	232, 621, 650, 233, 666, 234, 235, 236, 0, 237,
+	0, 0, 238, 239, 0, 0, 240, 371, 497, 241,
+	498, 645, 242, 243, 244, 245, 246, 247, 248, 0,
+	249, 250, 646, 251, 374, 254, 252, 253, 0, 255,
+	256, 257, 258, 259, 260, 261, 262, 667, 263, 264,
+	265, 266, 0, 267, 268, 269, 270, 271, 272, 273,
+	274, 275, 276, 277, 0, 278, 279, 499, 280, 281,
+	282, 1306, 283, 284, 285, 286, 287, 288, 289, 0,
+	290, 291, 292, 293, 294, 641, 295, 296, 377, 297,
+	298, 500, 299, 300, 668, 301, 0, 302, 303, 304,
+	305, 306, 307, 308, 309, 310, 311, 647, 0, 312,
+	313, 0, 314, 315, 0, 669, 670, 0, 0, 316,
+	317, 648, 318, 649, 619, 319, 320, 321, 322, 323,
+	324, 325, 0, 0, 326, 327, 328, 329, 330, 642,
+	331, 0, 332, 333, 334, 335, 384, 671, 0, 336,
+	501, 337, 0, 0, 0, 0, 338, 339, 340, 341,
+	342, 343, 344, 345, 0, 0, 0, 0, 0, 0,
+	0, 1302, 1303, 625, 612, 613, 614, 615, 611, 599,
+	0, 1304, 0, 0, 1305, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 605, 0, 0, 99, 100, 0, 101, 102,
+	484, 103, 104, 105, 0, 655, 485, 656, 0, 657,
+	0, 106, 107, 108, 109, 110, 622, 643, 111, 658,
+	659, 112, 0, 113, 114, 115, 116, 651, 0, 633,
+	0, 117, 118, 119, 120, 121, 0, 487, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 488,
+	131, 132, 2131, 0, 634, 639, 635, 636, 640, 134,
+	135, 136, 137, 138, 660, 139, 140, 661, 662, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 654, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 604, 166, 167, 168, 644, 620, 169, 0,
+	170, 171, 663, 172, 0, 173, 0, 174, 489, 0,
+	490, 175, 176, 177, 0, 178, 179, 652, 0, 608,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	491, 361, 197, 198, 199, 200, 664, 665, 0, 632,
+	0, 201, 0, 202, 493, 203, 204, 205, 206, 207,
+	0, 0, 208, 653, 494, 209, 0, 0, 210, 211,
+	212, 637, 638, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 496,
+	367, 228, 229, 368, 593, 230, 231, 232, 621, 650,
+	233, 666, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 497, 241, 498, 645, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 646,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 667, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 499, 280, 281, 282, 609, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 641, 295, 296, 377, 297, 298, 0, 299,
+	300, 668, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 647, 0, 312, 313, 0, 314,
+	315, 0, 669, 670, 0, 0, 316, 317, 648, 318,
+	649, 619, 319, 320, 321, 322, 2130, 324, 325, 0,
+	596, 326, 327, 328, 329, 330, 642, 331, 0, 332,
+	333, 334, 335, 384, 671, 0, 336, 501, 337, 0,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 0, 0, 0, 0, 0, 0, 590, 591,
+	625, 0, 0, 0, 0, 0, 0, 0, 592, 0,
+	0, 600, 0, 88, 89, 90, 91, 92, 93, 94,
+	95, 0, 96, 97, 98, 0, 0, 0, 0, 0,
+	0, 0, 99, 100, 0, 101, 102, 484, 103, 104,
+	105, 346, 347, 485, 348, 0, 349, 0, 106, 107,
+	108, 109, 110, 0, 643, 111, 350, 351, 112, 0,
+	113, 114, 115, 116, 651, 0, 633, 0, 117, 118,
+	119, 120, 121, 0, 487, 122, 123, 124, 0, 125,
+	126, 127, 128, 129, 130, 0, 488, 131, 132, 133,
+	0, 634, 639, 635, 636, 640, 134, 135, 136, 137,
+	138, 353, 139, 140, 354, 355, 141, 0, 142, 0,
+	143, 144, 145, 146, 147, 0, 148, 149, 150, 0,
+	0, 151, 152, 153, 154, 155, 0, 156, 157, 158,
+	0, 159, 160, 161, 0, 162, 163, 164, 165, 356,
+	166, 167, 168, 644, 0, 169, 0, 170, 171, 358,
+	172, 0, 173, 0, 174, 489, 0, 490, 175, 176,
+	177, 0, 178, 179, 652, 0, 360, 180, 0, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 0, 190,
+	191, 192, 193, 194, 195, 0, 196, 491, 361, 197,
+	198, 199, 200, 362, 363, 0, 364, 0, 201, 492,
+	202, 493, 203, 204, 205, 206, 207, 1159, 0, 208,
+	653, 494, 209, 495, 0, 210, 211, 212, 637, 638,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 366, 496, 367, 228, 229,
+	368, 0, 230, 231, 232, 0, 650, 233, 370, 234,
+	235, 236, 0, 237, 0, 457, 238, 239, 0, 0,
+	240, 371, 497, 241, 498, 645, 242, 243, 244, 245,
+	246, 247, 248, 0, 249, 250, 646, 251, 374, 254,
+	252, 253, 0, 255, 256, 257, 258, 259, 260, 261,
+	262, 375, 263, 264, 265, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 274, 275, 276, 277, 0, 278,
+	279, 499, 280, 281, 282, 376, 1164, 284, 285, 286,
+	287, 288, 289, 56, 290, 291, 292, 293, 294, 641,
+	295, 296, 377, 297, 298, 500, 299, 300, 378, 301,
+	0, 302, 303, 304, 305, 306, 307, 308, 309, 310,
+	311, 647, 0, 312, 313, 58, 314, 315, 0, 380,
+	381, 0, 0, 316, 317, 648, 318, 649, 0, 319,
+	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
+	328, 329, 330, 642, 331, 0, 332, 333, 334, 335,
+	483, 385, 0, 336, 501, 337, 0, 0, 625, 54,
+	338, 339, 340, 341, 342, 343, 344, 345, 0, 0,
+	55, 88, 89, 90, 91, 92, 93, 94, 95, 0,
+	96, 97, 98, 0, 0, 0, 0, 0, 1162, 0,
+	99, 100, 0, 101, 102, 484, 103, 104, 105, 346,
+	347, 485, 348, 0, 349, 0, 106, 107, 108, 109,
+	110, 0, 643, 111, 350, 351, 112, 0, 113, 114,
+	115, 116, 651, 0, 633, 0, 117, 118, 119, 120,
+	121, 0, 487, 122, 123, 124, 0, 125, 126, 127,
+	128, 129, 130, 0, 488, 131, 132, 133, 0, 634,
+	639, 635, 636, 640, 134, 135, 136, 137, 138, 353,
+	139, 140, 354, 355, 141, 0, 142, 0, 143, 144,
+	145, 146, 147, 0, 148, 149, 150, 0, 0, 151,
+	152, 153, 154, 155, 0, 156, 157, 158, 0, 159,
+	160, 161, 0, 162, 163, 164, 165, 356, 166, 167,
+	168, 644, 0, 169, 0, 170, 171, 358, 172, 0,
+	173, 0, 174, 489, 0, 490, 175, 176, 177, 0,
+	178, 179, 652, 0, 360, 180, 0, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 0, 190, 191, 192,
+	193, 194, 195, 0, 196, 491, 361, 197, 198, 199,
+	200, 362, 363, 0, 364, 0, 201, 492, 202, 493,
+	203, 204, 205, 206, 207, 1159, 0, 208, 653, 494,
+	209, 495, 0, 210, 211, 212, 637, 638, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 366, 496, 367, 228, 229, 368, 0,
+	230, 231, 232, 0, 650, 233, 370, 234, 235, 236,
+	0, 237, 0, 457, 238, 239, 0, 0, 240, 371,
+	497, 241, 498, 645, 242, 243, 244, 245, 246, 247,
+	248, 0, 249, 250, 646, 251, 374, 254, 252, 253,
+	0, 255, 256, 257, 258, 259, 260, 261, 262, 375,
+	263, 264, 265, 266, 0, 267, 268, 269, 270, 271,
+	272, 273, 274, 275, 276, 277, 0, 278, 279, 499,
+	280, 281, 282, 376, 1164, 284, 285, 286, 287, 288,
+	289, 0, 290, 291, 292, 293, 294, 641, 295, 296,
+	377, 297, 298, 500, 299, 300, 378, 301, 0, 302,
+	303, 304, 305, 306, 307, 308, 309, 310, 311, 647,
+	0, 312, 313, 0, 314, 315, 0, 380, 381, 0,
+	0, 316, 317, 648, 318, 649, 0, 319, 320, 321,
+	322, 323, 324, 325, 0, 0, 326, 327, 328, 329,
+	330, 642, 331, 0, 332, 333, 334, 335, 384, 385,
+	0, 336, 501, 337, 0, 625, 0, 0, 338, 339,
+	340, 341, 342, 343, 344, 345, 0, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 1162, 99, 100, 0,
+	101, 102, 484, 103, 104, 105, 346, 347, 485, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 643,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 651,
+	0, 633, 0, 117, 118, 119, 120, 121, 0, 487,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 488, 131, 132, 133, 0, 634, 639, 635, 636,
+	640, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 644, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	489, 0, 490, 175, 176, 177, 0, 178, 179, 652,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 491, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 492, 202, 493, 203, 204, 205,
+	206, 207, 0, 0, 208, 653, 494, 209, 495, 0,
+	210, 211, 212, 637, 638, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 496, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 650, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 497, 241, 498,
+	645, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 646, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 499, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 641, 295, 296, 377, 297, 298,
+	500, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 647, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	648, 318, 649, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 642, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 501,
+	337, 0, 625, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 0, 88, 89, 90, 91, 92,
+	93, 94, 95, 0, 96, 97, 98, 0, 0, 0,
+	0, 0, 0, 1807, 99, 100, 0, 101, 102, 484,
+	103, 104, 105, 346, 347, 485, 348, 0, 349, 0,
+	106, 107, 108, 109, 110, 0, 643, 111, 350, 351,
+	112, 0, 113, 114, 115, 116, 651, 0, 633, 0,
+	117, 118, 119, 120, 121, 0, 487, 122, 123, 124,
+	0, 125, 126, 127, 128, 129, 130, 0, 488, 131,
+	132, 133, 0, 634, 639, 635, 636, 640, 134, 135,
+	136, 137, 138, 353, 139, 140, 354, 355, 141, 0,
+	142, 0, 143, 144, 145, 146, 147, 0, 148, 149,
+	150, 0, 0, 151, 152, 153, 154, 155, 0, 156,
+	157, 158, 0, 159, 160, 161, 0, 162, 163, 164,
+	165, 356, 166, 167, 168, 644, 0, 169, 0, 170,
+	171, 358, 172, 0, 173, 0, 174, 489, 0, 490,
+	175, 176, 177, 0, 178, 179, 652, 0, 360, 180,
+	0, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	0, 190, 191, 192, 193, 194, 195, 0, 196, 491,
+	361, 197, 198, 199, 200, 362, 363, 0, 364, 0,
+	201, 492, 202, 493, 203, 204, 205, 206, 207, 0,
+	0, 208, 653, 494, 209, 495, 0, 210, 211, 212,
+	637, 638, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 366, 496, 367,
+	228, 229, 368, 0, 230, 231, 232, 0, 650, 233,
+	370, 234, 235, 236, 0, 237, 0, 0, 238, 239,
+	0, 0, 240, 371, 497, 241, 498, 645, 242, 243,
+	244, 245, 246, 247, 248, 0, 249, 250, 646, 251,
+	374, 254, 252, 253, 0, 255, 256, 257, 258, 259,
+	260, 261, 262, 375, 263, 264, 265, 266, 0, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+	0, 278, 279, 499, 280, 281, 282, 376, 1164, 284,
+	285, 286, 287, 288, 289, 0, 290, 291, 292, 293,
+	294, 641, 295, 296, 377, 297, 298, 500, 299, 300,
+	378, 301, 0, 302, 303, 304, 305, 306, 307, 308,
+	309, 310, 311, 647, 0, 312, 313, 0, 314, 315,
+	0, 380, 381, 0, 0, 316, 317, 648, 318, 649,
+	0, 319, 320, 321, 322, 323, 324, 325, 0, 0,
+	326, 327, 328, 329, 330, 642, 331, 0, 332, 333,
+	334, 335, 384, 385, 0, 336, 501, 337, 0, 479,
+	0, 0, 338, 339, 340, 341, 342, 343, 344, 345,
+	0, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	53, 99, 100, 0, 101, 102, 484, 103, 104, 105,
+	346, 347, 485, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 486, 0, 117, 118, 119,
+	120, 121, 0, 487, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 488, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 489, 0, 490, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 491, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 492, 202,
+	493, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	494, 209, 495, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 496, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 497, 241, 498, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	499, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 56, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 500, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 58, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 483,
+	385, 0, 336, 501, 337, 0, 0, 0, 54, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 0, 55,
+	0, 0, 0, 0, 0, 479, 760, 764, 0, 0,
+	765, 0, 0, 0, 0, 0, 0, 53, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 484, 103, 104, 105, 346, 347, 485, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 486, 0, 117, 118, 119, 120, 121, 0, 487,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 488, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 768, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 757,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	489, 0, 490, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 491, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 492, 202, 493, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 494, 209, 495, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 496, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 758,
+	0, 238, 239, 0, 0, 240, 371, 497, 241, 498,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 499, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	500, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 756, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 501,
+	337, 0, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 479, 760, 764, 0, 0, 765, 0,
+	766, 761, 0, 0, 0, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	484, 103, 104, 105, 346, 347, 485, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 486,
+	0, 117, 118, 119, 120, 121, 0, 487, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 488,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	752, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 757, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 489, 0,
+	490, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	491, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 492, 202, 493, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 494, 209, 495, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 496,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 758, 0, 238,
+	239, 0, 0, 240, 371, 497, 241, 498, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 499, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 500, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 756, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 501, 337, 0,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 479, 760, 764, 0, 0, 765, 0, 766, 761,
+	0, 0, 0, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 484, 103,
+	104, 105, 346, 347, 485, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 486, 0, 117,
+	118, 119, 120, 121, 0, 487, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 488, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 757, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 489, 0, 490, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 491, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	492, 202, 493, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 494, 209, 495, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 496, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 758, 0, 238, 239, 0,
+	0, 240, 371, 497, 241, 498, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 499, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 500, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 756,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 501, 337, 0, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 85,
+	0, 0, 0, 0, 0, 0, 766, 761, 1430, 1431,
+	1432, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 1429, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 0, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 0, 0,
+	1426, 1427, 1428, 625, 1417, 1418, 1419, 1420, 1421, 1422,
+	1423, 1424, 1425, 0, 0, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	484, 103, 104, 105, 346, 347, 485, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 643, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 651, 0, 633,
+	0, 117, 118, 119, 120, 121, 0, 487, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 488,
+	131, 132, 133, 0, 634, 639, 635, 636, 640, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 644, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 489, 0,
+	490, 175, 176, 177, 0, 178, 179, 652, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	491, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 492, 202, 493, 203, 204, 205, 206, 207,
+	0, 0, 208, 653, 494, 209, 495, 0, 210, 211,
+	212, 637, 638, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 496,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 650,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 497, 241, 498, 645, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 646,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 499, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 641, 295, 296, 377, 297, 298, 500, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 647, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 648, 318,
+	649, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 642, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 501, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 56, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 58, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 483,
+	385, 0, 336, 0, 337, 0, 0, 0, 54, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 0, 55,
+	0, 0, 0, 0, 0, 85, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 53, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 1454, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 0, 0, 85, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 0, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 579, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 0,
+	85, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 0, 88, 89, 90, 91, 92, 93, 94,
+	95, 0, 96, 97, 98, 0, 0, 0, 0, 0,
+	0, 1039, 99, 100, 0, 101, 102, 0, 103, 104,
+	105, 346, 347, 0, 348, 0, 349, 0, 106, 107,
+	108, 109, 110, 0, 0, 111, 350, 351, 112, 0,
+	113, 114, 115, 116, 352, 0, 0, 0, 117, 118,
+	119, 120, 121, 0, 0, 122, 123, 124, 0, 125,
 	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	1030, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 1036, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 1037, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 1034, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 1087, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 1035, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 1033, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 1032, 122, 123, 124, 125,
-	355, 0, 0, 1027, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 1030, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 1036, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 1037,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 0, 199, 200, 1034, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 0, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 1035, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 300, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 1033, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	94, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 0, 112, 113,
-	114, 349, 350, 0, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 1032,
-	122, 123, 124, 125, 355, 0, 0, 0, 126, 127,
-	128, 129, 130, 0, 0, 131, 132, 133, 1030, 134,
-	135, 136, 137, 138, 139, 0, 0, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 1036, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 1037, 183, 0, 0, 0, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 1034, 202, 203, 204, 0, 205, 0, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 0,
-	211, 0, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 0, 218, 0, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 0, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 0, 248, 0, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 1035, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 0, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 0, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 1033, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 0, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 2007, 335, 336,
-	337, 338, 386, 387, 0, 339, 0, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 1421, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 1425, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 0, 0, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 444, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 300, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	94, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 0, 112, 113,
-	114, 349, 350, 0, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 0, 0, 126, 127,
-	128, 129, 130, 0, 0, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 0, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 691,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 0, 0, 0, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 0, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 0,
-	211, 0, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 0, 218, 0, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 0, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 0, 248, 0, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 0, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 0, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 690, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 0, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 493, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 444,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 0, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 1339, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 0, 0, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 1337,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 0, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 300, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	94, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 0, 112, 113,
-	114, 349, 350, 0, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 0, 0, 126, 127,
-	128, 129, 130, 0, 0, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 0, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 0, 0, 0, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 1094, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 0, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 0,
-	211, 0, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 0, 218, 0, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 0, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 0, 248, 0, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 0, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 0, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 0, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 91, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 496, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 90, 383, 0, 0, 86, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 0, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 840, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 686, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 676, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 0, 0, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 0, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 0, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	94, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 0, 112, 113,
-	114, 349, 350, 0, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 0, 0, 126, 127,
-	128, 129, 130, 0, 0, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 0, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 0, 0, 0, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 536, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 0, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 0,
-	211, 0, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 0, 218, 0, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 0, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 0, 248, 0, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 0, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 0, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 0, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 532, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 0, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 498, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 91, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 272, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 496, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 90, 383, 0, 0,
-	86, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 491, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 291,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 0, 0, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 459,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 406, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	407, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 0, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 408, 0, 300, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 410, 383, 0, 0, 409, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	94, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 0, 112, 113,
-	114, 349, 350, 0, 351, 0, 352, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 353, 354, 121, 0,
-	122, 123, 124, 125, 355, 0, 0, 0, 126, 127,
-	128, 129, 130, 0, 0, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 0, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 356, 148, 149, 357, 358, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 162, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 359,
-	175, 176, 177, 360, 0, 178, 0, 179, 180, 361,
-	181, 0, 182, 0, 183, 0, 0, 0, 184, 185,
-	186, 0, 187, 188, 362, 0, 363, 189, 0, 190,
-	191, 192, 193, 457, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 0, 364, 206,
-	207, 208, 209, 365, 366, 0, 367, 0, 210, 0,
-	211, 0, 212, 213, 214, 215, 216, 0, 0, 217,
-	368, 0, 218, 0, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 369, 0, 370, 235, 236,
-	371, 0, 237, 238, 239, 0, 372, 240, 373, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 374, 0, 248, 0, 375, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 376, 258, 377, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 378, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 0, 287, 288, 379, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 380, 302, 303, 0, 304, 305, 381, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	382, 0, 317, 318, 0, 319, 320, 0, 410, 383,
-	0, 0, 409, 321, 384, 322, 385, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 386, 387,
-	0, 339, 0, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 453, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 253, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 268, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 289,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 0, 340, 94, 0,
-	0, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 97, 98, 99, 100, 101, 102, 103, 104, 0,
-	105, 106, 107, 0, 0, 0, 0, 0, 0, 0,
-	108, 109, 0, 110, 111, 0, 112, 113, 114, 349,
-	350, 0, 351, 0, 352, 0, 115, 116, 117, 118,
-	119, 0, 0, 120, 353, 354, 121, 0, 122, 123,
-	124, 125, 355, 0, 0, 0, 126, 127, 128, 129,
-	130, 0, 0, 131, 132, 133, 0, 134, 135, 136,
-	137, 138, 139, 0, 0, 140, 141, 142, 0, 0,
-	0, 0, 0, 0, 143, 144, 145, 146, 147, 356,
-	148, 149, 357, 358, 150, 0, 151, 0, 152, 153,
-	154, 155, 156, 0, 157, 158, 159, 0, 0, 160,
-	161, 162, 163, 164, 0, 165, 166, 167, 0, 168,
-	169, 170, 0, 171, 172, 173, 174, 359, 175, 176,
-	177, 360, 0, 178, 0, 179, 180, 361, 181, 0,
-	182, 0, 183, 0, 0, 0, 184, 185, 186, 0,
-	187, 188, 362, 0, 363, 189, 0, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 0, 199, 200, 201,
-	202, 203, 204, 0, 205, 0, 364, 206, 207, 208,
-	209, 365, 366, 0, 367, 0, 210, 0, 211, 0,
-	212, 213, 214, 215, 216, 0, 0, 217, 368, 0,
-	218, 0, 0, 219, 220, 406, 0, 0, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 407, 369, 0, 370, 235, 236, 371, 0,
-	237, 238, 239, 0, 372, 240, 373, 241, 242, 243,
-	0, 244, 0, 0, 245, 246, 0, 0, 247, 374,
-	0, 248, 0, 375, 249, 250, 251, 252, 253, 254,
-	255, 0, 256, 257, 376, 258, 377, 261, 259, 260,
-	0, 262, 263, 264, 265, 266, 267, 268, 269, 378,
-	270, 271, 433, 273, 0, 274, 275, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 0, 285, 286, 0,
-	287, 288, 379, 289, 290, 291, 292, 293, 294, 295,
-	0, 296, 297, 298, 299, 408, 0, 300, 301, 380,
-	302, 303, 0, 304, 305, 381, 306, 0, 307, 308,
-	309, 310, 311, 312, 313, 314, 315, 316, 382, 0,
-	317, 318, 0, 319, 320, 0, 410, 383, 0, 0,
-	409, 321, 384, 322, 385, 0, 323, 324, 325, 326,
-	327, 328, 329, 0, 0, 330, 331, 332, 333, 334,
-	0, 0, 335, 336, 337, 338, 386, 387, 0, 339,
-	0, 340, 94, 0, 0, 0, 341, 342, 343, 344,
-	345, 346, 347, 348, 0, 97, 98, 99, 100, 101,
-	102, 103, 104, 0, 105, 106, 107, 0, 0, 0,
-	0, 0, 0, 0, 108, 109, 0, 110, 111, 0,
-	112, 113, 114, 349, 350, 0, 351, 0, 352, 0,
-	115, 116, 117, 118, 119, 0, 0, 120, 353, 354,
-	121, 0, 122, 123, 124, 125, 355, 0, 0, 0,
-	126, 127, 128, 129, 130, 0, 0, 131, 132, 133,
-	0, 134, 135, 136, 137, 138, 139, 0, 0, 140,
-	141, 142, 0, 0, 0, 0, 0, 0, 143, 144,
-	145, 146, 147, 356, 148, 149, 357, 358, 150, 0,
-	151, 0, 152, 153, 154, 155, 156, 0, 157, 158,
-	159, 0, 0, 160, 161, 162, 163, 164, 0, 165,
-	166, 167, 0, 168, 169, 170, 0, 171, 172, 173,
-	174, 359, 175, 176, 177, 360, 0, 178, 0, 179,
-	180, 361, 181, 0, 182, 0, 183, 0, 0, 0,
-	184, 185, 186, 0, 187, 188, 362, 0, 363, 189,
-	0, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	0, 199, 200, 201, 202, 203, 204, 0, 205, 0,
-	364, 206, 207, 208, 209, 365, 366, 0, 367, 0,
-	210, 0, 211, 0, 212, 213, 214, 215, 216, 0,
-	0, 217, 368, 0, 218, 0, 0, 219, 220, 406,
-	0, 0, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 407, 369, 0, 370,
-	235, 236, 371, 0, 237, 238, 239, 0, 372, 240,
-	373, 241, 242, 243, 0, 244, 0, 0, 245, 246,
-	0, 0, 247, 374, 0, 248, 0, 375, 249, 250,
-	251, 252, 253, 254, 255, 0, 256, 257, 376, 258,
-	377, 261, 259, 260, 0, 262, 263, 264, 265, 266,
-	267, 268, 269, 378, 270, 271, 272, 273, 0, 274,
-	275, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	0, 285, 286, 0, 287, 288, 379, 289, 290, 411,
-	292, 293, 294, 295, 0, 296, 297, 298, 299, 408,
-	0, 300, 301, 380, 302, 303, 0, 304, 305, 381,
-	306, 0, 307, 308, 309, 310, 311, 312, 313, 314,
-	315, 316, 382, 0, 317, 318, 0, 319, 320, 0,
-	410, 383, 0, 0, 409, 321, 384, 322, 385, 0,
-	323, 324, 325, 326, 327, 328, 329, 0, 0, 330,
-	331, 332, 333, 334, 0, 0, 335, 336, 337, 338,
-	386, 387, 0, 339, 0, 340, 94, 0, 0, 0,
-	341, 342, 343, 344, 345, 346, 347, 348, 0, 97,
-	98, 99, 100, 101, 102, 103, 104, 0, 105, 106,
-	107, 0, 0, 0, 0, 0, 0, 0, 108, 109,
-	0, 110, 111, 0, 112, 113, 114, 349, 350, 0,
-	351, 0, 352, 0, 115, 116, 117, 118, 119, 0,
-	0, 120, 353, 354, 121, 0, 122, 123, 124, 125,
-	355, 0, 0, 0, 126, 127, 128, 129, 130, 0,
-	0, 131, 132, 133, 0, 134, 135, 136, 137, 138,
-	139, 0, 0, 140, 141, 142, 0, 0, 0, 0,
-	0, 0, 143, 144, 145, 146, 147, 356, 148, 149,
-	357, 358, 150, 0, 151, 0, 152, 153, 154, 155,
-	156, 0, 157, 158, 159, 0, 0, 160, 161, 162,
-	163, 164, 0, 165, 166, 167, 0, 168, 169, 170,
-	0, 171, 172, 173, 174, 359, 175, 176, 177, 360,
-	0, 178, 0, 179, 180, 361, 181, 0, 182, 0,
-	183, 0, 0, 0, 184, 185, 186, 0, 187, 188,
-	362, 0, 363, 189, 0, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 0, 199, 200, 201, 202, 203,
-	204, 0, 205, 0, 364, 206, 207, 208, 209, 365,
-	366, 0, 367, 0, 210, 0, 211, 0, 212, 213,
-	214, 215, 216, 0, 0, 217, 368, 0, 218, 0,
-	0, 219, 220, 84, 0, 0, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	91, 369, 0, 370, 235, 236, 371, 0, 237, 238,
-	239, 0, 372, 240, 373, 241, 242, 243, 0, 244,
-	0, 0, 245, 246, 0, 0, 247, 374, 0, 248,
-	0, 375, 249, 250, 251, 252, 253, 254, 255, 0,
-	256, 257, 376, 258, 377, 261, 259, 260, 0, 262,
-	263, 264, 265, 266, 267, 268, 269, 378, 270, 271,
-	272, 273, 0, 274, 275, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 0, 285, 286, 0, 287, 288,
-	379, 289, 290, 291, 292, 293, 294, 295, 0, 296,
-	297, 298, 299, 85, 0, 300, 301, 380, 302, 303,
-	0, 304, 305, 381, 306, 0, 307, 308, 309, 310,
-	311, 312, 313, 314, 315, 316, 382, 0, 317, 318,
-	0, 319, 320, 0, 90, 383, 0, 0, 86, 321,
-	384, 322, 385, 0, 323, 324, 325, 326, 327, 328,
-	329, 0, 0, 330, 331, 332, 333, 334, 0, 0,
-	335, 336, 337, 338, 386, 387, 0, 339, 0, 340,
-	1800, 0, 0, 0, 341, 342, 343, 344, 345, 346,
-	347, 348, 0, 97, 98, 99, 100, 101, 102, 103,
-	104, 0, 105, 106, 107, 0, 0, 0, 0, 0,
-	0, 0, 108, 109, 0, 110, 111, 468, 112, 113,
-	114, 0, 1149, 469, 1164, 1144, 1156, 0, 115, 116,
-	117, 118, 119, 0, 0, 120, 1166, 1165, 121, 0,
-	122, 123, 124, 125, 0, 0, 470, 0, 126, 127,
-	128, 129, 130, 0, 471, 131, 132, 133, 0, 134,
-	135, 136, 137, 138, 139, 0, 472, 140, 141, 142,
-	0, 0, 0, 0, 0, 0, 143, 144, 145, 146,
-	147, 1161, 148, 149, 1154, 1153, 150, 0, 151, 0,
-	152, 153, 154, 155, 156, 0, 157, 158, 159, 0,
-	0, 160, 161, 632, 163, 164, 0, 165, 166, 167,
-	0, 168, 169, 170, 0, 171, 172, 173, 174, 0,
-	175, 176, 177, 0, 0, 178, 0, 179, 180, 1151,
-	181, 0, 182, 0, 183, 473, 0, 474, 184, 185,
-	186, 0, 187, 188, 0, 0, 0, 189, 0, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 0, 199,
-	200, 201, 202, 203, 204, 0, 205, 475, 0, 206,
-	207, 208, 209, 1146, 1147, 0, 749, 0, 210, 476,
-	211, 477, 212, 213, 214, 215, 216, 0, 0, 217,
-	0, 478, 218, 479, 0, 219, 220, 406, 0, 0,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 407, 0, 480, 0, 235, 236,
-	0, 0, 237, 238, 239, 0, 0, 240, 1155, 241,
-	242, 243, 0, 244, 0, 0, 245, 246, 0, 0,
-	247, 0, 481, 248, 482, 0, 249, 250, 251, 252,
-	253, 254, 255, 0, 256, 257, 0, 258, 0, 261,
-	259, 260, 0, 262, 263, 264, 265, 266, 267, 268,
-	269, 1150, 270, 271, 272, 273, 0, 274, 275, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 0, 285,
-	286, 483, 287, 288, 0, 289, 290, 291, 292, 293,
-	294, 295, 0, 296, 297, 298, 299, 408, 0, 300,
-	301, 0, 302, 303, 484, 304, 305, 1148, 306, 0,
-	307, 308, 309, 310, 311, 312, 313, 314, 315, 316,
-	0, 0, 317, 318, 0, 319, 1802, 0, 1163, 1162,
-	0, 0, 409, 321, 0, 322, 0, 0, 323, 324,
-	325, 326, 327, 328, 329, 0, 0, 330, 331, 332,
-	333, 334, 0, 0, 335, 336, 337, 338, 0, 1167,
-	0, 339, 485, 340, 94, 0, 0, 0, 341, 342,
-	343, 344, 345, 346, 347, 348, 0, 97, 98, 99,
-	100, 101, 102, 103, 104, 0, 105, 106, 107, 0,
-	0, 0, 0, 0, 0, 0, 108, 109, 0, 110,
-	111, 0, 112, 113, 114, 349, 350, 0, 351, 0,
-	352, 0, 115, 116, 117, 118, 119, 0, 0, 120,
-	353, 354, 121, 0, 122, 123, 124, 125, 355, 0,
-	0, 0, 126, 127, 128, 129, 130, 0, 0, 131,
-	132, 133, 0, 134, 135, 136, 137, 138, 139, 0,
-	0, 140, 141, 142, 0, 0, 0, 0, 0, 0,
-	143, 144, 145, 146, 147, 356, 148, 149, 357, 358,
-	150, 0, 151, 0, 152, 153, 154, 155, 156, 0,
-	157, 158, 159, 0, 0, 160, 161, 162, 163, 164,
-	0, 165, 166, 167, 0, 168, 169, 170, 0, 171,
-	172, 173, 174, 359, 175, 176, 177, 360, 0, 178,
-	0, 179, 180, 361, 181, 0, 182, 0, 183, 0,
-	0, 0, 184, 185, 186, 0, 187, 188, 362, 0,
-	363, 189, 0, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 0, 199, 200, 201, 202, 203, 204, 0,
-	205, 0, 364, 206, 207, 208, 209, 365, 366, 0,
-	367, 0, 210, 0, 211, 0, 212, 213, 214, 215,
-	216, 0, 0, 217, 368, 0, 218, 0, 0, 219,
-	220, 406, 0, 0, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 407, 369,
-	0, 370, 235, 236, 371, 0, 237, 238, 239, 0,
-	372, 240, 373, 241, 242, 243, 0, 244, 0, 0,
-	245, 246, 0, 0, 247, 374, 0, 248, 0, 375,
-	249, 250, 251, 252, 0, 254, 255, 0, 256, 257,
-	376, 258, 377, 261, 259, 260, 0, 262, 263, 264,
-	265, 266, 267, 0, 269, 378, 270, 271, 272, 273,
-	0, 274, 275, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 0, 285, 286, 0, 287, 288, 379, 0,
-	290, 291, 292, 293, 294, 295, 0, 296, 297, 298,
-	299, 408, 0, 300, 301, 380, 302, 303, 0, 304,
-	305, 381, 306, 0, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 382, 0, 317, 318, 0, 319,
-	320, 0, 410, 383, 0, 0, 409, 321, 384, 322,
-	385, 0, 323, 324, 325, 326, 327, 328, 329, 0,
-	0, 330, 331, 332, 333, 334, 0, 0, 335, 336,
-	337, 338, 386, 387, 0, 339, 892, 340, 908, 909,
-	910, 0, 341, 342, 343, 344, 345, 346, 347, 348,
-	0, 0, 0, 0, 0, 892, 911, 908, 909, 910,
-	0, 0, 0, 0, 894, 0, 0, 0, 0, 0,
-	917, 0, 0, 0, 0, 911, 0, 892, 0, 908,
-	909, 910, 0, 894, 0, 0, 0, 0, 0, 917,
-	0, 0, 0, 0, 893, 0, 892, 911, 908, 909,
-	910, 0, 907, 0, 0, 894, 0, 0, 0, 0,
-	0, 917, 0, 893, 0, 0, 911, 0, 0, 0,
-	0, 907, 0, 0, 894, 892, 0, 908, 909, 910,
-	917, 0, 0, 0, 0, 893, 0, 0, 0, 0,
-	0, 0, 0, 907, 892, 911, 908, 909, 910, 0,
-	0, 0, 0, 894, 893, 0, 0, 0, 0, 917,
-	0, 0, 907, 0, 911, 0, 892, 0, 908, 909,
-	910, 0, 894, 0, 0, 0, 0, 0, 917, 0,
-	0, 0, 0, 893, 0, 0, 911, 0, 0, 0,
-	1116, 907, 0, 0, 894, 0, 0, 0, 0, 0,
-	917, 0, 893, 0, 0, 0, 0, 918, 0, 0,
-	907, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 893, 0, 918, 0, 916, 0,
-	0, 0, 907, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 913, 0, 0, 0, 0, 916, 918, 0,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 353, 139, 140, 354, 355, 141, 0, 142, 0,
+	143, 144, 145, 146, 147, 0, 148, 149, 150, 0,
+	0, 151, 152, 153, 154, 155, 0, 156, 157, 158,
+	0, 159, 160, 161, 0, 162, 163, 164, 165, 356,
+	166, 167, 168, 357, 0, 169, 0, 170, 171, 358,
+	172, 0, 173, 0, 174, 0, 0, 0, 175, 176,
+	177, 0, 178, 179, 359, 0, 360, 180, 0, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 0, 190,
+	191, 192, 193, 194, 195, 0, 196, 0, 361, 197,
+	198, 199, 200, 362, 363, 0, 364, 0, 201, 0,
+	202, 0, 203, 204, 205, 206, 207, 0, 0, 208,
+	365, 0, 209, 0, 0, 210, 211, 212, 0, 0,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 366, 0, 367, 228, 229,
+	368, 0, 230, 231, 232, 0, 369, 233, 370, 234,
+	235, 236, 0, 237, 0, 0, 238, 239, 0, 0,
+	240, 371, 0, 241, 0, 372, 242, 243, 244, 245,
+	246, 247, 248, 0, 249, 250, 373, 251, 374, 254,
+	252, 253, 0, 255, 256, 257, 258, 259, 260, 261,
+	262, 375, 263, 264, 265, 266, 0, 267, 268, 269,
+	270, 271, 272, 273, 274, 275, 276, 277, 0, 278,
+	279, 0, 280, 281, 282, 376, 283, 284, 285, 286,
+	287, 288, 289, 0, 290, 291, 292, 293, 294, 0,
+	295, 296, 377, 297, 298, 0, 299, 300, 378, 301,
+	0, 302, 303, 304, 305, 306, 307, 308, 309, 310,
+	311, 379, 0, 312, 313, 0, 314, 315, 0, 380,
+	381, 0, 0, 316, 317, 382, 318, 383, 0, 319,
+	320, 321, 322, 323, 324, 325, 0, 0, 326, 327,
+	328, 329, 330, 0, 331, 0, 332, 333, 334, 335,
+	384, 385, 0, 336, 0, 337, 0, 85, 0, 0,
+	338, 339, 340, 341, 342, 343, 344, 345, 0, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 1730, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 0, 85, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 0, 88, 89, 90,
+	91, 92, 93, 94, 95, 0, 96, 97, 98, 0,
+	0, 0, 0, 0, 0, 1675, 99, 100, 0, 101,
+	102, 0, 103, 104, 105, 346, 347, 0, 348, 0,
+	349, 0, 106, 107, 108, 109, 110, 0, 0, 111,
+	350, 351, 112, 0, 113, 114, 115, 116, 352, 0,
+	0, 0, 117, 118, 119, 120, 121, 0, 0, 122,
+	123, 124, 0, 125, 126, 127, 128, 129, 130, 0,
+	0, 131, 132, 133, 0, 0, 0, 0, 0, 0,
+	134, 135, 136, 137, 138, 353, 139, 140, 354, 355,
+	141, 0, 142, 0, 143, 144, 145, 146, 147, 0,
+	148, 149, 150, 0, 0, 151, 152, 153, 154, 155,
+	0, 156, 157, 158, 0, 159, 160, 161, 0, 162,
+	163, 164, 165, 356, 166, 167, 168, 357, 0, 169,
+	0, 170, 171, 358, 172, 0, 173, 0, 174, 0,
+	0, 0, 175, 176, 177, 0, 178, 179, 359, 0,
+	360, 180, 0, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 0, 190, 191, 192, 193, 194, 195, 0,
+	196, 0, 361, 197, 198, 199, 200, 362, 363, 0,
+	364, 0, 201, 0, 202, 0, 203, 204, 205, 206,
+	207, 0, 0, 208, 365, 0, 209, 0, 0, 210,
+	211, 212, 0, 0, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 366,
+	0, 367, 228, 229, 368, 0, 230, 231, 232, 0,
+	369, 233, 370, 234, 235, 236, 0, 237, 0, 0,
+	238, 239, 0, 0, 240, 371, 0, 241, 0, 372,
+	242, 243, 244, 245, 246, 247, 248, 0, 249, 250,
+	373, 251, 374, 254, 252, 253, 0, 255, 256, 257,
+	258, 259, 260, 261, 262, 375, 263, 264, 265, 266,
+	0, 267, 268, 269, 270, 271, 272, 273, 274, 275,
+	276, 277, 0, 278, 279, 0, 280, 281, 282, 376,
+	283, 284, 285, 286, 287, 288, 289, 0, 290, 291,
+	292, 293, 294, 0, 295, 296, 377, 297, 298, 0,
+	299, 300, 378, 301, 0, 302, 303, 304, 305, 306,
+	307, 308, 309, 310, 311, 379, 0, 312, 313, 0,
+	314, 315, 0, 380, 381, 0, 0, 316, 317, 382,
+	318, 383, 0, 319, 320, 321, 322, 323, 324, 325,
+	0, 0, 326, 327, 328, 329, 330, 0, 331, 0,
+	332, 333, 334, 335, 384, 385, 0, 336, 0, 337,
+	0, 479, 0, 0, 338, 339, 340, 341, 342, 343,
+	344, 345, 0, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 683, 99, 100, 0, 101, 102, 484, 103,
+	104, 105, 346, 347, 485, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 486, 0, 117,
+	118, 119, 120, 121, 0, 487, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 488, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 489, 0, 490, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 491, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	492, 202, 493, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 494, 209, 495, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 496, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 497, 241, 498, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 499, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 500, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 501, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 1064, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 1062, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 1068, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	1069, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 0, 190, 191, 1066, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	1121, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 1067,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 1065, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 1064, 113, 114, 115, 116, 352, 0, 0,
+	1059, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 1062, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 1068, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 1069, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 1066, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 1067, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	1065, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 1064, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 1062, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 1068, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 1069, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	1066, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 1067, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 1065, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 79, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	82, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 83, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 84, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 85, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 0, 103,
+	104, 105, 346, 347, 0, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 0, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 82, 0, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 0, 0, 0, 175,
+	176, 177, 83, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 0, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	0, 202, 0, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 0, 209, 0, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 0, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 0, 241, 0, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 0, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 84, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 0, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 0, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 2039, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 1454, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 1458,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	457, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 85, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 0, 103,
+	104, 105, 346, 347, 0, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 0, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 0, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	714, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 0, 0, 0, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 0, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	0, 202, 0, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 0, 209, 0, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 0, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 0, 241, 0, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 0, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 0, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 713, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 0, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	511, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 457, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 1372, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 1370, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 85, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 0, 103,
+	104, 105, 346, 347, 0, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 0, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 0, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 0, 0, 0, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 0, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	0, 202, 0, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 0, 209, 0, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 400, 366, 0, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 0, 241, 0, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 0, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 516,
+	0, 295, 296, 377, 297, 298, 0, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	399, 381, 0, 0, 395, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 0, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	882, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 868, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 709, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 698, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 0, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 85, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 0, 103,
+	104, 105, 346, 347, 0, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 0, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 0, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 0, 0, 0, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 558, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 0, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	0, 202, 0, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 0, 209, 0, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 0, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 0, 241, 0, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 0, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 0, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 0, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	554, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 518, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 400, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 265, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 516, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 399, 381, 0, 0, 395, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 509, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 285, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 472, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 212, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 294, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 380, 381, 0, 0, 316, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 85, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 0, 103,
+	104, 105, 346, 347, 0, 348, 0, 349, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 350, 351, 112,
+	0, 113, 114, 115, 116, 352, 0, 0, 0, 117,
+	118, 119, 120, 121, 0, 0, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 0, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 353, 139, 140, 354, 355, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 153, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	356, 166, 167, 168, 357, 0, 169, 0, 170, 171,
+	358, 172, 0, 173, 0, 174, 0, 0, 0, 175,
+	176, 177, 0, 178, 179, 359, 0, 360, 180, 0,
+	181, 182, 183, 184, 470, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 0, 361,
+	197, 198, 199, 200, 362, 363, 0, 364, 0, 201,
+	0, 202, 0, 203, 204, 205, 206, 207, 0, 0,
+	208, 365, 0, 209, 0, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 366, 0, 367, 228,
+	229, 368, 0, 230, 231, 232, 0, 369, 233, 370,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 371, 0, 241, 0, 372, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 373, 251, 374,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 375, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 0, 280, 281, 282, 376, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 377, 297, 298, 0, 299, 300, 378,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 379, 0, 312, 313, 0, 314, 315, 0,
+	380, 381, 0, 0, 316, 317, 382, 318, 383, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 384, 385, 0, 336, 0, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	467, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 246, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 261, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 283, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 0, 337, 85, 0, 0, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 88, 89, 90, 91,
+	92, 93, 94, 95, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 99, 100, 0, 101, 102,
+	0, 103, 104, 105, 346, 347, 0, 348, 0, 349,
+	0, 106, 107, 108, 109, 110, 0, 0, 111, 350,
+	351, 112, 0, 113, 114, 115, 116, 352, 0, 0,
+	0, 117, 118, 119, 120, 121, 0, 0, 122, 123,
+	124, 0, 125, 126, 127, 128, 129, 130, 0, 0,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 353, 139, 140, 354, 355, 141,
+	0, 142, 0, 143, 144, 145, 146, 147, 0, 148,
+	149, 150, 0, 0, 151, 152, 153, 154, 155, 0,
+	156, 157, 158, 0, 159, 160, 161, 0, 162, 163,
+	164, 165, 356, 166, 167, 168, 357, 0, 169, 0,
+	170, 171, 358, 172, 0, 173, 0, 174, 0, 0,
+	0, 175, 176, 177, 0, 178, 179, 359, 0, 360,
+	180, 0, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 0, 190, 191, 192, 193, 194, 195, 0, 196,
+	0, 361, 197, 198, 199, 200, 362, 363, 0, 364,
+	0, 201, 0, 202, 0, 203, 204, 205, 206, 207,
+	0, 0, 208, 365, 0, 209, 0, 0, 210, 211,
+	212, 0, 0, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 366, 0,
+	367, 228, 229, 368, 0, 230, 231, 232, 0, 369,
+	233, 370, 234, 235, 236, 0, 237, 0, 0, 238,
+	239, 0, 0, 240, 371, 0, 241, 0, 372, 242,
+	243, 244, 245, 246, 247, 248, 0, 249, 250, 373,
+	251, 374, 254, 252, 253, 0, 255, 256, 257, 258,
+	259, 260, 261, 262, 375, 263, 264, 446, 266, 0,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 0, 278, 279, 0, 280, 281, 282, 376, 283,
+	284, 285, 286, 287, 288, 289, 0, 290, 291, 292,
+	293, 294, 0, 295, 296, 377, 297, 298, 0, 299,
+	300, 378, 301, 0, 302, 303, 304, 305, 306, 307,
+	308, 309, 310, 311, 379, 0, 312, 313, 0, 314,
+	315, 0, 380, 381, 0, 0, 316, 317, 382, 318,
+	383, 0, 319, 320, 321, 322, 323, 324, 325, 0,
+	0, 326, 327, 328, 329, 330, 0, 331, 0, 332,
+	333, 334, 335, 384, 385, 0, 336, 0, 337, 85,
+	0, 0, 0, 338, 339, 340, 341, 342, 343, 344,
+	345, 0, 88, 89, 90, 91, 92, 93, 94, 95,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 0, 101, 102, 0, 103, 104, 105,
+	346, 347, 0, 348, 0, 349, 0, 106, 107, 108,
+	109, 110, 0, 0, 111, 350, 351, 112, 0, 113,
+	114, 115, 116, 352, 0, 0, 0, 117, 118, 119,
+	120, 121, 0, 0, 122, 123, 124, 0, 125, 126,
+	127, 128, 129, 130, 0, 0, 131, 132, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	353, 139, 140, 354, 355, 141, 0, 142, 0, 143,
+	144, 145, 146, 147, 0, 148, 149, 150, 0, 0,
+	151, 152, 153, 154, 155, 0, 156, 157, 158, 0,
+	159, 160, 161, 0, 162, 163, 164, 165, 356, 166,
+	167, 168, 357, 0, 169, 0, 170, 171, 358, 172,
+	0, 173, 0, 174, 0, 0, 0, 175, 176, 177,
+	0, 178, 179, 359, 0, 360, 180, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 0, 190, 191,
+	192, 193, 194, 195, 0, 196, 0, 361, 197, 198,
+	199, 200, 362, 363, 0, 364, 0, 201, 0, 202,
+	0, 203, 204, 205, 206, 207, 0, 0, 208, 365,
+	0, 209, 0, 0, 210, 211, 212, 0, 0, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 366, 0, 367, 228, 229, 368,
+	0, 230, 231, 232, 0, 369, 233, 370, 234, 235,
+	236, 0, 237, 0, 0, 238, 239, 0, 0, 240,
+	371, 0, 241, 0, 372, 242, 243, 244, 245, 246,
+	247, 248, 0, 249, 250, 373, 251, 374, 254, 252,
+	253, 0, 255, 256, 257, 258, 259, 260, 261, 262,
+	375, 263, 264, 265, 266, 0, 267, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 277, 0, 278, 279,
+	0, 280, 281, 282, 376, 283, 284, 422, 286, 287,
+	288, 289, 0, 290, 291, 292, 293, 294, 0, 295,
+	296, 377, 297, 298, 0, 299, 300, 378, 301, 0,
+	302, 303, 304, 305, 306, 307, 308, 309, 310, 311,
+	379, 0, 312, 313, 0, 314, 315, 0, 380, 381,
+	0, 0, 316, 317, 382, 318, 383, 0, 319, 320,
+	321, 322, 323, 324, 325, 0, 0, 326, 327, 328,
+	329, 330, 0, 331, 0, 332, 333, 334, 335, 384,
+	385, 0, 336, 0, 337, 85, 0, 0, 0, 338,
+	339, 340, 341, 342, 343, 344, 345, 0, 88, 89,
+	90, 91, 92, 93, 94, 95, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 99, 100, 0,
+	101, 102, 0, 103, 104, 105, 346, 347, 0, 348,
+	0, 349, 0, 106, 107, 108, 109, 110, 0, 0,
+	111, 350, 351, 112, 0, 113, 114, 115, 116, 352,
+	0, 0, 0, 117, 118, 119, 120, 121, 0, 0,
+	122, 123, 124, 0, 125, 126, 127, 128, 129, 130,
+	0, 0, 131, 132, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 353, 139, 140, 354,
+	355, 141, 0, 142, 0, 143, 144, 145, 146, 147,
+	0, 148, 149, 150, 0, 0, 151, 152, 153, 154,
+	155, 0, 156, 157, 158, 0, 159, 160, 161, 0,
+	162, 163, 164, 165, 356, 166, 167, 168, 357, 0,
+	169, 0, 170, 171, 358, 172, 0, 173, 0, 174,
+	0, 0, 0, 175, 176, 177, 0, 178, 179, 359,
+	0, 360, 180, 0, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 0, 190, 191, 192, 193, 194, 195,
+	0, 196, 0, 361, 197, 198, 199, 200, 362, 363,
+	0, 364, 0, 201, 0, 202, 0, 203, 204, 205,
+	206, 207, 0, 0, 208, 365, 0, 209, 0, 0,
+	210, 211, 393, 0, 0, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 400,
+	366, 0, 367, 228, 229, 368, 0, 230, 231, 232,
+	0, 369, 233, 370, 234, 235, 236, 0, 237, 0,
+	0, 238, 239, 0, 0, 240, 371, 0, 241, 0,
+	372, 242, 243, 244, 245, 246, 247, 248, 0, 249,
+	250, 373, 251, 374, 254, 252, 253, 0, 255, 256,
+	257, 258, 259, 260, 261, 262, 375, 263, 264, 265,
+	266, 0, 267, 268, 269, 270, 271, 272, 273, 274,
+	275, 276, 277, 0, 278, 279, 0, 280, 281, 282,
+	376, 283, 284, 285, 286, 287, 288, 289, 0, 290,
+	291, 292, 293, 394, 0, 295, 296, 377, 297, 298,
+	0, 299, 300, 378, 301, 0, 302, 303, 304, 305,
+	306, 307, 308, 309, 310, 311, 379, 0, 312, 313,
+	0, 314, 315, 0, 399, 381, 0, 0, 395, 317,
+	382, 318, 383, 0, 319, 320, 321, 322, 323, 324,
+	325, 0, 0, 326, 327, 328, 329, 330, 0, 331,
+	0, 332, 333, 334, 335, 384, 385, 0, 336, 0,
+	337, 1832, 0, 0, 0, 338, 339, 340, 341, 342,
+	343, 344, 345, 0, 88, 89, 90, 91, 92, 93,
+	94, 95, 0, 96, 97, 98, 0, 0, 0, 0,
+	0, 0, 0, 99, 100, 0, 101, 102, 484, 103,
+	104, 105, 0, 1182, 485, 1197, 1177, 1189, 0, 106,
+	107, 108, 109, 110, 0, 0, 111, 1199, 1198, 112,
+	0, 113, 114, 115, 116, 0, 0, 486, 0, 117,
+	118, 119, 120, 121, 0, 487, 122, 123, 124, 0,
+	125, 126, 127, 128, 129, 130, 0, 488, 131, 132,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 1194, 139, 140, 1187, 1186, 141, 0, 142,
+	0, 143, 144, 145, 146, 147, 0, 148, 149, 150,
+	0, 0, 151, 152, 654, 154, 155, 0, 156, 157,
+	158, 0, 159, 160, 161, 0, 162, 163, 164, 165,
+	0, 166, 167, 168, 0, 0, 169, 0, 170, 171,
+	1184, 172, 0, 173, 0, 174, 489, 0, 490, 175,
+	176, 177, 0, 178, 179, 0, 0, 0, 180, 0,
+	181, 182, 183, 184, 185, 186, 187, 188, 189, 0,
+	190, 191, 192, 193, 194, 195, 0, 196, 491, 0,
+	197, 198, 199, 200, 1179, 1180, 0, 777, 0, 201,
+	492, 202, 493, 203, 204, 205, 206, 207, 0, 0,
+	208, 0, 494, 209, 495, 0, 210, 211, 212, 0,
+	0, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 496, 0, 228,
+	229, 0, 0, 230, 231, 232, 0, 0, 233, 1188,
+	234, 235, 236, 0, 237, 0, 0, 238, 239, 0,
+	0, 240, 0, 497, 241, 498, 0, 242, 243, 244,
+	245, 246, 247, 248, 0, 249, 250, 0, 251, 0,
+	254, 252, 253, 0, 255, 256, 257, 258, 259, 260,
+	261, 262, 1183, 263, 264, 265, 266, 0, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 0,
+	278, 279, 499, 280, 281, 282, 0, 283, 284, 285,
+	286, 287, 288, 289, 0, 290, 291, 292, 293, 294,
+	0, 295, 296, 0, 297, 298, 500, 299, 300, 1181,
+	301, 0, 302, 303, 304, 305, 306, 307, 308, 309,
+	310, 311, 0, 0, 312, 313, 0, 314, 1834, 0,
+	1196, 1195, 0, 0, 316, 317, 0, 318, 0, 0,
+	319, 320, 321, 322, 323, 324, 325, 0, 0, 326,
+	327, 328, 329, 330, 0, 331, 0, 332, 333, 334,
+	335, 0, 1200, 0, 336, 501, 337, 85, 0, 0,
+	0, 338, 339, 340, 341, 342, 343, 344, 345, 0,
+	88, 89, 90, 91, 92, 93, 94, 95, 0, 96,
+	97, 98, 0, 0, 0, 0, 0, 0, 0, 99,
+	100, 0, 101, 102, 0, 103, 104, 105, 346, 347,
+	0, 348, 0, 349, 0, 106, 107, 108, 109, 110,
+	0, 0, 111, 350, 351, 112, 0, 113, 114, 115,
+	116, 352, 0, 0, 0, 117, 118, 119, 120, 121,
+	0, 0, 122, 123, 124, 0, 125, 126, 127, 128,
+	129, 130, 0, 0, 131, 132, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 353, 139,
+	140, 354, 355, 141, 0, 142, 0, 143, 144, 145,
+	146, 147, 0, 148, 149, 150, 0, 0, 151, 152,
+	153, 154, 155, 0, 156, 157, 158, 0, 159, 160,
+	161, 0, 162, 163, 164, 165, 356, 166, 167, 168,
+	357, 0, 169, 0, 170, 171, 358, 172, 0, 173,
+	0, 174, 0, 0, 0, 175, 176, 177, 0, 178,
+	179, 359, 0, 360, 180, 0, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 0, 190, 191, 192, 193,
+	194, 195, 0, 196, 0, 361, 197, 198, 199, 200,
+	362, 363, 0, 364, 0, 201, 0, 202, 0, 203,
+	204, 205, 206, 207, 0, 0, 208, 365, 0, 209,
+	0, 0, 210, 211, 212, 0, 0, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 366, 0, 367, 228, 229, 368, 0, 230,
+	231, 232, 0, 369, 233, 370, 234, 235, 236, 0,
+	237, 0, 0, 238, 239, 0, 0, 240, 371, 0,
+	241, 0, 372, 242, 243, 244, 245, 0, 247, 248,
+	0, 249, 250, 373, 251, 374, 254, 252, 253, 0,
+	255, 256, 257, 258, 259, 260, 0, 262, 375, 263,
+	264, 265, 266, 0, 267, 268, 269, 270, 271, 272,
+	273, 274, 275, 276, 277, 0, 278, 279, 0, 280,
+	281, 282, 376, 0, 284, 285, 286, 287, 288, 289,
+	0, 290, 291, 292, 293, 294, 0, 295, 296, 377,
+	297, 298, 0, 299, 300, 378, 301, 0, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 379, 0,
+	312, 313, 0, 314, 315, 0, 380, 381, 0, 0,
+	316, 317, 382, 318, 383, 0, 319, 320, 321, 322,
+	323, 324, 325, 0, 0, 326, 327, 328, 329, 330,
+	0, 331, 0, 332, 333, 334, 335, 384, 385, 0,
+	336, 923, 337, 939, 940, 941, 0, 338, 339, 340,
+	341, 342, 343, 344, 345, 0, 0, 0, 0, 0,
+	923, 942, 939, 940, 941, 0, 0, 0, 0, 925,
+	0, 0, 0, 0, 0, 948, 0, 0, 0, 0,
+	942, 0, 923, 0, 939, 940, 941, 0, 925, 0,
+	0, 0, 0, 0, 948, 0, 0, 0, 0, 924,
+	0, 923, 942, 939, 940, 941, 0, 938, 0, 0,
+	925, 0, 0, 0, 0, 0, 948, 0, 924, 0,
+	0, 942, 0, 0, 0, 0, 938, 0, 0, 925,
+	923, 0, 939, 940, 941, 948, 0, 0, 0, 0,
+	924, 0, 0, 0, 0, 0, 0, 0, 938, 0,
+	942, 0, 923, 0, 939, 940, 941, 0, 925, 924,
+	0, 0, 0, 0, 948, 0, 0, 938, 0, 0,
+	0, 923, 942, 939, 940, 941, 1149, 0, 0, 0,
+	925, 0, 0, 0, 0, 0, 948, 0, 924, 0,
+	0, 942, 0, 0, 0, 0, 938, 0, 0, 925,
+	0, 0, 0, 0, 0, 948, 0, 0, 0, 0,
+	924, 0, 949, 0, 0, 0, 0, 0, 938, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 924,
+	0, 949, 0, 947, 0, 0, 0, 938, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
+	0, 0, 947, 949, 0, 0, 0, 0, 0, 0,
+	1150, 0, 0, 0, 0, 0, 944, 0, 0, 0,
+	0, 0, 949, 0, 947, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 944, 0,
+	0, 0, 943, 947, 0, 0, 0, 0, 0, 0,
+	0, 949, 0, 0, 0, 0, 0, 944, 0, 0,
+	0, 943, 0, 0, 2151, 0, 0, 0, 0, 0,
+	0, 0, 947, 949, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 943, 0, 0, 944, 0, 0, 0,
+	0, 0, 949, 0, 947, 0, 0, 0, 0, 0,
+	0, 0, 943, 0, 0, 0, 0, 0, 944, 0,
+	0, 0, 0, 947, 0, 0, 0, 0, 0, 946,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
+	0, 943, 0, 0, 0, 0, 0, 0, 946, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 943, 0, 0, 0, 0, 0, 0,
+	946, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 943, 0, 0, 0, 0, 0, 0, 946,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 913, 0, 0, 0, 0, 0, 918, 0, 916,
-	0, 0, 0, 0, 1117, 0, 0, 0, 0, 0,
-	0, 0, 0, 913, 0, 0, 0, 912, 916, 0,
-	0, 0, 0, 0, 0, 0, 918, 0, 0, 0,
-	0, 0, 913, 0, 0, 0, 912, 0, 0, 0,
-	0, 0, 0, 0, 0, 918, 0, 916, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 912, 0,
-	0, 913, 0, 0, 0, 0, 916, 918, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 912, 0, 0,
-	913, 0, 0, 0, 0, 0, 0, 0, 916, 0,
-	0, 0, 0, 915, 0, 0, 0, 0, 0, 0,
-	0, 0, 913, 0, 0, 0, 912, 0, 0, 0,
-	0, 0, 915, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 912, 0, 0, 0, 0,
-	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 912, 0, 0,
-	0, 0, 0, 915, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 914, 0, 0, 904, 905,
-	906, 0, 895, 896, 897, 898, 899, 901, 902, 900,
-	903, 0, 915, 0, 914, 0, 1765, 904, 905, 906,
-	0, 895, 896, 897, 898, 899, 901, 902, 900, 903,
-	0, 915, 0, 0, 0, 1701, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 0, 915, 0, 914, 0, 1676, 904, 905,
-	906, 0, 895, 896, 897, 898, 899, 901, 902, 900,
-	903, 0, 0, 0, 0, 0, 1622, 0, 0, 0,
-	0, 0, 0, 0, 914, 0, 0, 904, 905, 906,
-	0, 895, 896, 897, 898, 899, 901, 902, 900, 903,
-	0, 0, 1114, 914, 0, 1217, 904, 905, 906, 0,
-	895, 896, 897, 898, 899, 901, 902, 900, 903, 0,
-	0, 36, 1747, 0, 0, 914, 0, 0, 904, 905,
-	906, 30, 895, 896, 897, 898, 899, 901, 902, 900,
-	903, 892, 0, 908, 909, 910, 0, 0, 37, 0,
+	0, 2150, 945, 0, 0, 935, 936, 937, 0, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 946, 0,
+	0, 945, 0, 1733, 935, 936, 937, 0, 926, 927,
+	928, 929, 930, 932, 933, 931, 934, 0, 0, 0,
+	946, 0, 1708, 945, 0, 0, 935, 936, 937, 0,
+	926, 927, 928, 929, 930, 932, 933, 931, 934, 946,
+	0, 0, 945, 0, 1654, 935, 936, 937, 0, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 0, 0,
+	0, 0, 0, 1250, 0, 0, 0, 0, 0, 0,
+	1147, 945, 0, 0, 935, 936, 937, 0, 926, 927,
+	928, 929, 930, 932, 933, 931, 934, 0, 0, 0,
+	1779, 0, 0, 945, 0, 0, 935, 936, 937, 0,
+	926, 927, 928, 929, 930, 932, 933, 931, 934, 0,
+	0, 0, 945, 0, 0, 935, 936, 937, 0, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 923, 0,
+	939, 940, 941, 0, 0, 0, 0, 0, 0, 0,
+	952, 0, 0, 0, 0, 0, 0, 923, 942, 939,
+	940, 941, 0, 0, 0, 0, 925, 0, 0, 0,
+	0, 0, 948, 0, 0, 0, 0, 942, 39, 0,
+	951, 0, 0, 0, 0, 925, 0, 0, 33, 0,
+	923, 948, 939, 940, 941, 0, 924, 0, 0, 0,
+	0, 0, 0, 0, 938, 40, 0, 0, 0, 923,
+	942, 939, 940, 941, 0, 924, 0, 0, 925, 0,
+	0, 0, 0, 938, 948, 0, 0, 0, 0, 942,
+	0, 0, 0, 0, 0, 0, 42, 925, 0, 0,
+	0, 0, 0, 948, 0, 0, 0, 0, 924, 0,
+	49, 0, 0, 0, 0, 0, 938, 923, 0, 939,
+	940, 941, 0, 0, 0, 0, 0, 924, 0, 0,
+	0, 0, 0, 0, 0, 938, 0, 942, 0, 0,
+	0, 0, 1569, 0, 0, 925, 0, 1568, 0, 30,
+	0, 948, 0, 0, 0, 43, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 31, 0, 0, 0, 949,
+	0, 0, 0, 0, 0, 924, 0, 0, 0, 0,
+	0, 0, 0, 938, 0, 0, 32, 0, 949, 0,
+	947, 0, 923, 0, 939, 940, 941, 0, 0, 0,
+	0, 0, 0, 0, 944, 0, 0, 0, 0, 947,
+	0, 0, 942, 0, 0, 1570, 0, 0, 0, 0,
+	925, 949, 0, 944, 0, 0, 948, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 911, 0, 0, 892, 0, 908, 909, 910, 894,
-	0, 0, 0, 0, 0, 917, 921, 0, 0, 39,
-	0, 0, 0, 892, 911, 908, 909, 910, 0, 0,
-	0, 0, 894, 46, 0, 0, 0, 0, 917, 893,
-	0, 0, 0, 911, 0, 0, 920, 907, 0, 0,
-	0, 894, 0, 0, 0, 0, 0, 917, 0, 0,
-	0, 0, 893, 0, 0, 0, 0, 0, 0, 0,
-	907, 0, 27, 0, 0, 0, 0, 0, 40, 0,
-	0, 893, 0, 0, 0, 0, 0, 0, 28, 907,
-	0, 0, 892, 0, 908, 909, 910, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 29,
-	0, 892, 911, 908, 909, 910, 0, 0, 0, 0,
-	894, 0, 0, 0, 2124, 0, 917, 0, 0, 0,
-	0, 911, 0, 0, 0, 0, 0, 0, 0, 894,
-	0, 0, 0, 0, 0, 917, 0, 0, 1536, 0,
-	893, 0, 918, 1535, 0, 0, 0, 0, 907, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 893,
-	0, 0, 0, 916, 0, 918, 0, 907, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 913, 0, 0,
-	0, 0, 0, 0, 918, 0, 916, 0, 0, 0,
+	949, 0, 947, 0, 0, 0, 0, 0, 0, 943,
+	924, 0, 0, 0, 0, 0, 944, 0, 938, 0,
+	0, 947, 0, 0, 0, 0, 1575, 0, 943, 0,
+	0, 0, 0, 0, 0, 944, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 949, 0,
+	0, 0, 0, 0, 0, 0, 923, 0, 939, 940,
+	941, 943, 441, 0, 0, 47, 0, 0, 0, 947,
+	0, 0, 0, 0, 0, 0, 942, 0, 0, 0,
+	943, 0, 0, 944, 925, 46, 946, 0, 0, 0,
+	948, 0, 0, 0, 0, 34, 0, 0, 44, 0,
+	0, 0, 0, 45, 0, 946, 0, 0, 56, 0,
+	0, 0, 0, 0, 924, 37, 0, 0, 0, 38,
+	0, 0, 938, 949, 0, 0, 0, 0, 943, 41,
+	0, 0, 0, 0, 0, 0, 0, 0, 946, 0,
+	58, 0, 0, 0, 947, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 48, 0, 0, 946, 944, 945,
+	0, 0, 935, 936, 937, 0, 926, 927, 928, 929,
+	930, 932, 933, 931, 934, 59, 0, 0, 945, 0,
+	1727, 935, 936, 937, 54, 926, 927, 928, 929, 930,
+	932, 933, 931, 934, 0, 55, 0, 0, 0, 0,
+	0, 0, 0, 943, 0, 946, 0, 0, 0, 0,
+	0, 945, 0, 53, 935, 936, 937, 0, 926, 927,
+	928, 929, 930, 932, 933, 931, 934, 949, 0, 0,
+	945, 0, 0, 935, 936, 937, 0, 926, 927, 928,
+	929, 930, 932, 933, 931, 934, 0, 0, 947, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	913, 0, 0, 0, 0, 916, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 44, 913,
-	0, 0, 912, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 43, 0,
-	0, 2123, 0, 0, 0, 912, 0, 0, 31, 0,
-	41, 0, 0, 918, 0, 42, 0, 0, 0, 0,
-	53, 0, 0, 0, 912, 0, 0, 34, 0, 0,
-	0, 35, 918, 0, 916, 0, 0, 0, 0, 0,
-	0, 38, 0, 0, 0, 0, 0, 0, 913, 0,
-	0, 0, 55, 916, 0, 0, 0, 0, 915, 0,
-	0, 0, 0, 0, 0, 0, 45, 913, 0, 0,
+	0, 0, 944, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 945, 0,
+	946, 935, 936, 937, 0, 926, 927, 928, 929, 930,
+	932, 933, 931, 934, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 943, 0, 0,
+	0, 923, 0, 939, 940, 941, 0, 0, 0, 0,
+	0, 0, 1539, 1182, 0, 1197, 1177, 1189, 0, 0,
+	0, 942, 0, 0, 1534, 0, 0, 1199, 1198, 925,
+	0, 0, 0, 0, 0, 948, 0, 0, 0, 0,
+	0, 0, 0, 945, 0, 0, 935, 936, 937, 0,
+	926, 927, 928, 929, 930, 932, 933, 931, 934, 924,
+	0, 923, 0, 939, 940, 941, 0, 938, 0, 0,
+	0, 0, 1194, 0, 946, 1187, 1186, 0, 0, 0,
+	923, 942, 939, 940, 941, 0, 0, 0, 0, 925,
+	0, 0, 0, 0, 1185, 948, 0, 0, 0, 0,
+	942, 0, 0, 0, 0, 0, 0, 1182, 925, 1197,
+	1177, 1189, 0, 0, 948, 0, 0, 0, 0, 924,
+	1184, 1199, 1198, 0, 0, 0, 0, 938, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 924, 0,
+	0, 0, 0, 0, 0, 0, 938, 945, 0, 0,
+	935, 936, 937, 0, 926, 927, 928, 929, 930, 932,
+	933, 931, 934, 0, 1179, 1180, 1194, 777, 0, 1187,
+	1186, 0, 949, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1185, 0,
+	0, 0, 0, 947, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 944, 0, 0,
+	0, 0, 0, 0, 1184, 0, 0, 0, 0, 1188,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 915, 0, 0, 0, 0, 56, 0, 892, 0,
-	908, 909, 910, 912, 428, 51, 0, 0, 0, 0,
-	915, 0, 0, 0, 0, 0, 52, 0, 911, 0,
-	0, 0, 912, 0, 0, 0, 894, 0, 0, 0,
-	0, 0, 917, 0, 50, 0, 0, 0, 0, 0,
-	914, 0, 0, 904, 905, 906, 0, 895, 896, 897,
-	898, 899, 901, 902, 900, 903, 893, 0, 0, 0,
-	0, 0, 0, 914, 907, 0, 904, 905, 906, 0,
-	895, 896, 897, 898, 899, 901, 902, 900, 903, 915,
-	0, 0, 914, 0, 0, 904, 905, 906, 0, 895,
-	896, 897, 898, 899, 901, 902, 900, 903, 915, 0,
+	0, 0, 949, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	892, 0, 908, 909, 910, 0, 0, 0, 0, 0,
-	0, 1695, 0, 0, 0, 0, 0, 0, 0, 0,
-	911, 0, 0, 1537, 0, 0, 0, 0, 894, 0,
-	0, 0, 0, 0, 917, 0, 0, 1542, 0, 0,
-	0, 914, 0, 0, 904, 905, 906, 0, 895, 896,
-	897, 898, 899, 901, 902, 900, 903, 0, 893, 918,
-	914, 0, 0, 904, 905, 906, 907, 895, 896, 897,
-	898, 899, 901, 902, 900, 903, 0, 0, 0, 0,
-	916, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 913, 0, 0, 892, 0, 908,
-	909, 910, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
-	0, 0, 0, 0, 0, 894, 0, 0, 0, 0,
-	0, 917, 0, 892, 0, 908, 909, 910, 0, 912,
+	0, 949, 943, 947, 0, 0, 0, 0, 1179, 1180,
+	0, 777, 1183, 0, 0, 0, 0, 944, 0, 0,
+	0, 0, 947, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 944, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 911, 0, 893, 1501, 0, 0, 0,
-	0, 894, 0, 907, 0, 0, 892, 917, 908, 909,
-	910, 918, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 911, 0, 0, 0,
-	0, 893, 916, 0, 894, 0, 0, 0, 0, 907,
-	917, 0, 0, 0, 0, 0, 913, 0, 0, 0,
-	0, 0, 0, 0, 0, 915, 0, 0, 0, 0,
-	0, 0, 0, 0, 893, 0, 0, 892, 0, 908,
-	909, 910, 907, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
-	0, 912, 0, 0, 0, 894, 0, 0, 0, 0,
-	0, 917, 0, 0, 0, 0, 0, 0, 918, 1149,
-	0, 1164, 1144, 1156, 0, 0, 0, 892, 0, 908,
-	909, 910, 0, 1166, 1165, 893, 0, 914, 0, 916,
-	904, 905, 906, 907, 895, 896, 897, 898, 899, 901,
-	902, 900, 903, 913, 918, 894, 0, 0, 0, 0,
-	0, 917, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 916, 0, 915, 1161, 0,
-	0, 1154, 1153, 0, 0, 893, 0, 918, 0, 913,
-	0, 0, 0, 907, 0, 0, 0, 0, 912, 0,
-	1152, 0, 0, 0, 0, 0, 0, 0, 916, 0,
-	0, 0, 0, 1506, 0, 0, 0, 0, 0, 0,
-	0, 0, 913, 0, 0, 0, 1151, 0, 0, 0,
-	0, 0, 0, 0, 912, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 918, 914,
-	0, 0, 904, 905, 906, 0, 895, 896, 897, 898,
-	899, 901, 902, 900, 903, 0, 0, 912, 0, 916,
-	1146, 1147, 0, 749, 915, 0, 0, 0, 0, 0,
-	0, 0, 0, 913, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 918, 0,
+	0, 0, 0, 1171, 0, 0, 0, 0, 0, 1181,
+	0, 0, 943, 1188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1178, 946,
+	1196, 1195, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	915, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1155, 0, 0, 0, 0,
-	0, 0, 0, 913, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 915, 0, 0, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 0, 0, 0, 0, 0, 0, 1150, 0,
+	0, 0, 0, 0, 0, 0, 1183, 0, 0, 0,
+	0, 0, 1200, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 914, 0, 0, 904, 905, 906, 0, 895,
-	896, 897, 898, 899, 901, 902, 900, 903, 0, 0,
-	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1148, 914, 0, 0, 904, 905,
-	906, 0, 895, 896, 897, 898, 899, 901, 902, 900,
-	903, 0, 0, 1145, 0, 1163, 1162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 946,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1167, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 945, 1181, 0, 935, 936, 937, 946, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 0, 0,
+	0, 0, 1178, 0, 1196, 1195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 914, 0, 0, 904,
-	905, 906, 0, 895, 896, 897, 898, 899, 901, 902,
-	900, 903,
+	0, 0, 0, 0, 0, 0, 1200, 0, 0, 0,
+	0, 0, 945, 0, 0, 935, 936, 937, 0, 926,
+	927, 928, 929, 930, 932, 933, 931, 934, 0, 0,
+	0, 945, 0, 0, 935, 936, 937, 0, 926, 927,
+	928, 929, 930, 932, 933, 931, 934,
 }
 var sqlPact = [...]int{
 
-	32444, -1000, -252, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	33621, -1000, -97, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 560,
+	745, 93, 21961, 626, 21961, -1000, -1000, 31861, 877, 263,
+	263, 322, 396, 263, 374, 25129, 31465, 319, 413, 569,
+	10, -1000, 831, -99, 31069, 25129, 1337, -105, 23941, 158,
+	33621, 1137, 914, 23941, 30673, 30277, 29881, 25129, 25129, -1000,
+	15525, -1000, -1000, -1000, -1000, -1000, 410, 764, -110, 596,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 605, 990, 113, 604,
-	866, 604, -1000, -1000, 30752, 1087, 254, 254, 337, 512,
-	254, 360, 24054, 30358, 336, 435, 622, 10, -1000, 716,
-	-120, 29964, 24054, 1352, -108, 22872, 144, 32444, 1157, 971,
-	22872, 29570, 29176, 28782, -1000, -1000, 15286, -1000, -1000, -1000,
-	-1000, -1000, 434, 819, -1000, -109, -1000, -1000, -1000, 24054,
-	28388, 23660, 815, -1000, 25630, 27994, 1075, -1000, -1000, 456,
-	202, 1368, -1000, -99, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4673,44 +4785,46 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1144, -1000,
-	814, 1141, 1140, 201, -1000, -1000, -1000, -1000, 1075, 1075,
-	-1000, 1555, -1000, 409, 1554, -1000, -1000, -1000, -1000, -1000,
-	-1000, 24054, -1000, 1553, 22872, -1000, 27600, 1047, 27206, -1000,
-	716, -1000, -1000, -1000, 1026, 1342, 1342, 1342, 1384, -11,
-	-18, 10, -110, 24054, -1000, 146, -110, 9969, 9969, -1000,
-	813, 144, -1000, 173, 19719, -248, -1000, 9554, -1000, 22872,
-	24054, 473, 26812, 1218, 131, -1000, -1000, 1217, -115, 1216,
-	-127, -1000, -1000, -1000, -1000, -1000, -1000, 144, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 25129, 29485, 24733, 25129,
+	756, 45, -1000, 26317, 29089, 880, -1000, -1000, 439, 217,
+	1357, -1000, -94, 1136, -1000, 754, 1133, 1128, -1000, 216,
+	-1000, -1000, -1000, -1000, 880, 880, -1000, 1522, -1000, 395,
+	1520, -1000, 25129, -1000, 1519, 23941, -1000, 25129, 25129, 28693,
+	1027, 28297, -1000, 831, -1000, -1000, -1000, 909, 1333, 1333,
+	1333, 1356, -8, -9, 10, -106, 25129, -1000, 159, -106,
+	10182, 10182, -1000, 748, 158, -1000, 176, 19980, -258, -1000,
+	9765, -1000, 23941, 25129, 483, 27901, 126, 1205, -1000, -1000,
+	1203, -111, 1202, -1000, -1000, -1000, -119, -1000, -1000, -1000,
+	-1000, -1000, -1000, 158, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 26418, 23266, 318, 513,
-	3652, 1215, 2600, 1214, 23266, -1000, 1350, -1000, 1420, 58,
-	-1000, 918, 355, -1000, 1260, 16106, 15700, 1285, 953, -1000,
-	-1000, -1000, -92, 4997, 24054, 1183, 23266, 24054, -1000, 24054,
-	-1000, -1000, 58, -1000, -1000, 26024, -1000, -1000, -1000, 89,
-	-1000, 156, 860, 1434, 1320, -1000, 857, -1000, 1026, 924,
-	1171, 1182, 711, 822, 1056, -1000, 10800, 967, 12045, 828,
-	10, -1000, -1000, 10, 10, 12045, -1000, -1000, 24054, -110,
-	1425, 24054, 1137, -128, -1000, 32512, -1000, -1000, 12045, 12045,
-	12045, 12045, 12045, 687, 142, -1000, -1000, -1000, 6649, -1000,
-	-1000, -248, 142, -21, -1000, -1000, 141, 133, -248, -1000,
-	-1000, -1000, -1000, -1000, 128, 1551, 412, -1000, -1000, -1000,
-	12045, 215, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1178, -1000, 127, 125, 121, 120, -1000, -1000, -1000,
-	-1000, 119, 117, 116, 110, 108, 104, 100, 80, 78,
-	77, 76, 630, -1000, 272, -1000, 272, 272, -1000, 56,
-	56, 61, -1000, -1000, 56, -1000, -1000, -68, -66, -1000,
-	-1000, -1000, -1000, -1000, -1000, 345, 98, 73, -1000, -1000,
-	-1000, 24054, -248, -1000, 4591, 4997, 12045, -129, -1000, 33105,
-	-1000, 258, 230, 1423, 18534, -1000, 24054, 24054, -1000, 24054,
-	-1000, -1000, 24054, 24054, 112, 20114, 849, -7, 399, -118,
-	24054, 24054, -1000, -1000, -1000, 398, 25630, -121, 390, -1000,
-	20902, 1319, 1318, 1301, 107, 24054, 1025, 24054, 763, -9,
-	24054, -121, 727, 22872, 1136, 1420, 334, 1075, -1000, 752,
-	-1000, -1000, -1000, -112, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1523, -1000, -1000, -1000, -1000, 1491, -112,
-	-1000, -1000, -1000, -1000, -1000, 408, -1000, -1000, -1000, -1000,
-	4997, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 27505, 24337, 25129, 22357, -1000, 503, 2902, 1198,
+	2670, 1195, 358, 24337, 25129, -1000, 1329, -1000, 1388, 54,
+	-1000, 886, 362, -1000, 1247, 16349, 15941, 1275, 1094, -1000,
+	-1000, -1000, -92, 5186, 25129, 1165, 24337, 25129, -1000, 25129,
+	-1000, -1000, 54, -1000, -1000, 27109, -1000, -1000, -1000, 103,
+	-1000, 358, -1000, 177, 822, 1391, 26713, -1000, 804, -1000,
+	909, 957, 1147, 1162, 525, 724, 1005, -1000, 11017, 967,
+	12268, 771, 10, -1000, -1000, 10, 10, 12268, -1000, -1000,
+	25129, -106, 1390, 25129, 1127, -166, -1000, 33606, -1000, -1000,
+	12268, 12268, 12268, 12268, 12268, 635, 157, -1000, -1000, -1000,
+	6846, -1000, -1000, -258, 157, -11, -1000, -1000, 156, 155,
+	-258, -1000, -1000, -1000, -1000, -1000, 151, 1518, 412, -1000,
+	-1000, -1000, 12268, 225, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1148, -1000, 139, 137, 132, 127, -1000,
+	-1000, -1000, -1000, 123, 121, 111, 110, 109, 98, 92,
+	91, 90, 80, 78, 608, -1000, 259, -1000, 259, 259,
+	-1000, 62, 62, 65, -1000, -1000, 62, -1000, -1000, -64,
+	-61, -1000, -1000, -1000, -1000, -1000, -1000, 361, 41, 77,
+	-1000, -1000, -1000, 25129, -258, -1000, 4778, 5186, 12268, -123,
+	-1000, 34200, -1000, 230, 79, 1389, 18789, -1000, 25129, -1000,
+	25129, -1000, -1000, 25129, 25129, 25129, 25129, 81, 20377, 797,
+	-81, 382, -114, 25129, 25129, -118, -1000, -1000, -1000, 381,
+	26317, -117, 380, -1000, 21169, 1322, 1317, 1288, 136, 25129,
+	1003, 25129, 716, 24, 25129, -117, 712, 23941, -1000, 1516,
+	622, 1126, -118, 1388, 316, 880, -1000, 549, -1000, -1000,
+	-1000, -107, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1465, -1000, -1000, -1000, -1000, 1464, -107, -1000, -1000,
+	-1000, -1000, -1000, 393, -1000, -1000, -1000, -1000, 5186, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4718,263 +4832,265 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 24054, -1000, -1000, -1000,
-	24054, -1000, 796, 22872, 1089, 1060, 20508, 24054, 224, 1212,
-	-120, 25236, -1000, 1211, 924, 1171, -1000, -1000, -1000, -1000,
-	-1000, 606, 755, -1000, 950, -1000, 373, -1000, -1000, -1000,
-	33105, -1000, 321, -1000, -1000, 33105, 537, 573, 24054, 997,
-	-1000, 997, -113, -1000, 32055, -1000, 72, -134, -1000, 258,
-	14101, 9969, 3607, 24054, 420, 12045, 12045, 12045, 12045, 12045,
-	12045, 12045, 12045, 12045, 12045, 12045, 12045, 12045, 12045, 12045,
-	12045, 12045, 12045, 12045, 919, 388, 1165, 766, 54, 818,
-	4997, -1000, -1000, 1473, 1473, 1473, 3127, 3127, 55, -1000,
-	-248, -253, 32014, -114, -248, -1000, -1000, -1000, 8724, 12045,
-	8309, -248, 5818, -1000, 879, 1542, 266, 33105, 1189, 1111,
-	71, 1541, 1540, 1535, 1534, 12045, 1080, 12045, 12875, 12045,
-	12045, 7064, 12045, 12045, 12045, 12045, -1000, 70, -1000, -1000,
-	-1000, -1000, 1533, -1000, -1000, 1531, -1000, 1529, -1000, 416,
-	368, 1526, -1000, 1520, -1000, 472, 258, 12045, -27, -1000,
-	-1000, -1000, -1000, 366, 9554, -1000, 524, 12045, 812, -1000,
-	17724, 276, 24054, 82, -1000, -1000, -1000, 188, 24054, -1000,
-	131, -1000, -1000, -115, -1000, -1000, 1207, 24054, 24054, 24054,
-	-118, -116, 24054, -1000, 1082, 24054, -1000, 24054, -1000, 3607,
-	24054, -1000, 67, 16, 17, 933, 282, 932, 24054, 24054,
-	-1000, 24842, 24448, 24054, -1000, -1000, -1000, 4997, -1000, 24054,
-	-1000, -1000, -1000, 2324, 24054, 24054, 24054, 1102, 24054, 1075,
-	-1000, -1000, 634, 566, -1000, 16512, -1000, -1000, -1000, 879,
-	1517, -1000, -122, -1000, -1000, -1000, -1000, -1000, -1000, -30,
-	-135, -117, 3607, -1000, -1000, -1000, -1000, 24054, 0, 803,
-	-9, 24054, 231, 809, 845, -1000, 24054, -1000, -1000, -1000,
-	301, -1000, 537, -1000, 12045, 1512, -1000, -1000, -1000, -1000,
-	-1000, 892, -118, 12045, 2821, 150, -1000, -1000, -120, 24054,
-	-1000, 1107, -119, 2124, 22084, 22478, 14891, 22084, -1000, 13705,
-	192, 1135, -1000, -1000, 63, -1000, -1000, 1444, 33173, -1000,
-	-1000, -1000, -1000, 66, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 61, 630, 56, 56, 56, -1000, -1000, -1000, -1000,
-	-1000, -1000, -66, -68, 272, 272, 272, -1000, -1000, 200,
-	347, 347, 450, 450, 450, 1465, 1465, 1465, 1465, 216,
-	216, 216, 1769, 216, 216, 216, 3127, 33166, 3327, 12045,
-	383, 761, 54, 12045, -1000, 1198, -1000, -1000, -1000, 1134,
-	49, -1000, 12875, 12875, -1000, -1000, -1000, 6649, 47, -1000,
-	-1000, -1000, -1000, -1000, 46, 12045, -1000, -1000, 12045, -139,
-	-140, -1000, 33105, -1000, -143, -147, -1000, -1000, -80, 12045,
-	12045, 12045, -31, -1000, 371, -1000, 369, 365, 364, -1000,
-	42, -35, 523, -1000, 12045, 689, 39, 38, 12045, -36,
-	-37, -38, -39, 33072, -40, 1133, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -42, 33036, -43, 2404, -1000, 12875,
-	12875, 12875, 6649, 37, -44, 32493, -221, 32939, 10385, 10385,
-	10385, -46, 32827, 12045, -221, 2851, -148, -154, -155, 1509,
-	-156, -47, -48, 199, 198, -49, -53, 24054, 1107, -161,
-	-1000, -1000, 12045, -1000, -1000, 9969, 33105, 524, 1322, -165,
-	-1000, -1000, 258, 18534, 9554, -166, -1000, 24054, -122, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1016, 12045, 24054, -1000, 1046,
-	24054, -22, 33, 24054, 32, 920, -1000, 1206, 796, 1201,
-	796, -1000, -167, -1000, -101, -1000, -121, 356, -1000, 354,
-	351, -121, 663, -122, 58, -1000, -1000, -1000, -1000, -1000,
-	-56, 24054, 999, -1000, 20508, -1000, 224, 21296, -1000, 1324,
-	-1000, 97, 1419, -1000, 1020, 24054, 1199, -1000, -1000, 739,
-	31985, -1000, -1000, 150, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 983, -57, -1000, -1000, -1000, -1000, -1000, 1101, 1366,
-	14101, 977, 973, 14101, 1037, 699, 699, 699, -1000, -1000,
-	-1000, 24054, 30, -1000, -1000, 19324, 24, 22478, 22084, -248,
-	-1000, 18138, -59, 2124, -1000, 719, 19, 5818, 151, 162,
-	1436, -1000, 1500, 12045, 12045, 3079, 12045, 12875, 12875, -1000,
-	2959, -1000, -1000, -1000, -1000, 1132, 18, -1000, 12045, 3607,
-	2170, 553, -171, -1000, 6649, 7894, -123, -248, 31966, 12045,
-	-1000, -1000, 159, -1000, -1000, -60, 9139, -1000, 32581, -58,
-	-58, -1000, 880, 806, 729, 486, 1499, 1550, 1153, -1000,
-	12045, 32600, -1000, 18929, 264, 732, 31944, -1000, -1000, -1000,
-	-1000, 3607, -1000, 12045, -1000, 1131, 12045, -1000, 3607, 12875,
-	12875, 12875, 12875, 12875, 12875, 12875, 12875, 12875, 12875, 12875,
-	12875, 12875, 12875, 12875, 12875, 981, 12875, 1470, 1470, 1470,
-	-125, 7479, -1000, 1169, 1131, 12045, 12045, 3607, -61, -62,
-	-64, -1000, 12045, -221, 12045, -1000, -1000, -1000, -65, -1000,
-	1498, -1000, 203, -1000, -1000, 203, 1356, -1000, 1101, -1000,
-	32033, -128, -1000, 105, -120, 524, -1000, -1000, 187, 1253,
-	-1000, 17, 282, 31925, -175, 24054, -177, 24054, -1000, 14496,
-	-1000, 24054, -1000, 988, 221, -9, 316, 24054, -1000, 24054,
-	-1000, -1000, 4997, 4179, 4997, 24054, 24054, 24054, 24054, 24054,
-	24054, 1548, -1000, 224, 14, -1000, 1127, 803, -181, -1000,
-	-1000, -1000, 245, 783, 20508, 24054, -120, -1000, 1273, 282,
-	24054, -1000, -1000, -1000, -1000, -1000, -1000, 250, 12045, 12460,
-	2124, 14101, 14101, 2112, 957, 14101, -1000, -1000, -1000, -1000,
-	11, 24054, 7, 24054, 24054, -1000, -1000, -248, 22084, -1000,
-	17330, -80, 447, 1492, 151, 149, -71, 1428, 483, 12045,
-	2314, 467, 444, 12045, 12045, 3607, 33216, -182, -1000, 12045,
-	12045, -1000, 3756, -183, -1000, 12045, -1000, 33105, -1000, 1546,
-	12045, -72, -74, -75, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -76, -1000, -1000, 33105, 12045, -1000, -1000, 31540, 12045,
-	-78, -1000, -81, 33105, 1169, 33105, -1000, 528, 528, 612,
-	612, 612, 1470, 1470, 1470, 1470, 746, 746, 746, 726,
-	746, 746, 746, 1125, 1186, 6, -1000, 2526, 12045, -187,
-	-1000, -1000, -1000, 33105, 33105, -82, -1000, -1000, -1000, -221,
-	3696, -1000, -83, -1000, -1000, -1000, 250, -1000, 1239, 14496,
-	1300, -1000, -1000, 2, -1000, 24054, -1000, 24054, 838, -1000,
-	-1000, 931, 1, 12875, 24054, -1000, -1000, -1000, -1000, -188,
-	-194, -1000, 242, 101, 1334, 12045, -195, 836, -1000, 834,
-	582, -1000, 12045, -1000, -1000, 3607, 796, 796, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -99, 31146, 1491, 1523,
-	-1000, -1000, -1000, 178, -1000, -1000, -1000, -1000, 348, 343,
-	-1000, 803, 24054, 1381, 1381, -1000, -1000, 21296, 736, -1000,
-	503, 496, -84, 0, 231, -1000, -1, 804, -1000, 24054,
-	33105, -152, -1000, 33105, -1000, 5403, -1000, 2112, -1000, -2,
-	12045, 14101, -1000, 24054, -199, 24054, -200, -1000, 3607, -201,
-	3607, -1000, -209, -1000, 1407, -86, -1000, 102, 99, 1480,
-	-1000, 483, 12045, 12045, 12045, 483, 33216, -211, -1000, 3607,
-	483, 483, -1000, -1000, 3574, -1000, 32581, -1000, -1000, -1000,
-	-1000, 33105, 645, -1000, 3443, -1000, -1000, -1000, 12875, 1123,
-	-3, -1000, 3607, 3399, -1000, -1000, -1000, -1000, -1000, 428,
-	-213, -1000, 24054, -1000, -1000, 6234, 1094, -1000, -1000, -1000,
-	12045, 2526, -7, -1000, 262, 14496, 16918, 21690, 24054, 21690,
-	3277, 539, -1000, -1000, -1000, 33105, 1340, -1000, -1000, -93,
-	-99, 4179, 24054, 24054, -1000, -217, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 999, 224, -1000,
-	14496, 24054, -163, -1000, 1404, 12460, -1000, -1000, 24054, 33105,
-	-1000, -227, -1000, -231, -1000, 24054, 1340, -1000, 192, 17330,
-	-1000, -4, -1000, -1000, -1000, 83, 483, 483, 483, -1000,
-	-1000, -1000, -88, 732, 1361, -1000, 1844, 12875, 3607, -235,
-	-1000, 463, -1000, 14496, 258, -1000, -1000, 3217, 895, -1000,
-	-5, -1000, -1000, -1000, -103, 1331, -1000, 24054, -1000, 1331,
-	1334, 24054, 284, 24054, 315, -94, -1000, -1000, -1000, -1000,
-	1253, 224, 803, -236, 282, 24054, -6, -1000, -237, -1000,
-	-1000, -1000, -1000, -1000, -1000, 24054, -1000, -1000, 756, 12045,
-	1844, -241, -1000, 18534, -1000, -1000, 867, 758, 759, 12045,
-	16918, 150, -1000, 150, 21690, -7, -1000, 12045, -1000, -1000,
-	314, 803, -1000, 220, -8, -1000, -1000, -1000, -242, -89,
-	11630, 11630, -221, -1000, -226, -1000, 1017, -1000, 802, 771,
-	560, -1000, -1000, -1000, 3147, -1000, -1000, -1000, 1331, 895,
-	33105, -1000, -1000, 258, -9, 14496, -1000, -1000, -1000, -1000,
-	13290, 741, 514, 32470, -1000, -1000, -1000, -1000, 1256, -1000,
-	294, 889, 889, -1000, 150, 758, -1000, -1000, -246, 1447,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1460, -1000, -1000, 978,
-	-1000, -1000, -1000, 220, 11215, -1000, -1000, -1000, 258, -1000,
-	-1000,
+	-1000, -1000, -1000, -1000, 25129, -1000, -1000, -1000, 25129, -1000,
+	813, 23941, 1051, 1041, -1000, -1000, 20773, 25129, 229, 1193,
+	-99, 739, 796, -1000, 1191, 957, 1147, -1000, -1000, -1000,
+	-1000, -1000, 459, 689, -1000, 925, -1000, 434, -1000, -1000,
+	-1000, 34200, -1000, 330, -1000, -1000, 34200, 547, 564, 25129,
+	973, -1000, 973, -108, -1000, 33151, -1000, 70, -125, -1000,
+	230, 14334, 10182, 34117, 25129, 376, 12268, 12268, 12268, 12268,
+	12268, 12268, 12268, 12268, 12268, 12268, 12268, 12268, 12268, 12268,
+	12268, 12268, 12268, 12268, 12268, 972, 377, 1059, 757, 60,
+	827, 5186, -1000, -1000, 1431, 1431, 1431, 3707, 3707, 38,
+	-1000, -258, -259, 33100, -109, -258, -1000, -1000, -1000, 8931,
+	12268, 8514, -258, 6011, -1000, 783, 1500, 246, 34200, 1103,
+	1072, 69, 1499, 1498, 1496, 1495, 12268, 1077, 12268, 13102,
+	12268, 12268, 7263, 12268, 12268, 12268, 12268, -1000, 68, -1000,
+	-1000, -1000, -1000, 1493, -1000, -1000, 1492, -1000, 1490, -1000,
+	360, 332, 1489, -1000, 1484, -1000, 479, 230, 12268, -16,
+	-1000, -1000, -1000, -1000, 2303, 9765, -1000, 568, 12268, 738,
+	-1000, 17975, 268, 25129, 29, -1000, -1000, -1000, 189, 25129,
+	-1000, -1000, 126, -1000, -1000, -111, -1000, -1000, 1190, 25129,
+	25129, -114, -112, 25129, 25129, -1000, 924, 25129, -1000, 25129,
+	-1000, 34117, 25129, -1000, 67, -17, 27, 910, 275, 906,
+	25129, 25129, -1000, 25921, 25525, 25129, -1000, -1000, -1000, 5186,
+	-1000, 25129, -1000, -1000, -1000, 2517, 25129, 25129, 25129, 2171,
+	-1000, 1515, 25129, 880, -1000, -1000, 609, 567, -1000, -1000,
+	16757, -1000, -1000, -1000, 783, 1482, -1000, -118, -1000, -1000,
+	-1000, -1000, -1000, -19, -127, -113, 34117, -1000, -1000, -1000,
+	-1000, 25129, 11, 714, 24, 25129, 233, 25129, 1184, 25129,
+	-1000, -1000, -1000, 288, -1000, 547, -1000, 12268, 1481, -1000,
+	-1000, -1000, -1000, -1000, 871, -114, 12268, 3603, 205, -1000,
+	-1000, -99, 25129, -1000, 1062, -116, 1382, 23149, 23545, 15128,
+	23149, -1000, 13936, 202, 1122, -1000, -1000, 66, -1000, -1000,
+	1393, 34211, -1000, -1000, -1000, -1000, 59, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 65, 608, 62, 62, 62, -1000,
+	-1000, -1000, -1000, -1000, -1000, -61, -64, 259, 259, 259,
+	-1000, -1000, 215, 364, 364, 449, 449, 449, 1379, 1379,
+	1379, 1379, 228, 228, 228, 497, 228, 228, 228, 3707,
+	34219, 3418, 12268, 375, 735, 60, 12268, -1000, 1217, -1000,
+	-1000, -1000, 1121, 57, -1000, 13102, 13102, -1000, -1000, -1000,
+	6846, 53, -1000, -1000, -1000, -1000, -1000, 51, 12268, -1000,
+	-1000, 12268, -129, -143, -1000, 34200, -1000, -133, -135, -1000,
+	-1000, -78, 12268, 12268, 12268, -21, -1000, 346, -1000, 343,
+	338, 335, -1000, 48, -22, 515, -1000, 12268, 637, 47,
+	46, 12268, -24, -26, -28, -31, 34140, -32, 1107, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -33, 33885, -36,
+	3571, -1000, 13102, 13102, 13102, 6846, 44, -37, 33587, -239,
+	33781, 10600, 10600, 10600, -40, 33706, 12268, -239, 2769, -146,
+	-150, -151, 1476, -152, -41, -42, 212, 211, -47, -50,
+	25129, 1062, -157, -1000, -1000, 12268, -1000, -1000, 10182, 34200,
+	568, 1291, -158, -1000, -1000, 230, 18789, 9765, -159, -1000,
+	25129, -118, -1000, -1000, -1000, -1000, -1000, -1000, 929, 12268,
+	25129, -1000, 1018, 25129, -34, 42, 25129, 40, 711, -1000,
+	1179, 813, 1171, 813, -1000, -164, -1000, -82, -1000, -117,
+	333, -1000, 329, 327, -117, 683, -1000, -118, 54, -1000,
+	-1000, -1000, -1000, -1000, -51, 974, -1000, 20773, -1000, 229,
+	21565, -1000, 1298, -1000, 131, 1386, -1000, 983, 275, 25129,
+	-1000, -1000, 676, 33081, -1000, -1000, 205, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 969, -52, -1000, -1000, -1000, -1000,
+	-1000, 1056, 1355, 14334, 963, 955, 14334, 1134, 641, 641,
+	641, -1000, -1000, -1000, 25129, 39, -1000, -1000, 19583, 37,
+	23545, 23149, -258, -1000, 18391, -53, 1382, -1000, 662, 35,
+	6011, 128, 166, 1383, -1000, 1472, 12268, 12268, 2988, 12268,
+	13102, 13102, -1000, 824, -1000, -1000, -1000, -1000, 1104, 30,
+	-1000, 12268, 34117, 3556, 3385, -168, -1000, 6846, 8097, -120,
+	-258, 33059, 12268, -1000, -1000, 162, -1000, -1000, -54, 9348,
+	-1000, 33639, -76, -76, -1000, 857, 722, 589, 495, 1471,
+	1511, 1216, -1000, 12268, 33658, -1000, 19186, 242, 664, 33040,
+	-1000, -1000, -1000, -1000, 34117, -1000, 12268, -1000, 1099, 12268,
+	-1000, 34117, 13102, 13102, 13102, 13102, 13102, 13102, 13102, 13102,
+	13102, 13102, 13102, 13102, 13102, 13102, 13102, 13102, 1083, 13102,
+	1427, 1427, 1427, -144, 7680, -1000, 1144, 1099, 12268, 12268,
+	34117, -55, -56, -57, -1000, 12268, -239, 12268, -1000, -1000,
+	-1000, -60, -1000, 1468, -1000, 203, -1000, -1000, 203, 1342,
+	-1000, 1056, -1000, 33129, -166, -1000, 116, -99, 568, -1000,
+	-1000, 184, 1261, -1000, 27, 275, 3890, -170, 25129, -172,
+	25129, -1000, 14731, -1000, 25129, -1000, 959, 210, 24, 307,
+	25129, -1000, 25129, -1000, -1000, 5186, 4364, 5186, 25129, 25129,
+	25129, 25129, 25129, 25129, 1510, 229, 22, -1000, 1080, 714,
+	-174, -1000, -1000, -1000, 235, 874, 20773, 25129, -99, -1000,
+	1264, 21, 720, -1000, -1000, -1000, -1000, -1000, -1000, 237,
+	12268, 12685, 1382, 14334, 14334, 1524, 951, 14334, -1000, -1000,
+	-1000, -1000, 19, 25129, 18, 25129, 25129, -1000, -1000, -258,
+	23149, -1000, 17579, -78, 440, 1467, 128, 31, -63, 1363,
+	517, 12268, 153, 2453, 960, 12268, 12268, 34117, 2182, -179,
+	-1000, 12268, 12268, -1000, 3818, -180, -1000, 12268, -1000, 34200,
+	-1000, 1509, 12268, -66, -67, -68, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -69, -1000, -1000, 34200, 12268, -1000, -1000,
+	32653, 12268, -71, -1000, -72, 34200, 1144, 34200, -1000, 541,
+	541, 574, 574, 574, 1427, 1427, 1427, 1427, 1159, 1159,
+	1159, 1904, 1159, 1159, 1159, 1096, 1146, 16, -1000, 3632,
+	12268, -184, -1000, -1000, -1000, 34200, 34200, -74, -1000, -1000,
+	-1000, -239, 3782, -1000, -77, -1000, -1000, -1000, 237, -1000,
+	1228, 14731, 1287, -1000, -1000, 14, -1000, 25129, -1000, 25129,
+	787, -1000, -1000, 903, 12, 13102, 25129, -1000, -1000, -1000,
+	-1000, -185, -186, -1000, 234, 117, 1325, 12268, -190, 784,
+	-1000, 780, 591, -1000, 12268, -1000, -1000, 34117, 813, 813,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -94, 32257,
+	1464, 1465, -1000, -1000, -1000, 181, -1000, -1000, -1000, -1000,
+	326, 325, -1000, 714, 25129, 1209, 1209, -1000, -1000, 21565,
+	671, -1000, 514, 512, -79, 11, 233, -1000, 25129, 25129,
+	-1000, 25129, 34200, -209, -1000, 34200, -1000, 5594, -1000, 1524,
+	-1000, 9, 12268, 14334, -1000, 25129, -191, 25129, -192, -1000,
+	34117, -196, 34117, -1000, -198, -1000, 1378, -84, -1000, 118,
+	55, 1455, -1000, 517, 12268, 12268, 12268, 517, 2182, -200,
+	-1000, 34117, 517, 517, -1000, -1000, 3749, -1000, 33639, -1000,
+	-1000, -1000, -1000, 34200, 625, -1000, 3521, -1000, -1000, -1000,
+	13102, 1095, 7, -1000, 34117, 3453, -1000, -1000, -1000, -1000,
+	-1000, 432, -204, -1000, 25129, -1000, -1000, 6429, 820, -1000,
+	-1000, -1000, 12268, 3632, -81, -1000, 240, 14731, 17165, 22753,
+	25129, 22753, 3262, 581, -1000, -1000, -1000, 34200, 1327, -1000,
+	-1000, -89, -94, 4364, 25129, 25129, -1000, -210, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 974,
+	229, -1000, -219, 275, -217, -1000, 1377, 12685, -1000, -1000,
+	25129, 34200, -1000, -220, -1000, -224, -1000, 25129, 1327, -1000,
+	202, 17579, -1000, 4, -1000, -1000, -1000, -15, 517, 517,
+	517, -1000, -1000, -1000, -86, 664, 1353, -1000, 2537, 13102,
+	34117, -226, -1000, 447, -1000, 14731, 230, -1000, -1000, 3187,
+	866, -1000, 3, -1000, -1000, -1000, -98, 1320, -1000, 25129,
+	-1000, 1320, 1325, 25129, 274, 25129, 304, -91, -1000, -1000,
+	-1000, -1000, 1261, 229, 714, -1000, 2, 25129, -5, -1000,
+	-234, -1000, -1000, -1000, -1000, -1000, -1000, 25129, -1000, -1000,
+	534, 12268, 2537, -238, -1000, 18789, -1000, -1000, 830, 697,
+	703, 12268, 17165, 205, -1000, 205, 22753, -81, -1000, 12268,
+	-1000, -1000, 300, 714, -1000, 25129, -1000, -1000, -1000, -240,
+	-87, 11851, 11851, -239, -1000, -218, -1000, 994, -1000, 701,
+	699, 561, -1000, -1000, -1000, 3092, -1000, -1000, -1000, 1320,
+	866, 34200, -1000, -1000, -242, -1000, -1000, -1000, -1000, 13519,
+	742, 522, 33170, -1000, -1000, -1000, -1000, 1243, -1000, 280,
+	853, 853, -1000, 205, 697, -1000, 1396, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1410, -1000, -1000, 927, -1000, -1000, -1000,
+	11434, -1000, -1000, -1000, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1794, 1792, 1458, 1789, 1788, 1784, 1783, 1782, 1781,
-	1779, 1778, 148, 132, 1777, 1776, 149, 1774, 131, 1772,
-	1771, 1768, 58, 1764, 1762, 1760, 1759, 129, 92, 2318,
-	168, 162, 1757, 1753, 1751, 10, 8, 135, 32, 125,
-	54, 124, 101, 49, 1748, 20, 0, 1747, 1744, 154,
-	22, 28, 1791, 1743, 18, 1742, 1741, 74, 1739, 39,
-	51, 1738, 17, 3, 30, 1736, 1733, 12, 88, 31,
-	1732, 5, 191, 1731, 90, 137, 1730, 67, 66, 151,
-	24, 274, 46, 102, 1729, 19, 109, 1728, 65, 112,
-	1725, 57, 1724, 106, 156, 139, 652, 47, 77, 1719,
-	1718, 27, 1717, 103, 1716, 1715, 9, 1713, 157, 155,
-	136, 1711, 1710, 585, 96, 48, 100, 1706, 1703, 1701,
-	1700, 107, 115, 89, 1698, 79, 70, 1694, 1693, 98,
-	1688, 1687, 153, 152, 1685, 1684, 120, 1681, 1680, 1679,
-	1678, 1677, 143, 1676, 1673, 95, 126, 104, 60, 34,
-	1672, 76, 40, 6, 73, 26, 1041, 1006, 1671, 110,
-	78, 122, 91, 1668, 23, 1667, 1666, 33, 1665, 38,
-	29, 1664, 140, 1663, 1661, 1660, 1659, 1658, 108, 1654,
-	1653, 105, 36, 71, 94, 97, 127, 25, 113, 117,
-	163, 169, 141, 1650, 144, 1648, 644, 55, 1647, 1266,
-	114, 1646, 1638, 1632, 1258, 1221, 1218, 870, 1629, 1625,
-	1117, 1113, 1622, 1620, 84, 111, 83, 1619, 68, 1618,
-	165, 1613, 167, 75, 37, 145, 1611, 123, 1610, 1381,
-	159, 142, 41, 59, 1609, 1608, 50, 45, 1607, 42,
-	2, 7, 13, 14, 4, 99, 134, 72, 1606, 11,
-	146, 118, 1595, 308, 1594, 1593, 69, 1592, 1589, 15,
-	1587, 21, 1583, 16, 1, 1581, 164, 1577, 133, 1572,
-	1488, 1570, 166, 1566, 1586, 116,
+	0, 1793, 1792, 1406, 1791, 1789, 1787, 1786, 1785, 1783,
+	1781, 1780, 1779, 1778, 141, 1777, 122, 1774, 1773, 142,
+	1766, 120, 1765, 1760, 1756, 114, 1754, 1752, 1751, 1748,
+	118, 108, 2471, 180, 160, 1745, 1744, 1742, 5, 2,
+	128, 124, 29, 62, 125, 90, 50, 1740, 45, 1703,
+	1737, 1734, 565, 19, 20, 156, 1733, 25, 1726, 1720,
+	72, 1713, 30, 54, 1711, 104, 21, 1708, 1700, 16,
+	83, 23, 1694, 1693, 28, 1692, 82, 131, 1690, 78,
+	99, 139, 17, 12, 44, 98, 1689, 13, 100, 1688,
+	60, 105, 1687, 61, 1680, 103, 157, 135, 611, 14,
+	76, 1678, 1673, 27, 1670, 95, 1664, 1663, 11, 1662,
+	149, 155, 132, 1661, 1660, 163, 89, 48, 85, 1658,
+	1656, 1655, 1654, 101, 113, 87, 1653, 81, 74, 1650,
+	96, 1646, 145, 151, 1645, 1640, 121, 1638, 1637, 1636,
+	1635, 1625, 137, 1624, 1619, 91, 126, 94, 49, 36,
+	1618, 75, 41, 8, 73, 206, 900, 720, 1617, 106,
+	79, 119, 86, 1616, 24, 1613, 1610, 26, 1608, 37,
+	34, 1607, 130, 1602, 1601, 1598, 1596, 1595, 102, 1594,
+	1593, 97, 32, 80, 84, 88, 115, 22, 53, 112,
+	144, 161, 134, 1591, 138, 1590, 748, 58, 1576, 1397,
+	111, 1575, 1572, 1571, 1357, 1323, 1117, 744, 1570, 1569,
+	1053, 1005, 1568, 1567, 77, 107, 93, 1565, 57, 1564,
+	153, 1563, 184, 127, 39, 133, 1562, 123, 1560, 0,
+	225, 200, 42, 51, 1559, 1558, 70, 40, 1557, 43,
+	6, 3, 9, 7, 1, 92, 280, 31, 1555, 52,
+	147, 116, 1553, 168, 1552, 1551, 63, 1550, 1548, 15,
+	1544, 18, 1538, 10, 4, 1534, 159, 1532, 129, 1531,
+	1428, 162, 1409, 1530, 158, 1529, 1451, 110,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 4, 5, 5, 6, 6, 7, 7, 39,
-	39, 37, 37, 37, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 33, 33, 40, 40, 40,
-	38, 38, 34, 34, 62, 63, 63, 92, 92, 151,
-	151, 151, 151, 8, 8, 8, 8, 13, 14, 14,
-	14, 14, 14, 14, 131, 79, 79, 78, 78, 82,
-	82, 15, 15, 16, 16, 16, 16, 16, 195, 195,
-	194, 17, 21, 266, 266, 266, 270, 270, 271, 271,
-	272, 272, 268, 268, 23, 23, 23, 142, 142, 142,
-	141, 141, 141, 141, 143, 143, 143, 143, 222, 220,
-	220, 227, 227, 55, 55, 55, 55, 219, 219, 219,
-	219, 228, 228, 228, 228, 228, 228, 228, 56, 56,
-	56, 226, 226, 144, 24, 24, 24, 24, 24, 24,
-	24, 221, 221, 267, 267, 269, 269, 11, 11, 11,
-	11, 57, 57, 60, 60, 58, 58, 61, 61, 145,
-	145, 145, 148, 148, 146, 150, 237, 237, 238, 238,
-	238, 239, 239, 239, 239, 239, 239, 239, 234, 235,
-	235, 235, 236, 236, 236, 236, 236, 233, 233, 147,
-	147, 147, 147, 147, 147, 147, 147, 217, 217, 67,
-	67, 242, 242, 242, 242, 168, 168, 169, 163, 163,
-	240, 240, 240, 240, 240, 243, 241, 244, 244, 244,
-	244, 244, 59, 59, 64, 64, 106, 106, 106, 106,
-	245, 12, 12, 103, 43, 43, 43, 182, 182, 182,
-	152, 152, 152, 152, 152, 26, 105, 105, 105, 10,
-	10, 127, 127, 128, 128, 45, 45, 71, 71, 187,
-	187, 187, 51, 51, 50, 50, 50, 35, 35, 35,
-	36, 36, 36, 104, 104, 104, 80, 80, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 129, 129, 130,
-	130, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 274, 274, 274, 42, 42,
-	42, 42, 42, 41, 41, 41, 96, 96, 9, 9,
-	18, 53, 53, 138, 138, 138, 140, 140, 140, 139,
-	139, 139, 98, 98, 27, 85, 85, 86, 86, 193,
-	87, 87, 22, 22, 29, 29, 28, 28, 28, 28,
-	28, 28, 28, 28, 30, 30, 31, 31, 31, 31,
-	31, 31, 31, 251, 251, 251, 253, 253, 250, 19,
-	19, 19, 19, 252, 252, 273, 273, 113, 113, 113,
-	65, 65, 66, 66, 69, 69, 68, 70, 70, 186,
-	186, 94, 94, 94, 94, 95, 95, 132, 132, 133,
-	133, 134, 134, 135, 135, 135, 136, 136, 137, 137,
-	93, 93, 100, 100, 101, 101, 102, 160, 160, 109,
-	109, 110, 110, 111, 111, 108, 44, 44, 44, 107,
-	107, 107, 107, 112, 112, 32, 32, 75, 75, 76,
-	76, 188, 188, 188, 188, 188, 188, 188, 189, 189,
-	189, 189, 189, 189, 183, 183, 183, 183, 184, 184,
-	185, 185, 185, 185, 185, 116, 116, 116, 116, 114,
-	114, 115, 115, 190, 190, 190, 190, 99, 99, 191,
-	191, 191, 161, 161, 164, 165, 165, 166, 166, 167,
-	167, 153, 153, 97, 97, 149, 196, 196, 196, 196,
-	196, 196, 74, 74, 74, 197, 197, 197, 197, 197,
-	197, 197, 197, 198, 198, 198, 198, 200, 200, 200,
-	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
-	199, 201, 201, 208, 208, 209, 209, 210, 211, 202,
-	202, 203, 203, 204, 205, 212, 212, 212, 215, 215,
-	214, 214, 206, 206, 206, 206, 206, 207, 216, 216,
-	216, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 123, 123, 155, 155, 155,
+	4, 4, 4, 4, 5, 5, 6, 6, 7, 7,
+	8, 41, 41, 40, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 40, 36, 36, 43,
+	43, 43, 42, 42, 37, 37, 65, 94, 94, 151,
+	151, 151, 151, 9, 9, 9, 9, 9, 9, 15,
+	273, 273, 274, 274, 12, 16, 17, 17, 17, 17,
+	17, 17, 17, 17, 81, 81, 80, 80, 84, 84,
+	18, 18, 19, 19, 19, 19, 19, 195, 195, 194,
+	20, 20, 24, 24, 266, 266, 266, 270, 270, 270,
+	271, 271, 271, 271, 272, 272, 268, 268, 26, 26,
+	26, 142, 142, 142, 141, 141, 141, 141, 143, 143,
+	143, 143, 222, 220, 220, 227, 227, 58, 58, 58,
+	58, 58, 219, 219, 219, 219, 228, 228, 228, 228,
+	228, 228, 228, 59, 59, 59, 226, 226, 144, 27,
+	27, 27, 27, 27, 27, 27, 27, 221, 221, 267,
+	267, 269, 269, 13, 13, 13, 13, 60, 60, 63,
+	63, 61, 61, 64, 64, 145, 145, 145, 148, 148,
+	146, 150, 237, 237, 238, 238, 238, 239, 239, 239,
+	239, 239, 239, 239, 234, 235, 235, 235, 236, 236,
+	236, 236, 236, 233, 233, 147, 147, 147, 147, 147,
+	147, 147, 147, 217, 217, 69, 69, 240, 240, 240,
+	240, 168, 168, 169, 163, 163, 241, 241, 241, 241,
+	241, 243, 242, 244, 244, 244, 244, 244, 62, 62,
+	66, 66, 108, 108, 108, 108, 245, 14, 14, 105,
+	46, 46, 46, 182, 182, 182, 152, 152, 152, 152,
+	152, 29, 107, 107, 107, 11, 11, 129, 129, 48,
+	48, 73, 73, 187, 187, 187, 54, 54, 53, 53,
+	53, 38, 38, 38, 39, 39, 39, 106, 106, 106,
+	82, 82, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 130, 130, 131, 131, 28, 28, 28, 28, 28,
+	28, 28, 28, 28, 28, 28, 28, 28, 28, 276,
+	276, 276, 45, 45, 45, 45, 45, 44, 44, 44,
+	98, 98, 10, 10, 21, 56, 56, 138, 138, 138,
+	140, 140, 140, 139, 139, 139, 100, 100, 30, 87,
+	87, 88, 88, 193, 89, 89, 25, 25, 32, 32,
+	31, 31, 31, 31, 31, 31, 31, 31, 33, 33,
+	34, 34, 34, 34, 34, 34, 34, 251, 251, 251,
+	253, 253, 250, 22, 22, 22, 22, 252, 252, 275,
+	275, 115, 115, 115, 67, 67, 68, 68, 71, 71,
+	70, 72, 72, 186, 186, 96, 96, 96, 96, 97,
+	97, 132, 132, 133, 133, 134, 134, 135, 135, 135,
+	136, 136, 137, 137, 95, 95, 102, 102, 103, 103,
+	104, 160, 160, 111, 111, 112, 112, 113, 113, 110,
+	47, 47, 47, 109, 109, 109, 109, 114, 114, 35,
+	35, 77, 77, 78, 78, 188, 188, 188, 188, 188,
+	188, 188, 189, 189, 189, 189, 189, 189, 183, 183,
+	183, 183, 184, 184, 185, 185, 185, 185, 185, 118,
+	118, 118, 118, 116, 116, 117, 117, 190, 190, 190,
+	190, 101, 101, 191, 191, 191, 161, 161, 164, 165,
+	165, 166, 166, 167, 167, 153, 153, 99, 99, 149,
+	196, 196, 196, 196, 196, 196, 76, 76, 76, 197,
+	197, 197, 197, 197, 197, 197, 197, 198, 198, 198,
+	198, 200, 200, 200, 199, 199, 199, 199, 199, 199,
+	199, 199, 199, 199, 199, 201, 201, 208, 208, 209,
+	209, 210, 211, 202, 202, 203, 203, 204, 205, 212,
+	212, 212, 215, 215, 214, 214, 206, 206, 206, 206,
+	206, 207, 216, 216, 216, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 125,
+	125, 155, 155, 155, 155, 155, 155, 155, 155, 155,
 	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
 	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
 	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
 	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
 	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
-	155, 155, 155, 155, 155, 155, 156, 156, 156, 156,
 	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
 	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 246, 246, 246,
-	246, 246, 246, 246, 248, 248, 249, 249, 247, 247,
+	156, 156, 156, 156, 156, 156, 156, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 246, 246, 246, 246, 246, 246, 246, 248, 248,
+	249, 249, 247, 247, 247, 247, 247, 247, 247, 247,
 	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
 	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
-	247, 247, 247, 247, 247, 247, 254, 254, 255, 255,
-	256, 256, 257, 257, 259, 260, 260, 260, 261, 265,
-	265, 258, 258, 262, 262, 262, 263, 263, 264, 264,
-	264, 264, 264, 172, 172, 172, 173, 173, 174, 180,
-	180, 180, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 54, 54, 54, 48, 48,
-	48, 81, 81, 170, 170, 162, 162, 162, 171, 171,
-	117, 117, 213, 213, 213, 213, 213, 213, 213, 213,
-	118, 118, 124, 119, 119, 120, 120, 120, 120, 120,
-	120, 125, 126, 121, 121, 121, 159, 159, 175, 179,
-	179, 178, 177, 177, 176, 176, 154, 154, 154, 154,
-	154, 88, 88, 89, 89, 275, 275, 181, 181, 90,
-	90, 91, 84, 84, 83, 83, 192, 192, 192, 192,
-	77, 77, 52, 52, 72, 72, 73, 73, 49, 49,
-	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
-	158, 158, 218, 218, 218, 46, 46, 46, 47, 47,
-	224, 224, 224, 225, 225, 225, 225, 223, 223, 223,
-	223, 223, 229, 229, 229, 229, 229, 229, 229, 229,
+	254, 254, 255, 255, 256, 256, 257, 257, 259, 260,
+	260, 260, 261, 265, 265, 258, 258, 262, 262, 262,
+	263, 263, 264, 264, 264, 264, 264, 172, 172, 172,
+	173, 173, 174, 180, 180, 180, 57, 57, 57, 57,
+	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
+	57, 57, 51, 51, 51, 83, 83, 170, 170, 162,
+	162, 162, 171, 171, 119, 119, 213, 213, 213, 213,
+	213, 213, 213, 213, 120, 120, 126, 121, 121, 122,
+	122, 122, 122, 122, 122, 127, 128, 123, 123, 123,
+	159, 159, 175, 179, 179, 178, 177, 177, 176, 176,
+	154, 154, 154, 154, 154, 90, 90, 91, 91, 277,
+	277, 181, 181, 92, 92, 93, 86, 86, 85, 85,
+	192, 192, 192, 192, 79, 79, 55, 55, 74, 74,
+	75, 75, 52, 52, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 218, 218, 218, 49,
+	49, 49, 50, 50, 224, 224, 224, 225, 225, 225,
+	225, 223, 223, 223, 223, 223, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
@@ -4999,114 +5115,114 @@ var sqlR1 = [...]int{
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
 	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 231, 231,
+	229, 229, 229, 229, 231, 231, 231, 231, 231, 231,
 	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
 	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
 	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
-	231, 231, 231, 231, 231, 231, 231, 231, 230, 230,
+	231, 231, 231, 231, 230, 230, 230, 230, 230, 230,
 	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 232, 232, 232, 232,
+	230, 230, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
 	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 1, 1, 3, 4, 4, 6, 4, 6, 1,
-	3, 2, 3, 4, 6, 6, 6, 5, 5, 6,
-	4, 8, 2, 3, 3, 6, 4, 2, 2, 2,
-	3, 2, 2, 2, 2, 3, 2, 1, 1, 0,
-	2, 0, 2, 0, 3, 2, 0, 1, 3, 3,
-	1, 5, 3, 1, 1, 1, 1, 6, 6, 4,
-	3, 5, 3, 5, 1, 1, 3, 1, 2, 2,
-	3, 2, 5, 1, 1, 1, 1, 1, 1, 3,
-	1, 6, 6, 1, 2, 2, 1, 1, 1, 3,
-	1, 1, 1, 3, 2, 3, 3, 2, 5, 1,
-	3, 3, 3, 3, 1, 3, 3, 2, 1, 1,
-	3, 1, 1, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 3, 5, 1, 1, 1, 1, 1,
-	0, 1, 1, 2, 4, 2, 4, 4, 3, 3,
-	2, 2, 0, 2, 0, 2, 0, 9, 12, 8,
-	11, 1, 0, 3, 0, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 4, 2, 0, 3, 1,
-	2, 2, 1, 1, 2, 5, 2, 5, 3, 3,
-	3, 0, 1, 1, 1, 1, 1, 3, 1, 4,
-	4, 2, 4, 5, 3, 6, 10, 2, 0, 3,
-	0, 2, 2, 2, 0, 1, 3, 3, 4, 0,
-	1, 1, 2, 2, 0, 3, 3, 2, 1, 1,
-	2, 2, 4, 0, 2, 0, 3, 4, 4, 0,
-	3, 6, 9, 4, 2, 3, 0, 1, 2, 1,
-	1, 1, 1, 1, 1, 5, 2, 2, 0, 13,
-	16, 1, 0, 1, 0, 2, 0, 1, 3, 5,
-	5, 7, 2, 0, 1, 2, 0, 1, 1, 0,
-	2, 2, 0, 1, 4, 5, 1, 3, 6, 6,
-	8, 6, 8, 8, 10, 8, 10, 1, 0, 2,
-	0, 2, 3, 3, 2, 2, 2, 2, 3, 2,
-	5, 4, 3, 3, 3, 1, 1, 0, 3, 2,
-	2, 1, 2, 1, 3, 2, 1, 0, 3, 6,
-	7, 1, 3, 1, 4, 2, 8, 5, 0, 4,
-	3, 0, 2, 0, 8, 1, 3, 1, 1, 3,
-	5, 5, 1, 1, 3, 3, 1, 2, 4, 4,
-	2, 3, 5, 5, 1, 1, 8, 8, 1, 2,
-	4, 4, 4, 2, 2, 3, 1, 3, 6, 1,
-	1, 1, 1, 1, 0, 1, 0, 1, 1, 0,
-	1, 5, 1, 0, 1, 0, 3, 1, 3, 4,
-	3, 2, 2, 1, 1, 1, 0, 2, 5, 2,
-	3, 1, 1, 1, 3, 0, 1, 1, 1, 1,
-	3, 0, 1, 3, 1, 1, 2, 2, 0, 1,
-	3, 1, 0, 1, 2, 3, 1, 2, 0, 2,
-	4, 2, 3, 2, 0, 2, 3, 2, 0, 1,
-	3, 2, 2, 3, 2, 3, 1, 4, 3, 4,
-	5, 4, 5, 4, 5, 2, 4, 1, 1, 0,
-	1, 4, 5, 4, 0, 2, 2, 2, 1, 1,
-	0, 4, 2, 1, 2, 2, 4, 1, 3, 1,
-	2, 3, 2, 6, 2, 1, 3, 4, 0, 2,
-	0, 2, 0, 1, 3, 3, 2, 3, 5, 6,
-	2, 3, 3, 4, 0, 1, 1, 1, 1, 2,
-	4, 1, 1, 1, 1, 1, 1, 3, 5, 0,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
-	1, 3, 0, 1, 1, 1, 1, 5, 2, 1,
-	1, 1, 1, 5, 2, 2, 2, 1, 1, 0,
-	3, 0, 1, 5, 2, 5, 2, 1, 3, 3,
-	0, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 0, 1, 4, 1, 3, 3,
-	5, 2, 2, 2, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 3, 4, 4, 6, 4, 6,
+	4, 1, 3, 2, 3, 4, 6, 6, 6, 5,
+	5, 6, 4, 8, 2, 3, 3, 6, 4, 2,
+	2, 2, 3, 2, 2, 2, 2, 3, 2, 1,
+	1, 0, 2, 0, 2, 0, 3, 1, 3, 3,
+	1, 5, 3, 1, 1, 1, 1, 1, 1, 4,
+	1, 0, 2, 3, 3, 6, 4, 6, 3, 5,
+	3, 5, 3, 3, 1, 3, 1, 2, 2, 3,
+	2, 5, 1, 1, 1, 1, 1, 1, 3, 1,
+	6, 4, 6, 4, 1, 2, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 2, 3,
+	3, 2, 5, 1, 3, 3, 3, 3, 1, 3,
+	3, 2, 1, 1, 3, 1, 1, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 5,
+	1, 1, 1, 1, 1, 0, 1, 1, 2, 4,
+	2, 4, 4, 3, 2, 3, 2, 2, 0, 2,
+	0, 2, 0, 9, 12, 8, 11, 1, 0, 3,
+	0, 1, 3, 1, 3, 1, 1, 1, 1, 1,
+	3, 4, 2, 0, 3, 1, 2, 2, 1, 1,
+	2, 5, 2, 5, 3, 3, 3, 0, 1, 1,
+	1, 1, 1, 3, 1, 4, 4, 2, 4, 5,
+	3, 6, 10, 2, 0, 3, 0, 2, 2, 2,
+	0, 1, 3, 3, 4, 0, 1, 1, 2, 2,
+	0, 3, 3, 2, 1, 1, 2, 2, 4, 0,
+	2, 0, 3, 4, 4, 0, 3, 6, 9, 4,
+	2, 3, 0, 1, 2, 1, 1, 1, 1, 1,
+	1, 5, 2, 2, 0, 10, 13, 1, 0, 2,
+	0, 1, 3, 5, 5, 7, 2, 0, 1, 2,
+	0, 1, 1, 0, 2, 2, 0, 1, 4, 5,
+	1, 3, 6, 6, 8, 6, 8, 8, 10, 8,
+	10, 1, 0, 2, 0, 2, 3, 3, 2, 2,
+	2, 2, 3, 2, 5, 4, 3, 3, 3, 1,
+	1, 0, 3, 2, 2, 1, 2, 1, 3, 2,
+	1, 0, 3, 6, 7, 1, 3, 1, 4, 2,
+	8, 5, 0, 4, 3, 0, 2, 0, 8, 1,
+	3, 1, 1, 3, 5, 5, 1, 1, 3, 3,
+	1, 2, 4, 4, 2, 3, 5, 5, 1, 1,
+	8, 8, 1, 2, 4, 4, 4, 2, 2, 3,
+	1, 3, 6, 1, 1, 1, 1, 1, 0, 1,
+	0, 1, 1, 0, 1, 5, 1, 0, 1, 0,
+	3, 1, 3, 4, 3, 2, 2, 1, 1, 1,
+	0, 2, 5, 2, 3, 1, 1, 1, 3, 0,
+	1, 1, 1, 1, 3, 0, 1, 3, 1, 1,
+	2, 2, 0, 1, 3, 1, 0, 1, 2, 3,
+	1, 2, 0, 2, 4, 2, 3, 2, 0, 2,
+	3, 2, 0, 1, 3, 2, 2, 3, 2, 3,
+	1, 4, 3, 4, 5, 4, 5, 4, 5, 2,
+	4, 1, 1, 0, 1, 4, 5, 4, 0, 2,
+	2, 2, 1, 1, 0, 4, 2, 1, 2, 2,
+	4, 1, 3, 1, 2, 3, 2, 6, 2, 1,
+	3, 4, 0, 2, 0, 2, 0, 1, 3, 3,
+	2, 3, 5, 6, 2, 3, 3, 4, 0, 1,
+	1, 1, 1, 2, 4, 1, 1, 1, 1, 1,
+	1, 3, 5, 0, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 3, 0, 1, 1, 1,
+	1, 5, 2, 1, 1, 1, 1, 5, 2, 2,
+	2, 1, 1, 0, 3, 0, 1, 5, 2, 5,
+	2, 1, 3, 3, 0, 1, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 0, 1,
+	4, 1, 3, 3, 5, 2, 2, 2, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 2, 3, 5, 4, 6, 4, 6,
-	5, 7, 3, 4, 3, 3, 4, 3, 4, 3,
-	4, 5, 6, 6, 7, 6, 7, 6, 7, 3,
-	4, 4, 6, 2, 3, 4, 1, 3, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 5, 6, 6,
-	7, 3, 4, 1, 1, 2, 4, 1, 1, 1,
-	2, 2, 2, 2, 1, 1, 4, 3, 5, 6,
-	8, 6, 6, 4, 4, 1, 1, 1, 5, 1,
-	1, 4, 1, 4, 1, 4, 1, 4, 1, 1,
-	1, 1, 6, 4, 4, 4, 4, 6, 5, 5,
-	5, 4, 6, 4, 4, 4, 5, 0, 5, 0,
-	2, 0, 1, 3, 3, 2, 2, 0, 6, 1,
-	0, 3, 0, 2, 2, 0, 1, 4, 2, 2,
-	2, 2, 2, 4, 3, 5, 4, 3, 5, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 3, 1, 3, 3, 3, 2, 1, 3,
-	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
-	4, 3, 2, 3, 0, 3, 3, 2, 2, 1,
-	0, 2, 2, 3, 2, 1, 1, 3, 5, 1,
-	2, 4, 2, 0, 1, 0, 2, 2, 2, 3,
-	5, 1, 2, 0, 2, 1, 0, 1, 1, 1,
-	3, 3, 1, 0, 1, 3, 3, 2, 1, 1,
-	1, 3, 1, 2, 1, 3, 3, 0, 1, 2,
-	1, 1, 1, 1, 1, 6, 2, 3, 5, 1,
-	1, 1, 1, 2, 2, 1, 1, 1, 1, 0,
+	3, 3, 3, 3, 3, 3, 2, 2, 3, 5,
+	4, 6, 4, 6, 5, 7, 3, 4, 3, 3,
+	4, 3, 4, 3, 4, 5, 6, 6, 7, 6,
+	7, 6, 7, 3, 4, 4, 6, 2, 3, 4,
+	1, 3, 2, 2, 2, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 5, 6, 6, 7, 3, 4, 1, 1, 2,
+	4, 1, 1, 1, 2, 2, 2, 2, 1, 1,
+	4, 3, 5, 6, 8, 6, 6, 4, 4, 1,
+	1, 1, 5, 1, 1, 4, 1, 4, 1, 4,
+	1, 4, 1, 1, 1, 1, 6, 4, 4, 4,
+	4, 6, 5, 5, 5, 4, 6, 4, 4, 4,
+	5, 0, 5, 0, 2, 0, 1, 3, 3, 2,
+	2, 0, 6, 1, 0, 3, 0, 2, 2, 0,
+	1, 4, 2, 2, 2, 2, 2, 4, 3, 5,
+	4, 3, 5, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 1, 3, 1, 3, 3,
+	3, 2, 1, 3, 3, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 4, 3, 2, 3, 0, 3,
+	3, 2, 2, 1, 0, 2, 2, 3, 2, 1,
+	1, 3, 5, 1, 2, 4, 2, 0, 1, 0,
+	2, 2, 2, 3, 5, 1, 2, 0, 2, 1,
+	0, 1, 1, 1, 3, 3, 1, 0, 1, 3,
+	3, 2, 1, 1, 1, 3, 1, 2, 1, 3,
+	3, 0, 1, 2, 1, 1, 1, 1, 1, 6,
+	2, 3, 5, 1, 1, 1, 1, 2, 2, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5146,459 +5262,463 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -8, -13, -14, -15, -17,
-	-18, -20, -21, -22, -23, -24, -25, -26, -27, -5,
-	-6, -7, -9, -10, -11, -12, -252, 128, 144, 165,
-	27, 314, -28, -29, 333, 337, 17, 44, 347, 75,
-	134, 316, 321, 304, 284, 372, 89, -251, -31, -30,
-	430, 401, 412, 326, -32, 358, 392, 433, 116, 195,
-	385, -131, 103, 358, 185, -16, 430, -22, -18, -27,
-	-13, -12, 89, -270, 25, -271, -272, 294, 406, 103,
-	185, 358, -270, -142, 221, 331, 366, -143, -141, -222,
-	362, 238, -78, -46, 4, -229, -231, 17, 18, 19,
-	20, 21, 22, 23, 24, 26, 27, 28, 36, 37,
-	39, 40, 42, 43, 44, 52, 53, 54, 55, 56,
-	59, 62, 64, 65, 66, 67, 72, 73, 74, 75,
-	76, 79, 80, 81, 83, 84, 85, 86, 87, 88,
-	91, 92, 93, 100, 101, 102, 103, 104, 106, 107,
-	110, 112, 114, 115, 116, 117, 118, 120, 121, 122,
-	125, 126, 127, 128, 129, 131, 132, 133, 135, 136,
-	137, 139, 140, 141, 142, 144, 145, 146, 149, 151,
-	152, 154, 156, 158, 162, 163, 164, 166, 167, 171,
-	173, 174, 175, 176, 177, 178, 179, 180, 181, 183,
-	184, 185, 186, 187, 188, 190, 193, 194, 195, 196,
-	202, 204, 206, 207, 208, 209, 210, 213, 216, 219,
-	220, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 235, 236, 237, 242, 243, 246, 247, 248,
-	251, 253, 254, 255, 257, 260, 261, 264, 267, 270,
-	271, 272, 273, 274, 275, 276, 278, 279, 281, 284,
-	285, 283, 287, 288, 289, 290, 291, 292, 293, 294,
-	296, 297, 298, 299, 301, 302, 303, 304, 305, 306,
-	307, 308, 309, 310, 311, 313, 314, 316, 317, 319,
-	320, 321, 322, 323, 324, 325, 327, 328, 329, 330,
-	333, 334, 336, 337, 339, 340, 342, 344, 345, 346,
-	347, 348, 349, 350, 351, 352, 353, 356, 357, 359,
-	360, 367, 369, 372, 373, 374, 375, 376, 377, 378,
-	381, 382, 383, 384, 385, 388, 389, 390, 391, 395,
-	397, 402, 403, 404, 405, 406, 407, 408, 409, 45,
-	46, 48, 50, 60, 61, 68, 105, 108, 109, 143,
-	147, 153, 168, 170, 192, 197, 198, 200, 214, 239,
-	241, 244, 250, 252, 265, 269, 280, 282, 295, 318,
-	335, 341, 354, 363, 368, 370, 392, 393, 72, 104,
-	167, 185, 359, 362, 25, -274, 404, 366, -274, 366,
-	-274, 285, -274, -274, 285, -46, 221, 238, 331, 366,
-	362, 321, -46, 366, -273, 358, 358, -127, 103, 380,
-	-30, -31, -29, -68, -69, 379, 199, 138, 263, -28,
-	-29, -251, -253, 298, -250, -46, -253, -66, -65, 25,
-	123, 434, -190, -52, 259, -46, -91, 430, -3, 160,
-	201, -191, -190, 177, -79, -78, -46, 177, -79, 177,
-	-195, -194, -225, 4, -229, -231, -230, 392, 41, 47,
-	70, 78, 90, 159, 161, 191, 203, 205, 215, 217,
-	240, 266, 268, 315, 338, 396, 358, 258, 434, -46,
-	-52, 177, -190, 177, 258, -142, 331, -142, 62, -41,
-	-42, 204, 294, 113, 245, 364, 415, 160, 409, -56,
-	6, 111, -82, 432, 160, -267, 258, 160, -221, 160,
-	409, -96, -41, -96, 6, 364, 6, -46, 6, -99,
-	-190, -78, 177, -103, 185, -46, 177, -68, -69, -109,
-	-94, -111, 155, -132, -133, -108, 218, 150, 256, -107,
-	-113, 25, 123, -113, -113, 52, 431, 431, 434, -253,
-	-73, 430, -84, -83, -192, -155, 419, -157, 417, 418,
-	427, 245, 410, -172, 380, -52, -158, 10, 430, -175,
-	-248, -29, 143, 33, -173, -174, 170, 318, -46, 9,
-	5, 6, 7, 8, -49, -198, -207, 371, 148, 249,
-	57, -246, -247, 4, -229, -224, -199, -209, -203, -206,
-	200, 70, 95, 97, 98, 222, 223, 96, 99, 332,
-	386, 58, 147, 269, 280, 354, 368, 370, 250, 68,
-	168, 214, 127, 46, 48, 50, 60, 61, 105, 108,
-	109, 153, 197, 198, 252, 295, 341, 362, 363, 393,
-	-230, -210, -211, -204, -205, -212, -83, 258, -91, 419,
-	-52, 430, -88, -154, 432, 435, 428, -90, -181, -155,
-	111, -191, -53, -52, 333, -46, 34, 143, -40, 434,
-	55, 311, 143, 143, 431, 434, 177, -78, -266, -77,
-	358, 103, -52, -272, -144, 305, 333, -39, 305, -37,
-	21, 27, 128, 390, 333, 187, 243, 254, 245, 309,
-	143, -39, 305, 143, -266, 62, 34, 434, -42, 216,
-	259, 406, 113, -220, 111, -227, -219, -182, 371, 148,
-	258, -226, 5, 418, -218, -225, 6, 9, 417, -220,
-	111, 93, -228, 6, 4, -207, -182, 111, 221, 200,
-	432, -223, 4, -229, -231, -230, -232, 25, 29, 30,
-	31, 32, 33, 34, 35, 38, 51, 57, 58, 63,
-	69, 71, 82, 89, 94, 95, 96, 97, 98, 99,
-	111, 113, 119, 123, 124, 130, 134, 138, 148, 150,
-	155, 157, 160, 165, 169, 172, 182, 189, 199, 201,
-	211, 212, 218, 222, 223, 245, 249, 256, 258, 259,
-	262, 263, 277, 286, 300, 312, 326, 332, 343, 355,
-	358, 361, 364, 365, 371, 379, 380, 386, 387, 394,
-	398, 399, 400, 401, -222, -269, 155, -266, -222, -222,
-	321, -46, -105, 434, 85, 310, 430, 254, -67, 245,
-	34, -128, 78, 245, -109, -94, -95, -94, -110, -109,
-	-108, 155, 294, 385, 243, 336, 206, -133, -132, -134,
-	-155, 25, -137, 152, 242, -155, -157, -112, 254, -30,
-	-30, -30, -70, -186, -155, -250, 34, -72, -46, -75,
-	160, 434, 11, 69, 39, 417, 418, 419, 420, 421,
-	424, 422, 423, 425, 413, 414, 415, 77, 13, 14,
-	15, 31, 262, 217, 410, 338, 203, 45, 182, -48,
-	34, 4, -54, -155, -155, -155, -155, -155, 268, -29,
-	-89, -29, -155, -81, -88, -29, -29, -162, 428, 430,
-	430, -88, 430, 6, 6, 430, -176, -155, -254, 402,
-	155, 430, 430, 430, 430, 430, 430, 430, 430, 430,
-	430, 430, 430, 430, 430, 430, 282, -215, 395, -215,
-	-215, -200, 430, -200, -201, 430, -200, 430, -216, 412,
-	403, 430, -216, 430, -214, 61, -75, 430, -52, -154,
-	-223, 419, -223, -155, 434, 431, -153, 399, -138, -22,
-	430, 111, 34, -85, -86, -193, -87, -52, 430, -46,
-	-79, -78, -46, -79, -16, -194, 245, 430, 364, 434,
-	-77, -72, 364, -142, 434, 364, -146, 71, -233, -46,
-	82, -147, 63, 380, 185, 286, 139, 157, -129, 82,
-	71, -129, 82, 82, 227, 383, -62, 430, -52, 187,
-	-78, 254, -62, -52, 364, -129, 82, -190, 160, 366,
-	-42, -55, 294, 306, 329, 434, 5, 9, 9, 6,
-	430, -223, -268, -46, -46, -40, -190, 176, 176, -57,
-	-72, -58, -46, -145, -146, -234, -233, 217, -78, -64,
-	401, 143, -22, -47, 177, -46, 143, -95, -110, 259,
-	206, 336, -135, -218, 430, 418, -136, 318, 319, -44,
-	248, 340, -77, 434, 387, -35, 35, 119, 430, 434,
-	431, -153, -76, -188, -190, -161, 211, -29, -189, 430,
-	-249, 319, -246, -247, -49, -192, -196, -197, 335, -199,
-	-208, -202, -206, -207, 49, 360, 197, 198, 341, 46,
-	295, 153, 127, 109, 108, 252, 50, -210, -211, -204,
-	-205, 105, 363, 362, 48, 61, 60, 393, -78, 362,
+	-1000, -1, -2, -3, -4, -9, -16, -17, -18, -20,
+	-21, -23, -24, -25, -26, -27, -28, -29, -30, -5,
+	-6, -7, -8, -10, -11, -12, -13, -14, -15, -252,
+	128, 144, 165, 27, 314, -31, -32, 334, 338, 17,
+	44, 348, 75, 134, 317, 322, 304, 284, 373, 89,
+	-251, -34, -33, 432, 403, 414, 327, -35, 359, 394,
+	435, 116, 195, 386, 185, 103, 359, 387, 316, -19,
+	432, -25, -21, -30, -16, -14, 89, -270, -272, 25,
+	-271, -49, 89, 165, 327, 4, -229, -231, 17, 18,
+	19, 20, 21, 22, 23, 24, 26, 27, 28, 36,
+	37, 39, 40, 42, 43, 44, 52, 53, 54, 55,
+	56, 59, 62, 64, 65, 66, 67, 72, 73, 74,
+	75, 76, 79, 80, 81, 83, 84, 85, 86, 87,
+	88, 91, 92, 93, 100, 101, 102, 103, 104, 106,
+	107, 110, 112, 114, 115, 116, 117, 118, 120, 121,
+	122, 125, 126, 127, 128, 129, 131, 132, 133, 135,
+	136, 137, 139, 140, 141, 142, 144, 145, 146, 149,
+	151, 152, 154, 156, 158, 162, 163, 164, 166, 167,
+	171, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	183, 184, 185, 186, 187, 188, 190, 193, 194, 195,
+	196, 202, 204, 206, 207, 208, 209, 210, 213, 216,
+	219, 220, 221, 224, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 242, 243,
+	246, 247, 248, 251, 253, 254, 255, 257, 260, 261,
+	264, 267, 270, 271, 272, 273, 274, 275, 276, 278,
+	279, 281, 284, 285, 283, 287, 288, 289, 290, 291,
+	292, 293, 294, 296, 297, 298, 299, 301, 302, 303,
+	304, 305, 306, 307, 308, 309, 310, 311, 313, 314,
+	316, 317, 318, 320, 321, 322, 323, 324, 325, 326,
+	328, 329, 330, 331, 332, 334, 335, 337, 338, 340,
+	341, 343, 345, 346, 347, 348, 349, 350, 351, 352,
+	353, 354, 357, 358, 360, 361, 367, 368, 370, 373,
+	374, 375, 376, 377, 378, 379, 382, 383, 384, 385,
+	386, 388, 390, 391, 392, 393, 397, 399, 404, 405,
+	406, 407, 408, 409, 410, 411, 45, 46, 48, 50,
+	60, 61, 68, 105, 108, 109, 143, 147, 153, 168,
+	170, 192, 197, 198, 200, 214, 239, 241, 244, 250,
+	252, 265, 269, 280, 282, 295, 319, 336, 342, 355,
+	363, 364, 369, 371, 394, 395, 103, 185, 359, 387,
+	-270, -272, -142, 221, 332, 367, -143, -141, -222, 363,
+	238, -80, -49, 72, 104, 167, 185, 360, 388, 363,
+	25, -276, 406, 367, -276, 367, -276, 285, -276, -276,
+	285, -49, 322, -49, 367, -275, 359, 387, 316, 359,
+	-129, 103, 381, -33, -34, -32, -70, -71, 380, 199,
+	138, 263, -31, -32, -251, -253, 298, -250, -49, -253,
+	-68, -67, 25, 123, 436, -190, -55, 259, -49, -93,
+	432, -3, 160, 201, -191, -190, -79, 177, -55, -49,
+	177, -81, 177, -80, -49, -49, -195, -194, -225, 4,
+	-229, -231, -230, 394, 41, 47, 70, 78, 90, 159,
+	161, 191, 203, 205, 215, 217, 240, 266, 268, 315,
+	339, 398, 359, 258, 365, 436, 288, -49, -55, 177,
+	-190, 177, -49, 258, 160, -142, 332, -142, 62, -44,
+	-45, 204, 294, 113, 245, 365, 417, 160, 411, -59,
+	6, 111, -84, 434, 160, -267, 258, 160, -221, 160,
+	411, -98, -44, -98, 6, 365, 6, -49, 6, -101,
+	-190, -49, -49, -80, 177, -105, 185, -49, 177, -70,
+	-71, -111, -96, -113, 155, -132, -133, -110, 218, 150,
+	256, -109, -115, 25, 123, -115, -115, 52, 433, 433,
+	436, -253, -75, 432, -86, -85, -192, -155, 421, -157,
+	419, 420, 429, 245, 412, -172, 381, -55, -158, 10,
+	432, -175, -248, -32, 143, 33, -173, -174, 170, 319,
+	-49, 9, 5, 6, 7, 8, -52, -198, -207, 372,
+	148, 249, 57, -246, -247, 4, -229, -224, -199, -209,
+	-203, -206, 200, 70, 95, 97, 98, 222, 223, 96,
+	99, 333, 387, 58, 147, 269, 280, 355, 369, 371,
+	250, 68, 168, 214, 127, 46, 48, 50, 60, 61,
+	105, 108, 109, 153, 197, 198, 252, 295, 342, 363,
+	364, 395, -230, -210, -211, -204, -205, -212, -85, 258,
+	-93, 421, -55, 432, -90, -154, 434, 437, 430, -92,
+	-181, -155, 111, -191, -56, -55, 334, -49, 34, -43,
+	436, 55, 311, 143, 143, 436, 143, 433, 436, 177,
+	-80, -266, -79, 359, 103, -268, -49, -271, -144, 305,
+	334, -41, 305, -40, 21, 27, 128, 392, 334, 187,
+	243, 254, 245, 309, 143, -41, 305, 143, -274, 276,
+	403, -266, -268, 62, 34, 436, -45, 216, 259, 408,
+	113, -220, 111, -227, -219, -182, 372, 148, 258, -226,
+	5, 420, -218, -225, 6, 9, 419, -220, 111, 93,
+	-228, 6, 4, -207, -182, 111, 221, 200, 434, -223,
+	4, -229, -231, -230, -232, 25, 29, 30, 31, 32,
+	33, 34, 35, 38, 51, 57, 58, 63, 69, 71,
+	82, 89, 94, 95, 96, 97, 98, 99, 111, 113,
+	119, 123, 124, 130, 134, 138, 148, 150, 155, 157,
+	160, 165, 169, 172, 182, 189, 199, 201, 211, 212,
+	218, 222, 223, 245, 249, 256, 258, 259, 262, 263,
+	277, 286, 300, 312, 327, 333, 344, 356, 359, 362,
+	365, 366, 372, 380, 381, 387, 389, 396, 400, 401,
+	402, 403, -222, -269, 155, -266, -222, -222, 322, -49,
+	-107, 436, 85, 310, -273, -274, 432, 254, -69, 245,
+	34, -50, 177, -49, 245, -111, -96, -97, -96, -112,
+	-111, -110, 155, 294, 386, 243, 337, 206, -133, -132,
+	-134, -155, 25, -137, 152, 242, -155, -157, -114, 254,
+	-33, -33, -33, -72, -186, -155, -250, 34, -74, -49,
+	-77, 160, 436, 11, 69, 39, 419, 420, 421, 422,
+	423, 426, 424, 425, 427, 415, 416, 417, 77, 13,
+	14, 15, 31, 262, 217, 412, 339, 203, 45, 182,
+	-51, 34, 4, -57, -155, -155, -155, -155, -155, 268,
+	-32, -91, -32, -155, -83, -90, -32, -32, -162, 430,
+	432, 432, -90, 432, 6, 6, 432, -176, -155, -254,
+	404, 155, 432, 432, 432, 432, 432, 432, 432, 432,
+	432, 432, 432, 432, 432, 432, 432, 282, -215, 397,
+	-215, -215, -200, 432, -200, -201, 432, -200, 432, -216,
+	414, 405, 432, -216, 432, -214, 61, -77, 432, -55,
+	-154, -223, 421, -223, -155, 436, 433, -153, 401, -138,
+	-25, 432, 111, 34, -87, -88, -193, -89, -55, 432,
+	-49, -55, -79, -49, -80, -81, -19, -194, 245, 432,
+	365, -79, -74, 436, 365, -142, 436, 365, -146, 71,
+	-233, -49, 82, -147, 63, 381, 185, 286, 139, 157,
+	-130, 82, 71, -130, 82, 82, 227, 384, -65, 432,
+	-55, 187, -80, 254, -65, -55, 365, -130, 82, -190,
+	6, 276, 160, 367, -45, -58, 294, 306, 343, 330,
+	436, 5, 9, 9, 6, 432, -223, -268, -49, -43,
+	-190, 176, 176, -60, -74, -61, -49, -145, -146, -234,
+	-233, 217, -80, -66, 403, 143, -25, 258, 245, 143,
+	-97, -112, 259, 206, 337, -135, -218, 432, 420, -136,
+	319, 320, -47, 248, 341, -79, 436, 389, -38, 35,
+	119, 432, 436, 433, -153, -78, -188, -190, -161, 211,
+	-32, -189, 432, -249, 320, -246, -247, -52, -192, -196,
+	-197, 336, -199, -208, -202, -206, -207, 49, 361, 197,
+	198, 342, 46, 295, 153, 127, 109, 108, 252, 50,
+	-210, -211, -204, -205, 105, 364, 363, 48, 61, 60,
+	395, -80, 363, -155, -155, -155, -155, -155, -155, -155,
 	-155, -155, -155, -155, -155, -155, -155, -155, -155, -155,
-	-155, -155, -155, -155, -155, -155, -155, -155, -155, 217,
-	338, 45, 182, 364, 249, 245, 371, 148, 381, 123,
-	254, 125, -275, 355, 38, -159, -29, 430, -180, 32,
-	343, 25, -223, -172, 318, 430, -154, 431, 434, -81,
-	-171, 429, -155, -162, -81, -81, 431, 431, -81, 394,
-	25, 123, 419, -122, 407, 235, 106, 175, 232, -123,
-	324, 9, -179, -178, 398, -255, 151, 169, 430, 9,
-	9, 9, 9, -155, -117, -213, 4, 407, 235, 106,
-	175, 232, 324, 6, -118, -155, -119, -156, -157, 417,
-	418, 427, 430, 318, -120, -155, -81, -155, 51, 212,
-	365, -121, -155, 160, -81, -155, -81, -81, -81, 430,
-	9, 9, 9, 362, 362, 9, 9, 333, -153, -81,
-	431, 429, 436, -181, -98, 312, -155, -140, 258, -77,
-	392, -46, -75, 434, 415, -77, -40, 143, -268, -52,
-	-46, -37, -46, -146, -196, -46, 430, 430, -245, 387,
-	430, 206, -45, 387, 206, -46, -46, 177, -46, 177,
-	-46, -46, -92, -151, -223, -52, -39, 305, -46, -46,
-	-46, -39, 305, -268, -41, 377, 76, 294, -227, -122,
-	9, 434, 431, 431, 434, -52, -60, 430, -106, 258,
-	-62, -78, -103, -43, 401, 258, 245, -46, 385, -136,
-	-155, 226, -186, -54, 417, 418, 419, 420, 421, 422,
-	423, 424, 425, 413, 414, 415, 77, 13, 14, 15,
-	-36, 411, -19, -22, -18, -27, -13, -46, -93, 169,
-	434, 90, -116, 205, 240, 161, 215, 315, 191, -184,
-	-183, 34, -46, -185, -183, 34, -46, -161, -29, -46,
-	-184, -29, -189, -188, -167, 412, 160, 430, -74, 33,
-	-197, -122, 430, 409, 136, -155, 364, -275, 355, -159,
-	-155, 249, 371, 148, 381, 123, 254, 125, 160, 430,
-	-156, -156, -81, -29, 430, 430, -81, -89, -155, 434,
-	429, 429, 434, 431, 431, -69, 434, -68, -155, -81,
-	-81, 431, 364, 364, 364, 364, 430, 431, -177, -178,
-	130, -155, -260, 267, 430, 430, -155, 431, 431, 431,
-	431, 34, 431, 160, 431, -124, 277, 431, 11, 417,
-	418, 419, 420, 421, 424, 422, 423, 425, 413, 414,
-	415, 77, 13, 14, 15, 203, 182, -156, -156, -156,
-	-81, 430, 431, -125, -126, 160, 155, 34, -121, -121,
-	-121, 431, 160, -81, 434, 431, 431, 431, 9, 431,
-	434, 431, 431, 409, 409, 431, 431, -46, -93, 431,
-	-155, -83, -98, 80, 431, -153, -86, -181, 431, -237,
-	-147, 185, 139, -155, -72, 185, -72, 430, -245, 430,
-	-46, 430, -33, 128, 333, 309, -130, 143, -40, 143,
-	-40, 431, 434, 415, 432, 364, 364, 364, 364, -129,
-	82, 431, -46, -59, 188, -145, -235, -64, -61, -148,
-	-150, -233, -46, 75, 430, 254, 34, 102, 243, -52,
-	143, 259, 431, -36, 152, 210, 431, -160, 172, 52,
-	-188, 205, 205, -188, -116, 205, -114, 266, -114, -114,
-	-46, 430, -46, 430, 430, -185, -184, -88, 431, 264,
-	430, -81, 428, 428, -74, 33, 9, -155, -155, 136,
-	-155, -156, -156, 136, 160, 430, -155, -170, -196, 31,
-	31, 431, -155, -81, 431, 434, 431, -155, -162, 431,
-	394, -69, -69, -69, 235, 175, 232, -123, 232, -123,
-	-123, 9, 6, 134, -155, 361, -261, -46, 430, 399,
-	-68, 431, -196, -155, -125, -155, -196, -156, -156, -156,
+	-155, -155, 217, 339, 45, 182, 365, 249, 245, 372,
+	148, 382, 123, 254, 125, -277, 356, 38, -159, -32,
+	432, -180, 32, 344, 25, -223, -172, 319, 432, -154,
+	433, 436, -83, -171, 431, -155, -162, -83, -83, 433,
+	433, -83, 396, 25, 123, 421, -124, 409, 235, 106,
+	175, 232, -125, 325, 9, -179, -178, 400, -255, 151,
+	169, 432, 9, 9, 9, 9, -155, -119, -213, 4,
+	409, 235, 106, 175, 232, 325, 6, -120, -155, -121,
+	-156, -157, 419, 420, 429, 432, 319, -122, -155, -83,
+	-155, 51, 212, 366, -123, -155, 160, -83, -155, -83,
+	-83, -83, 432, 9, 9, 9, 363, 363, 9, 9,
+	334, -153, -83, 433, 431, 438, -181, -100, 312, -155,
+	-140, 258, -79, 394, -49, -77, 436, 417, -79, -43,
+	143, -268, -49, -49, -40, -49, -146, -196, -49, 432,
+	432, -245, 389, 432, 206, -48, 389, 206, -49, -49,
+	177, -49, 177, -49, -49, -94, -151, -223, -55, -41,
+	305, -49, -49, -49, -41, 305, 6, -268, -44, 378,
+	76, 294, -227, -124, 9, 433, 433, 436, -55, -63,
+	432, -108, 258, -65, -80, -105, -46, 403, -55, 143,
+	-49, 386, -136, -155, 226, -186, -57, 419, 420, 421,
+	422, 423, 424, 425, 426, 427, 415, 416, 417, 77,
+	13, 14, 15, -39, 413, -22, -25, -21, -30, -16,
+	-49, -95, 169, 436, 90, -118, 205, 240, 161, 215,
+	315, 191, -184, -183, 34, -49, -185, -183, 34, -49,
+	-161, -32, -49, -184, -32, -189, -188, -167, 414, 160,
+	432, -76, 33, -197, -124, 432, 411, 136, -155, 365,
+	-277, 356, -159, -155, 249, 372, 148, 382, 123, 254,
+	125, 160, 432, -156, -156, -83, -32, 432, 432, -83,
+	-91, -155, 436, 431, 431, 436, 433, 433, -71, 436,
+	-70, -155, -83, -83, 433, 365, 365, 365, 365, 432,
+	433, -177, -178, 130, -155, -260, 267, 432, 432, -155,
+	433, 433, 433, 433, 34, 433, 160, 433, -126, 277,
+	433, 11, 419, 420, 421, 422, 423, 426, 424, 425,
+	427, 415, 416, 417, 77, 13, 14, 15, 203, 182,
+	-156, -156, -156, -83, 432, 433, -127, -128, 160, 155,
+	34, -123, -123, -123, 433, 160, -83, 436, 433, 433,
+	433, 9, 433, 436, 433, 433, 411, 411, 433, 433,
+	-49, -95, 433, -155, -85, -100, 80, 433, -153, -88,
+	-181, 433, -237, -147, 185, 139, -155, -74, 185, -74,
+	432, -245, 432, -49, 432, -36, 128, 334, 309, -131,
+	143, -43, 143, -43, 433, 436, 417, 434, 365, 365,
+	365, 365, -130, 82, 433, -62, 188, -145, -235, -66,
+	-64, -148, -150, -233, -49, 75, 432, 254, 34, 102,
+	243, -48, -49, 259, 433, -39, 152, 210, 433, -160,
+	172, 52, -188, 205, 205, -188, -118, 205, -116, 266,
+	-116, -116, -49, 432, -49, 432, 432, -185, -184, -90,
+	433, 264, 432, -83, 430, 430, -76, 33, 9, -155,
+	-155, 136, -155, -156, -156, 136, 160, 432, -155, -170,
+	-196, 31, 31, 433, -155, -83, 433, 436, 433, -155,
+	-162, 433, 396, -71, -71, -71, 235, 175, 232, -125,
+	232, -125, -125, 9, 6, 134, -155, 362, -261, -49,
+	432, 401, -70, 433, -196, -155, -127, -155, -196, -156,
 	-156, -156, -156, -156, -156, -156, -156, -156, -156, -156,
-	-156, -156, -156, 123, 245, 254, 125, -156, 434, -81,
-	431, -126, -125, -155, -155, -196, 431, 431, 431, -81,
-	-155, 431, 9, -216, -216, -214, -160, 429, -139, 430,
-	258, -22, -98, 415, -238, 82, -239, 69, 245, 249,
-	380, 286, 63, 111, 300, 431, 431, -46, 431, -72,
-	-168, -169, -187, -46, -249, 430, -72, 245, 111, 245,
-	349, -62, 111, 102, -62, 374, -46, -46, -151, -152,
-	-104, -232, -54, -182, 6, -196, -224, 335, 417, 418,
-	4, -229, 360, -223, -46, -46, -46, -46, -46, -46,
-	6, -64, 430, 183, 140, -106, 431, 434, 401, 128,
-	116, 283, -57, -78, -22, 102, -45, -46, -256, 400,
-	-155, -100, -101, -155, -102, 430, -188, -188, -115, 387,
-	258, 205, -188, 430, -72, 430, -97, -149, -46, -97,
-	-46, -183, -165, -164, -249, -69, 429, 9, 9, 428,
-	431, -155, 136, 31, 31, -155, -155, -170, 431, 434,
-	-155, -155, 431, 431, -155, 6, -155, 431, 431, 431,
-	431, -155, -265, -46, -155, 431, 431, -126, 160, 123,
-	254, 125, 430, -155, 431, 431, 431, 431, -256, 124,
-	-71, -187, 82, -91, -29, 430, -46, -78, 249, 206,
-	430, -156, -52, 431, 431, 434, 401, -51, 69, -51,
-	-155, 431, 249, 249, -218, -155, -196, -40, -40, -82,
-	-224, 415, 364, 364, -106, -77, -236, 112, 83, 186,
-	74, 25, -236, -148, 261, 319, 319, 431, -60, -43,
-	430, 258, -257, -259, -46, 434, 431, -115, 430, -155,
-	-188, -72, 431, -97, 431, 434, -196, 431, 431, 434,
-	-166, 34, 431, 429, 429, 9, -155, -155, -155, 431,
-	-196, 431, -69, -258, 274, 431, -156, 160, 430, -170,
-	431, 385, 246, 434, 431, -46, -239, -155, -67, -163,
-	399, -169, -80, -54, -46, -50, -78, 387, -78, -50,
-	431, 300, -38, 69, 421, -82, -152, -46, -46, 431,
-	-237, -59, -64, -71, -52, 434, 34, -101, -72, 431,
-	431, -149, -38, -167, -164, 430, 429, 431, -69, 52,
-	-156, -170, 431, 333, -187, -153, 431, -242, 229, 430,
-	432, -35, -78, -35, -51, -52, -34, 387, -78, 374,
-	421, -64, -106, 431, -45, -259, -261, 431, -97, -262,
-	293, 319, -81, 431, -85, -217, 243, -240, -243, -241,
-	258, 161, 273, 339, -155, -80, -36, -36, -50, -67,
-	-155, 374, -106, -63, 401, 430, 431, 431, -263, -264,
-	45, 376, 93, -155, -263, -153, 187, -241, 258, -243,
-	258, 385, 116, 431, -35, -242, -153, -62, -71, -264,
-	281, 154, 318, 281, 154, -244, 243, 311, 55, 333,
-	-244, -36, -240, 431, 31, 20, 249, 111, -63, -264,
-	-153,
+	-156, -156, -156, -156, -156, 123, 245, 254, 125, -156,
+	436, -83, 433, -128, -127, -155, -155, -196, 433, 433,
+	433, -83, -155, 433, 9, -216, -216, -214, -160, 431,
+	-139, 432, 258, -25, -100, 417, -238, 82, -239, 69,
+	245, 249, 381, 286, 63, 111, 300, 433, 433, -49,
+	433, -74, -168, -169, -187, -49, -249, 432, -74, 245,
+	111, 245, 350, -65, 111, 102, -65, 375, -49, -49,
+	-151, -152, -106, -232, -57, -182, 6, -196, -224, 336,
+	419, 420, 4, -229, 361, -223, -49, -49, -49, -49,
+	-49, -49, 6, -66, 432, 183, 140, -108, 433, 436,
+	403, 128, 116, 283, -60, -80, -25, 102, 432, 258,
+	-256, 402, -155, -102, -103, -155, -104, 432, -188, -188,
+	-117, 389, 258, 205, -188, 432, -74, 432, -99, -149,
+	-49, -99, -49, -183, -165, -164, -249, -71, 431, 9,
+	9, 430, 433, -155, 136, 31, 31, -155, -155, -170,
+	433, 436, -155, -155, 433, 433, -155, 6, -155, 433,
+	433, 433, 433, -155, -265, -49, -155, 433, 433, -128,
+	160, 123, 254, 125, 432, -155, 433, 433, 433, 433,
+	-256, 124, -73, -187, 82, -93, -32, 432, -49, -80,
+	249, 206, 432, -156, -55, 433, 433, 436, 403, -54,
+	69, -54, -155, 433, 249, 249, -218, -155, -196, -43,
+	-43, -84, -224, 417, 365, 365, -108, -79, -236, 112,
+	83, 186, 74, 25, -236, -148, 261, 320, 320, 433,
+	-63, -46, -74, -55, -257, -259, -49, 436, 433, -117,
+	432, -155, -188, -74, 433, -99, 433, 436, -196, 433,
+	433, 436, -166, 34, 433, 431, 431, 9, -155, -155,
+	-155, 433, -196, 433, -71, -258, 274, 433, -156, 160,
+	432, -170, 433, 386, 246, 436, 433, -49, -239, -155,
+	-69, -163, 401, -169, -82, -57, -49, -53, -80, 389,
+	-80, -53, 433, 300, -42, 69, 423, -84, -152, -49,
+	-49, 433, -237, -62, -66, 433, -48, 436, 34, -103,
+	-74, 433, 433, -149, -42, -167, -164, 432, 431, 433,
+	-71, 52, -156, -170, 433, 334, -187, -153, 433, -240,
+	229, 432, 434, -38, -80, -38, -54, -55, -37, 389,
+	-80, 375, 423, -66, -108, 432, -259, -261, 433, -99,
+	-262, 293, 320, -83, 433, -87, -217, 243, -241, -243,
+	-242, 258, 161, 273, 340, -155, -82, -39, -39, -53,
+	-69, -155, 375, -108, -74, 433, 433, -263, -264, 45,
+	377, 93, -155, -263, -153, 187, -242, 258, -243, 258,
+	386, 116, 433, -38, -240, 433, -264, 281, 154, 319,
+	281, 154, -244, 243, 311, 55, 334, -244, -39, -241,
+	31, 20, 249, 111, -264,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 20,
-	21, 22, 73, 74, 75, 76, 0, 0, 394, 0,
-	0, 0, 362, -2, 0, 0, 327, 327, 0, 327,
-	327, 327, 0, 0, 0, 396, 272, 393, -2, 405,
-	0, 0, 0, 403, 378, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 84, 91, 0, 93, 94, 95,
-	96, 97, 0, 0, 106, 107, 108, 110, 111, 0,
-	0, 0, 0, 114, 1026, 1107, 1129, 119, 124, 0,
-	1192, -2, 128, 87, 885, 886, 887, 902, 903, 904,
-	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
-	935, 936, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 951, 952, 953, 954,
-	955, 956, 957, 958, 959, 960, 961, 962, 963, 964,
-	965, 966, 967, 968, 969, 970, 971, 972, 973, 974,
-	975, 976, 977, 978, 979, 980, 981, 982, 983, 984,
-	985, 986, 987, 988, 989, 990, 991, 992, 993, 994,
-	995, 996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004,
-	1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014,
-	1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024,
-	1025, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035,
-	1036, 1037, 1038, 1039, 1040, 1042, 1043, 1044, 1045, 1046,
-	1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
-	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
+	21, 22, 23, 73, 74, 75, 76, 77, 78, 0,
+	0, 408, 0, 0, 0, 376, -2, 0, 0, 341,
+	341, 0, 341, 341, 341, 0, 0, 0, 410, 288,
+	407, -2, 419, 0, 0, 0, 417, 392, 0, 0,
+	-2, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 102, 103, 104, 105, 106, 0, 0, 119, 117,
+	124, 120, 121, 122, 123, 899, 900, 901, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 955, 956, 957,
+	958, 959, 960, 961, 962, 963, 964, 965, 966, 967,
+	968, 969, 970, 971, 972, 973, 974, 975, 976, 977,
+	978, 979, 980, 981, 982, 983, 984, 985, 986, 987,
+	988, 989, 990, 991, 992, 993, 994, 995, 996, 997,
+	998, 999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007,
+	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
+	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
+	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
+	1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
+	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
+	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087,
+	1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
+	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107,
 	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
 	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
-	1128, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
-	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
-	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
-	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
-	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1193, 1194, 1195, 1196, 1197, 0, 155,
-	164, 0, 162, 0, 160, 311, 325, 326, 337, 337,
-	314, 0, 315, 316, 0, 317, 1026, 1041, 1107, 1129,
-	1192, 1098, 319, 0, 0, 395, 0, 0, 0, 271,
-	-2, 374, 375, -2, 0, 399, 399, 399, 0, 0,
-	375, 0, 383, 1079, 386, 867, 384, 853, 0, 402,
-	400, 0, 379, 493, 0, 862, 455, 0, 2, 0,
-	0, 0, 499, 999, 59, 85, 80, 999, 82, 999,
-	0, 98, 100, 893, 894, 895, 896, 1196, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
-	1210, 1211, 1212, 1213, 1214, 1215, 0, 0, 0, 23,
-	0, 999, 0, 999, 0, 115, 1107, 116, 926, 117,
-	333, 0, 0, 331, 0, 0, 0, 0, 0, 127,
-	148, 149, 88, 0, 0, 166, 0, 0, 158, 0,
-	159, 312, 336, 313, 323, 0, 324, 318, 322, 268,
-	497, 220, 999, 0, 274, 338, 999, -2, 0, 416,
-	442, 439, 0, 413, 414, 443, 0, 0, 0, 454,
-	0, 397, 398, 0, 0, 0, 364, 365, 0, 385,
-	0, 0, 458, 852, 854, 858, 859, 597, 0, 0,
-	0, 0, 0, 0, 0, 683, 684, 843, 0, 687,
-	688, 689, 1168, 0, 694, 695, 1172, 1188, 862, 870,
-	871, 872, 873, 874, 0, 0, 0, 879, 880, 881,
-	835, 737, 705, -2, -2, 868, 533, 534, 535, 536,
-	-2, 1200, 709, 710, 712, 714, 716, 718, 719, 720,
-	721, 0, 1169, 1184, 1185, 1191, 1194, 1195, 1181, 1164,
-	1171, 1177, 967, -2, -2, -2, -2, -2, -2, -2,
+	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
+	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
+	1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157,
+	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
+	1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
+	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
+	1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
+	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
+	1208, 1209, 1210, 1211, 1212, 1213, 0, 0, 0, 0,
+	0, 119, 128, 1040, 1122, 1144, 133, 138, 0, 1208,
+	-2, 142, 96, 0, 170, 180, 0, 178, 174, 0,
+	176, 325, 339, 340, 351, 351, 328, 0, 329, 330,
+	0, 331, 1113, 333, 0, 0, 409, 0, 0, 0,
+	0, 0, 287, -2, 388, 389, -2, 0, 413, 413,
+	413, 0, 0, 389, 0, 397, 1093, 400, 881, 398,
+	867, 0, 416, 414, 0, 393, 507, 0, 876, 469,
+	0, 2, 0, 0, 0, 513, 61, 1013, 874, 88,
+	1013, 90, 1013, 94, 92, 93, 0, 107, 109, 907,
+	908, 909, 910, 1212, 1214, 1215, 1216, 1217, 1218, 1219,
+	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 0, 0, 0, 0, 118, 24, 0, 1013,
+	0, 1013, 0, 0, 0, 129, 1122, 130, 940, 131,
+	347, 0, 0, 345, 0, 0, 0, 0, 0, 141,
+	163, 164, 97, 0, 0, 182, 0, 0, 173, 0,
+	175, 326, 350, 327, 337, 0, 338, 332, 336, 284,
+	511, 81, 84, 236, 1013, 0, 903, 352, 1013, -2,
+	0, 430, 456, 453, 0, 427, 428, 457, 0, 0,
+	0, 468, 0, 411, 412, 0, 0, 0, 378, 379,
+	0, 399, 0, 0, 472, 866, 868, 872, 873, 611,
+	0, 0, 0, 0, 0, 0, 0, 697, 698, 857,
+	0, 701, 702, 703, 1184, 0, 708, 709, 1188, 1204,
+	876, 884, 885, 886, 887, 888, 0, 0, 0, 893,
+	894, 895, 849, 751, 719, -2, -2, 882, 547, 548,
+	549, 550, -2, 1216, 723, 724, 726, 728, 730, 732,
+	733, 734, 735, 0, 1185, 1200, 1201, 1207, 1210, 1211,
+	1197, 1180, 1187, 1193, 981, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	892, 555, 556, 561, 562, 571, 458, 0, 456, 494,
-	495, 0, 863, 841, 0, 0, 0, 0, 849, 847,
-	848, 512, 0, 341, 0, 500, 0, 0, 79, 0,
-	57, 58, 0, 0, 394, 0, 999, 220, 0, 103,
-	0, 951, 860, 109, 24, 0, 0, 25, 0, 29,
-	0, 308, 308, 0, 0, 0, 0, 0, 0, 0,
-	0, 27, 308, 0, 0, 0, 0, 0, 335, 0,
-	329, 330, 332, 120, 122, 129, 131, 132, 137, 138,
-	139, 140, 257, 0, 259, 151, 152, 882, 0, 121,
-	123, 125, 126, 141, 142, 0, 145, 146, 147, 577,
-	0, 89, 897, 898, 899, 900, 901, 1216, 1217, 1218,
-	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
-	1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248,
-	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
-	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
-	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
-	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
-	1289, 1290, 1291, 1292, 154, 156, 0, 163, 157, 161,
-	1098, 321, 59, 0, 0, 0, 172, 0, 245, 0,
-	0, 889, 273, 0, 416, 442, 368, 415, 369, 441,
-	444, 0, 0, 449, 0, 451, 0, 411, 412, 417,
-	421, 422, 425, 428, 429, 419, 597, 448, 0, 380,
-	381, 382, 406, 407, 289, 387, 0, 0, 864, 512,
-	0, 0, 0, 0, 0, 772, 773, 774, 775, 776,
-	779, 777, 778, 780, 781, 782, 783, 784, 785, 786,
-	787, 0, 0, 789, 0, 0, 0, 846, 0, 0,
-	0, 857, 788, 601, 602, 603, 622, 623, 0, 653,
-	685, -2, 791, 0, 690, 691, 692, 693, 0, 0,
-	0, -2, 0, 876, 594, 0, 0, 834, 739, 0,
-	0, 0, 0, 0, 0, 0, 801, 0, 814, 820,
-	0, 0, 0, 0, 0, 0, 546, 558, 568, 566,
-	565, 548, 0, 547, 545, 0, 549, 0, 576, 0,
-	0, 0, 574, 0, 564, 0, 512, 0, 0, 842,
-	836, 837, 838, 0, 0, 851, 353, 0, 348, 343,
-	0, 0, 0, 458, 355, 357, 358, 0, 0, 501,
-	59, 86, 81, 83, 92, 99, 0, 0, 0, 0,
-	104, 105, 0, 153, 0, 0, 31, 0, 42, 0,
-	0, 208, 0, 0, 1006, 0, -2, 0, 0, 0,
-	307, 0, 0, 0, 47, 48, 53, 0, 49, 0,
-	51, 52, 54, 0, 0, 0, 0, 0, 0, 0,
-	334, 328, 0, 0, 136, 0, 258, 884, 883, 594,
-	0, 90, 165, 112, 320, 265, 498, 266, 267, 0,
-	0, 171, 864, 175, 179, 180, 181, 0, 174, 249,
-	0, 0, 256, 0, 999, 888, 0, 372, 373, 440,
-	0, 452, 0, 423, 0, 0, 420, 426, 427, 445,
-	446, 0, 453, 0, 0, 292, 287, 288, 394, 0,
-	866, 431, 457, 459, 479, 484, 0, 479, 466, 0,
-	510, 1096, 706, 707, 0, 855, 598, 524, 0, 525,
-	526, 527, 528, 594, 531, 532, 540, 541, 542, 543,
-	544, 552, 0, 539, 539, 539, 550, 553, 554, 559,
-	560, 572, 580, 580, 569, 569, 569, 567, 599, 0,
-	604, 605, 606, 607, 608, 609, 610, 611, 612, -2,
-	-2, -2, 616, -2, -2, -2, 620, 621, -2, 790,
-	0, 846, 0, 0, 632, 0, 635, 637, 639, 0,
-	0, 654, 0, 0, 845, 649, 826, 0, 0, 769,
-	770, 771, 856, 634, 0, 0, 844, 843, 0, 0,
-	0, 797, 791, 798, 0, 0, -2, 697, 405, 0,
-	0, 0, 0, 877, 581, 582, 583, 584, 585, 586,
-	595, 0, 833, 829, 0, 747, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 802, 803, 804, 805,
-	806, 807, 808, 809, 0, 0, 0, 0, 656, 0,
-	0, 0, 0, 1188, 0, 791, 819, 0, 0, 0,
-	0, 0, 791, 0, 825, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 431, 0,
-	496, 839, 0, 850, 77, 0, 511, 353, 0, 0,
-	345, 342, 512, 0, 0, 0, 78, 0, 101, 861,
-	298, 30, 301, 32, 187, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 0, 310, 43, 999, 59, 999,
-	59, 44, 0, 67, 70, 50, 26, 0, 299, 0,
-	0, 28, 308, 102, 118, 133, 134, 135, 130, 143,
-	0, 0, 243, 219, 0, 201, 245, 0, 253, 0,
-	244, 220, 0, 251, 0, 0, 0, 339, 450, 0,
-	0, 447, 408, 292, 772, 773, 774, 775, 776, 777,
-	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
-	410, 0, 0, 389, 390, 391, 392, 865, 438, 0,
-	0, 0, 0, 0, 0, 490, 490, 490, 488, 461,
-	478, 0, 477, 462, 480, 0, 477, 484, 479, 0,
-	464, -2, 466, 0, 502, 0, 0, 0, 516, 520,
-	524, 529, 0, 0, 0, -2, 0, 0, 0, 650,
-	-2, 633, 636, 638, 640, 0, 0, 655, 0, 0,
-	0, 0, 0, 651, 0, 0, 0, 686, 792, 0,
-	795, 796, 0, 696, -2, 0, 0, 404, 405, 405,
-	405, 703, 0, 0, 0, 0, 0, 0, 0, 830,
-	0, 0, 704, 0, 0, 0, 0, 711, 713, 715,
-	717, 0, 723, 0, 724, 0, 0, 725, 0, 0,
+	-2, -2, 906, 569, 570, 575, 576, 585, 472, 0,
+	470, 508, 509, 0, 877, 855, 0, 0, 0, 0,
+	863, 861, 862, 526, 0, 355, 0, 514, 0, 86,
+	0, 59, 60, 0, 0, 0, 0, 408, 0, 1013,
+	236, 0, 114, 0, 965, 111, 126, 125, 25, 0,
+	0, 26, 0, 31, 0, 322, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 28, 322, 0, 30, 0,
+	0, 0, 113, 0, 0, 0, 349, 0, 343, 344,
+	346, 134, 136, 143, 145, 146, 152, 153, 154, 155,
+	273, 0, 275, 166, 167, 896, 0, 135, 137, 139,
+	140, 156, 157, 0, 160, 161, 162, 591, 0, 98,
+	911, 912, 913, 914, 915, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 169, 171, 0, 179, 172, 177, 1113, 335,
+	61, 0, 0, 0, 79, 80, 188, 0, 261, 0,
+	0, 0, 1013, 902, 0, 430, 456, 382, 429, 383,
+	455, 458, 0, 0, 463, 0, 465, 0, 425, 426,
+	431, 435, 436, 439, 442, 443, 433, 611, 462, 0,
+	394, 395, 396, 420, 421, 303, 401, 0, 0, 878,
+	526, 0, 0, 0, 0, 0, 786, 787, 788, 789,
+	790, 793, 791, 792, 794, 795, 796, 797, 798, 799,
+	800, 801, 0, 0, 803, 0, 0, 0, 860, 0,
+	0, 0, 871, 802, 615, 616, 617, 636, 637, 0,
+	667, 699, -2, 805, 0, 704, 705, 706, 707, 0,
+	0, 0, -2, 0, 890, 608, 0, 0, 848, 753,
+	0, 0, 0, 0, 0, 0, 0, 815, 0, 828,
+	834, 0, 0, 0, 0, 0, 0, 560, 572, 582,
+	580, 579, 562, 0, 561, 559, 0, 563, 0, 590,
+	0, 0, 0, 588, 0, 578, 0, 526, 0, 0,
+	856, 850, 851, 852, 0, 0, 865, 367, 0, 362,
+	357, 0, 0, 0, 472, 369, 371, 372, 0, 0,
+	515, 875, 61, 89, 95, 91, 101, 108, 0, 0,
+	0, 115, 116, 0, 0, 168, 0, 0, 33, 0,
+	44, 0, 0, 224, 0, 0, 1020, 0, -2, 0,
+	0, 0, 321, 0, 0, 0, 49, 50, 55, 0,
+	51, 0, 53, 54, 56, 0, 0, 0, 0, 0,
+	82, 0, 0, 0, 348, 342, 0, 0, 150, 151,
+	0, 274, 898, 897, 608, 0, 99, 181, 334, 281,
+	512, 282, 283, 0, 0, 187, 878, 191, 195, 196,
+	197, 0, 190, 265, 0, 0, 272, 0, 0, 0,
+	386, 387, 454, 0, 466, 0, 437, 0, 0, 434,
+	440, 441, 459, 460, 0, 467, 0, 0, 306, 301,
+	302, 408, 0, 880, 445, 471, 473, 493, 498, 0,
+	493, 480, 0, 524, 1111, 720, 721, 0, 869, 612,
+	538, 0, 539, 540, 541, 542, 608, 545, 546, 554,
+	555, 556, 557, 558, 566, 0, 553, 553, 553, 564,
+	567, 568, 573, 574, 586, 594, 594, 583, 583, 583,
+	581, 613, 0, 618, 619, 620, 621, 622, 623, 624,
+	625, 626, -2, -2, -2, 630, -2, -2, -2, 634,
+	635, -2, 804, 0, 860, 0, 0, 646, 0, 649,
+	651, 653, 0, 0, 668, 0, 0, 859, 663, 840,
+	0, 0, 783, 784, 785, 870, 648, 0, 0, 858,
+	857, 0, 0, 0, 811, 805, 812, 0, 0, -2,
+	711, 419, 0, 0, 0, 0, 891, 595, 596, 597,
+	598, 599, 600, 609, 0, 847, 843, 0, 761, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 816,
+	817, 818, 819, 820, 821, 822, 823, 0, 0, 0,
+	0, 670, 0, 0, 0, 0, 1204, 0, 805, 833,
+	0, 0, 0, 0, 0, 805, 0, 839, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 0, 510, 853, 0, 864, 85, 0, 525,
+	367, 0, 0, 359, 356, 526, 0, 0, 0, 87,
+	0, 110, 127, 312, 32, 315, 34, 203, 0, 0,
+	0, 227, 0, 0, 0, 0, 0, 0, 324, 45,
+	1013, 61, 1013, 61, 46, 0, 67, 70, 52, 27,
+	0, 313, 0, 0, 29, 322, 83, 112, 132, 147,
+	148, 149, 144, 158, 0, 259, 235, 0, 217, 261,
+	0, 269, 0, 260, 236, 0, 267, 0, 290, 0,
+	353, 464, 0, 0, 461, 422, 306, 786, 787, 788,
+	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
+	799, 800, 801, 424, 0, 0, 403, 404, 405, 406,
+	879, 452, 0, 0, 0, 0, 0, 0, 504, 504,
+	504, 502, 475, 492, 0, 491, 476, 494, 0, 491,
+	498, 493, 0, 478, -2, 480, 0, 516, 0, 0,
+	0, 530, 534, 538, 543, 0, 0, 0, -2, 0,
+	0, 0, 664, -2, 647, 650, 652, 654, 0, 0,
+	669, 0, 0, 0, 0, 0, 665, 0, 0, 0,
+	700, 806, 0, 809, 810, 0, 710, -2, 0, 0,
+	418, 419, 419, 419, 717, 0, 0, 0, 0, 0,
+	0, 0, 844, 0, 0, 718, 0, 0, 0, 0,
+	725, 727, 729, 731, 0, 737, 0, 738, 0, 0,
+	739, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 658, 659, 660,
-	0, 0, 726, 817, 818, 0, 0, 0, 0, 0,
-	0, 731, 0, 824, 0, 733, 734, 735, 0, 537,
-	0, 551, 580, 578, 579, 580, 571, 570, 438, 401,
-	0, 352, 340, 351, 0, 353, 356, 359, 0, 184,
-	207, 0, 276, 0, 0, 0, 0, 0, 214, 0,
-	275, 0, 33, 0, 0, 0, 0, 0, 40, 0,
-	46, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 245, 0, 176, 198, 249, 0, 177,
-	182, 183, 0, 0, 172, 0, 0, 254, 0, 276,
-	0, 418, 424, 409, 290, 291, 388, 741, 0, 0,
-	460, 0, 0, 0, 0, 0, 485, 489, 486, 487,
-	475, 0, 475, 0, 0, 463, 465, 869, 468, 509,
-	0, 405, 0, 0, 517, 521, 0, 600, -2, 0,
-	-2, 0, 0, 0, 0, 0, -2, 0, 793, 0,
-	0, 827, 0, 0, 764, 0, -2, 792, 799, 698,
-	0, 0, 0, 0, 587, 588, 589, 590, 591, 592,
-	593, 0, 878, 828, 832, 0, 745, 746, 750, 0,
-	0, 708, 0, 800, 811, 812, 657, 661, 662, 663,
-	664, 665, 666, 667, 668, 669, -2, -2, -2, 673,
-	-2, -2, -2, 0, 0, 0, 681, 813, 0, 0,
-	767, 815, 816, 821, 822, 0, 728, 729, 730, 823,
-	0, 557, 0, 575, 573, 563, 741, 840, 0, 0,
-	0, 344, 354, 0, 186, 0, 189, 0, 0, 192,
-	193, 0, 0, 0, 0, 209, 210, 250, 212, 0,
-	0, 225, 0, 283, 283, 0, 0, 0, 56, 0,
-	0, 37, 0, 309, 38, 0, 59, 59, 68, 69,
-	260, 261, 262, 263, 264, 293, 0, 0, 772, 773,
-	890, 891, -2, 72, 302, 303, 305, 300, 0, 0,
-	144, 249, 0, 0, 0, 169, 173, 0, 0, 246,
-	0, 0, 0, 174, 256, 255, 0, 0, 376, 0,
-	437, 430, 432, 434, 435, 0, 469, 0, 471, 0,
-	0, 0, 473, 0, 0, 0, 0, 513, 0, 0,
-	864, 467, 0, 505, 508, 0, 522, 0, 0, 0,
-	530, -2, 0, 0, 0, -2, -2, 0, 643, 0,
-	-2, -2, 652, 763, 792, 875, 405, 699, 701, 702,
-	596, 831, 752, 749, 0, 736, 722, 810, 0, 0,
-	0, 682, 0, 792, 766, 727, 732, 538, 377, 0,
-	0, 277, 0, 360, 361, 0, 0, 190, 191, 194,
-	0, 196, 220, 213, 229, 0, 0, 286, 0, 286,
-	0, 0, 34, 35, 36, 55, 61, 39, 45, 0,
-	0, 0, 0, 0, 167, 0, 199, 202, 203, 204,
-	205, 206, 200, 178, 187, 247, 248, 243, 245, 252,
-	0, 0, 740, 742, 0, 0, 436, 470, 0, 492,
-	472, 0, 476, 0, 481, 0, 61, 483, 510, 0,
-	504, 0, 698, 523, 518, 0, -2, -2, -2, 644,
-	794, 765, 0, 405, 0, 738, -2, 0, 0, 0,
-	768, 0, 347, 0, 512, 350, 188, 0, 224, 215,
-	0, 226, 227, 296, 0, 289, 284, 0, 282, 289,
-	283, 0, 63, 0, 0, 0, 71, 304, 306, 242,
-	185, 245, 249, 0, 276, 0, 0, 433, 0, 474,
-	482, 514, 515, 503, 506, 0, 519, 700, 755, 0,
-	-2, 0, 679, 0, 278, 349, 218, 234, 0, 0,
-	0, 292, 285, 292, 286, 220, 41, 0, 60, 294,
-	0, 249, 170, 66, 0, 743, 744, 491, 0, 0,
-	0, 0, 751, 680, 512, 195, 0, 197, 230, 231,
-	0, 221, 222, 223, 0, 297, 279, 280, 289, 224,
-	62, 295, 168, 512, 0, 0, 507, 748, 753, 756,
-	-2, 1136, 947, 0, 754, 346, 217, 232, 0, 233,
-	0, 0, 0, 228, 292, 234, 269, 65, 0, 0,
-	758, 759, 760, 761, 762, 235, 0, 238, 239, 0,
-	236, 281, 216, 66, 0, 237, 240, 241, 512, 757,
-	270,
+	672, 673, 674, 0, 0, 740, 831, 832, 0, 0,
+	0, 0, 0, 0, 745, 0, 838, 0, 747, 748,
+	749, 0, 551, 0, 565, 594, 592, 593, 594, 585,
+	584, 452, 415, 0, 366, 354, 365, 0, 367, 370,
+	373, 0, 200, 223, 0, 290, 0, 0, 0, 0,
+	0, 230, 0, 289, 0, 35, 0, 0, 0, 0,
+	0, 42, 0, 48, 66, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 192, 214, 265,
+	0, 193, 198, 199, 0, 0, 188, 0, 0, 270,
+	0, 0, 0, 432, 438, 423, 304, 305, 402, 755,
+	0, 0, 474, 0, 0, 0, 0, 0, 499, 503,
+	500, 501, 489, 0, 489, 0, 0, 477, 479, 883,
+	482, 523, 0, 419, 0, 0, 531, 535, 0, 614,
+	-2, 0, -2, 0, 0, 0, 0, 0, -2, 0,
+	807, 0, 0, 841, 0, 0, 778, 0, -2, 806,
+	813, 712, 0, 0, 0, 0, 601, 602, 603, 604,
+	605, 606, 607, 0, 892, 842, 846, 0, 759, 760,
+	764, 0, 0, 722, 0, 814, 825, 826, 671, 675,
+	676, 677, 678, 679, 680, 681, 682, 683, -2, -2,
+	-2, 687, -2, -2, -2, 0, 0, 0, 695, 827,
+	0, 0, 781, 829, 830, 835, 836, 0, 742, 743,
+	744, 837, 0, 571, 0, 589, 587, 577, 755, 854,
+	0, 0, 0, 358, 368, 0, 202, 0, 205, 0,
+	0, 208, 209, 0, 0, 0, 0, 225, 226, 266,
+	228, 0, 0, 241, 0, 297, 297, 0, 0, 0,
+	58, 0, 0, 39, 0, 323, 40, 0, 61, 61,
+	68, 69, 276, 277, 278, 279, 280, 307, 0, 0,
+	786, 787, 904, 905, -2, 72, 316, 317, 319, 314,
+	0, 0, 159, 265, 0, 0, 0, 185, 189, 0,
+	0, 262, 0, 0, 0, 190, 272, 271, 0, 0,
+	390, 0, 451, 444, 446, 448, 449, 0, 483, 0,
+	485, 0, 0, 0, 487, 0, 0, 0, 0, 527,
+	0, 0, 878, 481, 0, 519, 522, 0, 536, 0,
+	0, 0, 544, -2, 0, 0, 0, -2, -2, 0,
+	657, 0, -2, -2, 666, 777, 806, 889, 419, 713,
+	715, 716, 610, 845, 766, 763, 0, 750, 736, 824,
+	0, 0, 0, 696, 0, 806, 780, 741, 746, 552,
+	391, 0, 0, 291, 0, 374, 375, 0, 0, 206,
+	207, 210, 0, 212, 236, 229, 245, 0, 0, 300,
+	0, 300, 0, 0, 36, 37, 38, 57, 63, 41,
+	47, 0, 0, 0, 0, 0, 183, 0, 215, 218,
+	219, 220, 221, 222, 216, 194, 203, 263, 264, 259,
+	261, 268, 0, 290, 754, 756, 0, 0, 450, 484,
+	0, 506, 486, 0, 490, 0, 495, 0, 63, 497,
+	524, 0, 518, 0, 712, 537, 532, 0, -2, -2,
+	-2, 658, 808, 779, 0, 419, 0, 752, -2, 0,
+	0, 0, 782, 0, 361, 0, 526, 364, 204, 0,
+	240, 231, 0, 242, 243, 310, 0, 303, 298, 0,
+	296, 303, 297, 0, 65, 0, 0, 0, 71, 318,
+	320, 258, 201, 261, 265, 285, 0, 0, 0, 447,
+	0, 488, 496, 528, 529, 517, 520, 0, 533, 714,
+	769, 0, -2, 0, 693, 0, 292, 363, 234, 250,
+	0, 0, 0, 306, 299, 306, 300, 236, 43, 0,
+	62, 308, 0, 265, 186, 0, 757, 758, 505, 0,
+	0, 0, 0, 765, 694, 526, 211, 0, 213, 246,
+	247, 0, 237, 238, 239, 0, 311, 293, 294, 303,
+	240, 64, 309, 184, 0, 521, 762, 767, 770, -2,
+	1151, 961, 0, 768, 360, 233, 248, 0, 249, 0,
+	0, 0, 244, 306, 250, 286, 0, 772, 773, 774,
+	775, 776, 251, 0, 254, 255, 0, 252, 295, 232,
+	0, 253, 256, 257, 771,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 425, 3, 421, 422, 3,
-	430, 431, 419, 417, 434, 418, 432, 420, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 436, 433,
-	413, 415, 414, 3, 435, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 427, 3, 423, 424, 3,
+	432, 433, 421, 419, 436, 420, 434, 422, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 438, 435,
+	415, 417, 416, 3, 437, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 428, 3, 429, 424, 3, 3, 3, 3, 3,
+	3, 430, 3, 431, 426, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 423, 3, 427,
+	3, 3, 3, 3, 425, 3, 429,
 }
 var sqlTok2 = [...]int{
 
@@ -5661,7 +5781,7 @@ var sqlTok3 = [...]int{
 	57740, 398, 57741, 399, 57742, 400, 57743, 401, 57744, 402,
 	57745, 403, 57746, 404, 57747, 405, 57748, 406, 57749, 407,
 	57750, 408, 57751, 409, 57752, 410, 57753, 411, 57754, 412,
-	57755, 416, 57756, 426, 0,
+	57755, 413, 57756, 414, 57757, 418, 57758, 428, 0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -6005,13 +6125,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:456
+		//line sql.y:476
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:462
+		//line sql.y:482
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -6019,7 +6139,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:468
+		//line sql.y:488
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -6029,1800 +6149,1959 @@ sqldefault:
 		}
 	case 19:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:493
+		//line sql.y:513
 		{
 			sqlVAL.stmt = nil
 		}
-	case 23:
+	case 24:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:505
+		//line sql.y:526
 		{
 			sqlVAL.stmt = nil
 		}
-	case 24:
+	case 25:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:509
+		//line sql.y:530
 		{
 			sqlVAL.stmt = nil
 		}
-	case 25:
+	case 26:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:515
+		//line sql.y:536
 		{
 			sqlVAL.stmt = nil
 		}
-	case 26:
+	case 27:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:519
+		//line sql.y:540
 		{
 			sqlVAL.stmt = nil
 		}
-	case 27:
+	case 28:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:525
+		//line sql.y:546
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
-	case 28:
+	case 29:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:550
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
+		}
+	case 30:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:557
+		{
+			sqlVAL.stmt = &AlterUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[4].str}
 		}
 	case 31:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:539
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:563
 		{
+			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
 		}
 	case 32:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:541
+		//line sql.y:567
 		{
+			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
 	case 33:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:543
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:574
 		{
+			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, ColumnDef: sqlDollar[2].tblDef.(*ColumnTableDef)}
 		}
 	case 34:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:545
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:579
 		{
+			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, ColumnDef: sqlDollar[3].tblDef.(*ColumnTableDef)}
 		}
 	case 35:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:547
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:583
 		{
 		}
 	case 36:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:549
+		//line sql.y:585
 		{
 		}
 	case 37:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:551
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:587
 		{
 		}
 	case 38:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:553
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:589
 		{
 		}
 	case 39:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:555
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:591
 		{
 		}
 	case 40:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:557
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:593
 		{
 		}
 	case 41:
-		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:560
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:596
 		{
+			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: Name(sqlDollar[5].str)}
 		}
 	case 42:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:562
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:601
 		{
+			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: Name(sqlDollar[3].str)}
 		}
 	case 43:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:564
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+		//line sql.y:606
 		{
 		}
 	case 44:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:566
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:608
 		{
 		}
 	case 45:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:568
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:610
 		{
 		}
 	case 46:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:570
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:612
 		{
 		}
 	case 47:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:572
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:614
 		{
 		}
 	case 48:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:574
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:616
 		{
 		}
 	case 49:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:576
+		//line sql.y:618
 		{
 		}
 	case 50:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:578
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:620
 		{
 		}
 	case 51:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:580
+		//line sql.y:622
 		{
 		}
 	case 52:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:582
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:624
 		{
 		}
 	case 53:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:584
+		//line sql.y:626
 		{
 		}
 	case 54:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:586
+		//line sql.y:628
 		{
 		}
 	case 55:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:589
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:630
 		{
 		}
 	case 56:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:590
+		//line sql.y:632
 		{
 		}
 	case 57:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:593
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:635
 		{
 		}
 	case 58:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:594
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:636
 		{
 		}
 	case 59:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:595
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:639
 		{
 		}
 	case 60:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:598
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:640
 		{
 		}
 	case 61:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:599
+		//line sql.y:641
 		{
 		}
 	case 62:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:602
+		//line sql.y:644
 		{
 		}
 	case 63:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:603
+		//line sql.y:645
 		{
 		}
 	case 64:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:607
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:648
 		{
-			sqlVAL.empty = sqlDollar[2].empty
 		}
 	case 65:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:612
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:649
 		{
 		}
 	case 66:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:613
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:653
 		{
+			sqlVAL.empty = sqlDollar[2].empty
 		}
 	case 67:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:658
 		{
 		}
 	case 68:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:617
+		//line sql.y:659
 		{
 		}
 	case 69:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:621
+		//line sql.y:663
 		{
 		}
 	case 70:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:622
+		//line sql.y:664
 		{
 		}
 	case 71:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:623
+		//line sql.y:665
 		{
 		}
 	case 72:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:624
+		//line sql.y:666
 		{
 		}
-	case 77:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:637
+	case 79:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:680
 		{
-			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
+			sqlVAL.stmt = &CreateUser{Name: Name(sqlDollar[3].str), Password: sqlDollar[4].str}
+		}
+	case 81:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:687
+		{
+			sqlVAL.str = ""
+		}
+	case 82:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:693
+		{
+			sqlVAL.str = sqlDollar[2].str
+		}
+	case 83:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:697
+		{
+			sqlVAL.str = sqlDollar[3].str
+		}
+	case 84:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:704
+		{
+			sqlVAL.stmt = &CreateRole{Name: Name(sqlDollar[3].str)}
 		}
-	case 78:
+	case 85:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:644
+		//line sql.y:712
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
 		}
-	case 79:
+	case 86:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:648
+		//line sql.y:719
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
+		}
+	case 87:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:723
+		{
+			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 80:
+	case 88:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:652
+		//line sql.y:727
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 81:
+	case 89:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:656
+		//line sql.y:731
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 82:
+	case 90:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:660
+		//line sql.y:735
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 83:
+	case 91:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:664
+		//line sql.y:739
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 84:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:669
+	case 92:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:743
 		{
+			sqlVAL.stmt = &DropUser{Name: Name(sqlDollar[3].str)}
 		}
-	case 85:
+	case 93:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:747
+		{
+			sqlVAL.stmt = &DropRole{Name: Name(sqlDollar[3].str)}
+		}
+	case 94:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:673
+		//line sql.y:753
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 86:
+	case 95:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:677
+		//line sql.y:757
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 87:
+	case 96:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:683
+		//line sql.y:763
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 88:
+	case 97:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:687
+		//line sql.y:767
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 89:
+	case 98:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:693
+		//line sql.y:773
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 90:
+	case 99:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:697
+		//line sql.y:777
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 91:
+	case 100:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:704
+		//line sql.y:784
 		{
 			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 92:
+	case 101:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:708
+		//line sql.y:788
 		{
 			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 98:
+	case 107:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:721
+		//line sql.y:801
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 99:
+	case 108:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:725
+		//line sql.y:805
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 101:
+	case 110:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:735
+		//line sql.y:816
 		{
 			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 102:
+	case 111:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:820
+		{
+			sqlVAL.stmt = &GrantRole{Roles: NameList(sqlDollar[2].strs), Grantees: NameList(sqlDollar[4].strs)}
+		}
+	case 112:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:742
+		//line sql.y:828
 		{
 			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 103:
+	case 113:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:832
+		{
+			sqlVAL.stmt = &RevokeRole{Roles: NameList(sqlDollar[2].strs), Grantees: NameList(sqlDollar[4].strs)}
+		}
+	case 114:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:749
+		//line sql.y:839
 		{
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 104:
+	case 115:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:753
+		//line sql.y:843
 		{
 			// TODO(marc): this is postgres' grammar, but do we really need
 			// both "x" and "TABLE X"?
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
 		}
-	case 105:
+	case 116:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:759
+		//line sql.y:849
 		{
 			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
 		}
-	case 106:
+	case 117:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:765
+		//line sql.y:857
 		{
 			sqlVAL.privilegeList = []PrivilegeType{PrivilegeAll}
 		}
-	case 107:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:768
+	case 118:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:861
 		{
+			sqlVAL.privilegeList = []PrivilegeType{PrivilegeAll}
 		}
-	case 108:
+	case 119:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:772
-		{
-			sqlVAL.privilegeList = []PrivilegeType{sqlDollar[1].privilegeType}
-		}
-	case 109:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:776
+		//line sql.y:865
 		{
-			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
+			privs, err := parsePrivileges(sqlDollar[1].strs)
+			if err != nil {
+				sqllex.Error(err.Error())
+				return 1
+			}
+			sqlVAL.privilegeList = privs
 		}
-	case 110:
+	case 124:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:782
+		//line sql.y:884
 		{
-			sqlVAL.privilegeType = PrivilegeRead
+			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 111:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:786
+	case 125:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:888
 		{
-			sqlVAL.privilegeType = PrivilegeWrite
+			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 112:
+	case 126:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:794
+		//line sql.y:896
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 113:
+	case 127:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:798
+		//line sql.y:900
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 114:
+	case 128:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:806
+		//line sql.y:908
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 115:
+	case 129:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:810
+		//line sql.y:912
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 116:
+	case 130:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:814
+		//line sql.y:916
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 117:
+	case 131:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:819
+		//line sql.y:922
 		{
+			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 118:
+	case 132:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:820
+		//line sql.y:925
 		{
 		}
-	case 119:
+	case 133:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:821
+		//line sql.y:926
 		{
 		}
-	case 120:
+	case 134:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:825
+		//line sql.y:930
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 121:
+	case 135:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:829
+		//line sql.y:934
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 122:
+	case 136:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:833
+		//line sql.y:938
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 123:
+	case 137:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:837
+		//line sql.y:942
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 125:
+	case 139:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:844
+		//line sql.y:949
 		{
 		}
-	case 126:
+	case 140:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:846
+		//line sql.y:951
 		{
 		}
-	case 127:
+	case 141:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:847
+		//line sql.y:952
 		{
 		}
-	case 129:
+	case 143:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:854
+		//line sql.y:959
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 130:
+	case 144:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:858
+		//line sql.y:963
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 133:
+	case 147:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:867
+		//line sql.y:975
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 134:
+	case 148:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:868
+		//line sql.y:979
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 135:
+	case 149:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:983
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 136:
+	case 150:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:870
+		//line sql.y:987
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 137:
+	case 151:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:991
+		{
+			sqlVAL.isoLevel = SerializableIsolation
+		}
+	case 152:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:874
+		//line sql.y:997
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
-	case 138:
+	case 153:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:878
+		//line sql.y:1001
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
-	case 139:
+	case 154:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:882
+		//line sql.y:1005
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 141:
+	case 156:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:898
+		//line sql.y:1021
 		{
 		}
-	case 142:
+	case 157:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:899
+		//line sql.y:1022
 		{
 		}
-	case 143:
+	case 158:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:900
+		//line sql.y:1023
 		{
 		}
-	case 144:
+	case 159:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:901
+		//line sql.y:1024
 		{
 		}
-	case 145:
+	case 160:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:902
+		//line sql.y:1025
 		{
 		}
-	case 146:
+	case 161:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:903
+		//line sql.y:1026
 		{
 		}
-	case 147:
+	case 162:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:904
+		//line sql.y:1027
 		{
 		}
-	case 148:
+	case 163:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:907
+		//line sql.y:1030
 		{
 		}
-	case 149:
+	case 164:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:908
+		//line sql.y:1031
 		{
 		}
-	case 150:
+	case 165:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:909
+		//line sql.y:1032
 		{
 		}
-	case 151:
+	case 166:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:913
+		//line sql.y:1036
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 152:
+	case 167:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:917
+		//line sql.y:1040
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 153:
+	case 168:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:922
+		//line sql.y:1045
 		{
 		}
-	case 154:
+	case 169:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:926
+		//line sql.y:1049
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 155:
+	case 170:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:930
+		//line sql.y:1053
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 156:
+	case 171:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:1057
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 157:
+	case 172:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:938
+		//line sql.y:1061
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 158:
+	case 173:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:942
+		//line sql.y:1065
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 159:
+	case 174:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1069
+		{
+			sqlVAL.stmt = &ShowUsers{}
+		}
+	case 175:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:946
+		//line sql.y:1073
 		{
 			sqlVAL.stmt = nil
 		}
-	case 160:
+	case 176:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:950
+		//line sql.y:1077
 		{
 			sqlVAL.stmt = nil
 		}
-	case 161:
+	case 177:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:1083
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 162:
+	case 178:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:960
+		//line sql.y:1087
 		{
 			sqlVAL.qname = nil
 		}
-	case 163:
+	case 179:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:966
+		//line sql.y:1093
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 164:
+	case 180:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:971
+		//line sql.y:1098
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 165:
+	case 181:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:977
+		//line sql.y:1104
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 166:
+	case 182:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:981
+		//line sql.y:1108
 		{
 			sqlVAL.strs = nil
 		}
-	case 167:
+	case 183:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:989
+		//line sql.y:1116
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs}
 		}
-	case 168:
+	case 184:
 		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
-		//line sql.y:994
+		//line sql.y:1121
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs}
 		}
-	case 169:
+	case 185:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:999
+		//line sql.y:1126
 		{
 			sqlVAL.stmt = nil
 		}
-	case 170:
+	case 186:
 		sqlDollar = sqlS[sqlpt-11 : sqlpt+1]
-		//line sql.y:1004
+		//line sql.y:1131
 		{
 			sqlVAL.stmt = nil
 		}
-	case 172:
+	case 188:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1138
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 173:
+	case 189:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1017
+		//line sql.y:1144
 		{
 			sqlVAL.empty = sqlDollar[2].empty
 		}
-	case 174:
+	case 190:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1020
+		//line sql.y:1147
 		{
 		}
-	case 175:
+	case 191:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1024
+		//line sql.y:1151
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 176:
+	case 192:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1028
+		//line sql.y:1155
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 177:
+	case 193:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1033
+		//line sql.y:1160
 		{
 		}
-	case 178:
+	case 194:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1034
+		//line sql.y:1161
 		{
 		}
-	case 180:
+	case 196:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1038
+		//line sql.y:1165
 		{
 		}
-	case 182:
+	case 198:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1042
+		//line sql.y:1169
 		{
 		}
-	case 183:
+	case 199:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1043
+		//line sql.y:1170
 		{
 		}
-	case 184:
+	case 200:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1047
+		//line sql.y:1174
 		{
 			sqlVAL.tblDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colConstraints)
 		}
-	case 185:
+	case 201:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1052
+		//line sql.y:1179
 		{
 		}
-	case 186:
+	case 202:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1056
+		//line sql.y:1183
 		{
 			sqlVAL.colConstraints = append(sqlDollar[1].colConstraints, sqlDollar[2].colConstraint)
 		}
-	case 187:
+	case 203:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1060
+		//line sql.y:1187
 		{
 			sqlVAL.colConstraints = nil
 		}
-	case 188:
+	case 204:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1066
+		//line sql.y:1193
 		{
-			// TODO(pmattis): Handle constraint name.
+			// TODO(pmattis): Handle the names of constraints other than CHECK.
 			sqlVAL.colConstraint = sqlDollar[3].colConstraint
+			switch c := sqlVAL.colConstraint.(type) {
+			case *ColumnCheckConstraint:
+				c.Name = Name(sqlDollar[2].str)
+			case *ColumnFKConstraint:
+				c.Name = Name(sqlDollar[2].str)
+			}
 		}
-	case 190:
+	case 206:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1071
+		//line sql.y:1204
 		{
 		}
-	case 191:
+	case 207:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1087
+		//line sql.y:1220
 		{
 			sqlVAL.colConstraint = NotNullConstraint{}
 		}
-	case 192:
+	case 208:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1091
+		//line sql.y:1224
 		{
 			sqlVAL.colConstraint = NullConstraint{}
 		}
-	case 193:
+	case 209:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1095
+		//line sql.y:1228
 		{
 			sqlVAL.colConstraint = UniqueConstraint{}
 		}
-	case 194:
+	case 210:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1099
+		//line sql.y:1232
 		{
 			sqlVAL.colConstraint = PrimaryKeyConstraint{}
 		}
-	case 195:
+	case 211:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1102
+		//line sql.y:1236
 		{
+			sqlVAL.colConstraint = &ColumnCheckConstraint{Expr: sqlDollar[3].expr}
 		}
-	case 196:
+	case 212:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1103
+		//line sql.y:1240
 		{
+			sqlVAL.colConstraint = &DefaultConstraint{Expr: sqlDollar[2].expr}
 		}
-	case 197:
+	case 213:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1104
+		//line sql.y:1244
 		{
+			if len(sqlDollar[3].strs) > 1 {
+				sqllex.Error("a column REFERENCES constraint must reference a single column")
+				return 1
+			}
+			c := &ColumnFKConstraint{Table: sqlDollar[2].qname, OnDelete: sqlDollar[5].refAction}
+			if len(sqlDollar[3].strs) == 1 {
+				c.Col = Name(sqlDollar[3].strs[0])
+			}
+			sqlVAL.colConstraint = c
 		}
-	case 198:
+	case 214:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1107
+		//line sql.y:1257
 		{
 		}
-	case 199:
+	case 215:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1110
+		//line sql.y:1260
 		{
 		}
-	case 200:
+	case 216:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1111
+		//line sql.y:1261
 		{
 		}
-	case 201:
+	case 217:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1112
+		//line sql.y:1262
 		{
 		}
-	case 202:
+	case 218:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1115
+		//line sql.y:1265
 		{
 		}
-	case 203:
+	case 219:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1116
+		//line sql.y:1266
 		{
 		}
-	case 204:
+	case 220:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1117
+		//line sql.y:1267
 		{
 		}
-	case 205:
+	case 221:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1118
+		//line sql.y:1268
 		{
 		}
-	case 206:
+	case 222:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1119
+		//line sql.y:1269
 		{
 		}
-	case 207:
+	case 223:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1126
+		//line sql.y:1276
 		{
 			sqlVAL.tblDef = sqlDollar[3].tblDef
-			if i, ok := sqlVAL.tblDef.(*IndexTableDef); ok {
-				i.Name = Name(sqlDollar[2].str)
+			switch t := sqlVAL.tblDef.(type) {
+			case *IndexTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *CheckConstraintTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *ForeignKeyConstraintTableDef:
+				t.Name = Name(sqlDollar[2].str)
 			}
 		}
-	case 208:
+	case 224:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1133
+		//line sql.y:1288
 		{
 			sqlVAL.tblDef = sqlDollar[1].tblDef
 		}
-	case 209:
+	case 225:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1138
+		//line sql.y:1294
 		{
+			sqlVAL.tblDef = &CheckConstraintTableDef{Expr: sqlDollar[3].expr}
 		}
-	case 210:
+	case 226:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1140
+		//line sql.y:1298
 		{
 			sqlVAL.tblDef = &IndexTableDef{Unique: true, Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 211:
+	case 227:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1143
+		//line sql.y:1301
 		{
 		}
-	case 212:
+	case 228:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1145
+		//line sql.y:1303
 		{
 			sqlVAL.tblDef = &IndexTableDef{Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 213:
+	case 229:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1149
+		//line sql.y:1307
 		{
 			sqlVAL.tblDef = &IndexTableDef{PrimaryKey: true, Unique: true, Columns: NameList(sqlDollar[4].strs)}
 		}
-	case 214:
+	case 230:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1152
+		//line sql.y:1310
 		{
 		}
-	case 215:
+	case 231:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1154
+		//line sql.y:1312
 		{
 		}
-	case 216:
+	case 232:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1156
+		//line sql.y:1315
 		{
+			sqlVAL.tblDef = &ForeignKeyConstraintTableDef{
+				FromCols: NameList(sqlDollar[4].strs),
+				Table:    sqlDollar[7].qname,
+				ToCols:   NameList(sqlDollar[8].strs),
+				OnDelete: sqlDollar[10].refAction,
+			}
 		}
-	case 217:
+	case 233:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1159
+		//line sql.y:1325
 		{
 		}
-	case 218:
+	case 234:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1160
+		//line sql.y:1326
 		{
 		}
-	case 219:
+	case 235:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1164
+		//line sql.y:1330
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 220:
+	case 236:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1168
+		//line sql.y:1334
 		{
 			sqlVAL.strs = nil
 		}
-	case 221:
+	case 237:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1173
+		//line sql.y:1340
 		{
+			sqllex.Error("MATCH FULL is not supported")
+			return 1
 		}
-	case 222:
+	case 238:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1174
+		//line sql.y:1345
 		{
+			sqllex.Error("MATCH PARTIAL is not supported")
+			return 1
 		}
-	case 223:
+	case 239:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1175
+		//line sql.y:1349
 		{
 		}
-	case 224:
+	case 240:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1176
+		//line sql.y:1350
 		{
 		}
-	case 225:
+	case 241:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1179
+		//line sql.y:1353
 		{
 		}
-	case 226:
+	case 242:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1180
+		//line sql.y:1354
 		{
 		}
-	case 227:
+	case 243:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1183
+		//line sql.y:1357
 		{
 		}
-	case 228:
+	case 244:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1186
+		//line sql.y:1360
 		{
 		}
-	case 229:
+	case 245:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1187
+		//line sql.y:1361
 		{
 		}
-	case 230:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1194
-		{
-		}
-	case 231:
+	case 246:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1195
+		//line sql.y:1368
 		{
+			sqlVAL.refAction = NoAction
 		}
-	case 232:
+	case 248:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1196
+		//line sql.y:1373
 		{
+			sqlVAL.refAction = sqlDollar[2].refAction
 		}
-	case 233:
+	case 249:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1197
+		//line sql.y:1377
 		{
+			sqlVAL.refAction = sqlDollar[1].refAction
 		}
-	case 234:
+	case 250:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1198
+		//line sql.y:1381
 		{
+			sqlVAL.refAction = NoAction
 		}
-	case 235:
+	case 251:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1201
+		//line sql.y:1387
 		{
+			if sqlDollar[3].refAction == Cascade {
+				sqllex.Error("ON UPDATE CASCADE is not supported")
+				return 1
+			}
+			sqlVAL.refAction = sqlDollar[3].refAction
 		}
-	case 236:
+	case 252:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1204
+		//line sql.y:1397
 		{
+			sqlVAL.refAction = sqlDollar[3].refAction
 		}
-	case 237:
+	case 253:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1207
+		//line sql.y:1403
 		{
+			sqlVAL.refAction = NoAction
 		}
-	case 238:
+	case 254:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1208
+		//line sql.y:1407
 		{
+			sqlVAL.refAction = Restrict
 		}
-	case 239:
+	case 255:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1209
+		//line sql.y:1411
 		{
+			sqlVAL.refAction = Cascade
 		}
-	case 240:
+	case 256:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1210
+		//line sql.y:1415
 		{
+			sqllex.Error("SET NULL actions are not supported")
+			return 1
 		}
-	case 241:
+	case 257:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1211
+		//line sql.y:1420
 		{
+			sqllex.Error("SET DEFAULT actions are not supported")
+			return 1
 		}
-	case 242:
+	case 258:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1214
+		//line sql.y:1426
 		{
 		}
-	case 243:
+	case 259:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1215
+		//line sql.y:1427
 		{
 		}
-	case 244:
+	case 260:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1218
+		//line sql.y:1430
 		{
 		}
-	case 245:
+	case 261:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1431
 		{
 		}
-	case 246:
+	case 262:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1222
+		//line sql.y:1434
 		{
 		}
-	case 247:
+	case 263:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1223
+		//line sql.y:1435
 		{
 		}
-	case 248:
+	case 264:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1224
+		//line sql.y:1436
 		{
 		}
-	case 249:
+	case 265:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1225
+		//line sql.y:1437
 		{
 		}
-	case 250:
+	case 266:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1228
+		//line sql.y:1440
 		{
 		}
-	case 251:
+	case 267:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1233
+		//line sql.y:1445
 		{
 			sqlVAL.stmt = nil
 		}
-	case 252:
+	case 268:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1237
+		//line sql.y:1449
 		{
 			sqlVAL.stmt = nil
 		}
-	case 253:
+	case 269:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1454
 		{
 		}
-	case 254:
+	case 270:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1457
 		{
 		}
-	case 255:
+	case 271:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1246
+		//line sql.y:1458
 		{
 		}
-	case 256:
+	case 272:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1247
+		//line sql.y:1459
 		{
 		}
-	case 257:
+	case 273:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1251
+		//line sql.y:1463
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 258:
+	case 274:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1255
+		//line sql.y:1467
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 259:
+	case 275:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1259
+		//line sql.y:1471
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 260:
+	case 276:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1265
+		//line sql.y:1477
 		{
 		}
-	case 261:
+	case 277:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1266
+		//line sql.y:1478
 		{
 		}
-	case 262:
+	case 278:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1267
+		//line sql.y:1479
 		{
 		}
-	case 263:
+	case 279:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1268
+		//line sql.y:1480
 		{
 		}
-	case 264:
+	case 280:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1269
+		//line sql.y:1481
 		{
 		}
-	case 265:
+	case 281:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1274
+		//line sql.y:1486
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 266:
+	case 282:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1279
+		//line sql.y:1491
 		{
 		}
-	case 267:
+	case 283:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1280
+		//line sql.y:1492
 		{
 		}
-	case 268:
+	case 284:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1281
-		{
-		}
-	case 269:
-		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1288
+		//line sql.y:1493
 		{
-			sqlVAL.stmt = nil
-		}
-	case 270:
-		sqlDollar = sqlS[sqlpt-16 : sqlpt+1]
-		//line sql.y:1294
-		{
-			sqlVAL.stmt = nil
 		}
-	case 271:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1299
+	case 285:
+		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
+		//line sql.y:1498
 		{
+			sqlVAL.stmt = &CreateIndex{Name: Name(sqlDollar[4].str), Table: sqlDollar[6].qname, Unique: sqlDollar[2].boolVal, Columns: NameList(sqlDollar[9].strs)}
 		}
-	case 272:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1300
+	case 286:
+		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
+		//line sql.y:1502
 		{
+			sqlVAL.stmt = &CreateIndex{Name: Name(sqlDollar[7].str), Table: sqlDollar[9].qname, Unique: sqlDollar[2].boolVal, IfNotExists: true, Columns: NameList(sqlDollar[12].strs)}
 		}
-	case 273:
+	case 287:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1303
+		//line sql.y:1508
 		{
+			sqlVAL.boolVal = true
 		}
-	case 274:
+	case 288:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1304
+		//line sql.y:1512
 		{
+			sqlVAL.boolVal = false
 		}
-	case 275:
+	case 289:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1307
+		//line sql.y:1517
 		{
 		}
-	case 276:
+	case 290:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1308
+		//line sql.y:1518
 		{
 		}
-	case 277:
+	case 291:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1311
+		//line sql.y:1521
 		{
 		}
-	case 278:
+	case 292:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1312
+		//line sql.y:1522
 		{
 		}
-	case 279:
+	case 293:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1319
+		//line sql.y:1529
 		{
 		}
-	case 280:
+	case 294:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1321
+		//line sql.y:1531
 		{
 		}
-	case 281:
+	case 295:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1323
+		//line sql.y:1533
 		{
 		}
-	case 282:
+	case 296:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1326
+		//line sql.y:1536
 		{
 		}
-	case 283:
+	case 297:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1327
+		//line sql.y:1537
 		{
 		}
-	case 284:
+	case 298:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1330
+		//line sql.y:1540
 		{
 		}
-	case 285:
+	case 299:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1331
+		//line sql.y:1541
 		{
 		}
-	case 286:
+	case 300:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1332
+		//line sql.y:1542
 		{
 		}
-	case 287:
+	case 301:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1335
+		//line sql.y:1546
 		{
+			sqlVAL.dir = Ascending
 		}
-	case 288:
+	case 302:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1336
+		//line sql.y:1550
 		{
+			sqlVAL.dir = Descending
 		}
-	case 289:
+	case 303:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1337
+		//line sql.y:1554
 		{
+			sqlVAL.dir = DefaultDirection
 		}
-	case 290:
+	case 304:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1340
+		//line sql.y:1559
 		{
 		}
-	case 291:
+	case 305:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1341
+		//line sql.y:1560
 		{
 		}
-	case 292:
+	case 306:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1342
+		//line sql.y:1561
 		{
 		}
-	case 293:
+	case 307:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1348
+		//line sql.y:1567
 		{
 		}
-	case 294:
+	case 308:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1568
 		{
 		}
-	case 295:
+	case 309:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1350
+		//line sql.y:1569
 		{
 		}
-	case 296:
+	case 310:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1572
 		{
 		}
-	case 297:
+	case 311:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1354
+		//line sql.y:1573
 		{
 		}
-	case 298:
+	case 312:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1359
+		//line sql.y:1578
 		{
 			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 299:
+	case 313:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1363
+		//line sql.y:1582
 		{
 			sqlVAL.stmt = nil
 		}
-	case 300:
+	case 314:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1367
+		//line sql.y:1586
 		{
 			sqlVAL.stmt = nil
 		}
-	case 301:
+	case 315:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1371
+		//line sql.y:1590
 		{
 			sqlVAL.stmt = nil
 		}
-	case 302:
+	case 316:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1375
+		//line sql.y:1594
 		{
 			sqlVAL.stmt = nil
 		}
-	case 303:
+	case 317:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1379
+		//line sql.y:1598
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false, columnKeyword: sqlDollar[5].boolVal}
 		}
-	case 304:
+	case 318:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1383
+		//line sql.y:1602
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true, columnKeyword: sqlDollar[7].boolVal}
 		}
-	case 305:
+	case 319:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1387
+		//line sql.y:1606
 		{
 			sqlVAL.stmt = nil
 		}
-	case 306:
+	case 320:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1391
+		//line sql.y:1610
 		{
 			sqlVAL.stmt = nil
 		}
-	case 307:
+	case 321:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1396
+		//line sql.y:1616
 		{
+			sqlVAL.boolVal = true
 		}
-	case 308:
+	case 322:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1397
+		//line sql.y:1620
 		{
+			sqlVAL.boolVal = false
 		}
-	case 309:
+	case 323:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1400
+		//line sql.y:1625
 		{
 		}
-	case 310:
+	case 324:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1401
+		//line sql.y:1626
 		{
 		}
-	case 311:
+	case 325:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1406
+		//line sql.y:1631
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 312:
+	case 326:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1410
+		//line sql.y:1635
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 313:
+	case 327:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1414
+		//line sql.y:1639
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 314:
+	case 328:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1418
+		//line sql.y:1643
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 315:
+	case 329:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1422
+		//line sql.y:1647
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 316:
+	case 330:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1426
+		//line sql.y:1651
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 317:
+	case 331:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1430
+		//line sql.y:1655
 		{
 			sqlVAL.stmt = nil
 		}
-	case 318:
+	case 332:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1434
+		//line sql.y:1659
 		{
 			sqlVAL.stmt = nil
 		}
-	case 319:
+	case 333:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1438
+		//line sql.y:1663
 		{
 			sqlVAL.stmt = nil
 		}
-	case 320:
+	case 334:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1442
+		//line sql.y:1667
 		{
 			sqlVAL.stmt = nil
 		}
-	case 321:
+	case 335:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1446
+		//line sql.y:1671
 		{
 			sqlVAL.stmt = nil
 		}
-	case 322:
+	case 336:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1450
+		//line sql.y:1675
 		{
 			sqlVAL.stmt = nil
 		}
-	case 323:
+	case 337:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1454
+		//line sql.y:1679
 		{
 			sqlVAL.stmt = nil
 		}
-	case 324:
+	case 338:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1458
+		//line sql.y:1683
 		{
 			sqlVAL.stmt = nil
 		}
-	case 325:
+	case 339:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1688
 		{
 		}
-	case 326:
+	case 340:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1464
+		//line sql.y:1689
 		{
 		}
-	case 327:
+	case 341:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1465
+		//line sql.y:1690
 		{
 		}
-	case 328:
+	case 342:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1468
+		//line sql.y:1694
 		{
+			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 329:
+	case 343:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1469
+		//line sql.y:1698
 		{
+			sqllex.Error("READ ONLY transactions are not supported")
+			return 1
 		}
-	case 330:
+	case 344:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1470
+		//line sql.y:1703
 		{
+			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 331:
+	case 345:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1471
+		//line sql.y:1707
 		{
+			sqllex.Error("DEFERRABLE transactions are not supported")
+			return 1
 		}
-	case 332:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1472
-		{
-		}
-	case 333:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1476
+		//line sql.y:1712
 		{
+			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 334:
+	case 348:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1477
+		//line sql.y:1721
 		{
+			sqlVAL.isoLevel = sqlDollar[1].isoLevel
+			if sqlDollar[3].isoLevel != UnspecifiedIsolation {
+				sqlVAL.isoLevel = sqlDollar[3].isoLevel
+			}
 		}
-	case 335:
+	case 349:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1478
-		{
-		}
-	case 336:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1481
+		//line sql.y:1728
 		{
+			sqlVAL.isoLevel = sqlDollar[1].isoLevel
+			if sqlDollar[2].isoLevel != UnspecifiedIsolation {
+				sqlVAL.isoLevel = sqlDollar[2].isoLevel
+			}
 		}
-	case 337:
+	case 351:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1482
+		//line sql.y:1738
 		{
+			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 338:
+	case 352:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1486
+		//line sql.y:1744
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 339:
+	case 353:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1490
+		//line sql.y:1748
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 340:
+	case 354:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1497
+		//line sql.y:1755
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 343:
+	case 357:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1513
+		//line sql.y:1771
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 344:
+	case 358:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1517
+		//line sql.y:1775
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].stmt.(SelectStatement)}
 		}
-	case 345:
+	case 359:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1521
+		//line sql.y:1779
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 346:
+	case 360:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1526
+		//line sql.y:1784
 		{
 		}
-	case 347:
+	case 361:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1527
+		//line sql.y:1785
 		{
 		}
-	case 348:
+	case 362:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1528
+		//line sql.y:1786
 		{
 		}
-	case 349:
+	case 363:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1531
+		//line sql.y:1789
 		{
 		}
-	case 350:
+	case 364:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1532
+		//line sql.y:1790
 		{
 		}
-	case 351:
+	case 365:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1533
+		//line sql.y:1791
 		{
 		}
-	case 352:
+	case 366:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1536
+		//line sql.y:1794
 		{
 		}
-	case 353:
+	case 367:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1537
+		//line sql.y:1795
 		{
 		}
-	case 354:
+	case 368:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1545
+		//line sql.y:1803
 		{
 			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
 		}
-	case 355:
+	case 369:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1551
+		//line sql.y:1809
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 356:
+	case 370:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1555
+		//line sql.y:1813
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 358:
+	case 372:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1561
+		//line sql.y:1819
 		{
 		}
-	case 359:
+	case 373:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1565
+		//line sql.y:1823
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Name: sqlDollar[1].qname, Expr: sqlDollar[3].expr}
 		}
-	case 360:
+	case 374:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1576
+		//line sql.y:1834
 		{
 		}
-	case 361:
+	case 375:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1577
+		//line sql.y:1835
 		{
 		}
-	case 364:
+	case 378:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1622
+		//line sql.y:1880
 		{
 			sqlVAL.stmt = &ParenSelect{Select: sqlDollar[2].stmt.(SelectStatement)}
 		}
-	case 365:
+	case 379:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1626
+		//line sql.y:1884
 		{
 			sqlVAL.stmt = &ParenSelect{Select: sqlDollar[2].stmt.(SelectStatement)}
 		}
-	case 367:
+	case 381:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1642
+		//line sql.y:1900
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[2].orderBy
+			case *Union:
+				s.OrderBy = sqlDollar[2].orderBy
+			}
 		}
-	case 368:
+	case 382:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1646
+		//line sql.y:1910
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[2].orderBy
+				s.Limit = sqlDollar[4].limit
+			case *Union:
+				s.OrderBy = sqlDollar[2].orderBy
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 369:
+	case 383:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1653
+		//line sql.y:1922
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[2].orderBy
+				s.Limit = sqlDollar[3].limit
+			case *Union:
+				s.OrderBy = sqlDollar[2].orderBy
 				s.Limit = sqlDollar[3].limit
 			}
 		}
-	case 370:
+	case 384:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1660
+		//line sql.y:1934
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 371:
+	case 385:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1664
+		//line sql.y:1938
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[3].orderBy
+			case *Union:
+				s.OrderBy = sqlDollar[3].orderBy
+			}
 		}
-	case 372:
+	case 386:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1668
+		//line sql.y:1948
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[3].orderBy
+				s.Limit = sqlDollar[5].limit
+			case *Union:
+				s.OrderBy = sqlDollar[3].orderBy
 				s.Limit = sqlDollar[5].limit
 			}
 		}
-	case 373:
+	case 387:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1675
+		//line sql.y:1960
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
+			switch s := sqlVAL.stmt.(type) {
+			case *Select:
+				s.OrderBy = sqlDollar[3].orderBy
+				s.Limit = sqlDollar[4].limit
+			case *Union:
+				s.OrderBy = sqlDollar[3].orderBy
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 376:
+	case 390:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1712
+		//line sql.y:2002
 		{
 			sqlVAL.stmt = &Select{
-				Exprs:  sqlDollar[3].selExprs,
-				From:   sqlDollar[4].tblExprs,
-				Where:  newWhere(astWhere, sqlDollar[5].expr),
-				Having: newWhere(astHaving, sqlDollar[7].expr),
+				Exprs:   sqlDollar[3].selExprs,
+				From:    sqlDollar[4].tblExprs,
+				Where:   newWhere(astWhere, sqlDollar[5].expr),
+				GroupBy: sqlDollar[6].groupBy,
+				Having:  newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 377:
+	case 391:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1723
+		//line sql.y:2014
 		{
 			// TODO(pmattis): Support DISTINCT ON?
 			sqlVAL.stmt = &Select{
@@ -7830,12 +8109,13 @@ sqldefault:
 				Exprs:    sqlDollar[3].selExprs,
 				From:     sqlDollar[4].tblExprs,
 				Where:    newWhere(astWhere, sqlDollar[5].expr),
+				GroupBy:  sqlDollar[6].groupBy,
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 379:
+	case 393:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1735
+		//line sql.y:2027
 		{
 			sqlVAL.stmt = &Select{
 				Exprs:       SelectExprs{StarSelectExpr},
@@ -7843,162 +8123,167 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 380:
+	case 394:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1743
+		//line sql.y:2035
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
-				Type:  astUnion,
+				Type:  AstUnion,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 381:
+	case 395:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1752
+		//line sql.y:2044
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
-				Type:  astIntersect,
+				Type:  AstIntersect,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 382:
+	case 396:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1761
+		//line sql.y:2053
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
-				Type:  astExcept,
+				Type:  AstExcept,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 383:
+	case 397:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1779
+		//line sql.y:2071
 		{
 		}
-	case 384:
+	case 398:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1780
+		//line sql.y:2072
 		{
 		}
-	case 385:
+	case 399:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1781
+		//line sql.y:2073
 		{
 		}
-	case 386:
+	case 400:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1784
+		//line sql.y:2076
 		{
 		}
-	case 387:
+	case 401:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1785
+		//line sql.y:2077
 		{
 		}
-	case 388:
+	case 402:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1788
+		//line sql.y:2080
 		{
 		}
-	case 393:
+	case 407:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1797
+		//line sql.y:2089
 		{
 		}
-	case 394:
+	case 408:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1798
+		//line sql.y:2090
 		{
 		}
-	case 395:
+	case 409:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1801
+		//line sql.y:2093
 		{
 		}
-	case 396:
+	case 410:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1802
+		//line sql.y:2094
 		{
 		}
-	case 397:
+	case 411:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1805
+		//line sql.y:2098
 		{
+			sqlVAL.boolVal = true
 		}
-	case 398:
+	case 412:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1806
+		//line sql.y:2102
 		{
+			sqlVAL.boolVal = false
 		}
-	case 399:
+	case 413:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1807
+		//line sql.y:2106
 		{
+			sqlVAL.boolVal = false
 		}
-	case 400:
+	case 414:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1812
+		//line sql.y:2113
 		{
 		}
-	case 401:
+	case 415:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1813
+		//line sql.y:2114
 		{
 		}
-	case 402:
+	case 416:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1816
+		//line sql.y:2117
 		{
 		}
-	case 403:
+	case 417:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1817
-		{
-		}
-	case 404:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1820
+		//line sql.y:2118
 		{
 		}
-	case 405:
+	case 419:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1821
+		//line sql.y:2123
 		{
+			sqlVAL.orderBy = nil
 		}
-	case 406:
+	case 420:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1824
+		//line sql.y:2129
 		{
+			sqlVAL.orderBy = OrderBy(sqlDollar[3].orderBy)
 		}
-	case 407:
+	case 421:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1827
+		//line sql.y:2135
 		{
+			sqlVAL.orderBy = OrderBy{sqlDollar[1].order}
 		}
-	case 408:
+	case 422:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1828
+		//line sql.y:2139
 		{
+			sqlVAL.orderBy = append(sqlDollar[1].orderBy, sqlDollar[3].order)
 		}
-	case 409:
+	case 423:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1831
+		//line sql.y:2145
 		{
+			sqllex.Error("ORDER BY ... USING is not supported")
+			return 1
 		}
-	case 410:
+	case 424:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1832
+		//line sql.y:2150
 		{
+			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 411:
+	case 425:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1836
+		//line sql.y:2156
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -8007,24 +8292,24 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 412:
+	case 426:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1845
+		//line sql.y:2165
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 416:
+	case 430:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1857
+		//line sql.y:2177
 		{
 			sqlVAL.limit = nil
 		}
-	case 417:
+	case 431:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1863
+		//line sql.y:2183
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -8032,2418 +8317,2427 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 418:
+	case 432:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1871
+		//line sql.y:2191
 		{
 		}
-	case 419:
+	case 433:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1875
+		//line sql.y:2195
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 420:
+	case 434:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1882
+		//line sql.y:2202
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 422:
+	case 436:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1889
+		//line sql.y:2209
 		{
 			sqlVAL.expr = nil
 		}
-	case 423:
+	case 437:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1897
+		//line sql.y:2217
 		{
 		}
-	case 424:
+	case 438:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1898
+		//line sql.y:2218
 		{
 		}
-	case 425:
+	case 439:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1899
+		//line sql.y:2219
 		{
 		}
-	case 426:
+	case 440:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1903
+		//line sql.y:2223
 		{
 		}
-	case 427:
+	case 441:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1904
+		//line sql.y:2224
 		{
 		}
-	case 428:
+	case 442:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1907
+		//line sql.y:2227
 		{
 		}
-	case 429:
+	case 443:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1908
+		//line sql.y:2228
 		{
 		}
-	case 430:
+	case 444:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1929
+		//line sql.y:2250
 		{
+			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 431:
+	case 445:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1930
+		//line sql.y:2254
 		{
+			sqlVAL.groupBy = nil
 		}
-	case 432:
+	case 446:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1933
+		//line sql.y:2260
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 433:
+	case 447:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1934
-		{
-		}
-	case 434:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1937
+		//line sql.y:2264
 		{
+			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 435:
+	case 449:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1938
+		//line sql.y:2271
 		{
+			sqllex.Error("empty grouping sets are not supported")
+			return 1
 		}
-	case 436:
+	case 450:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1941
+		//line sql.y:2277
 		{
 		}
-	case 437:
+	case 451:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1945
+		//line sql.y:2281
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 438:
+	case 452:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1949
+		//line sql.y:2285
 		{
 			sqlVAL.expr = nil
 		}
-	case 439:
+	case 453:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1954
+		//line sql.y:2290
 		{
 		}
-	case 440:
+	case 454:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1955
+		//line sql.y:2291
 		{
 		}
-	case 441:
+	case 455:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1958
+		//line sql.y:2294
 		{
 		}
-	case 442:
+	case 456:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1959
+		//line sql.y:2295
 		{
 		}
-	case 443:
+	case 457:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1962
+		//line sql.y:2298
 		{
 		}
-	case 444:
+	case 458:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1963
+		//line sql.y:2299
 		{
 		}
-	case 445:
+	case 459:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1966
+		//line sql.y:2302
 		{
 		}
-	case 446:
+	case 460:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1969
+		//line sql.y:2305
 		{
 		}
-	case 447:
+	case 461:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1970
+		//line sql.y:2306
 		{
 		}
-	case 448:
+	case 462:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1971
+		//line sql.y:2307
 		{
 		}
-	case 449:
+	case 463:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1974
+		//line sql.y:2310
 		{
 		}
-	case 450:
+	case 464:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1975
+		//line sql.y:2311
 		{
 		}
-	case 451:
+	case 465:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1976
+		//line sql.y:2312
 		{
 		}
-	case 452:
+	case 466:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1977
+		//line sql.y:2313
 		{
 		}
-	case 453:
+	case 467:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1980
+		//line sql.y:2316
 		{
 		}
-	case 454:
+	case 468:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1981
+		//line sql.y:2317
 		{
 		}
-	case 455:
+	case 469:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1985
+		//line sql.y:2321
 		{
 			sqlVAL.stmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 456:
+	case 470:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1989
+		//line sql.y:2325
 		{
 			sqlVAL.stmt = append(sqlDollar[1].stmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 457:
+	case 471:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1999
+		//line sql.y:2335
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 458:
+	case 472:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2003
+		//line sql.y:2339
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 459:
+	case 473:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2009
+		//line sql.y:2345
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 460:
+	case 474:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2013
+		//line sql.y:2349
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 461:
+	case 475:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2020
+		//line sql.y:2356
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 462:
+	case 476:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2023
+		//line sql.y:2359
 		{
 		}
-	case 463:
+	case 477:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2024
+		//line sql.y:2360
 		{
 		}
-	case 464:
+	case 478:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2026
+		//line sql.y:2362
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}, As: Name(sqlDollar[2].str)}
 		}
-	case 465:
+	case 479:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2029
+		//line sql.y:2365
 		{
 		}
-	case 467:
+	case 481:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2031
+		//line sql.y:2367
 		{
 		}
-	case 468:
+	case 482:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2049
+		//line sql.y:2385
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 469:
+	case 483:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2053
+		//line sql.y:2389
 		{
-			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
+			sqlVAL.tblExpr = &JoinTableExpr{Join: AstCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 470:
+	case 484:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2057
+		//line sql.y:2393
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 471:
+	case 485:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2397
 		{
-			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
+			sqlVAL.tblExpr = &JoinTableExpr{Join: AstJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 472:
+	case 486:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2065
+		//line sql.y:2401
 		{
-			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
+			sqlVAL.tblExpr = &JoinTableExpr{Join: AstNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 473:
+	case 487:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2069
+		//line sql.y:2405
 		{
-			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
+			sqlVAL.tblExpr = &JoinTableExpr{Join: AstNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 474:
+	case 488:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2074
+		//line sql.y:2410
 		{
 		}
-	case 475:
+	case 489:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2076
+		//line sql.y:2412
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 476:
+	case 490:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2079
+		//line sql.y:2415
 		{
 		}
-	case 477:
+	case 491:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2081
+		//line sql.y:2417
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 479:
+	case 493:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2088
+		//line sql.y:2424
 		{
 			sqlVAL.str = ""
 		}
-	case 480:
+	case 494:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2095
+		//line sql.y:2431
 		{
 		}
-	case 481:
+	case 495:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2096
+		//line sql.y:2432
 		{
 		}
-	case 482:
+	case 496:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2097
+		//line sql.y:2433
 		{
 		}
-	case 483:
+	case 497:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2098
+		//line sql.y:2434
 		{
 		}
-	case 484:
+	case 498:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2435
 		{
 		}
-	case 485:
+	case 499:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2439
 		{
-			sqlVAL.str = astFullJoin
+			sqlVAL.str = AstFullJoin
 		}
-	case 486:
+	case 500:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2107
+		//line sql.y:2443
 		{
-			sqlVAL.str = astLeftJoin
+			sqlVAL.str = AstLeftJoin
 		}
-	case 487:
+	case 501:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2111
+		//line sql.y:2447
 		{
-			sqlVAL.str = astRightJoin
+			sqlVAL.str = AstRightJoin
 		}
-	case 488:
+	case 502:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2115
+		//line sql.y:2451
 		{
-			sqlVAL.str = astInnerJoin
+			sqlVAL.str = AstInnerJoin
 		}
-	case 489:
+	case 503:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2121
+		//line sql.y:2457
 		{
 		}
-	case 490:
+	case 504:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2122
+		//line sql.y:2458
 		{
 		}
-	case 491:
+	case 505:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2133
+		//line sql.y:2469
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 492:
+	case 506:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2137
+		//line sql.y:2473
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 493:
+	case 507:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2143
+		//line sql.y:2479
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 494:
+	case 508:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2147
+		//line sql.y:2483
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 495:
+	case 509:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2152
+		//line sql.y:2488
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 496:
+	case 510:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2157
+		//line sql.y:2493
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 497:
+	case 511:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2164
+		//line sql.y:2500
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 498:
+	case 512:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2168
+		//line sql.y:2504
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 499:
+	case 513:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2181
+		//line sql.y:2517
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 500:
+	case 514:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2185
+		//line sql.y:2521
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 501:
+	case 515:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2189
+		//line sql.y:2525
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 502:
+	case 516:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2203
+		//line sql.y:2539
 		{
 		}
-	case 503:
+	case 517:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2204
+		//line sql.y:2540
 		{
 		}
-	case 504:
+	case 518:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2207
+		//line sql.y:2543
 		{
 		}
-	case 505:
+	case 519:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2210
+		//line sql.y:2546
 		{
 		}
-	case 506:
+	case 520:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2211
+		//line sql.y:2547
 		{
 		}
-	case 507:
+	case 521:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2214
+		//line sql.y:2550
 		{
 		}
-	case 508:
+	case 522:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2215
+		//line sql.y:2551
 		{
 		}
-	case 509:
+	case 523:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2218
+		//line sql.y:2554
 		{
 		}
-	case 510:
+	case 524:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2219
+		//line sql.y:2555
 		{
 		}
-	case 511:
+	case 525:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2223
+		//line sql.y:2559
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 512:
+	case 526:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2227
+		//line sql.y:2563
 		{
 			sqlVAL.expr = nil
 		}
-	case 513:
+	case 527:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2232
+		//line sql.y:2568
 		{
 		}
-	case 514:
+	case 528:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2233
+		//line sql.y:2569
 		{
 		}
-	case 515:
+	case 529:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2236
+		//line sql.y:2572
 		{
 		}
-	case 516:
+	case 530:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2246
+		//line sql.y:2582
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 517:
+	case 531:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2249
+		//line sql.y:2585
 		{
 		}
-	case 518:
+	case 532:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2251
+		//line sql.y:2587
 		{
 		}
-	case 519:
+	case 533:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2252
+		//line sql.y:2588
 		{
 		}
-	case 520:
+	case 534:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2253
+		//line sql.y:2589
 		{
 		}
-	case 521:
+	case 535:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2590
 		{
 		}
-	case 522:
+	case 536:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2257
+		//line sql.y:2593
 		{
 		}
-	case 523:
+	case 537:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2258
+		//line sql.y:2594
 		{
 		}
-	case 524:
+	case 538:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2259
+		//line sql.y:2595
 		{
 		}
-	case 529:
+	case 543:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2266
+		//line sql.y:2603
 		{
+			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 530:
+	case 544:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2267
+		//line sql.y:2607
 		{
+			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 531:
+	case 545:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2269
+		//line sql.y:2611
 		{
 			sqlVAL.colType = &BlobType{}
 		}
-	case 532:
+	case 546:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2273
+		//line sql.y:2615
 		{
 			sqlVAL.colType = &TextType{}
 		}
-	case 537:
+	case 551:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2294
+		//line sql.y:2636
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival}
 		}
-	case 538:
+	case 552:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2298
+		//line sql.y:2640
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival, Scale: sqlDollar[4].ival}
 		}
-	case 539:
+	case 553:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2302
+		//line sql.y:2644
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 540:
+	case 554:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2309
+		//line sql.y:2651
 		{
 			sqlVAL.colType = &IntType{Name: astInt}
 		}
-	case 541:
+	case 555:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2313
+		//line sql.y:2655
 		{
 			sqlVAL.colType = &IntType{Name: astInteger}
 		}
-	case 542:
+	case 556:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2317
+		//line sql.y:2659
 		{
 			sqlVAL.colType = &IntType{Name: astSmallInt}
 		}
-	case 543:
+	case 557:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2321
+		//line sql.y:2663
 		{
 			sqlVAL.colType = &IntType{Name: astBigInt}
 		}
-	case 544:
+	case 558:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2325
+		//line sql.y:2667
 		{
 			sqlVAL.colType = &FloatType{Name: astReal}
 		}
-	case 545:
+	case 559:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2329
+		//line sql.y:2671
 		{
 			sqlVAL.colType = &FloatType{Name: astFloat, Prec: sqlDollar[2].ival}
 		}
-	case 546:
+	case 560:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2333
+		//line sql.y:2675
 		{
 			sqlVAL.colType = &FloatType{Name: astDouble}
 		}
-	case 547:
+	case 561:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2337
+		//line sql.y:2679
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 548:
+	case 562:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2342
+		//line sql.y:2684
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 549:
+	case 563:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2689
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astNumeric
 		}
-	case 550:
+	case 564:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2352
+		//line sql.y:2694
 		{
 			sqlVAL.colType = &BoolType{}
 		}
-	case 551:
+	case 565:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2358
+		//line sql.y:2700
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 552:
+	case 566:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2362
+		//line sql.y:2704
 		{
 			sqlVAL.ival = 0
 		}
-	case 557:
+	case 571:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2380
+		//line sql.y:2722
 		{
 			sqlVAL.colType = &BitType{N: sqlDollar[4].ival}
 		}
-	case 558:
+	case 572:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2386
+		//line sql.y:2728
 		{
 			sqlVAL.colType = &BitType{}
 		}
-	case 563:
+	case 577:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2744
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*CharType).N = sqlDollar[3].ival
 		}
-	case 564:
+	case 578:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2409
+		//line sql.y:2751
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 565:
+	case 579:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2415
+		//line sql.y:2757
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 566:
+	case 580:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2419
+		//line sql.y:2761
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 567:
+	case 581:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2423
+		//line sql.y:2765
 		{
 			sqlVAL.colType = &CharType{Name: astVarChar}
 		}
-	case 568:
+	case 582:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2428
+		//line sql.y:2770
 		{
 		}
-	case 569:
+	case 583:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2429
+		//line sql.y:2771
 		{
 		}
-	case 570:
+	case 584:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2432
+		//line sql.y:2774
 		{
 		}
-	case 571:
+	case 585:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2433
+		//line sql.y:2775
 		{
 		}
-	case 572:
+	case 586:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2438
+		//line sql.y:2780
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 573:
+	case 587:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2442
+		//line sql.y:2784
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 574:
+	case 588:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2446
+		//line sql.y:2788
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 575:
+	case 589:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2450
+		//line sql.y:2792
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 576:
+	case 590:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2454
+		//line sql.y:2796
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 577:
+	case 591:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2459
+		//line sql.y:2802
 		{
+			sqlVAL.colType = &IntervalType{}
 		}
-	case 578:
+	case 592:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2462
+		//line sql.y:2807
 		{
 		}
-	case 579:
+	case 593:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2463
+		//line sql.y:2808
 		{
 		}
-	case 580:
+	case 594:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2464
+		//line sql.y:2809
 		{
 		}
-	case 581:
+	case 595:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2467
+		//line sql.y:2812
 		{
 		}
-	case 582:
+	case 596:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2468
+		//line sql.y:2813
 		{
 		}
-	case 583:
+	case 597:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2469
+		//line sql.y:2814
 		{
 		}
-	case 584:
+	case 598:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2470
+		//line sql.y:2815
 		{
 		}
-	case 585:
+	case 599:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2471
+		//line sql.y:2816
 		{
 		}
-	case 586:
+	case 600:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2472
+		//line sql.y:2817
 		{
 		}
-	case 587:
+	case 601:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2473
+		//line sql.y:2818
 		{
 		}
-	case 588:
+	case 602:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2474
+		//line sql.y:2819
 		{
 		}
-	case 589:
+	case 603:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2475
+		//line sql.y:2820
 		{
 		}
-	case 590:
+	case 604:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2476
+		//line sql.y:2821
 		{
 		}
-	case 591:
+	case 605:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2477
+		//line sql.y:2822
 		{
 		}
-	case 592:
+	case 606:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2478
+		//line sql.y:2823
 		{
 		}
-	case 593:
+	case 607:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2479
+		//line sql.y:2824
 		{
 		}
-	case 594:
+	case 608:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2480
+		//line sql.y:2825
 		{
 		}
-	case 595:
+	case 609:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2483
+		//line sql.y:2828
 		{
 		}
-	case 596:
+	case 610:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2484
+		//line sql.y:2829
 		{
 		}
-	case 598:
+	case 612:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2508
+		//line sql.y:2853
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 599:
+	case 613:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2511
+		//line sql.y:2856
 		{
 		}
-	case 600:
+	case 614:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2512
+		//line sql.y:2857
 		{
 		}
-	case 601:
+	case 615:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2521
+		//line sql.y:2866
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 602:
+	case 616:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2525
+		//line sql.y:2870
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 603:
+	case 617:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2529
+		//line sql.y:2874
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 604:
+	case 618:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2533
+		//line sql.y:2878
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 605:
+	case 619:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2537
+		//line sql.y:2882
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 606:
+	case 620:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2541
+		//line sql.y:2886
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 607:
+	case 621:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2545
+		//line sql.y:2890
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 608:
+	case 622:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2549
+		//line sql.y:2894
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 609:
+	case 623:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2553
+		//line sql.y:2898
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 610:
+	case 624:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2557
+		//line sql.y:2902
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 611:
+	case 625:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2561
+		//line sql.y:2906
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 612:
+	case 626:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2565
+		//line sql.y:2910
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 613:
+	case 627:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2569
+		//line sql.y:2914
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 614:
+	case 628:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2573
+		//line sql.y:2918
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 615:
+	case 629:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2577
+		//line sql.y:2922
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 616:
+	case 630:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2581
+		//line sql.y:2926
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 617:
+	case 631:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2585
+		//line sql.y:2930
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 618:
+	case 632:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2589
+		//line sql.y:2934
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 619:
+	case 633:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2593
+		//line sql.y:2938
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 620:
+	case 634:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2597
+		//line sql.y:2942
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 621:
+	case 635:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2601
+		//line sql.y:2946
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 622:
+	case 636:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2605
+		//line sql.y:2950
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 623:
+	case 637:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2609
+		//line sql.y:2954
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 624:
+	case 638:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2613
+		//line sql.y:2958
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 625:
+	case 639:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2617
+		//line sql.y:2962
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 626:
+	case 640:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2621
+		//line sql.y:2966
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 627:
+	case 641:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2625
+		//line sql.y:2970
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 628:
+	case 642:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2973
 		{
 		}
-	case 629:
+	case 643:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2629
+		//line sql.y:2974
 		{
 		}
-	case 630:
+	case 644:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2630
+		//line sql.y:2975
 		{
 		}
-	case 631:
+	case 645:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2631
+		//line sql.y:2976
 		{
 		}
-	case 632:
+	case 646:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2633
+		//line sql.y:2978
 		{
 			sqlVAL.expr = &NullCheck{Expr: sqlDollar[1].expr}
 		}
-	case 633:
+	case 647:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2637
+		//line sql.y:2982
 		{
 			sqlVAL.expr = &NullCheck{Not: true, Expr: sqlDollar[1].expr}
 		}
-	case 634:
+	case 648:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2640
+		//line sql.y:2985
 		{
 		}
-	case 635:
+	case 649:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2641
+		//line sql.y:2986
 		{
 		}
-	case 636:
+	case 650:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2642
+		//line sql.y:2987
 		{
 		}
-	case 637:
+	case 651:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2643
+		//line sql.y:2988
 		{
 		}
-	case 638:
+	case 652:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2644
+		//line sql.y:2989
 		{
 		}
-	case 639:
+	case 653:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2645
+		//line sql.y:2990
 		{
 		}
-	case 640:
+	case 654:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2646
+		//line sql.y:2991
 		{
 		}
-	case 641:
+	case 655:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2647
+		//line sql.y:2992
 		{
 		}
-	case 642:
+	case 656:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2648
+		//line sql.y:2993
 		{
 		}
-	case 643:
+	case 657:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2649
+		//line sql.y:2994
 		{
 		}
-	case 644:
+	case 658:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2650
+		//line sql.y:2995
 		{
 		}
-	case 645:
+	case 659:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2652
+		//line sql.y:2997
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 646:
+	case 660:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2656
+		//line sql.y:3001
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 647:
+	case 661:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2660
+		//line sql.y:3005
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 648:
+	case 662:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2664
+		//line sql.y:3009
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 649:
+	case 663:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2668
+		//line sql.y:3013
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 650:
+	case 664:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:3017
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 651:
+	case 665:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2675
+		//line sql.y:3020
 		{
 		}
-	case 652:
+	case 666:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:3021
 		{
 		}
-	case 653:
+	case 667:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2677
+		//line sql.y:3022
 		{
 		}
-	case 654:
+	case 668:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2678
+		//line sql.y:3023
 		{
 		}
-	case 655:
+	case 669:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2679
+		//line sql.y:3024
 		{
 		}
-	case 657:
+	case 671:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2691
+		//line sql.y:3036
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 658:
+	case 672:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2695
+		//line sql.y:3040
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 659:
+	case 673:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2699
+		//line sql.y:3044
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 660:
+	case 674:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2703
+		//line sql.y:3048
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 661:
+	case 675:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2707
+		//line sql.y:3052
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 662:
+	case 676:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2711
+		//line sql.y:3056
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 663:
+	case 677:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2715
+		//line sql.y:3060
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 664:
+	case 678:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2719
+		//line sql.y:3064
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 665:
+	case 679:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2723
+		//line sql.y:3068
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 666:
+	case 680:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2727
+		//line sql.y:3072
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 667:
+	case 681:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3076
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 668:
+	case 682:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2735
+		//line sql.y:3080
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 669:
+	case 683:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2739
+		//line sql.y:3084
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 670:
+	case 684:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2743
+		//line sql.y:3088
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 671:
+	case 685:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2747
+		//line sql.y:3092
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 672:
+	case 686:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2751
+		//line sql.y:3096
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 673:
+	case 687:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2755
+		//line sql.y:3100
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 674:
+	case 688:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2759
+		//line sql.y:3104
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 675:
+	case 689:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2763
+		//line sql.y:3108
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 676:
+	case 690:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2767
+		//line sql.y:3112
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 677:
+	case 691:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2770
+		//line sql.y:3115
 		{
 		}
-	case 678:
+	case 692:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2771
+		//line sql.y:3116
 		{
 		}
-	case 679:
+	case 693:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2772
+		//line sql.y:3117
 		{
 		}
-	case 680:
+	case 694:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2773
+		//line sql.y:3118
 		{
 		}
-	case 681:
+	case 695:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2774
+		//line sql.y:3119
 		{
 		}
-	case 682:
+	case 696:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2775
+		//line sql.y:3120
 		{
 		}
-	case 683:
+	case 697:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2785
+		//line sql.y:3130
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 685:
+	case 699:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2790
+		//line sql.y:3135
 		{
 			sqlVAL.expr = ValArg(sqlDollar[1].ival)
 		}
-	case 686:
+	case 700:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2794
+		//line sql.y:3139
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 689:
+	case 703:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2800
+		//line sql.y:3145
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 690:
+	case 704:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2804
+		//line sql.y:3149
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 691:
+	case 705:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2808
+		//line sql.y:3153
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].stmt.(SelectStatement)}}
 		}
-	case 692:
+	case 706:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2811
+		//line sql.y:3156
 		{
 		}
-	case 693:
+	case 707:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2812
+		//line sql.y:3157
 		{
 		}
-	case 694:
+	case 708:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2814
+		//line sql.y:3159
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 695:
+	case 709:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2818
+		//line sql.y:3163
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 696:
+	case 710:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2821
+		//line sql.y:3166
 		{
 		}
-	case 697:
+	case 711:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2825
+		//line sql.y:3170
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 698:
+	case 712:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3174
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 699:
+	case 713:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2834
+		//line sql.y:3179
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 700:
+	case 714:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2838
+		//line sql.y:3183
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 701:
+	case 715:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2842
+		//line sql.y:3187
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 702:
+	case 716:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2846
+		//line sql.y:3191
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 703:
+	case 717:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2851
+		//line sql.y:3196
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{StarExpr}}
 		}
-	case 704:
+	case 718:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2864
+		//line sql.y:3209
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 705:
+	case 719:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2870
+		//line sql.y:3215
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 706:
+	case 720:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2879
+		//line sql.y:3224
 		{
 		}
-	case 707:
+	case 721:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2880
+		//line sql.y:3225
 		{
 		}
-	case 708:
+	case 722:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2884
+		//line sql.y:3229
 		{
 		}
-	case 709:
+	case 723:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2885
+		//line sql.y:3231
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("current_date")}}
 		}
-	case 710:
+	case 724:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2886
+		//line sql.y:3234
 		{
 		}
-	case 711:
+	case 725:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2887
+		//line sql.y:3235
 		{
 		}
-	case 712:
+	case 726:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2888
+		//line sql.y:3237
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("now")}}
 		}
-	case 713:
+	case 727:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2889
+		//line sql.y:3240
 		{
 		}
-	case 714:
+	case 728:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2890
+		//line sql.y:3241
 		{
 		}
-	case 715:
+	case 729:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2891
+		//line sql.y:3242
 		{
 		}
-	case 716:
+	case 730:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2892
+		//line sql.y:3243
 		{
 		}
-	case 717:
+	case 731:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2893
+		//line sql.y:3244
 		{
 		}
-	case 718:
+	case 732:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2894
+		//line sql.y:3245
 		{
 		}
-	case 719:
+	case 733:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2895
+		//line sql.y:3246
 		{
 		}
-	case 720:
+	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2896
+		//line sql.y:3247
 		{
 		}
-	case 721:
+	case 735:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2897
+		//line sql.y:3248
 		{
 		}
-	case 722:
+	case 736:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2899
+		//line sql.y:3250
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 723:
+	case 737:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2902
+		//line sql.y:3253
 		{
 		}
-	case 724:
+	case 738:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2903
+		//line sql.y:3254
 		{
 		}
-	case 725:
+	case 739:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2904
+		//line sql.y:3255
 		{
 		}
-	case 726:
+	case 740:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2905
+		//line sql.y:3256
 		{
 		}
-	case 727:
+	case 741:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2906
+		//line sql.y:3257
 		{
 		}
-	case 728:
+	case 742:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2907
+		//line sql.y:3258
 		{
 		}
-	case 729:
+	case 743:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2908
+		//line sql.y:3259
 		{
 		}
-	case 730:
+	case 744:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2909
+		//line sql.y:3260
 		{
 		}
-	case 731:
+	case 745:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2910
+		//line sql.y:3261
 		{
 		}
-	case 732:
+	case 746:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2911
+		//line sql.y:3262
 		{
 		}
-	case 733:
+	case 747:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2912
+		//line sql.y:3263
 		{
 		}
-	case 734:
+	case 748:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2913
+		//line sql.y:3264
 		{
 		}
-	case 735:
+	case 749:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2914
+		//line sql.y:3265
 		{
 		}
-	case 736:
+	case 750:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3269
 		{
 		}
-	case 737:
+	case 751:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2919
+		//line sql.y:3270
 		{
 		}
-	case 738:
+	case 752:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2922
+		//line sql.y:3273
 		{
 		}
-	case 739:
+	case 753:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3274
 		{
 		}
-	case 740:
+	case 754:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2927
+		//line sql.y:3278
 		{
 		}
-	case 741:
+	case 755:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2928
+		//line sql.y:3279
 		{
 		}
-	case 742:
+	case 756:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2931
+		//line sql.y:3282
 		{
 		}
-	case 743:
+	case 757:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2932
+		//line sql.y:3283
 		{
 		}
-	case 744:
+	case 758:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2935
+		//line sql.y:3286
 		{
 		}
-	case 745:
+	case 759:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2938
+		//line sql.y:3289
 		{
 		}
-	case 746:
+	case 760:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2939
+		//line sql.y:3290
 		{
 		}
-	case 747:
+	case 761:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2940
+		//line sql.y:3291
 		{
 		}
-	case 748:
+	case 762:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2944
+		//line sql.y:3295
 		{
 		}
-	case 749:
+	case 763:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2955
+		//line sql.y:3306
 		{
 		}
-	case 750:
+	case 764:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2956
+		//line sql.y:3307
 		{
 		}
-	case 751:
+	case 765:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2959
+		//line sql.y:3310
 		{
 		}
-	case 752:
+	case 766:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2960
+		//line sql.y:3311
 		{
 		}
-	case 753:
+	case 767:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2968
+		//line sql.y:3319
 		{
 		}
-	case 754:
+	case 768:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2969
+		//line sql.y:3320
 		{
 		}
-	case 755:
+	case 769:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2970
+		//line sql.y:3321
 		{
 		}
-	case 756:
+	case 770:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2973
+		//line sql.y:3324
 		{
 		}
-	case 757:
+	case 771:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2974
+		//line sql.y:3325
 		{
 		}
-	case 758:
+	case 772:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2980
+		//line sql.y:3331
 		{
 		}
-	case 759:
+	case 773:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2981
+		//line sql.y:3332
 		{
 		}
-	case 760:
+	case 774:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2982
+		//line sql.y:3333
 		{
 		}
-	case 761:
+	case 775:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2983
+		//line sql.y:3334
 		{
 		}
-	case 762:
+	case 776:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2984
+		//line sql.y:3335
 		{
 		}
-	case 763:
+	case 777:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2995
+		//line sql.y:3346
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 764:
+	case 778:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2999
+		//line sql.y:3350
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 765:
+	case 779:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3003
+		//line sql.y:3354
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 766:
+	case 780:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3009
+		//line sql.y:3360
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 767:
+	case 781:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3013
+		//line sql.y:3364
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 768:
+	case 782:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3019
+		//line sql.y:3370
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 769:
+	case 783:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3024
+		//line sql.y:3375
 		{
 		}
-	case 770:
+	case 784:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3025
+		//line sql.y:3376
 		{
 		}
-	case 771:
+	case 785:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3026
+		//line sql.y:3377
 		{
 		}
-	case 772:
+	case 786:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3029
+		//line sql.y:3380
 		{
 		}
-	case 773:
+	case 787:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3030
+		//line sql.y:3381
 		{
 		}
-	case 774:
+	case 788:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3031
+		//line sql.y:3382
 		{
 		}
-	case 775:
+	case 789:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3032
+		//line sql.y:3383
 		{
 		}
-	case 776:
+	case 790:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3033
+		//line sql.y:3384
 		{
 		}
-	case 777:
+	case 791:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3034
+		//line sql.y:3385
 		{
 		}
-	case 778:
+	case 792:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3035
+		//line sql.y:3386
 		{
 		}
-	case 779:
+	case 793:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3036
+		//line sql.y:3387
 		{
 		}
-	case 780:
+	case 794:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3037
+		//line sql.y:3388
 		{
 		}
-	case 781:
+	case 795:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3038
+		//line sql.y:3389
 		{
 		}
-	case 782:
+	case 796:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3039
+		//line sql.y:3390
 		{
 		}
-	case 783:
+	case 797:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3040
+		//line sql.y:3391
 		{
 		}
-	case 784:
+	case 798:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3041
+		//line sql.y:3392
 		{
 		}
-	case 785:
+	case 799:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3042
+		//line sql.y:3393
 		{
 		}
-	case 786:
+	case 800:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3043
+		//line sql.y:3394
 		{
 		}
-	case 787:
+	case 801:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3044
+		//line sql.y:3395
 		{
 		}
-	case 788:
+	case 802:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3398
 		{
 		}
-	case 789:
+	case 803:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3048
+		//line sql.y:3399
 		{
 		}
-	case 790:
+	case 804:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3049
+		//line sql.y:3400
 		{
 		}
-	case 791:
+	case 805:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3060
+		//line sql.y:3411
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 792:
+	case 806:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3064
+		//line sql.y:3415
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 793:
+	case 807:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3069
+		//line sql.y:3420
 		{
 		}
-	case 794:
+	case 808:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3070
+		//line sql.y:3421
 		{
 		}
-	case 795:
+	case 809:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3073
+		//line sql.y:3424
 		{
 		}
-	case 796:
+	case 810:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3074
+		//line sql.y:3425
 		{
 		}
-	case 797:
+	case 811:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3075
+		//line sql.y:3426
 		{
 		}
-	case 798:
+	case 812:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3078
+		//line sql.y:3429
 		{
 		}
-	case 799:
+	case 813:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3079
+		//line sql.y:3430
 		{
 		}
-	case 800:
+	case 814:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3082
+		//line sql.y:3433
 		{
 		}
-	case 801:
+	case 815:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3083
+		//line sql.y:3434
 		{
 		}
-	case 802:
+	case 816:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3088
+		//line sql.y:3439
 		{
 		}
-	case 803:
+	case 817:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3089
+		//line sql.y:3440
 		{
 		}
-	case 804:
+	case 818:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3090
+		//line sql.y:3441
 		{
 		}
-	case 805:
+	case 819:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3091
+		//line sql.y:3442
 		{
 		}
-	case 806:
+	case 820:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3092
+		//line sql.y:3443
 		{
 		}
-	case 807:
+	case 821:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3093
+		//line sql.y:3444
 		{
 		}
-	case 808:
+	case 822:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3094
+		//line sql.y:3445
 		{
 		}
-	case 809:
+	case 823:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3095
+		//line sql.y:3446
 		{
 		}
-	case 810:
+	case 824:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3103
+		//line sql.y:3454
 		{
 		}
-	case 811:
+	case 825:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3104
+		//line sql.y:3455
 		{
 		}
-	case 812:
+	case 826:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3107
+		//line sql.y:3458
 		{
 		}
-	case 813:
+	case 827:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3111
+		//line sql.y:3462
 		{
 		}
-	case 814:
+	case 828:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3112
+		//line sql.y:3463
 		{
 		}
-	case 815:
+	case 829:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3126
+		//line sql.y:3477
 		{
 		}
-	case 816:
+	case 830:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3127
+		//line sql.y:3478
 		{
 		}
-	case 817:
+	case 831:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3128
+		//line sql.y:3479
 		{
 		}
-	case 818:
+	case 832:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3129
+		//line sql.y:3480
 		{
 		}
-	case 819:
+	case 833:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3130
+		//line sql.y:3481
 		{
 		}
-	case 820:
+	case 834:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3131
+		//line sql.y:3482
 		{
 		}
-	case 821:
+	case 835:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3134
+		//line sql.y:3485
 		{
 		}
-	case 822:
+	case 836:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3137
+		//line sql.y:3488
 		{
 		}
-	case 823:
+	case 837:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3140
+		//line sql.y:3491
 		{
 		}
-	case 824:
+	case 838:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3141
+		//line sql.y:3492
 		{
 		}
-	case 825:
+	case 839:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3142
+		//line sql.y:3493
 		{
 		}
-	case 826:
+	case 840:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3146
+		//line sql.y:3497
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 827:
+	case 841:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3150
+		//line sql.y:3501
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 828:
+	case 842:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3161
+		//line sql.y:3512
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 829:
+	case 843:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3168
+		//line sql.y:3519
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 830:
+	case 844:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3172
+		//line sql.y:3523
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 831:
+	case 845:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3178
+		//line sql.y:3529
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 832:
+	case 846:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3535
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 833:
+	case 847:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3188
+		//line sql.y:3539
 		{
 			sqlVAL.expr = nil
 		}
-	case 835:
+	case 849:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3195
+		//line sql.y:3546
 		{
 			sqlVAL.expr = nil
 		}
-	case 836:
+	case 850:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3201
+		//line sql.y:3552
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
-	case 837:
+	case 851:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3205
+		//line sql.y:3556
 		{
 			sqlVAL.indirectElem = qualifiedStar
 		}
-	case 838:
+	case 852:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3209
+		//line sql.y:3560
 		{
 			sqlVAL.indirectElem = IndexIndirection(sqlDollar[2].str)
 		}
-	case 839:
+	case 853:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3213
+		//line sql.y:3564
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
-	case 840:
+	case 854:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3217
+		//line sql.y:3568
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
-	case 841:
+	case 855:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3223
+		//line sql.y:3574
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
-	case 842:
+	case 856:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3227
+		//line sql.y:3578
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 843:
+	case 857:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3233
+		//line sql.y:3584
 		{
 			sqlVAL.indirect = nil
 		}
-	case 844:
+	case 858:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3237
+		//line sql.y:3588
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 845:
+	case 859:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3242
+		//line sql.y:3593
 		{
 		}
-	case 846:
+	case 860:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3243
+		//line sql.y:3594
 		{
 		}
-	case 848:
+	case 862:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3252
+		//line sql.y:3603
 		{
 			sqlVAL.expr = nil
 		}
-	case 849:
+	case 863:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3258
+		//line sql.y:3609
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 850:
+	case 864:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3262
+		//line sql.y:3613
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 851:
+	case 865:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3271
+		//line sql.y:3622
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 853:
+	case 867:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3279
+		//line sql.y:3630
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 854:
+	case 868:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3285
+		//line sql.y:3636
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 855:
+	case 869:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3289
+		//line sql.y:3640
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 856:
+	case 870:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3295
+		//line sql.y:3646
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 857:
+	case 871:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3304
+		//line sql.y:3655
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 858:
+	case 872:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3308
+		//line sql.y:3659
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr}
 		}
-	case 859:
+	case 873:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3312
+		//line sql.y:3663
 		{
 			sqlVAL.selExpr = StarSelectExpr
 		}
-	case 860:
+	case 874:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3320
+		//line sql.y:3671
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 861:
+	case 875:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3324
+		//line sql.y:3675
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 862:
+	case 876:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3335
+		//line sql.y:3686
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 863:
+	case 877:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3339
+		//line sql.y:3690
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 864:
+	case 878:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3345
+		//line sql.y:3696
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 865:
+	case 879:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3349
+		//line sql.y:3700
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 866:
+	case 880:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3355
+		//line sql.y:3706
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 867:
+	case 881:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3358
+		//line sql.y:3709
 		{
 		}
-	case 868:
+	case 882:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3368
+		//line sql.y:3719
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 869:
+	case 883:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3372
+		//line sql.y:3723
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 870:
+	case 884:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3379
+		//line sql.y:3730
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 871:
+	case 885:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3383
+		//line sql.y:3734
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 872:
+	case 886:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3387
+		//line sql.y:3738
 		{
 			// TODO(pmattis): string literal
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 873:
+	case 887:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3392
+		//line sql.y:3743
 		{
 			// TODO(pmattis): bit literal.
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 874:
+	case 888:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3397
+		//line sql.y:3748
 		{
 			// TODO(pmattis): hex literal.
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 875:
+	case 889:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3401
+		//line sql.y:3752
 		{
 		}
-	case 876:
+	case 890:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3402
+		//line sql.y:3754
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 877:
+	case 891:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3403
+		//line sql.y:3758
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 878:
+	case 892:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3404
+		//line sql.y:3762
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
-	case 879:
+	case 893:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3406
+		//line sql.y:3766
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
-	case 880:
+	case 894:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3410
+		//line sql.y:3770
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
-	case 881:
+	case 895:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3414
+		//line sql.y:3774
 		{
 			sqlVAL.expr = NullVal{}
 		}
-	case 883:
+	case 897:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3421
+		//line sql.y:3781
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
-	case 884:
+	case 898:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3425
+		//line sql.y:3785
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
-	case 889:
+	case 903:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3447
+		//line sql.y:3807
 		{
 			sqlVAL.str = ""
 		}
@@ -26,6 +26,7 @@ package parser
   id             int
   pos            int
   empty          struct{}
+  boolVal        bool
   ival           int
   str            string
   strs           []string
@@ -39,6 +40,8 @@ package parser
   tblDefs        []TableDef
   colConstraint  ColumnConstraint
   colConstraints []ColumnConstraint
+  alterTableCmd  AlterTableCmd
+  alterTableCmds AlterTableCmds
   colType        ColumnType
   expr           Expr
   exprs          Exprs
@@ -51,11 +54,16 @@ package parser
   whens          []*When
   updateExpr     *UpdateExpr
   updateExprs    []*UpdateExpr
+  groupBy        GroupBy
+  orderBy        OrderBy
+  order          *Order
+  dir            Direction
   limit          *Limit
   targetList     TargetList
   targetListPtr  *TargetList
-  privilegeType  PrivilegeType
   privilegeList  PrivilegeList
+  isoLevel       IsolationLevel
+  refAction      ReferenceAction
 }
 
 %type <stmts> stmt_block
@@ -66,11 +74,14 @@ package parser
 %type <stmt> alter_database_stmt
 %type <stmt> alter_index_stmt
 %type <stmt> alter_table_stmt
+%type <stmt> alter_user_stmt
 %type <stmt> create_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
+%type <stmt> create_role_stmt
 %type <stmt> create_table_stmt
 %type <stmt> create_table_as_stmt
+%type <stmt> create_user_stmt
 %type <stmt> delete_stmt
 %type <stmt> drop_stmt
 %type <stmt> explain_stmt
@@ -91,15 +102,17 @@ package parser
 %type <stmt> simple_select values_clause
 
 %type <empty> alter_column_default alter_using
-%type <empty> opt_asc_desc opt_nulls_order
+%type <dir> opt_asc_desc
+%type <empty> opt_nulls_order
 
-%type <empty> alter_table_cmd opt_collate_clause
-%type <empty> alter_table_cmds
+%type <alterTableCmd> alter_table_cmd
+%type <alterTableCmds> alter_table_cmds
+%type <empty> opt_collate_clause
 
 %type <empty> opt_drop_behavior
 
-%type <empty> transaction_mode_list
-%type <empty> transaction_mode_item
+%type <isoLevel> transaction_mode_list
+%type <isoLevel> transaction_mode_item
 
 %type <empty> opt_with_data
 %type <empty> opt_nowait_or_skip
@@ -117,15 +130,17 @@ package parser
 
 %type <empty> math_op
 
-%type <empty> iso_level opt_encoding
+%type <isoLevel> iso_level
+%type <empty> opt_encoding
 
 %type <tblDefs> opt_table_elem_list table_elem_list 
 %type <empty> opt_inherit
 %type <empty> opt_typed_table_elem_list typed_table_elem_list
-%type <empty> reloptions opt_reloptions
+%type <empty> reloptions
 %type <empty> opt_with distinct_clause opt_all_clause
 %type <strs> opt_column_list
-%type <empty> sort_clause opt_sort_clause sortby_list index_params
+%type <orderBy> sort_clause opt_sort_clause sortby_list
+%type <empty> index_params
 %type <strs> name_list opt_name_list
 %type <empty> opt_array_bounds
 %type <tblExprs> from_clause from_list
@@ -140,15 +155,17 @@ package parser
 %type <updateExpr> set_clause multiple_set_clause
 %type <indirect> indirection opt_indirection
 %type <exprs> ctext_expr_list ctext_row
-%type <empty> reloption_list group_clause
+%type <empty> reloption_list
+%type <groupBy> group_clause
 %type <limit> select_limit opt_select_limit
-%type <empty> transaction_mode_list_or_empty
+%type <isoLevel> transaction_mode_list_or_empty
 %type <empty> table_func_elem_list
 %type <empty> returning_clause
 %type <qnames> relation_expr_list
 
-%type <empty> group_by_list
-%type <empty> group_by_item empty_grouping_set
+%type <exprs> group_by_list
+%type <expr> group_by_item
+%type <empty> empty_grouping_set
 
 %type <empty> create_as_target
 
@@ -161,7 +178,7 @@ package parser
 %type <empty> for_locking_item
 %type <empty> for_locking_clause opt_for_locking_clause for_locking_items
 %type <empty> locked_rels_list
-%type <empty> all_or_distinct
+%type <boolVal> all_or_distinct
 
 %type <empty> join_outer
 %type <joinCond> join_qual
@@ -172,10 +189,10 @@ package parser
 %type <empty> opt_interval interval_second
 %type <empty> overlay_placing substr_from substr_for
 
-%type <empty> opt_unique opt_concurrently
+%type <boolVal> opt_unique
 
-%type <empty> opt_column opt_set_data
-%type <empty> drop_type
+%type <boolVal> opt_column
+%type <empty> opt_set_data
 
 %type <limit> limit_clause offset_clause 
 %type <expr>  select_limit_value
@@ -214,7 +231,7 @@ package parser
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <empty> func_alias_clause
-%type <empty> sortby
+%type <order> sortby
 %type <empty> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
@@ -255,7 +272,8 @@ package parser
 %type <empty> table_like_option_list table_like_option
 %type <colConstraints> col_qual_list
 %type <colConstraint> col_constraint col_constraint_elem
-%type <empty> key_actions key_delete key_match key_update key_action
+%type <empty> key_match
+%type <refAction> key_actions key_delete key_update key_action
 %type <empty> existing_index
 
 %type <expr>  func_application func_expr_common_subexpr
@@ -274,8 +292,10 @@ package parser
 %type <targetList>    privilege_target
 %type <targetListPtr> on_privilege_target_clause
 %type <strs>          grantee_list for_grantee_clause
-%type <privilegeList> privileges privilege_list
-%type <privilegeType> privilege
+%type <privilegeList> privileges
+%type <str>           privilege_name
+%type <strs>          privilege_name_list
+%type <str>           opt_password password
 
 // Non-keyword token types. These are hard-wired into the "flex" lexer. They
 // must be listed first so that their numeric codes do not depend on the set of
@@ -356,7 +376,7 @@ package parser
 
 %token <str>   RANGE READ REAL REASSIGN RECHECK RECURSIVE REF REFERENCES REFRESH REINDEX
 %token <str>   RELATIVE RELEASE RENAME REPEATABLE REPLACE REPLICA RESET RESTART
-%token <str>   RESTRICT RETURNING RETURNS REVOKE RIGHT ROLLBACK ROLLUP
+%token <str>   RESTRICT RETURNING RETURNS REVOKE RIGHT ROLE ROLLBACK ROLLUP
 %token <str>   ROW ROWS RULE
 
 %token <str>   SAVEPOINT SCROLL SEARCH SECOND SECURITY SELECT SEQUENCE SEQUENCES
@@ -370,7 +390,7 @@ package parser
 %token <str>   TRUNCATE TRUSTED TYPE TYPES
 
 %token <str>   UNBOUNDED UNCOMMITTED UNENCRYPTED UNION UNIQUE UNKNOWN UNLISTEN UNLOGGED
-%token <str>   UNTIL UPDATE USER USING
+%token <str>   UNTIL UPDATE USER USERS USING
 
 %token <str>   VACUUM VALID VALIDATE VALUE VALUES VARCHAR VARIADIC VARYING
 %token <str>   VERBOSE VERSION
@@ -494,11 +514,12 @@ stmt:
     $$ = nil
   }
 
-// ALTER [DATABASE|INDEX|TABLE]
+// ALTER [DATABASE|INDEX|TABLE|USER]
 alter_stmt:
   alter_database_stmt
 | alter_index_stmt
 | alter_table_stmt
+| alter_user_stmt
 
 alter_database_stmt:
   ALTER DATABASE name
@@ -523,22 +544,41 @@ alter_index_stmt:
 alter_table_stmt:
   ALTER TABLE relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = &AlterTable{Table: $3, IfExists: false, Cmds: $4}
   }
 | ALTER TABLE IF EXISTS relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = &AlterTable{Table: $5, IfExists: true, Cmds: $6}
+  }
+
+// ALTER USER name [WITH] PASSWORD 'password'
+alter_user_stmt:
+  ALTER USER name password
+  {
+    $$ = &AlterUser{Name: Name($3), Password: $4}
   }
 
 alter_table_cmds:
   alter_table_cmd
+  {
+    $$ = AlterTableCmds{$1}
+  }
 | alter_table_cmds ',' alter_table_cmd
+  {
+    $$ = append($1, $3)
+  }
 
 alter_table_cmd:
   // ALTER TABLE <name> ADD <coldef>
-  ADD column_def {}
+  ADD column_def
+  {
+    $$ = &AlterTableAddColumn{columnKeyword: false, ColumnDef: $2.(*ColumnTableDef)}
+  }
   // ALTER TABLE <name> ADD COLUMN <coldef>
-| ADD COLUMN column_def {}
+| ADD COLUMN column_def
+  {
+    $$ = &AlterTableAddColumn{columnKeyword: true, ColumnDef: $3.(*ColumnTableDef)}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> {SET DEFAULT <expr>|DROP DEFAULT}
 | ALTER opt_column name alter_column_default {}
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
@@ -552,9 +592,15 @@ alter_table_cmd:
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET ( column_parameter = value [, ... ] )
 | ALTER opt_column name RESET reloptions {}
   // ALTER TABLE <name> DROP [COLUMN] IF EXISTS <colname> [RESTRICT|CASCADE]
-| DROP opt_column IF EXISTS name opt_drop_behavior {}
+| DROP opt_column IF EXISTS name opt_drop_behavior
+  {
+    $$ = &AlterTableDropColumn{columnKeyword: $2, IfExists: true, Column: Name($5)}
+  }
   // ALTER TABLE <name> DROP [COLUMN] <colname> [RESTRICT|CASCADE]
-| DROP opt_column name opt_drop_behavior {}
+| DROP opt_column name opt_drop_behavior
+  {
+    $$ = &AlterTableDropColumn{columnKeyword: $2, IfExists: false, Column: Name($3)}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> [SET DATA] TYPE <typename>
   //     [ USING <expression> ]
 | ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using {}
@@ -608,10 +654,6 @@ reloptions:
     $$ = $2
   }
 
-opt_reloptions:
-  WITH reloptions {}
-| /* EMPTY */ {}
-
 reloption_list:
   reloption_elem {}
 | reloption_list ',' reloption_elem {}
@@ -623,12 +665,45 @@ reloption_elem:
 | col_label '.' col_label '=' def_arg {}
 | col_label '.' col_label {}
 
-// CREATE [DATABASE|INDEX|TABLE|TABLE AS]
+// CREATE [DATABASE|INDEX|ROLE|TABLE|TABLE AS|USER]
 create_stmt:
   create_database_stmt
 | create_index_stmt
+| create_role_stmt
 | create_table_stmt
 | create_table_as_stmt
+| create_user_stmt
+
+// CREATE USER name [[WITH] PASSWORD 'password']
+create_user_stmt:
+  CREATE USER name opt_password
+  {
+    $$ = &CreateUser{Name: Name($3), Password: $4}
+  }
+
+opt_password:
+  password
+| /* EMPTY */
+  {
+    $$ = ""
+  }
+
+password:
+  PASSWORD SCONST
+  {
+    $$ = $2
+  }
+| WITH PASSWORD SCONST
+  {
+    $$ = $3
+  }
+
+// CREATE ROLE name
+create_role_stmt:
+  CREATE ROLE name
+  {
+    $$ = &CreateRole{Name: Name($3)}
+  }
 
 // DELETE FROM query
 delete_stmt:
@@ -640,13 +715,13 @@ delete_stmt:
 
 // DROP itemtype [ IF EXISTS ] itemname [, itemname ...] [ RESTRICT | CASCADE ]
 drop_stmt:
-  DROP drop_type IF EXISTS any_name_list opt_drop_behavior
+  DROP INDEX qualified_name_list opt_drop_behavior
   {
-    $$ = nil
+    $$ = &DropIndex{Names: $3, IfExists: false}
   }
-| DROP drop_type any_name_list opt_drop_behavior
+| DROP INDEX IF EXISTS qualified_name_list opt_drop_behavior
   {
-    $$ = nil
+    $$ = &DropIndex{Names: $5, IfExists: true}
   }
 | DROP DATABASE name
   {
@@ -664,9 +739,14 @@ drop_stmt:
   {
     $$ = &DropTable{Names: $5, IfExists: true}
   }
-
-drop_type:
-  INDEX {}
+| DROP USER name
+  {
+    $$ = &DropUser{Name: Name($3)}
+  }
+| DROP ROLE name
+  {
+    $$ = &DropRole{Name: Name($3)}
+  }
 
 any_name_list:
   any_name
@@ -730,18 +810,28 @@ explain_option_name:
   non_reserved_word
 
 // GRANT privileges ON privilege_target TO grantee_list
+// GRANT privilege_name_list TO grantee_list
 grant_stmt:
   GRANT privileges ON privilege_target TO grantee_list
   {
     $$ = &Grant{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| GRANT privilege_name_list TO grantee_list
+  {
+    $$ = &GrantRole{Roles: NameList($2), Grantees: NameList($4)}
+  }
 
 // REVOKE privileges ON privilege_target FROM grantee_list
+// REVOKE privilege_name_list FROM grantee_list
 revoke_stmt:
   REVOKE privileges ON privilege_target FROM grantee_list
   {
     $$ = &Revoke{Privileges: $2, Grantees: NameList($6), Targets: $4}
   }
+| REVOKE privilege_name_list FROM grantee_list
+  {
+    $$ = &RevokeRole{Roles: NameList($2), Grantees: NameList($4)}
+  }
 
 
 privilege_target:
@@ -760,31 +850,43 @@ privilege_target:
     $$ = TargetList{Databases: NameList($2)}
   }
 
+// The privileges are parsed as a list of names so that they can be told
+// apart from the roles of GRANT privilege_name_list TO grantee_list.
 privileges:
   ALL
   {
     $$ = []PrivilegeType{PrivilegeAll}
   }
-  | privilege_list { }
-
-privilege_list:
-  privilege
+  | ALL PRIVILEGES
   {
-    $$ = []PrivilegeType{$1}
+    $$ = []PrivilegeType{PrivilegeAll}
   }
-  | privilege_list ',' privilege
+  | privilege_name_list
   {
-    $$ = append($1, $3)
+    privs, err := parsePrivileges($1)
+    if err != nil {
+      sqllex.Error(err.Error())
+      return 1
+    }
+    $$ = privs
   }
 
-privilege:
-  READ
+// The names of the privileges which are reserved keywords are added to the
+// names of the roles.
+privilege_name:
+  name
+| CREATE
+| GRANT
+| SELECT
+
+privilege_name_list:
+  privilege_name
   {
-    $$ = PrivilegeRead
+    $$ = []string{$1}
   }
-  | WRITE
+| privilege_name_list ',' privilege_name
   {
-    $$ = PrivilegeWrite
+    $$ = append($1, $3)
   }
 
 // TODO(marc): this should not be 'name', but should instead be a
@@ -816,7 +918,10 @@ set_stmt:
   }
 
 set_rest:
-  TRANSACTION transaction_mode_list {}
+  TRANSACTION transaction_mode_list
+  {
+    $$ = &SetTransaction{Isolation: $2}
+  }
 | SESSION CHARACTERISTICS AS TRANSACTION transaction_mode_list {}
 | set_rest_more {}
 
@@ -863,11 +968,29 @@ var_value:
   opt_boolean_or_string
 | numeric_only
 
+// The weaker isolation levels are upgraded to snapshot isolation, which is
+// the weakest isolation level supported.
 iso_level:
-  READ UNCOMMITTED {}
-| READ COMMITTED {}
-| REPEATABLE READ {}
-| SERIALIZABLE {}
+  READ UNCOMMITTED
+  {
+    $$ = SnapshotIsolation
+  }
+| READ COMMITTED
+  {
+    $$ = SnapshotIsolation
+  }
+| REPEATABLE READ
+  {
+    $$ = SnapshotIsolation
+  }
+| SNAPSHOT
+  {
+    $$ = SnapshotIsolation
+  }
+| SERIALIZABLE
+  {
+    $$ = SerializableIsolation
+  }
 
 opt_boolean_or_string:
   TRUE
@@ -942,6 +1065,10 @@ show_stmt:
   {
     $$ = &ShowTables{Name: $3}
   }
+| SHOW USERS
+  {
+    $$ = &ShowUsers{}
+  }
 | SHOW TIME ZONE
   {
     $$ = nil
@@ -1064,8 +1191,14 @@ col_qual_list:
 col_constraint:
   CONSTRAINT name col_constraint_elem
   {
-    // TODO(pmattis): Handle constraint name.
+    // TODO(pmattis): Handle the names of constraints other than CHECK.
     $$ = $3
+    switch c := $$.(type) {
+    case *ColumnCheckConstraint:
+      c.Name = Name($2)
+    case *ColumnFKConstraint:
+      c.Name = Name($2)
+    }
   }
 | col_constraint_elem
 | COLLATE any_name {}
@@ -1099,9 +1232,26 @@ col_constraint_elem:
   {
     $$ = PrimaryKeyConstraint{}
   }
-| CHECK '(' a_expr ')' opt_no_inherit {}
-| DEFAULT b_expr {}
-| REFERENCES qualified_name opt_column_list key_match key_actions {}
+| CHECK '(' a_expr ')' opt_no_inherit
+  {
+    $$ = &ColumnCheckConstraint{Expr: $3}
+  }
+| DEFAULT b_expr
+  {
+    $$ = &DefaultConstraint{Expr: $2}
+  }
+| REFERENCES qualified_name opt_column_list key_match key_actions
+  {
+    if len($3) > 1 {
+      sqllex.Error("a column REFERENCES constraint must reference a single column")
+      return 1
+    }
+    c := &ColumnFKConstraint{Table: $2, OnDelete: $5}
+    if len($3) == 1 {
+      c.Col = Name($3[0])
+    }
+    $$ = c
+  }
 
 table_like_clause:
   LIKE qualified_name table_like_option_list {}
@@ -1125,8 +1275,13 @@ table_constraint:
   CONSTRAINT name constraint_elem
   {
     $$ = $3
-    if i, ok := $$.(*IndexTableDef); ok {
-      i.Name = Name($2)
+    switch t := $$.(type) {
+    case *IndexTableDef:
+      t.Name = Name($2)
+    case *CheckConstraintTableDef:
+      t.Name = Name($2)
+    case *ForeignKeyConstraintTableDef:
+      t.Name = Name($2)
     }
   }
 | constraint_elem
@@ -1135,7 +1290,10 @@ table_constraint:
   }
 
 constraint_elem:
-  CHECK '(' a_expr ')' {}
+  CHECK '(' a_expr ')'
+  {
+    $$ = &CheckConstraintTableDef{Expr: $3}
+  }
 | UNIQUE '(' name_list ')'
   {
     $$ = &IndexTableDef{Unique: true, Columns: NameList($3)}
@@ -1153,7 +1311,15 @@ constraint_elem:
 | EXCLUDE access_method_clause '(' exclusion_constraint_list ')'
     exclusion_where_clause {}
 | FOREIGN KEY '(' name_list ')' REFERENCES qualified_name
-    opt_column_list key_match key_actions {}
+    opt_column_list key_match key_actions
+  {
+    $$ = &ForeignKeyConstraintTableDef{
+      FromCols: NameList($4),
+      Table:    $7,
+      ToCols:   NameList($8),
+      OnDelete: $10,
+    }
+  }
 
 opt_no_inherit:
   NO INHERIT {}
@@ -1170,8 +1336,16 @@ opt_column_list:
   }
 
 key_match:
-  MATCH FULL {}
-| MATCH PARTIAL {}
+  MATCH FULL
+  {
+    sqllex.Error("MATCH FULL is not supported")
+    return 1
+  }
+| MATCH PARTIAL
+  {
+    sqllex.Error("MATCH PARTIAL is not supported")
+    return 1
+  }
 | MATCH SIMPLE {}
 | /* EMPTY */ {}
 
@@ -1186,29 +1360,67 @@ exclusion_where_clause:
   WHERE '(' a_expr ')' {}
 | /* EMPTY */ {}
 
-// We combine the update and delete actions into one value temporarily for
-// simplicity of parsing, and then break them down again in the calling
-// production. update is in the left 8 bits, delete in the right. Note that
-// NOACTION is the default.
+// key_actions is the ON DELETE action. The only supported ON UPDATE actions
+// are the ones which prevent the update of referenced rows. Note that NO
+// ACTION is the default.
 key_actions:
-  key_update {}
-| key_delete {}
-| key_update key_delete {}
-| key_delete key_update {}
-| /* EMPTY */ {}
+  key_update
+  {
+    $$ = NoAction
+  }
+| key_delete
+| key_update key_delete
+  {
+    $$ = $2
+  }
+| key_delete key_update
+  {
+    $$ = $1
+  }
+| /* EMPTY */
+  {
+    $$ = NoAction
+  }
 
 key_update:
-  ON UPDATE key_action {}
+  ON UPDATE key_action
+  {
+    if $3 == Cascade {
+      sqllex.Error("ON UPDATE CASCADE is not supported")
+      return 1
+    }
+    $$ = $3
+  }
 
 key_delete:
-  ON DELETE key_action {}
+  ON DELETE key_action
+  {
+    $$ = $3
+  }
 
 key_action:
-  NO ACTION {}
-| RESTRICT {}
-| CASCADE {}
-| SET NULL {}
-| SET DEFAULT {}
+  NO ACTION
+  {
+    $$ = NoAction
+  }
+| RESTRICT
+  {
+    $$ = Restrict
+  }
+| CASCADE
+  {
+    $$ = Cascade
+  }
+| SET NULL
+  {
+    sqllex.Error("SET NULL actions are not supported")
+    return 1
+  }
+| SET DEFAULT
+  {
+    sqllex.Error("SET DEFAULT actions are not supported")
+    return 1
+  }
 
 opt_inherit:
   INHERITS '(' qualified_name_list ')' {}
@@ -1282,26 +1494,24 @@ opt_restart_seqs:
 
 // CREATE INDEX
 create_index_stmt:
-  CREATE opt_unique INDEX opt_concurrently opt_name
-    ON qualified_name access_method_clause '(' index_params ')'
-    opt_reloptions where_clause
+  CREATE opt_unique INDEX opt_name ON qualified_name access_method_clause '(' name_list ')'
   {
-    $$ = nil
+    $$ = &CreateIndex{Name: Name($4), Table: $6, Unique: $2, Columns: NameList($9)}
   }
-| CREATE opt_unique INDEX opt_concurrently IF NOT EXISTS name
-    ON qualified_name access_method_clause '(' index_params ')'
-    opt_reloptions where_clause
+| CREATE opt_unique INDEX IF NOT EXISTS name ON qualified_name access_method_clause '(' name_list ')'
   {
-    $$ = nil
+    $$ = &CreateIndex{Name: Name($7), Table: $9, Unique: $2, IfNotExists: true, Columns: NameList($12)}
   }
 
 opt_unique:
-  UNIQUE {}
-| /* EMPTY */ {}
-
-opt_concurrently:
-  CONCURRENTLY {}
-| /* EMPTY */ {}
+  UNIQUE
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 access_method_clause:
   USING name {}
@@ -1332,9 +1542,18 @@ opt_class:
 | /* EMPTY */ {}
 
 opt_asc_desc:
-  ASC {}
-| DESC {}
-| /* EMPTY */ {}
+  ASC
+  {
+    $$ = Ascending
+  }
+| DESC
+  {
+    $$ = Descending
+  }
+| /* EMPTY */
+  {
+    $$ = DefaultDirection
+  }
 
 opt_nulls_order:
   NULLS_LA FIRST {}
@@ -1377,11 +1596,11 @@ rename_stmt:
   }
 | ALTER TABLE relation_expr RENAME opt_column name TO name
   {
-    $$ = nil
+    $$ = &RenameColumn{Table: $3, Name: Name($6), NewName: Name($8), IfExists: false, columnKeyword: $5}
   }
 | ALTER TABLE IF EXISTS relation_expr RENAME opt_column name TO name
   {
-    $$ = nil
+    $$ = &RenameColumn{Table: $5, Name: Name($8), NewName: Name($10), IfExists: true, columnKeyword: $7}
   }
 | ALTER TABLE relation_expr RENAME CONSTRAINT name TO name
   {
@@ -1393,8 +1612,14 @@ rename_stmt:
   }
 
 opt_column:
-  COLUMN {}
-| /* EMPTY */ {}
+  COLUMN
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 opt_set_data:
   SET DATA {}
@@ -1404,27 +1629,27 @@ opt_set_data:
 transaction_stmt:
   ABORT opt_transaction
   {
-    $$ = nil
+    $$ = &RollbackTransaction{}
   }
 | BEGIN opt_transaction transaction_mode_list_or_empty
   {
-    $$ = nil
+    $$ = &BeginTransaction{Isolation: $3}
   }
 | START TRANSACTION transaction_mode_list_or_empty
   {
-    $$ = nil
+    $$ = &BeginTransaction{Isolation: $3}
   }
 | COMMIT opt_transaction
   {
-    $$ = nil
+    $$ = &CommitTransaction{}
   }
 | END opt_transaction
   {
-    $$ = nil
+    $$ = &CommitTransaction{}
   }
 | ROLLBACK opt_transaction
   {
-    $$ = nil
+    $$ = &RollbackTransaction{}
   }
 | SAVEPOINT name
   {
@@ -1465,21 +1690,54 @@ opt_transaction:
 | /* EMPTY */ {}
 
 transaction_mode_item:
-  ISOLATION LEVEL iso_level {}
-| READ ONLY {}
-| READ WRITE {}
-| DEFERRABLE {}
-| NOT DEFERRABLE {}
+  ISOLATION LEVEL iso_level
+  {
+    $$ = $3
+  }
+| READ ONLY
+  {
+    sqllex.Error("READ ONLY transactions are not supported")
+    return 1
+  }
+| READ WRITE
+  {
+    $$ = UnspecifiedIsolation
+  }
+| DEFERRABLE
+  {
+    sqllex.Error("DEFERRABLE transactions are not supported")
+    return 1
+  }
+| NOT DEFERRABLE
+  {
+    $$ = UnspecifiedIsolation
+  }
 
-// Syntax with commas is SQL-spec, without commas is Postgres historical.
+// Syntax with commas is SQL-spec, without commas is Postgres historical. If
+// the isolation level is specified more than once the last one wins.
 transaction_mode_list:
-  transaction_mode_item {}
-| transaction_mode_list ',' transaction_mode_item {}
-| transaction_mode_list transaction_mode_item {}
+  transaction_mode_item
+| transaction_mode_list ',' transaction_mode_item
+  {
+    $$ = $1
+    if $3 != UnspecifiedIsolation {
+      $$ = $3
+    }
+  }
+| transaction_mode_list transaction_mode_item
+  {
+    $$ = $1
+    if $2 != UnspecifiedIsolation {
+      $$ = $2
+    }
+  }
 
 transaction_mode_list_or_empty:
-  transaction_mode_list {}
-| /* EMPTY */ {}
+  transaction_mode_list
+| /* EMPTY */
+  {
+    $$ = UnspecifiedIsolation
+  }
 
 create_database_stmt:
   CREATE DATABASE name
@@ -1641,18 +1899,34 @@ select_no_parens:
 | select_clause sort_clause
   {
     $$ = $1
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $2
+    case *Union:
+      s.OrderBy = $2
+    }
   }
 | select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
     $$ = $1
-    if s, ok := $$.(*Select); ok {
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $2
+      s.Limit = $4
+    case *Union:
+      s.OrderBy = $2
       s.Limit = $4
     }
   }
 | select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
     $$ = $1
-    if s, ok := $$.(*Select); ok {
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $2
+      s.Limit = $3
+    case *Union:
+      s.OrderBy = $2
       s.Limit = $3
     }
   }
@@ -1663,18 +1937,34 @@ select_no_parens:
 | with_clause select_clause sort_clause
   {
     $$ = $2
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $3
+    case *Union:
+      s.OrderBy = $3
+    }
   }
 | with_clause select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
     $$ = $2
-    if s, ok := $$.(*Select); ok {
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $3
+      s.Limit = $5
+    case *Union:
+      s.OrderBy = $3
       s.Limit = $5
     }
   }
 | with_clause select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
     $$ = $2
-    if s, ok := $$.(*Select); ok {
+    switch s := $$.(type) {
+    case *Select:
+      s.OrderBy = $3
+      s.Limit = $4
+    case *Union:
+      s.OrderBy = $3
       s.Limit = $4
     }
   }
@@ -1711,10 +2001,11 @@ simple_select:
     group_clause having_clause window_clause
   {
     $$ = &Select{
-      Exprs:  $3,
-      From:   $4,
-      Where:  newWhere(astWhere, $5),
-      Having: newWhere(astHaving, $7),
+      Exprs:   $3,
+      From:    $4,
+      Where:   newWhere(astWhere, $5),
+      GroupBy: $6,
+      Having:  newWhere(astHaving, $7),
     }
   }
 | SELECT distinct_clause target_list
@@ -1727,6 +2018,7 @@ simple_select:
       Exprs:    $3,
       From:     $4,
       Where:    newWhere(astWhere, $5),
+      GroupBy:  $6,
       Having:   newWhere(astHaving, $7),
     }
   }
@@ -1741,29 +2033,29 @@ simple_select:
   }
 | select_clause UNION all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
-      Type:  astUnion,
+      Type:  AstUnion,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause INTERSECT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
-      Type:  astIntersect,
+      Type:  AstIntersect,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause EXCEPT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
-      Type:  astExcept,
+      Type:  AstExcept,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 
@@ -1802,9 +2094,18 @@ opt_table:
 | /* EMPTY */ {}
 
 all_or_distinct:
-  ALL {}
-| DISTINCT {}
-| /* EMPTY */ {}
+  ALL
+  {
+    $$ = true
+  }
+| DISTINCT
+  {
+    $$ = false
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 // We use (NIL) as a placeholder to indicate that all target expressions should
 // be placed in the DISTINCT list during parsetree analysis.
@@ -1817,19 +2118,38 @@ opt_all_clause:
 | /* EMPTY */ {}
 
 opt_sort_clause:
-  sort_clause {}
-| /* EMPTY */ {}
+  sort_clause
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 sort_clause:
-  ORDER BY sortby_list {}
+  ORDER BY sortby_list
+  {
+    $$ = OrderBy($3)
+  }
 
 sortby_list:
-  sortby {}
-| sortby_list ',' sortby {}
+  sortby
+  {
+    $$ = OrderBy{$1}
+  }
+| sortby_list ',' sortby
+  {
+    $$ = append($1, $3)
+  }
 
 sortby:
-  a_expr USING math_op opt_nulls_order {}
-| a_expr opt_asc_desc opt_nulls_order {}
+  a_expr USING math_op opt_nulls_order
+  {
+    sqllex.Error("ORDER BY ... USING is not supported")
+    return 1
+  }
+| a_expr opt_asc_desc opt_nulls_order
+  {
+    $$ = &Order{Expr: $1, Direction: $2}
+  }
 
 select_limit:
   limit_clause offset_clause
@@ -1926,16 +2246,32 @@ first_or_next:
 // Each item in the group_clause list is either an expression tree or a
 // GroupingSet node of some type.
 group_clause:
-  GROUP BY group_by_list {}
-| /* EMPTY */ {}
+  GROUP BY group_by_list
+  {
+    $$ = GroupBy($3)
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 group_by_list:
-  group_by_item {}
-| group_by_list ',' group_by_item {}
+  group_by_item
+  {
+    $$ = Exprs{$1}
+  }
+| group_by_list ',' group_by_item
+  {
+    $$ = append($1, $3)
+  }
 
 group_by_item:
-  a_expr {}
-| empty_grouping_set {}
+  a_expr
+| empty_grouping_set
+  {
+    sqllex.Error("empty grouping sets are not supported")
+    return 1
+  }
 
 empty_grouping_set:
   '(' ')' {}
@@ -2051,7 +2387,7 @@ joined_table:
   }
 | table_ref CROSS JOIN table_ref
   {
-    $$ = &JoinTableExpr{Join: astCrossJoin, Left: $1, Right: $4}
+    $$ = &JoinTableExpr{Join: AstCrossJoin, Left: $1, Right: $4}
   }
 | table_ref join_type JOIN table_ref join_qual
   {
@@ -2059,15 +2395,15 @@ joined_table:
   }
 | table_ref JOIN table_ref join_qual
   {
-    $$ = &JoinTableExpr{Join: astJoin, Left: $1, Right: $3, Cond: $4}
+    $$ = &JoinTableExpr{Join: AstJoin, Left: $1, Right: $3, Cond: $4}
   }
 | table_ref NATURAL join_type JOIN table_ref
   {
-    $$ = &JoinTableExpr{Join: astNaturalJoin, Left: $1, Right: $5}
+    $$ = &JoinTableExpr{Join: AstNaturalJoin, Left: $1, Right: $5}
   }
 | table_ref NATURAL JOIN table_ref
   {
-    $$ = &JoinTableExpr{Join: astNaturalJoin, Left: $1, Right: $4}
+    $$ = &JoinTableExpr{Join: AstNaturalJoin, Left: $1, Right: $4}
   }
 
 alias_clause:
@@ -2101,19 +2437,19 @@ func_alias_clause:
 join_type:
   FULL join_outer
   {
-    $$ = astFullJoin
+    $$ = AstFullJoin
   }
 | LEFT join_outer
   {
-    $$ = astLeftJoin
+    $$ = AstLeftJoin
   }
 | RIGHT join_outer
   {
-    $$ = astRightJoin
+    $$ = AstRightJoin
   }
 | INNER
   {
-    $$ = astInnerJoin
+    $$ = AstInnerJoin
   }
 
 // OUTER is just noise...
@@ -2263,8 +2599,14 @@ simple_typename:
 | bit
 | character
 | const_datetime
-| const_interval opt_interval {}
-| const_interval '(' ICONST ')' {}
+| const_interval opt_interval
+  {
+    $$ = $1
+  }
+| const_interval '(' ICONST ')'
+  {
+    $$ = $1
+  }
 | BLOB
   {
     $$ = &BlobType{}
@@ -2456,7 +2798,10 @@ const_datetime:
   }
 
 const_interval:
-  INTERVAL {}
+  INTERVAL
+  {
+    $$ = &IntervalType{}
+  }
 
 opt_timezone:
   WITH_LA TIME ZONE {}
@@ -2882,10 +3227,16 @@ func_expr_windowless:
 // Special expressions that are considered to be functions.
 func_expr_common_subexpr:
   COLLATION FOR '(' a_expr ')' {}
-| CURRENT_DATE {}
+| CURRENT_DATE
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("current_date")}}
+  }
 | CURRENT_TIME {}
 | CURRENT_TIME '(' ICONST ')' {}
-| CURRENT_TIMESTAMP {}
+| CURRENT_TIMESTAMP
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("now")}}
+  }
 | CURRENT_TIMESTAMP '(' ICONST ')' {}
 | LOCALTIME {}
 | LOCALTIME '(' ICONST ')' {}
@@ -3399,9 +3750,18 @@ a_expr_const:
     $$ = StrVal($1)
   }
 | func_name '(' expr_list opt_sort_clause ')' SCONST {}
-| const_typename SCONST {}
-| const_interval SCONST opt_interval {}
-| const_interval '(' ICONST ')' SCONST {}
+| const_typename SCONST
+  {
+    $$ = &CastExpr{Expr: StrVal($2), Type: $1}
+  }
+| const_interval SCONST opt_interval
+  {
+    $$ = &CastExpr{Expr: StrVal($2), Type: $1}
+  }
+| const_interval '(' ICONST ')' SCONST
+  {
+    $$ = &CastExpr{Expr: StrVal($5), Type: $1}
+  }
 | TRUE
   {
     $$ = BoolVal(true)
@@ -3675,6 +4035,7 @@ unreserved_keyword:
 | RESTRICT
 | RETURNS
 | REVOKE
+| ROLE
 | ROLLBACK
 | ROLLUP
 | ROWS
@@ -3725,6 +4086,7 @@ unreserved_keyword:
 | UNLOGGED
 | UNTIL
 | UPDATE
+| USERS
 | VACUUM
 | VALID
 | VALIDATE
@@ -40,24 +40,39 @@ type Statement interface {
 	statement()
 }
 
-func (*CreateDatabase) statement() {}
-func (*CreateTable) statement()    {}
-func (*Delete) statement()         {}
-func (*DropDatabase) statement()   {}
-func (*DropTable) statement()      {}
-func (*Explain) statement()        {}
-func (*Grant) statement()          {}
-func (*Insert) statement()         {}
-func (*ParenSelect) statement()    {}
-func (*Revoke) statement()         {}
-func (*Select) statement()         {}
-func (*Set) statement()            {}
-func (*ShowColumns) statement()    {}
-func (*ShowDatabases) statement()  {}
-func (*ShowGrants) statement()     {}
-func (*ShowIndex) statement()      {}
-func (*ShowTables) statement()     {}
-func (*Truncate) statement()       {}
-func (*Union) statement()          {}
-func (*Update) statement()         {}
-func (Values) statement()          {}
+func (*AlterTable) statement()          {}
+func (*AlterUser) statement()           {}
+func (*BeginTransaction) statement()    {}
+func (*CommitTransaction) statement()   {}
+func (*CreateDatabase) statement()      {}
+func (*CreateIndex) statement()         {}
+func (*CreateRole) statement()          {}
+func (*CreateTable) statement()         {}
+func (*CreateUser) statement()          {}
+func (*Delete) statement()              {}
+func (*DropDatabase) statement()        {}
+func (*DropIndex) statement()           {}
+func (*DropRole) statement()            {}
+func (*DropTable) statement()           {}
+func (*DropUser) statement()            {}
+func (*Explain) statement()             {}
+func (*Grant) statement()               {}
+func (*GrantRole) statement()           {}
+func (*Insert) statement()              {}
+func (*ParenSelect) statement()         {}
+func (*Revoke) statement()              {}
+func (*RevokeRole) statement()          {}
+func (*RollbackTransaction) statement() {}
+func (*Select) statement()              {}
+func (*Set) statement()                 {}
+func (*SetTransaction) statement()      {}
+func (*ShowColumns) statement()         {}
+func (*ShowDatabases) statement()       {}
+func (*ShowGrants) statement()          {}
+func (*ShowIndex) statement()           {}
+func (*ShowTables) statement()          {}
+func (*ShowUsers) statement()           {}
+func (*Truncate) statement()            {}
+func (*Union) statement()               {}
+func (*Update) statement()              {}
+func (Values) statement()               {}
@@ -0,0 +1,466 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// PlaceholderTypes maps the numbers of the placeholders of a statement to
+// the types of the values they are expected to be bound to. A type is
+// represented by a datum of the type, e.g. DInt(0).
+type PlaceholderTypes map[int]Datum
+
+// A TypeResolver returns the type of the values of the column named by
+// qname, or nil if the column is not known.
+type TypeResolver func(qname *QualifiedName) Datum
+
+// InferTypes infers the types of the placeholders in expr from the context
+// in which they appear and adds them to t. A placeholder compared with,
+// combined with or used as the argument of a function taking a value of a
+// known type is expected to be of the same type. desired is the type
+// expected of expr itself, e.g. the type of the column it is inserted into,
+// or nil if that is not known. The types of columns are looked up with
+// columns, which may be nil. InferTypes returns the type of expr, or nil if
+// it is not known. An error is returned if different types are inferred for
+// the same placeholder.
+func (t PlaceholderTypes) InferTypes(expr Expr, desired Datum, columns TypeResolver) (Datum, error) {
+	c := typeChecker{types: t, columns: columns}
+	return c.check(expr, desired)
+}
+
+// CheckArgs returns the arguments converted to the types inferred for their
+// placeholders. NULL can be bound to any placeholder. Integers are converted
+// to floats and decimals, floats to decimals and dates and timestamps to
+// each other. Strings are converted to dates, timestamps, intervals and
+// decimals, which is how clients without native support for these types
+// send them. An error is returned for an argument which cannot be
+// converted. Arguments of placeholders whose type is not known are returned
+// unchanged.
+func (t PlaceholderTypes) CheckArgs(args Args) (Args, error) {
+	numbers := make([]int, 0, len(t))
+	for n := range t {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+
+	checked := checkedArgs{args: args, converted: make(map[int]Datum, len(t))}
+	for _, n := range numbers {
+		d, ok := args.Arg(n)
+		if !ok {
+			// Missing arguments are reported by FillArgs.
+			continue
+		}
+		v, err := convertArg(d, t[n])
+		if err != nil {
+			return nil, fmt.Errorf("placeholder $%d: %v", n, err)
+		}
+		checked.converted[n] = v
+	}
+	return checked, nil
+}
+
+func convertArg(d Datum, typ Datum) (Datum, error) {
+	if d == DNull || reflect.TypeOf(d) == reflect.TypeOf(typ) {
+		return d, nil
+	}
+	var target ColumnType
+	switch typ.(type) {
+	case DFloat:
+		if _, ok := d.(DInt); ok {
+			target = &FloatType{}
+		}
+	case DDecimal:
+		switch d.(type) {
+		case DInt, DFloat, DString:
+			target = &DecimalType{}
+		}
+	case DDate:
+		switch d.(type) {
+		case DTimestamp, DString:
+			target = &DateType{}
+		}
+	case DTimestamp:
+		switch d.(type) {
+		case DDate, DString:
+			target = &TimestampType{}
+		}
+	case DInterval:
+		if _, ok := d.(DString); ok {
+			target = &IntervalType{}
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("expected a value of type %s, but found type %s", typ.Type(), d.Type())
+	}
+	v, err := EvalExpr(&CastExpr{Expr: d, Type: target})
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %s to type %s: %v", d, typ.Type(), err)
+	}
+	return v, nil
+}
+
+// checkedArgs returns the converted arguments of the placeholders whose type
+// is known and the original arguments of the others.
+type checkedArgs struct {
+	args      Args
+	converted map[int]Datum
+}
+
+// Arg implements the Args interface.
+func (a checkedArgs) Arg(i int) (Datum, bool) {
+	if d, ok := a.converted[i]; ok {
+		return d, true
+	}
+	return a.args.Arg(i)
+}
+
+type typeChecker struct {
+	types   PlaceholderTypes
+	columns TypeResolver
+}
+
+func (c typeChecker) check(expr Expr, desired Datum) (Datum, error) {
+	switch t := expr.(type) {
+	case ValArg:
+		return c.placeholder(int(t), desired)
+
+	case *AndExpr:
+		return c.checkBool(t.Left, t.Right)
+
+	case *OrExpr:
+		return c.checkBool(t.Left, t.Right)
+
+	case *NotExpr:
+		return c.checkBool(t.Expr)
+
+	case *ParenExpr:
+		return c.check(t.Expr, desired)
+
+	case *ComparisonExpr:
+		var err error
+		switch t.Operator {
+		case Like, NotLike:
+			_, err = c.checkAll(DString(""), t.Left, t.Right)
+		case In, NotIn:
+			if tuple, ok := t.Right.(Tuple); ok {
+				_, err = c.unify(nil, append(Exprs{t.Left}, tuple...)...)
+			} else {
+				_, err = c.unify(nil, t.Left, t.Right)
+			}
+		default:
+			_, err = c.unify(nil, t.Left, t.Right)
+		}
+		return DBool(false), err
+
+	case *RangeCond:
+		_, err := c.unify(nil, t.Left, t.From, t.To)
+		return DBool(false), err
+
+	case *NullCheck:
+		_, err := c.check(t.Expr, nil)
+		return DBool(false), err
+
+	case *ExistsExpr:
+		return DBool(false), nil
+
+	case StrVal, BytesVal:
+		return DString(""), nil
+
+	case IntVal:
+		return DInt(0), nil
+
+	case NumVal:
+		return DFloat(0), nil
+
+	case BoolVal:
+		return DBool(false), nil
+
+	case NullVal:
+		return nil, nil
+
+	case *QualifiedName:
+		if c.columns == nil {
+			return nil, nil
+		}
+		return c.columns(t), nil
+
+	case Tuple:
+		return c.checkTuple(Exprs(t), desired)
+
+	case Row:
+		return c.checkTuple(Exprs(t), desired)
+
+	case *Subquery:
+		return nil, nil
+
+	case *BinaryExpr:
+		return c.checkBinary(t, desired)
+
+	case *UnaryExpr:
+		return c.check(t.Expr, desired)
+
+	case *FuncExpr:
+		return c.checkFunc(t)
+
+	case *CaseExpr:
+		return c.checkCase(t, desired)
+
+	case *CastExpr:
+		// The operand of a cast can be of any type which can be converted to
+		// the type of the cast.
+		if _, err := c.check(t.Expr, nil); err != nil {
+			return nil, err
+		}
+		return castType(t.Type), nil
+
+	case Datum:
+		if t == DNull {
+			return nil, nil
+		}
+		return t, nil
+	}
+	return nil, nil
+}
+
+// placeholder records the desired type of a placeholder. The type of the
+// placeholder is returned, which is nil if it is not known.
+func (c typeChecker) placeholder(n int, desired Datum) (Datum, error) {
+	if desired == nil || desired == DNull {
+		return c.types[n], nil
+	}
+	if _, ok := desired.(DTuple); ok {
+		return c.types[n], nil
+	}
+	if typ, ok := c.types[n]; ok {
+		if reflect.TypeOf(typ) != reflect.TypeOf(desired) {
+			return nil, fmt.Errorf("placeholder $%d is used as both %s and %s",
+				n, typ.Type(), desired.Type())
+		}
+		return typ, nil
+	}
+	c.types[n] = desired
+	return desired, nil
+}
+
+func (c typeChecker) checkBool(exprs ...Expr) (Datum, error) {
+	if _, err := c.checkAll(DBool(false), exprs...); err != nil {
+		return nil, err
+	}
+	return DBool(false), nil
+}
+
+// checkAll checks each of the expressions with the same desired type.
+func (c typeChecker) checkAll(desired Datum, exprs ...Expr) (Datum, error) {
+	for _, expr := range exprs {
+		if _, err := c.check(expr, desired); err != nil {
+			return nil, err
+		}
+	}
+	return desired, nil
+}
+
+// unify checks expressions which are expected to be of the same type, e.g.
+// the operands of a comparison. The type is that of the first expression
+// whose type is known, or else the desired type. It is returned.
+func (c typeChecker) unify(desired Datum, exprs ...Expr) (Datum, error) {
+	var typ Datum
+	for _, expr := range exprs {
+		t, err := c.check(expr, nil)
+		if err != nil {
+			return nil, err
+		}
+		if typ == nil {
+			typ = t
+		}
+	}
+	if typ == nil {
+		typ = desired
+	}
+	if typ == nil {
+		return nil, nil
+	}
+	return c.checkAll(typ, exprs...)
+}
+
+// checkTuple checks the elements of a tuple, with the elements of the
+// desired type if it is a tuple of the same length. The type of the tuple is
+// a tuple of the types of its elements, in which DNull stands for an
+// unknown type.
+func (c typeChecker) checkTuple(exprs Exprs, desired Datum) (Datum, error) {
+	d, ok := desired.(DTuple)
+	if !ok || len(d) != len(exprs) {
+		d = nil
+	}
+	typ := make(DTuple, len(exprs))
+	for i, expr := range exprs {
+		var elem Datum
+		if d != nil {
+			elem = d[i]
+		}
+		t, err := c.check(expr, elem)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			t = DNull
+		}
+		typ[i] = t
+	}
+	return typ, nil
+}
+
+func (c typeChecker) checkBinary(expr *BinaryExpr, desired Datum) (Datum, error) {
+	if expr.Operator == Concat {
+		return c.checkAll(DString(""), expr.Left, expr.Right)
+	}
+	left, err := c.check(expr.Left, nil)
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.check(expr.Right, nil)
+	if err != nil {
+		return nil, err
+	}
+	if left == nil {
+		if left, err = c.check(expr.Left, operandType(expr.Operator, right, desired)); err != nil {
+			return nil, err
+		}
+	}
+	if right == nil {
+		if right, err = c.check(expr.Right, operandType(expr.Operator, left, desired)); err != nil {
+			return nil, err
+		}
+	}
+	if left == nil || right == nil || reflect.TypeOf(left) != reflect.TypeOf(right) {
+		return nil, nil
+	}
+	switch left.(type) {
+	case DDate, DTimestamp:
+		if expr.Operator == Minus {
+			return DInterval{}, nil
+		}
+	}
+	return left, nil
+}
+
+// operandType returns the type expected of an operand of a binary operator
+// whose other operand is of type other, or nil if it is not known.
+func operandType(op BinaryOp, other Datum, desired Datum) Datum {
+	switch other.(type) {
+	case DInt, DFloat, DDecimal:
+		return other
+	case DDate, DTimestamp:
+		if op == Plus || op == Minus {
+			return DInterval{}
+		}
+	case nil:
+		switch desired.(type) {
+		case DInt, DFloat, DDecimal:
+			return desired
+		}
+	}
+	return nil
+}
+
+// checkFunc checks the arguments of a function call. If the function takes
+// arguments of only one combination of types, the arguments are expected to
+// be of those types. The type of the result of a function is not known.
+func (c typeChecker) checkFunc(expr *FuncExpr) (Datum, error) {
+	name := strings.ToLower(expr.Name.String())
+	if len(expr.Name.Indirect) == 0 {
+		name = strings.ToLower(string(expr.Name.Base))
+	}
+	var types typeList
+	if candidates := builtins[name]; len(candidates) == 1 &&
+		len(candidates[0].types) == len(expr.Exprs) {
+		types = candidates[0].types
+	}
+	for i, e := range expr.Exprs {
+		var desired Datum
+		if types != nil {
+			desired = datumForType(types[i])
+		}
+		if _, err := c.check(e, desired); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (c typeChecker) checkCase(expr *CaseExpr, desired Datum) (Datum, error) {
+	if expr.Expr != nil {
+		exprs := Exprs{expr.Expr}
+		for _, when := range expr.Whens {
+			exprs = append(exprs, when.Cond)
+		}
+		if _, err := c.unify(nil, exprs...); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, when := range expr.Whens {
+			if _, err := c.check(when.Cond, DBool(false)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var vals Exprs
+	for _, when := range expr.Whens {
+		vals = append(vals, when.Val)
+	}
+	if expr.Else != nil {
+		vals = append(vals, expr.Else)
+	}
+	return c.unify(desired, vals...)
+}
+
+// castType returns the type of the result of a cast to the column type, or
+// nil if casts to the column type are not supported.
+func castType(t ColumnType) Datum {
+	switch t.(type) {
+	case *BoolType:
+		return DBool(false)
+	case *IntType:
+		return DInt(0)
+	case *FloatType:
+		return DFloat(0)
+	case *CharType, *TextType, *BlobType:
+		return DString("")
+	case *DecimalType:
+		return DDecimal{}
+	case *DateType:
+		return DDate(0)
+	case *TimestampType:
+		return DTimestamp{}
+	case *IntervalType:
+		return DInterval{}
+	}
+	return nil
+}
+
+// datumForType returns the zero datum of a datum type, or nil if it is not
+// a type of scalar values.
+func datumForType(t reflect.Type) Datum {
+	switch t {
+	case boolType, intType, floatType, stringType, decimalType, dateType,
+		timestampType, intervalType:
+		return reflect.Zero(t).Interface().(Datum)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/testutils"
+)
+
+// testColumns resolves the columns i, f, s and d to an int, a float, a
+// string and a date respectively.
+func testColumns(qname *QualifiedName) Datum {
+	switch qname.String() {
+	case "i":
+		return DInt(0)
+	case "f":
+		return DFloat(0)
+	case "s":
+		return DString("")
+	case "d":
+		return DDate(0)
+	}
+	return nil
+}
+
+// typesString formats placeholder types as "$1:int $2:string".
+func typesString(types PlaceholderTypes) string {
+	var s string
+	for n := 1; n <= len(types); n++ {
+		if s != "" {
+			s += " "
+		}
+		typ := "?"
+		if types[n] != nil {
+			typ = types[n].Type()
+		}
+		s += fmt.Sprintf("$%d:%s", n, typ)
+	}
+	return s
+}
+
+func parseExpr(t *testing.T, expr string) Expr {
+	q, err := Parse("SELECT " + expr)
+	if err != nil {
+		t.Fatalf("%s: %v", expr, err)
+	}
+	return q[0].(*Select).Exprs[0].Expr
+}
+
+func TestInferTypes(t *testing.T) {
+	testData := []struct {
+		expr     string
+		desired  Datum
+		expected string
+	}{
+		{`i = $1`, nil, `$1:int`},
+		{`$1 > f`, nil, `$1:float`},
+		{`s LIKE $1 AND $2`, nil, `$1:string $2:bool`},
+		{`i = $1 OR s = $2`, nil, `$1:int $2:string`},
+		{`i BETWEEN $1 AND $2`, nil, `$1:int $2:int`},
+		{`f IN ($1, 2, $2)`, nil, `$1:float $2:float`},
+		{`(i, s) = ($1, $2)`, nil, `$1:int $2:string`},
+		{`i = $1 + 1`, nil, `$1:int`},
+		{`$1 * f > 2`, nil, `$1:float`},
+		{`d - $1`, nil, `$1:interval`},
+		{`s || $1`, nil, `$1:string`},
+		{`length($1)`, nil, `$1:string`},
+		{`CASE WHEN $1 THEN $2 ELSE s END`, nil, `$1:bool $2:string`},
+		{`CASE i WHEN $1 THEN 1 END`, nil, `$1:int`},
+		{`$1`, DDecimal{}, `$1:decimal`},
+		{`($1, $2)`, DTuple{DInt(0), DString("")}, `$1:int $2:string`},
+		{`-$1`, DFloat(0), `$1:float`},
+		{`i = ($1)`, nil, `$1:int`},
+	}
+	for _, d := range testData {
+		types := PlaceholderTypes{}
+		if _, err := types.InferTypes(parseExpr(t, d.expr), d.desired, testColumns); err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		if s := typesString(types); s != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
+		}
+	}
+}
+
+func TestInferTypesUnknown(t *testing.T) {
+	testData := []string{
+		`$1`,
+		`$1 = $2`,
+		`CAST($1 AS INT)`,
+		`$1 IS NULL`,
+		`i IN (SELECT $1)`,
+		`unknown = $1`,
+	}
+	for _, expr := range testData {
+		types := PlaceholderTypes{}
+		if _, err := types.InferTypes(parseExpr(t, expr), nil, testColumns); err != nil {
+			t.Fatalf("%s: %v", expr, err)
+		}
+		if len(types) != 0 {
+			t.Errorf("%s: expected no types, but found %s", expr, typesString(types))
+		}
+	}
+}
+
+func TestInferTypesError(t *testing.T) {
+	testData := []struct {
+		expr     string
+		expected string
+	}{
+		{`i = $1 AND s = $1`, `placeholder \$1 is used as both int and string`},
+		{`$1 AND f = $1`, `placeholder \$1 is used as both bool and float`},
+	}
+	for _, d := range testData {
+		types := PlaceholderTypes{}
+		_, err := types.InferTypes(parseExpr(t, d.expr), nil, testColumns)
+		if !testutils.IsError(err, d.expected) {
+			t.Errorf("%s: expected %s, but found %v", d.expr, d.expected, err)
+		}
+	}
+}
+
+func TestCheckArgs(t *testing.T) {
+	ts := time.Date(2015, 9, 1, 12, 30, 0, 0, time.UTC)
+	testData := []struct {
+		typ      Datum
+		arg      Datum
+		expected string
+	}{
+		{DInt(0), DInt(1), `int 1`},
+		{DInt(0), DNull, `NULL NULL`},
+		{DFloat(0), DInt(1), `float 1`},
+		{DDecimal{}, DInt(2), `decimal 2`},
+		{DDecimal{}, DString("1.50"), `decimal 1.50`},
+		{DDate(0), DString("2015-09-01"), `date 2015-09-01`},
+		{DDate(0), DTimestamp{Time: ts}, `date 2015-09-01`},
+		{DTimestamp{}, DString("2015-09-01 12:30:00"), `timestamp 2015-09-01 12:30:00+00:00`},
+		{DInterval{}, DString("1h30m"), `interval 1h30m0s`},
+		{DString(""), DString("a"), `string 'a'`},
+	}
+	for _, d := range testData {
+		args, err := PlaceholderTypes{1: d.typ}.CheckArgs(mapArgs{1: d.arg})
+		if err != nil {
+			t.Fatalf("%s for %s: %v", d.arg, d.typ.Type(), err)
+		}
+		v, _ := args.Arg(1)
+		if s := v.Type() + " " + v.String(); s != d.expected {
+			t.Errorf("%s for %s: expected %s, but found %s", d.arg, d.typ.Type(), d.expected, s)
+		}
+	}
+}
+
+func TestCheckArgsError(t *testing.T) {
+	testData := []struct {
+		typ      Datum
+		arg      Datum
+		expected string
+	}{
+		{DInt(0), DString("a"), `placeholder \$1: expected a value of type int, but found type string`},
+		{DInt(0), DFloat(1.5), `placeholder \$1: expected a value of type int, but found type float`},
+		{DBool(false), DInt(1), `placeholder \$1: expected a value of type bool, but found type int`},
+		{DString(""), DInt(1), `placeholder \$1: expected a value of type string, but found type int`},
+		{DDate(0), DString("a"), `placeholder \$1: cannot convert 'a' to type date`},
+	}
+	for _, d := range testData {
+		_, err := PlaceholderTypes{1: d.typ}.CheckArgs(mapArgs{1: d.arg})
+		if !testutils.IsError(err, d.expected) {
+			t.Errorf("%s for %s: expected %s, but found %v", d.arg, d.typ.Type(), d.expected, err)
+		}
+	}
+}
@@ -50,6 +50,7 @@ func (*DecimalType) columnType()   {}
 func (*DateType) columnType()      {}
 func (*TimeType) columnType()      {}
 func (*TimestampType) columnType() {}
+func (*IntervalType) columnType()  {}
 func (*CharType) columnType()      {}
 func (*TextType) columnType()      {}
 func (*BlobType) columnType()      {}
@@ -149,6 +150,14 @@ func (node *TimestampType) String() string {
 	return "TIMESTAMP"
 }
 
+// IntervalType represents an INTERVAL type.
+type IntervalType struct {
+}
+
+func (node *IntervalType) String() string {
+	return "INTERVAL"
+}
+
 // CharType represents a CHAR or VARCHAR type.
 type CharType struct {
 	Name string
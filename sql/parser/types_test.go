@@ -45,6 +45,7 @@ func TestParseColumnType(t *testing.T) {
 		{"DATE", &DateType{}},
 		{"TIME", &TimeType{}},
 		{"TIMESTAMP", &TimestampType{}},
+		{"INTERVAL", &IntervalType{}},
 		{"CHAR", &CharType{Name: astChar}},
 		{"VARCHAR", &CharType{Name: astVarChar}},
 		{"CHAR(11)", &CharType{Name: astChar, N: 11}},
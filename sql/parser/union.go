@@ -25,19 +25,27 @@ package parser
 
 import "fmt"
 
-// Union represents a UNION statement.
+// Union represents a UNION, INTERSECT or EXCEPT statement.
 type Union struct {
 	Type        string
 	Left, Right SelectStatement
+	All         bool
+	OrderBy     OrderBy
+	Limit       *Limit
 }
 
 // Union.Type
 const (
-	astUnion     = "UNION"
-	astExcept    = "EXCEPT"
-	astIntersect = "INTERSECT"
+	AstUnion     = "UNION"
+	AstExcept    = "EXCEPT"
+	AstIntersect = "INTERSECT"
 )
 
 func (node *Union) String() string {
-	return fmt.Sprintf("%s %s %s", node.Left, node.Type, node.Right)
+	all := ""
+	if node.All {
+		all = " ALL"
+	}
+	return fmt.Sprintf("%s %s%s %s%s%s", node.Left, node.Type, all, node.Right,
+		node.OrderBy, node.Limit)
 }
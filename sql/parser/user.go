@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// CreateUser represents a CREATE USER statement. An empty Password
+// creates a user which cannot authenticate with a password.
+type CreateUser struct {
+	Name     Name
+	Password string
+}
+
+func (node *CreateUser) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "CREATE USER %s", node.Name)
+	if node.Password != "" {
+		_, _ = buf.WriteString(" WITH PASSWORD ")
+		_, _ = buf.Write(encodeSQLString(nil, []byte(node.Password)))
+	}
+	return buf.String()
+}
+
+// AlterUser represents an ALTER USER ... PASSWORD statement.
+type AlterUser struct {
+	Name     Name
+	Password string
+}
+
+func (node *AlterUser) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "ALTER USER %s WITH PASSWORD ", node.Name)
+	_, _ = buf.Write(encodeSQLString(nil, []byte(node.Password)))
+	return buf.String()
+}
+
+// DropUser represents a DROP USER statement.
+type DropUser struct {
+	Name Name
+}
+
+func (node *DropUser) String() string {
+	return fmt.Sprintf("DROP USER %s", node.Name)
+}
+
+// CreateRole represents a CREATE ROLE statement.
+type CreateRole struct {
+	Name Name
+}
+
+func (node *CreateRole) String() string {
+	return fmt.Sprintf("CREATE ROLE %s", node.Name)
+}
+
+// DropRole represents a DROP ROLE statement.
+type DropRole struct {
+	Name Name
+}
+
+func (node *DropRole) String() string {
+	return fmt.Sprintf("DROP ROLE %s", node.Name)
+}
+
+// GrantRole represents a GRANT statement granting roles to users or other
+// roles.
+type GrantRole struct {
+	Roles    NameList
+	Grantees NameList
+}
+
+func (node *GrantRole) String() string {
+	return fmt.Sprintf("GRANT %s TO %s", node.Roles, node.Grantees)
+}
+
+// RevokeRole represents a REVOKE statement revoking roles from users or
+// other roles.
+type RevokeRole struct {
+	Roles    NameList
+	Grantees NameList
+}
+
+func (node *RevokeRole) String() string {
+	return fmt.Sprintf("REVOKE %s FROM %s", node.Roles, node.Grantees)
+}
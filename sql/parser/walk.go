@@ -201,17 +201,11 @@ func WalkStmt(v Visitor, stmt Statement) {
 		if stmt.Having != nil {
 			stmt.Having.Expr = WalkExpr(v, stmt.Having.Expr)
 		}
-		for i, expr := range stmt.OrderBy {
-			stmt.OrderBy[i].Expr = WalkExpr(v, expr.Expr)
-		}
-		if stmt.Limit != nil {
-			if stmt.Limit.Offset != nil {
-				stmt.Limit.Offset = WalkExpr(v, stmt.Limit.Offset)
-			}
-			if stmt.Limit.Count != nil {
-				stmt.Limit.Count = WalkExpr(v, stmt.Limit.Count)
-			}
-		}
+		walkOrderByAndLimit(v, stmt.OrderBy, stmt.Limit)
+	case *Union:
+		WalkStmt(v, stmt.Left)
+		WalkStmt(v, stmt.Right)
+		walkOrderByAndLimit(v, stmt.OrderBy, stmt.Limit)
 	case *Update:
 		for i, expr := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, expr.Expr)
@@ -225,3 +219,17 @@ func WalkStmt(v Visitor, stmt Statement) {
 		}
 	}
 }
+
+func walkOrderByAndLimit(v Visitor, orderBy OrderBy, limit *Limit) {
+	for i, expr := range orderBy {
+		orderBy[i].Expr = WalkExpr(v, expr.Expr)
+	}
+	if limit != nil {
+		if limit.Offset != nil {
+			limit.Offset = WalkExpr(v, limit.Offset)
+		}
+		if limit.Count != nil {
+			limit.Count = WalkExpr(v, limit.Count)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// maxMessageSize is the largest message accepted from a client.
+const maxMessageSize = 1 << 24
+
+// readBuffer holds the contents of the message most recently read from a
+// client. The get methods consume the message from the front.
+type readBuffer struct {
+	msg []byte
+	tmp [4]byte
+}
+
+// reset sets the buffer up to hold a message of the given size.
+func (b *readBuffer) reset(size int) {
+	if cap(b.msg) >= size {
+		b.msg = b.msg[:size]
+		return
+	}
+	b.msg = make([]byte, size)
+}
+
+// readUntypedMsg reads a length-prefixed message. It is only used directly
+// during the startup phase of the protocol; readTypedMsg is used for all
+// other messages.
+func (b *readBuffer) readUntypedMsg(rd io.Reader) error {
+	if _, err := io.ReadFull(rd, b.tmp[:]); err != nil {
+		return err
+	}
+	size := int(binary.BigEndian.Uint32(b.tmp[:]))
+	// size includes itself.
+	size -= 4
+	if size < 0 || size > maxMessageSize {
+		return util.Errorf("message size %d out of bounds (0..%d)", size, maxMessageSize)
+	}
+	b.reset(size)
+	_, err := io.ReadFull(rd, b.msg)
+	return err
+}
+
+// readTypedMsg reads a message, returning its type code.
+func (b *readBuffer) readTypedMsg(rd io.Reader) (byte, error) {
+	if _, err := io.ReadFull(rd, b.tmp[:1]); err != nil {
+		return 0, err
+	}
+	typ := b.tmp[0]
+	return typ, b.readUntypedMsg(rd)
+}
+
+// getString reads a null-terminated string.
+func (b *readBuffer) getString() (string, error) {
+	pos := bytes.IndexByte(b.msg, 0)
+	if pos == -1 {
+		return "", util.Errorf("NUL terminator not found")
+	}
+	s := string(b.msg[:pos])
+	b.msg = b.msg[pos+1:]
+	return s, nil
+}
+
+// getBytes reads n bytes. The returned slice aliases the buffer.
+func (b *readBuffer) getBytes(n int) ([]byte, error) {
+	if n < 0 || len(b.msg) < n {
+		return nil, util.Errorf("insufficient data: %d", len(b.msg))
+	}
+	v := b.msg[:n]
+	b.msg = b.msg[n:]
+	return v, nil
+}
+
+func (b *readBuffer) getByte() (byte, error) {
+	v, err := b.getBytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return v[0], nil
+}
+
+func (b *readBuffer) getInt16() (int16, error) {
+	v, err := b.getBytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return int16(binary.BigEndian.Uint16(v)), nil
+}
+
+func (b *readBuffer) getInt32() (int32, error) {
+	v, err := b.getBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(v)), nil
+}
+
+// writeBuffer accumulates the contents of a message to be sent to a
+// client. finishMsg frames the message and writes it out.
+type writeBuffer struct {
+	bytes.Buffer
+	putbuf [8]byte
+}
+
+func (b *writeBuffer) putByte(v byte) {
+	b.WriteByte(v)
+}
+
+func (b *writeBuffer) putInt16(v int16) {
+	binary.BigEndian.PutUint16(b.putbuf[:2], uint16(v))
+	b.Write(b.putbuf[:2])
+}
+
+func (b *writeBuffer) putInt32(v int32) {
+	binary.BigEndian.PutUint32(b.putbuf[:4], uint32(v))
+	b.Write(b.putbuf[:4])
+}
+
+func (b *writeBuffer) putInt64(v int64) {
+	binary.BigEndian.PutUint64(b.putbuf[:8], uint64(v))
+	b.Write(b.putbuf[:8])
+}
+
+// putString writes a null-terminated string.
+func (b *writeBuffer) putString(s string) {
+	b.WriteString(s)
+	b.WriteByte(0)
+}
+
+// finishMsg writes the accumulated message with the given type code and
+// resets the buffer.
+func (b *writeBuffer) finishMsg(w io.Writer, typ byte) error {
+	defer b.Reset()
+	var header [5]byte
+	header[0] = typ
+	binary.BigEndian.PutUint32(header[1:], uint32(b.Len()+4))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := b.WriteTo(w)
+	return err
+}
@@ -0,0 +1,417 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire_test
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/security/securitytest"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// writeClientCerts writes the CA certificate and the client certificate and
+// key of the given user to a temporary directory, as lib/pq reads them from
+// the file system.
+func writeClientCerts(t *testing.T, user string) string {
+	dir := util.CreateTempDir(t, "pgwire")
+	for _, name := range []string{"ca.crt", user + ".client.crt", user + ".client.key"} {
+		data, err := securitytest.Asset(filepath.Join(security.EmbeddedCertsDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// lib/pq refuses to use a key readable by others.
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// openDB opens a connection pool to the PostgreSQL server of s, using the
+// client certificate of certUser to authenticate as user.
+func openDB(t *testing.T, s *server.TestServer, certUser, user string) (*sql.DB, string) {
+	host, port, err := net.SplitHostPort(s.PGAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := writeClientCerts(t, certUser)
+	db, err := sql.Open("postgres", fmt.Sprintf(
+		"host=%s port=%s user=%s sslmode=require sslcert=%s sslkey=%s",
+		host, port, user,
+		filepath.Join(dir, certUser+".client.crt"),
+		filepath.Join(dir, certUser+".client.key")))
+	if err != nil {
+		util.CleanupDir(dir)
+		t.Fatal(err)
+	}
+	return db, dir
+}
+
+func TestPGWire(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	db, dir := openDB(t, s, security.RootUser, security.RootUser)
+	defer util.CleanupDir(dir)
+	defer db.Close()
+
+	// Simple query protocol.
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v TEXT, f FLOAT, b BOOLEAN);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Extended query protocol with untyped parameters.
+	for i, v := range []string{"a", "b", "c"} {
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2, $3, $4)`,
+			i, v, float64(i)+0.5, i%2 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv (k) VALUES (3)`); err != nil {
+		t.Fatal(err)
+	}
+
+	type row struct {
+		k int64
+		v sql.NullString
+		f sql.NullFloat64
+		b sql.NullBool
+	}
+	for _, args := range [][]interface{}{nil, {int64(0)}} {
+		query := `SELECT k, v, f, b FROM t.kv WHERE k >= 0 ORDER BY k`
+		if args != nil {
+			query = `SELECT k, v, f, b FROM t.kv WHERE k >= $1 ORDER BY k`
+		}
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var results []row
+		for rows.Next() {
+			var r row
+			if err := rows.Scan(&r.k, &r.v, &r.f, &r.b); err != nil {
+				t.Fatal(err)
+			}
+			results = append(results, r)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		expected := []row{
+			{0, sql.NullString{String: "a", Valid: true}, sql.NullFloat64{Float64: 0.5, Valid: true}, sql.NullBool{Bool: true, Valid: true}},
+			{1, sql.NullString{String: "b", Valid: true}, sql.NullFloat64{Float64: 1.5, Valid: true}, sql.NullBool{Bool: false, Valid: true}},
+			{2, sql.NullString{String: "c", Valid: true}, sql.NullFloat64{Float64: 2.5, Valid: true}, sql.NullBool{Bool: true, Valid: true}},
+			{3, sql.NullString{}, sql.NullFloat64{}, sql.NullBool{}},
+		}
+		if !reflect.DeepEqual(expected, results) {
+			t.Errorf("%s: expected %v, but found %v", query, expected, results)
+		}
+	}
+
+	// A prepared statement executed several times.
+	stmt, err := db.Prepare(`SELECT v FROM t.kv WHERE k = $1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, expected := range []string{"a", "b", "c"} {
+		var v string
+		if err := stmt.QueryRow(k).Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if v != expected {
+			t.Errorf("expected %q, but found %q", expected, v)
+		}
+	}
+	if err := stmt.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Errors do not break the connection.
+	if _, err := db.Exec(`SELEC 1`); !testutils.IsError(err, "syntax error") {
+		t.Fatalf("expected syntax error, but found %v", err)
+	}
+	if _, err := db.Query(`SELECT * FROM t.kv WHERE k = $1 AND`, 1); !testutils.IsError(err, "syntax error") {
+		t.Fatalf("expected syntax error, but found %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1)`, 0); !testutils.IsError(err, "duplicate key") {
+		t.Fatalf("expected duplicate key error, but found %v", err)
+	}
+	// The types of untyped parameters are inferred by the executor.
+	if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1)`, "x"); !testutils.IsError(err, `invalid integer value "x"`) {
+		t.Fatalf("expected invalid integer error, but found %v", err)
+	}
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 4 {
+		t.Errorf("expected 4 rows, but found %d", count)
+	}
+}
+
+func TestPGWireDateTimeDecimal(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	db, dir := openDB(t, s, security.RootUser, security.RootUser)
+	defer util.CleanupDir(dir)
+	defer db.Close()
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.events (ts TIMESTAMP PRIMARY KEY, d DATE, i INTERVAL, n DECIMAL(10,2));
+`); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Date(2015, 8, 30, 3, 34, 45, 345670000, time.UTC)
+	if _, err := db.Exec(`INSERT INTO t.events VALUES ($1, '2015-08-30', '1h30m', 12.345)`, ts); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		rts time.Time
+		rd  time.Time
+		ri  string
+		rn  string
+	)
+	if err := db.QueryRow(`SELECT ts, d, i, n FROM t.events`).Scan(&rts, &rd, &ri, &rn); err != nil {
+		t.Fatal(err)
+	}
+	if !rts.Equal(ts) {
+		t.Errorf("expected %s, but found %s", ts, rts)
+	}
+	if expected := time.Date(2015, 8, 30, 0, 0, 0, 0, time.UTC); !rd.Equal(expected) {
+		t.Errorf("expected %s, but found %s", expected, rd)
+	}
+	if ri != "1h30m0s" {
+		t.Errorf("expected 1h30m0s, but found %s", ri)
+	}
+	if rn != "12.35" {
+		t.Errorf("expected 12.35, but found %s", rn)
+	}
+}
+
+func TestPGWireTransaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	db, dir := openDB(t, s, security.RootUser, security.RootUser)
+	defer util.CleanupDir(dir)
+	defer db.Close()
+
+	// The transaction status reported to the client keeps the statements of
+	// a transaction on the same connection.
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, 3, 4); err != nil {
+		t.Fatal(err)
+	}
+	var v int
+	if err := tx.QueryRow(`SELECT v FROM t.kv WHERE k = 3`).Scan(&v); err != nil {
+		t.Fatal(err)
+	} else if v != 4 {
+		t.Errorf("expected 4, but found %d", v)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 1 {
+		t.Errorf("expected 1 row, but found %d", count)
+	}
+
+	// The types of the parameters of a statement on a table created by the
+	// same transaction are inferred within the transaction.
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`CREATE TABLE t.kv2 (k INT PRIMARY KEY, v TEXT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv2 VALUES ($1, $2)`, 1, "1"); err != nil {
+		t.Fatal(err)
+	}
+	var v2 string
+	if err := tx.QueryRow(`SELECT v FROM t.kv2 WHERE k = $1`, 1).Scan(&v2); err != nil {
+		t.Fatal(err)
+	} else if v2 != "1" {
+		t.Errorf("expected 1, but found %s", v2)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestPGWireLargeResult verifies that the rows of results larger than the
+// number of rows held back by the server are all returned, with the types of
+// their columns, through both the simple and the extended query protocols.
+func TestPGWireLargeResult(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	db, dir := openDB(t, s, security.RootUser, security.RootUser)
+	defer util.CleanupDir(dir)
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	// Several times the number of rows held back by the server.
+	const numRows = 301
+	for i := 0; i < numRows; i++ {
+		if _, err := db.Exec(`INSERT INTO t.kv VALUES ($1, $2)`, i, -i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, q := range []struct {
+		query string
+		args  []interface{}
+	}{
+		{`SELECT k, v FROM t.kv`, nil},
+		{`SELECT k, v FROM t.kv WHERE k >= $1`, []interface{}{0}},
+	} {
+		rows, err := db.Query(q.query, q.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for rows.Next() {
+			var k, v int
+			if err := rows.Scan(&k, &v); err != nil {
+				t.Fatal(err)
+			}
+			if k != n || v != -n {
+				t.Fatalf("%s: expected row %d, but found (%d, %d)", q.query, n, k, v)
+			}
+			n++
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		rows.Close()
+		if n != numRows {
+			t.Errorf("%s: expected %d rows, but found %d", q.query, numRows, n)
+		}
+	}
+}
+
+func TestPGWireAuth(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(t)
+	defer s.Stop()
+
+	host, port, err := net.SplitHostPort(s.PGAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a client certificate, a user without a password cannot log in.
+	db, err := sql.Open("postgres", fmt.Sprintf(
+		"host=%s port=%s user=root sslmode=require", host, port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Ping(); !testutils.IsError(err, "password authentication failed for user root") {
+		t.Errorf("expected authentication error, but found %v", err)
+	}
+	db.Close()
+
+	// Without SSL.
+	db, err = sql.Open("postgres", fmt.Sprintf(
+		"host=%s port=%s user=root sslmode=disable", host, port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Ping(); !testutils.IsError(err, "request is not using TLS") {
+		t.Errorf("expected authentication error, but found %v", err)
+	}
+	db.Close()
+
+	// With the certificate of another user.
+	db, dir := openDB(t, s, server.TestUser, security.RootUser)
+	defer util.CleanupDir(dir)
+	if err := db.Ping(); !testutils.IsError(err, "requested user is root, but certificate is for testuser") {
+		t.Errorf("expected authentication error, but found %v", err)
+	}
+	db.Close()
+
+	// With a password set by CREATE USER.
+	root, rootDir := openDB(t, s, security.RootUser, security.RootUser)
+	defer util.CleanupDir(rootDir)
+	defer root.Close()
+	if _, err := root.Exec(`CREATE USER alice WITH PASSWORD 'secret'`); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []struct {
+		password string
+		expected string
+	}{
+		{"secret", ""},
+		{"wrong", "password authentication failed for user alice"},
+	} {
+		db, err := sql.Open("postgres", fmt.Sprintf(
+			"host=%s port=%s user=alice password=%s sslmode=require", host, port, d.password))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Ping(); d.expected == "" && err != nil {
+			t.Errorf("%s: %v", d.password, err)
+		} else if d.expected != "" && !testutils.IsError(err, d.expected) {
+			t.Errorf("%s: expected authentication error, but found %v", d.password, err)
+		}
+		db.Close()
+	}
+}
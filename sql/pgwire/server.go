@@ -0,0 +1,226 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package pgwire implements the server side of the PostgreSQL v3 wire
+// protocol, so that standard PostgreSQL drivers and tools can connect to the
+// SQL layer. Statements are executed by the same sql.Server that serves the
+// HTTP endpoint of the sql/driver package.
+package pgwire
+
+import (
+	"crypto/tls"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+const (
+	// versionCancel is the protocol code of a request to cancel a query.
+	versionCancel = 80877102
+	// versionSSL is the protocol code of a request for SSL negotiation.
+	versionSSL = 80877103
+	// version30 is the code of version 3.0 of the protocol.
+	version30 = 196608
+)
+
+// A Server accepts connections speaking the PostgreSQL wire protocol and
+// executes their statements on a sql.Server.
+type Server struct {
+	context  *base.Context
+	executor *sql.Server
+
+	mu       sync.Mutex // Mutex protects the fields below
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+// NewServer creates a Server executing statements on the given sql.Server.
+func NewServer(context *base.Context, executor *sql.Server) *Server {
+	return &Server{
+		context:  context,
+		executor: executor,
+		conns:    map[net.Conn]struct{}{},
+	}
+}
+
+// Start binds the given address and serves connections until the stopper
+// is stopped. After this method returns, use Server.Addr() to ascertain the
+// bound address.
+func (s *Server) Start(addr string, stopper *stop.Stopper) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return util.Errorf("could not listen on %s: %s", addr, err)
+	}
+	s.mu.Lock()
+	s.listener = ln
+	s.mu.Unlock()
+
+	stopper.RunWorker(func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if !isClosedConnection(err) {
+					log.Errorf("pgwire: accept: %s", err)
+				}
+				return
+			}
+			if !s.addConn(conn) {
+				conn.Close()
+				return
+			}
+			go func() {
+				defer s.removeConn(conn)
+				if err := s.serveConn(conn); err != nil && !isClosedConnection(err) {
+					log.Warningf("pgwire: %s: %s", conn.RemoteAddr(), err)
+				}
+			}()
+		}
+	})
+
+	stopper.RunWorker(func() {
+		<-stopper.ShouldStop()
+		s.Close()
+	})
+	return nil
+}
+
+// Addr returns the address the server is listening on, or nil if it has
+// not been started.
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Close closes the listener and all open connections.
+func (s *Server) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.listener != nil {
+		s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+// addConn registers a new connection, returning false if the server has
+// been closed.
+func (s *Server) addConn(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Server) removeConn(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn.Close()
+	delete(s.conns, conn)
+}
+
+// serveConn runs the startup phase of the protocol on a new connection,
+// negotiating SSL if requested by the client, and then serves the
+// connection's messages until it is closed.
+func (s *Server) serveConn(conn net.Conn) error {
+	var buf readBuffer
+	if err := buf.readUntypedMsg(conn); err != nil {
+		return err
+	}
+	version, err := buf.getInt32()
+	if err != nil {
+		return err
+	}
+
+	if version == versionSSL {
+		tlsConfig, err := s.context.GetServerTLSConfig()
+		if err != nil {
+			return err
+		}
+		if tlsConfig == nil {
+			// Insecure mode: the client proceeds without SSL.
+			if _, err := conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+		} else {
+			if _, err := conn.Write([]byte{'S'}); err != nil {
+				return err
+			}
+			tlsConn := tls.Server(conn, tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return err
+			}
+			conn = tlsConn
+		}
+		if err := buf.readUntypedMsg(conn); err != nil {
+			return err
+		}
+		if version, err = buf.getInt32(); err != nil {
+			return err
+		}
+	}
+
+	switch version {
+	case version30:
+	case versionCancel:
+		// Query cancellation is not supported; the client does not expect a
+		// response.
+		return nil
+	default:
+		return util.Errorf("unsupported protocol version %d", version)
+	}
+
+	params := map[string]string{}
+	for {
+		key, err := buf.getString()
+		if err != nil {
+			return err
+		}
+		if key == "" {
+			break
+		}
+		if params[key], err = buf.getString(); err != nil {
+			return err
+		}
+	}
+
+	var tlsState *tls.ConnectionState
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		state := tlsConn.ConnectionState()
+		tlsState = &state
+	}
+	return newV3Conn(conn, s.executor).serve(s.context.Insecure, tlsState, params)
+}
+
+// isClosedConnection returns true if the error is caused by the use of a
+// closed network connection.
+func isClosedConnection(err error) bool {
+	return strings.Contains(err.Error(), "use of closed network connection")
+}
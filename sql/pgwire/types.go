@@ -0,0 +1,414 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/lib/pq/oid"
+)
+
+// formatCode is the encoding of a parameter or result value.
+type formatCode int16
+
+const (
+	formatText   formatCode = 0
+	formatBinary formatCode = 1
+)
+
+const (
+	// timestampFormat is the text format of timestamps.
+	timestampFormat = "2006-01-02 15:04:05.999999999-07:00"
+	// dateFormat is the text format of dates.
+	dateFormat = "2006-01-02"
+	// pgEpochDays is the number of days between the Unix epoch and the
+	// PostgreSQL epoch (2000-01-01), from which binary dates and timestamps
+	// are counted.
+	pgEpochDays  = 10957
+	secondsInDay = 24 * 60 * 60
+)
+
+// typeForDatum returns the type of a result value. The type of a NULL value
+// is unknown.
+func typeForDatum(d driver.Datum) oid.Oid {
+	switch d.GetValue().(type) {
+	case *bool:
+		return oid.T_bool
+	case *int64:
+		return oid.T_int8
+	case *float64:
+		return oid.T_float8
+	case []byte:
+		return oid.T_bytea
+	case *string:
+		return oid.T_text
+	case *driver.Datum_Timestamp:
+		return oid.T_timestamptz
+	case *int32:
+		return oid.T_date
+	case *time.Duration:
+		return oid.T_interval
+	case *driver.Decimal:
+		return oid.T_numeric
+	default:
+		return oid.T_unknown
+	}
+}
+
+// typeForName maps the names of the types inferred for placeholders by the
+// executor to the types of the values of parameters.
+var typeForName = map[string]oid.Oid{
+	"bool":      oid.T_bool,
+	"int":       oid.T_int8,
+	"float":     oid.T_float8,
+	"string":    oid.T_text,
+	"decimal":   oid.T_numeric,
+	"date":      oid.T_date,
+	"timestamp": oid.T_timestamptz,
+	"interval":  oid.T_interval,
+}
+
+// typeLen returns the length of the values of a type, or -1 for variable
+// length types.
+func typeLen(typ oid.Oid) int16 {
+	switch typ {
+	case oid.T_bool:
+		return 1
+	case oid.T_date:
+		return 4
+	case oid.T_int8, oid.T_float8, oid.T_timestamptz:
+		return 8
+	case oid.T_interval:
+		return 16
+	default:
+		return -1
+	}
+}
+
+// resultTypes returns the types of the columns of a result. SQL values are
+// not statically typed, so the type of each column is taken from its first
+// non-NULL value. Columns without such a value are reported as text.
+func resultTypes(result driver.Result) []oid.Oid {
+	types := make([]oid.Oid, len(result.Columns))
+	for i := range types {
+		types[i] = oid.T_text
+		for _, row := range result.Rows {
+			if typ := typeForDatum(row.Values[i]); typ != oid.T_unknown {
+				types[i] = typ
+				break
+			}
+		}
+	}
+	return types
+}
+
+// putDatum writes a length-prefixed value of a column of the given type.
+// The binary format is only used if the value has the column's type;
+// otherwise the value is written in the text format, which is also the
+// binary format of text columns.
+func (b *writeBuffer) putDatum(d driver.Datum, typ oid.Oid, format formatCode) {
+	if format == formatBinary && typeForDatum(d) == typ {
+		switch v := d.GetValue().(type) {
+		case *bool:
+			b.putInt32(1)
+			if *v {
+				b.putByte(1)
+			} else {
+				b.putByte(0)
+			}
+			return
+		case *int64:
+			b.putInt32(8)
+			b.putInt64(*v)
+			return
+		case *float64:
+			b.putInt32(8)
+			b.putInt64(int64(math.Float64bits(*v)))
+			return
+		case []byte:
+			b.putInt32(int32(len(v)))
+			b.Write(v)
+			return
+		case *driver.Datum_Timestamp:
+			b.putInt32(8)
+			b.putInt64(pgMicros(v.GoTime()))
+			return
+		case *int32:
+			b.putInt32(4)
+			b.putInt32(*v - pgEpochDays)
+			return
+		case *time.Duration:
+			// An interval is sent as microseconds, days and months.
+			b.putInt32(16)
+			b.putInt64(int64(*v / time.Microsecond))
+			b.putInt32(0)
+			b.putInt32(0)
+			return
+		case *driver.Decimal:
+			data := encodeBinaryNumeric(string(*v))
+			b.putInt32(int32(len(data)))
+			b.Write(data)
+			return
+		}
+	}
+
+	var s string
+	switch v := d.GetValue().(type) {
+	case nil:
+		b.putInt32(-1)
+		return
+	case *bool:
+		if *v {
+			s = "t"
+		} else {
+			s = "f"
+		}
+	case *int64:
+		s = strconv.FormatInt(*v, 10)
+	case *float64:
+		s = strconv.FormatFloat(*v, 'g', -1, 64)
+	case []byte:
+		s = `\x` + hex.EncodeToString(v)
+	case *string:
+		s = *v
+	case *driver.Datum_Timestamp:
+		s = v.GoTime().Format(timestampFormat)
+	case *int32:
+		s = time.Unix(int64(*v)*secondsInDay, 0).UTC().Format(dateFormat)
+	case *time.Duration:
+		// Intervals use the format of the SQL layer, which is the format of
+		// time.Duration.
+		s = v.String()
+	case *driver.Decimal:
+		s = string(*v)
+	}
+	b.putInt32(int32(len(s)))
+	b.WriteString(s)
+}
+
+// pgMicros returns the number of microseconds between the PostgreSQL epoch
+// and t.
+func pgMicros(t time.Time) int64 {
+	return t.Unix()*1000000 + int64(t.Nanosecond())/1000 - pgEpochDays*secondsInDay*1000000
+}
+
+// encodeBinaryNumeric returns the binary format of the decimal s, as
+// formatted by parser.DDecimal: the number of base 10000 digits, the weight
+// of the first digit, the sign and the number of decimal digits after the
+// decimal point, followed by the base 10000 digits.
+func encodeBinaryNumeric(s string) []byte {
+	const (
+		numericPos = 0x0000
+		numericNeg = 0x4000
+	)
+	sign := numericPos
+	if strings.HasPrefix(s, "-") {
+		sign = numericNeg
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	dscale := len(fracPart)
+	// Pad both parts with zeros to whole base 10000 digits.
+	if n := len(intPart) % 4; n != 0 {
+		intPart = strings.Repeat("0", 4-n) + intPart
+	}
+	if n := len(fracPart) % 4; n != 0 {
+		fracPart += strings.Repeat("0", 4-n)
+	}
+	digitStr := intPart + fracPart
+	digits := make([]int16, 0, len(digitStr)/4)
+	for i := 0; i < len(digitStr); i += 4 {
+		d, _ := strconv.Atoi(digitStr[i : i+4])
+		digits = append(digits, int16(d))
+	}
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = numericPos
+	}
+
+	data := make([]byte, 8+2*len(digits))
+	binary.BigEndian.PutUint16(data[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(data[2:], uint16(int16(weight)))
+	binary.BigEndian.PutUint16(data[4:], uint16(sign))
+	binary.BigEndian.PutUint16(data[6:], uint16(dscale))
+	for i, d := range digits {
+		binary.BigEndian.PutUint16(data[8+2*i:], uint16(d))
+	}
+	return data
+}
+
+// decodeParam converts the value of a placeholder sent by a client into a
+// datum. Values of an unspecified type are only accepted in the text format
+// and are interpreted like the corresponding SQL literal: as an integer or a
+// float if they parse as one, as a bool if they are "true" or "false" and as
+// a string otherwise.
+func decodeParam(b []byte, typ oid.Oid, format formatCode) (driver.Datum, error) {
+	var d driver.Datum
+	if format == formatBinary {
+		switch typ {
+		case oid.T_bool:
+			if len(b) != 1 {
+				return d, util.Errorf("invalid binary bool of length %d", len(b))
+			}
+			v := b[0] != 0
+			d.BoolVal = &v
+		case oid.T_int2:
+			if len(b) != 2 {
+				return d, util.Errorf("invalid binary int2 of length %d", len(b))
+			}
+			v := int64(int16(binary.BigEndian.Uint16(b)))
+			d.IntVal = &v
+		case oid.T_int4:
+			if len(b) != 4 {
+				return d, util.Errorf("invalid binary int4 of length %d", len(b))
+			}
+			v := int64(int32(binary.BigEndian.Uint32(b)))
+			d.IntVal = &v
+		case oid.T_int8:
+			if len(b) != 8 {
+				return d, util.Errorf("invalid binary int8 of length %d", len(b))
+			}
+			v := int64(binary.BigEndian.Uint64(b))
+			d.IntVal = &v
+		case oid.T_float4:
+			if len(b) != 4 {
+				return d, util.Errorf("invalid binary float4 of length %d", len(b))
+			}
+			v := float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+			d.FloatVal = &v
+		case oid.T_float8:
+			if len(b) != 8 {
+				return d, util.Errorf("invalid binary float8 of length %d", len(b))
+			}
+			v := math.Float64frombits(binary.BigEndian.Uint64(b))
+			d.FloatVal = &v
+		case oid.T_text, oid.T_varchar:
+			v := string(b)
+			d.StringVal = &v
+		case oid.T_bytea:
+			d.BytesVal = make([]byte, len(b))
+			copy(d.BytesVal, b)
+		case oid.T_date:
+			if len(b) != 4 {
+				return d, util.Errorf("invalid binary date of length %d", len(b))
+			}
+			v := int32(binary.BigEndian.Uint32(b)) + pgEpochDays
+			d.DateVal = &v
+		case oid.T_timestamp, oid.T_timestamptz:
+			if len(b) != 8 {
+				return d, util.Errorf("invalid binary timestamp of length %d", len(b))
+			}
+			micros := int64(binary.BigEndian.Uint64(b)) + pgEpochDays*secondsInDay*1000000
+			d.TimeVal = driver.TimestampFromTime(time.Unix(micros/1000000, micros%1000000*1000))
+		default:
+			return d, util.Errorf("unsupported binary parameter type %d", typ)
+		}
+		return d, nil
+	}
+
+	s := string(b)
+	switch typ {
+	case oid.T_bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return d, util.Errorf("invalid bool value %q", s)
+		}
+		d.BoolVal = &v
+	case oid.T_int2, oid.T_int4, oid.T_int8:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return d, util.Errorf("invalid integer value %q", s)
+		}
+		d.IntVal = &v
+	case oid.T_float4, oid.T_float8:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return d, util.Errorf("invalid float value %q", s)
+		}
+		d.FloatVal = &v
+	case oid.T_text, oid.T_varchar:
+		d.StringVal = &s
+	case oid.T_date:
+		v, err := parser.ParseDDate(s)
+		if err != nil {
+			return d, err
+		}
+		days := int32(v)
+		d.DateVal = &days
+	case oid.T_timestamp, oid.T_timestamptz:
+		v, err := parser.ParseDTimestamp(s)
+		if err != nil {
+			return d, err
+		}
+		d.TimeVal = driver.TimestampFromTime(v.Time)
+	case oid.T_interval:
+		v, err := parser.ParseDInterval(s)
+		if err != nil {
+			return d, err
+		}
+		d.IntervalVal = &v.Duration
+	case oid.T_numeric:
+		v, err := parser.ParseDDecimal(s)
+		if err != nil {
+			return d, err
+		}
+		dec := driver.Decimal(v.String())
+		d.DecimalVal = &dec
+	case oid.T_bytea:
+		if strings.HasPrefix(s, `\x`) {
+			v, err := hex.DecodeString(s[2:])
+			if err != nil {
+				return d, util.Errorf("invalid bytea value %q", s)
+			}
+			d.BytesVal = v
+		} else {
+			d.BytesVal = []byte(s)
+		}
+	case 0, oid.T_unknown:
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			d.IntVal = &v
+		} else if v, err := strconv.ParseFloat(s, 64); err == nil {
+			d.FloatVal = &v
+		} else if s == "true" || s == "false" {
+			v := s == "true"
+			d.BoolVal = &v
+		} else {
+			d.StringVal = &s
+		}
+	default:
+		return d, util.Errorf("unsupported parameter type %d", typ)
+	}
+	return d, nil
+}
@@ -0,0 +1,904 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package pgwire
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/lib/pq/oid"
+)
+
+// Message types sent by the client.
+const (
+	clientMsgBind        = 'B'
+	clientMsgClose       = 'C'
+	clientMsgDescribe    = 'D'
+	clientMsgExecute     = 'E'
+	clientMsgFlush       = 'H'
+	clientMsgParse       = 'P'
+	clientMsgPassword    = 'p'
+	clientMsgSimpleQuery = 'Q'
+	clientMsgSync        = 'S'
+	clientMsgTerminate   = 'X'
+)
+
+// Message types sent by the server.
+const (
+	serverMsgAuth                 = 'R'
+	serverMsgBindComplete         = '2'
+	serverMsgCloseComplete        = '3'
+	serverMsgCommandComplete      = 'C'
+	serverMsgDataRow              = 'D'
+	serverMsgEmptyQuery           = 'I'
+	serverMsgErrorResponse        = 'E'
+	serverMsgNoData               = 'n'
+	serverMsgParameterDescription = 't'
+	serverMsgParameterStatus      = 'S'
+	serverMsgParseComplete        = '1'
+	serverMsgPortalSuspended      = 's'
+	serverMsgReady                = 'Z'
+	serverMsgRowDescription       = 'T'
+)
+
+// Error codes sent to the client. See
+// http://www.postgresql.org/docs/9.4/static/errcodes-appendix.html.
+const (
+	codeSyntaxError       = "42601"
+	codeDuplicateObject   = "42P05"
+	codeInvalidObject     = "26000"
+	codeInvalidPortal     = "34000"
+	codeProtocolViolation = "08P01"
+	codeInvalidAuth       = "28000"
+	codeInternalError     = "XX000"
+)
+
+// serverParameters are reported to the client after authentication.
+// Drivers inspect server_version to decide on the features they use.
+var serverParameters = []struct{ key, value string }{
+	{"client_encoding", "UTF8"},
+	{"DateStyle", "ISO"},
+	{"integer_datetimes", "on"},
+	{"server_encoding", "UTF8"},
+	{"server_version", "9.5.0"},
+	{"standard_conforming_strings", "on"},
+}
+
+// A preparedStatement is a statement created by a Parse message.
+type preparedStatement struct {
+	query      string
+	paramTypes []oid.Oid
+	// columns are the names of the columns of the rows returned by the
+	// statement. They are determined by the first Describe message.
+	columns   []string
+	described bool
+}
+
+// A portal is a prepared statement bound to parameters by a Bind message.
+// The results of a portal are retained if an Execute message retrieves only
+// some of its rows.
+type portal struct {
+	stmt          *preparedStatement
+	params        []driver.Datum
+	resultFormats []formatCode
+	result        *driver.Result
+	types         []oid.Oid
+	tag           string
+}
+
+type v3Conn struct {
+	conn     net.Conn
+	rd       *bufio.Reader
+	wr       *bufio.Writer
+	executor *sql.Server
+	readBuf  readBuffer
+	writeBuf writeBuffer
+
+	user    string
+	session []byte
+
+	preparedStatements map[string]*preparedStatement
+	portals            map[string]*portal
+
+	// ignoreUntilSync is set when an error occurs while processing a message
+	// of the extended query protocol. All messages up to the next Sync are
+	// discarded.
+	ignoreUntilSync bool
+}
+
+func newV3Conn(conn net.Conn, executor *sql.Server) *v3Conn {
+	return &v3Conn{
+		conn:               conn,
+		rd:                 bufio.NewReader(conn),
+		wr:                 bufio.NewWriter(conn),
+		executor:           executor,
+		preparedStatements: map[string]*preparedStatement{},
+		portals:            map[string]*portal{},
+	}
+}
+
+// serve authenticates the user named in the startup parameters and then
+// processes the client's messages until the connection is terminated. In
+// secure mode, a client connecting over TLS without a client certificate
+// authenticates with the password of the user.
+func (c *v3Conn) serve(insecure bool, tlsState *tls.ConnectionState, params map[string]string) error {
+	c.user = params["user"]
+	if !insecure && tlsState != nil && len(tlsState.PeerCertificates) == 0 {
+		if ok, err := c.authenticatePassword(); !ok || err != nil {
+			return err
+		}
+	} else {
+		authenticationHook, err := security.AuthenticationHook(insecure, tlsState)
+		if err == nil {
+			err = authenticationHook(&driver.Request{RequestHeader: driver.RequestHeader{User: c.user}})
+		}
+		if err != nil {
+			return c.sendError(codeInvalidAuth, err)
+		}
+	}
+	var err error
+	if c.session, err = gogoproto.Marshal(&sql.Session{Database: params["database"]}); err != nil {
+		return err
+	}
+
+	// AuthenticationOk.
+	c.writeBuf.putInt32(0)
+	if err := c.writeBuf.finishMsg(c.wr, serverMsgAuth); err != nil {
+		return err
+	}
+	for _, param := range serverParameters {
+		c.writeBuf.putString(param.key)
+		c.writeBuf.putString(param.value)
+		if err := c.writeBuf.finishMsg(c.wr, serverMsgParameterStatus); err != nil {
+			return err
+		}
+	}
+	if err := c.sendReadyForQuery(); err != nil {
+		return err
+	}
+
+	for {
+		typ, err := c.readBuf.readTypedMsg(c.rd)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if c.ignoreUntilSync && typ != clientMsgSync {
+			continue
+		}
+		switch typ {
+		case clientMsgSimpleQuery:
+			err = c.handleSimpleQuery()
+		case clientMsgParse:
+			err = c.handleParse()
+		case clientMsgDescribe:
+			err = c.handleDescribe()
+		case clientMsgBind:
+			err = c.handleBind()
+		case clientMsgExecute:
+			err = c.handleExecute()
+		case clientMsgClose:
+			err = c.handleClose()
+		case clientMsgSync:
+			c.ignoreUntilSync = false
+			err = c.sendReadyForQuery()
+		case clientMsgFlush:
+			err = c.wr.Flush()
+		case clientMsgTerminate:
+			return nil
+		default:
+			err = c.sendError(codeProtocolViolation, util.Errorf("unrecognized client message type %q", typ))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// authenticatePassword requests the password of the user, which is sent in
+// clear text as the connection uses TLS, and checks it. It returns false if
+// the authentication failed, in which case the error has been sent to the
+// client.
+func (c *v3Conn) authenticatePassword() (bool, error) {
+	// AuthenticationCleartextPassword.
+	c.writeBuf.putInt32(3)
+	if err := c.writeBuf.finishMsg(c.wr, serverMsgAuth); err != nil {
+		return false, err
+	}
+	if err := c.wr.Flush(); err != nil {
+		return false, err
+	}
+	typ, err := c.readBuf.readTypedMsg(c.rd)
+	if err != nil {
+		return false, err
+	}
+	if typ != clientMsgPassword {
+		return false, c.sendError(codeProtocolViolation,
+			util.Errorf("expected a password message, but found message type %q", typ))
+	}
+	password, err := c.readBuf.getString()
+	if err != nil {
+		return false, c.sendError(codeProtocolViolation, err)
+	}
+	if err := c.executor.CheckPassword(c.user, password); err != nil {
+		return false, c.sendError(codeInvalidAuth, err)
+	}
+	return true, nil
+}
+
+// sendReadyForQuery reports the transaction status of the session and
+// flushes the pending messages.
+func (c *v3Conn) sendReadyForQuery() error {
+	var session sql.Session
+	if err := gogoproto.Unmarshal(c.session, &session); err != nil {
+		return err
+	}
+	status := byte('I')
+	if session.Txn != nil {
+		if session.Txn.Status == proto.ABORTED {
+			status = 'E'
+		} else {
+			status = 'T'
+		}
+	}
+	c.writeBuf.putByte(status)
+	if err := c.writeBuf.finishMsg(c.wr, serverMsgReady); err != nil {
+		return err
+	}
+	return c.wr.Flush()
+}
+
+// sendError sends an ErrorResponse. The error is sent to the client; only
+// errors writing to the connection are returned.
+func (c *v3Conn) sendError(code string, err error) error {
+	c.writeBuf.putByte('S')
+	c.writeBuf.putString("ERROR")
+	c.writeBuf.putByte('C')
+	c.writeBuf.putString(code)
+	c.writeBuf.putByte('M')
+	c.writeBuf.putString(err.Error())
+	c.writeBuf.putByte(0)
+	if err := c.writeBuf.finishMsg(c.wr, serverMsgErrorResponse); err != nil {
+		return err
+	}
+	return c.wr.Flush()
+}
+
+// sendExtendedError sends an ErrorResponse for a message of the extended
+// query protocol and discards the following messages up to the next Sync.
+func (c *v3Conn) sendExtendedError(code string, err error) error {
+	c.ignoreUntilSync = true
+	return c.sendError(code, err)
+}
+
+func (c *v3Conn) handleSimpleQuery() error {
+	query, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	stmts, err := parser.Parse(query)
+	if err != nil {
+		if err := c.sendError(codeSyntaxError, err); err != nil {
+			return err
+		}
+		return c.sendReadyForQuery()
+	}
+	if len(stmts) == 0 {
+		if err := c.writeBuf.finishMsg(c.wr, serverMsgEmptyQuery); err != nil {
+			return err
+		}
+		return c.sendReadyForQuery()
+	}
+
+	w := &resultWriter{c: c, stmts: stmts, describe: true}
+	err = c.executeTo(query, nil, w)
+	if w.err != nil {
+		return w.err
+	}
+	if err != nil {
+		if err := c.sendError(codeInternalError, err); err != nil {
+			return err
+		}
+	}
+	return c.sendReadyForQuery()
+}
+
+// execute runs the statements of the query on the executor, updating the
+// session state of the connection. The results of the statements are
+// returned in the response.
+func (c *v3Conn) execute(query string, params []driver.Datum) (driver.Response, error) {
+	resp, err := c.executor.Execute(c.request(query, params))
+	if resp.Session != nil {
+		c.session = resp.Session
+	}
+	return resp, err
+}
+
+// executeTo is like execute, but sends the results of the statements to the
+// client through w as they are produced.
+func (c *v3Conn) executeTo(query string, params []driver.Datum, w *resultWriter) error {
+	resp, err := c.executor.ExecuteTo(c.request(query, params), w)
+	if resp.Session != nil {
+		c.session = resp.Session
+	}
+	return err
+}
+
+func (c *v3Conn) request(query string, params []driver.Datum) driver.Request {
+	req := driver.Request{
+		RequestHeader: driver.RequestHeader{User: c.user},
+		Sql:           query,
+		Params:        params,
+	}
+	req.Session = c.session
+	return req
+}
+
+func (c *v3Conn) handleParse() error {
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	if _, ok := c.preparedStatements[name]; ok && name != "" {
+		return c.sendExtendedError(codeDuplicateObject,
+			util.Errorf("prepared statement %q already exists", name))
+	}
+	query, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	numTypes, err := c.readBuf.getInt16()
+	if err != nil {
+		return err
+	}
+	paramTypes := make([]oid.Oid, numTypes)
+	for i := range paramTypes {
+		typ, err := c.readBuf.getInt32()
+		if err != nil {
+			return err
+		}
+		paramTypes[i] = oid.Oid(typ)
+	}
+
+	stmts, err := parser.Parse(query)
+	if err != nil {
+		return c.sendExtendedError(codeSyntaxError, err)
+	}
+	if len(stmts) > 1 {
+		return c.sendExtendedError(codeSyntaxError,
+			util.Errorf("cannot insert multiple commands into a prepared statement"))
+	}
+	stmt := &preparedStatement{
+		query:      query,
+		paramTypes: paramTypes,
+	}
+	if len(stmts) == 1 {
+		// Placeholders whose type was not specified by the client take the
+		// types inferred by the executor. They are untyped if no type could be
+		// inferred.
+		v := placeholderVisitor{}
+		parser.WalkStmt(&v, stmts[0])
+		for len(stmt.paramTypes) < v.max {
+			stmt.paramTypes = append(stmt.paramTypes, oid.T_unknown)
+		}
+		for _, typ := range stmt.paramTypes {
+			if typ == 0 || typ == oid.T_unknown {
+				if _, err := c.describe(stmt); err != nil {
+					return c.sendExtendedError(codeInternalError, err)
+				}
+				break
+			}
+		}
+	}
+
+	c.preparedStatements[name] = stmt
+	return c.writeBuf.finishMsg(c.wr, serverMsgParseComplete)
+}
+
+// describe returns the result columns of the prepared statement, asking the
+// executor to plan it on first use. The types of the placeholders of the
+// statement which were not specified by the client are set to the types
+// inferred by the executor.
+func (c *v3Conn) describe(stmt *preparedStatement) ([]string, error) {
+	if !stmt.described {
+		req := driver.Request{
+			RequestHeader: driver.RequestHeader{User: c.user},
+			Sql:           stmt.query,
+		}
+		req.Session = c.session
+		types, columns, err := c.executor.Describe(req)
+		if err != nil {
+			return nil, err
+		}
+		for i, name := range types {
+			if i < len(stmt.paramTypes) && (stmt.paramTypes[i] == 0 || stmt.paramTypes[i] == oid.T_unknown) {
+				if typ, ok := typeForName[name]; ok {
+					stmt.paramTypes[i] = typ
+				}
+			}
+		}
+		stmt.columns = columns
+		stmt.described = true
+	}
+	return stmt.columns, nil
+}
+
+func (c *v3Conn) handleDescribe() error {
+	typ, err := c.readBuf.getByte()
+	if err != nil {
+		return err
+	}
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+
+	var stmt *preparedStatement
+	var formats []formatCode
+	switch typ {
+	case 'S':
+		var ok bool
+		if stmt, ok = c.preparedStatements[name]; !ok {
+			return c.sendExtendedError(codeInvalidObject,
+				util.Errorf("unknown prepared statement %q", name))
+		}
+		c.writeBuf.putInt16(int16(len(stmt.paramTypes)))
+		for _, t := range stmt.paramTypes {
+			c.writeBuf.putInt32(int32(t))
+		}
+		if err := c.writeBuf.finishMsg(c.wr, serverMsgParameterDescription); err != nil {
+			return err
+		}
+	case 'P':
+		p, ok := c.portals[name]
+		if !ok {
+			return c.sendExtendedError(codeInvalidPortal, util.Errorf("unknown portal %q", name))
+		}
+		stmt = p.stmt
+		formats = p.resultFormats
+	default:
+		return c.sendExtendedError(codeProtocolViolation,
+			util.Errorf("invalid describe type %q", typ))
+	}
+
+	columns, err := c.describe(stmt)
+	if err != nil {
+		return c.sendExtendedError(codeInternalError, err)
+	}
+	if columns == nil {
+		return c.writeBuf.finishMsg(c.wr, serverMsgNoData)
+	}
+	// The types of the columns are not known until the statement has been
+	// executed.
+	types := make([]oid.Oid, len(columns))
+	for i := range types {
+		types[i] = oid.T_text
+	}
+	return c.sendRowDescription(columns, types, formats)
+}
+
+func (c *v3Conn) handleBind() error {
+	portalName, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	if _, ok := c.portals[portalName]; ok && portalName != "" {
+		return c.sendExtendedError(codeDuplicateObject,
+			util.Errorf("portal %q already exists", portalName))
+	}
+	stmtName, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	stmt, ok := c.preparedStatements[stmtName]
+	if !ok {
+		return c.sendExtendedError(codeInvalidObject,
+			util.Errorf("unknown prepared statement %q", stmtName))
+	}
+
+	paramFormats, err := c.readFormatCodes()
+	if err != nil {
+		return err
+	}
+	numParams, err := c.readBuf.getInt16()
+	if err != nil {
+		return err
+	}
+	if int(numParams) != len(stmt.paramTypes) {
+		return c.sendExtendedError(codeProtocolViolation,
+			util.Errorf("expected %d parameters, but found %d", len(stmt.paramTypes), numParams))
+	}
+	// A single format code applies to all parameters.
+	if len(paramFormats) != 1 && len(paramFormats) != 0 && len(paramFormats) != int(numParams) {
+		return c.sendExtendedError(codeProtocolViolation,
+			util.Errorf("expected %d parameter format codes, but found %d", numParams, len(paramFormats)))
+	}
+	params := make([]driver.Datum, numParams)
+	for i := range params {
+		size, err := c.readBuf.getInt32()
+		if err != nil {
+			return err
+		}
+		if size == -1 {
+			// NULL.
+			continue
+		}
+		b, err := c.readBuf.getBytes(int(size))
+		if err != nil {
+			return err
+		}
+		format := formatText
+		if len(paramFormats) == 1 {
+			format = paramFormats[0]
+		} else if len(paramFormats) > 0 {
+			format = paramFormats[i]
+		}
+		if params[i], err = decodeParam(b, stmt.paramTypes[i], format); err != nil {
+			return c.sendExtendedError(codeProtocolViolation,
+				util.Errorf("parameter $%d: %s", i+1, err))
+		}
+	}
+
+	resultFormats, err := c.readFormatCodes()
+	if err != nil {
+		return err
+	}
+
+	c.portals[portalName] = &portal{
+		stmt:          stmt,
+		params:        params,
+		resultFormats: resultFormats,
+	}
+	return c.writeBuf.finishMsg(c.wr, serverMsgBindComplete)
+}
+
+// readFormatCodes reads a list of format codes.
+func (c *v3Conn) readFormatCodes() ([]formatCode, error) {
+	n, err := c.readBuf.getInt16()
+	if err != nil {
+		return nil, err
+	}
+	formats := make([]formatCode, n)
+	for i := range formats {
+		f, err := c.readBuf.getInt16()
+		if err != nil {
+			return nil, err
+		}
+		formats[i] = formatCode(f)
+	}
+	return formats, nil
+}
+
+func (c *v3Conn) handleExecute() error {
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	limit, err := c.readBuf.getInt32()
+	if err != nil {
+		return err
+	}
+	p, ok := c.portals[name]
+	if !ok {
+		return c.sendExtendedError(codeInvalidPortal, util.Errorf("unknown portal %q", name))
+	}
+
+	if p.result == nil {
+		stmts, err := parser.Parse(p.stmt.query)
+		if err != nil {
+			return c.sendExtendedError(codeSyntaxError, err)
+		}
+		if len(stmts) == 0 {
+			return c.writeBuf.finishMsg(c.wr, serverMsgEmptyQuery)
+		}
+		if limit <= 0 {
+			// All of the rows are retrieved, so they are sent as they are
+			// produced. Executing the portal again only reports its completion.
+			w := &resultWriter{
+				c:       c,
+				stmts:   stmts,
+				text:    p.stmt.described && p.stmt.columns != nil,
+				formats: p.resultFormats,
+			}
+			err := c.executeTo(p.stmt.query, p.params, w)
+			if w.err != nil {
+				return w.err
+			}
+			if err != nil {
+				return c.sendExtendedError(codeInternalError, err)
+			}
+			p.result = &driver.Result{}
+			p.tag = w.tag
+			return nil
+		}
+		// The rows which are not retrieved by this message are kept in the
+		// portal for the following Execute messages.
+		resp, err := c.execute(p.stmt.query, p.params)
+		if err != nil {
+			return c.sendExtendedError(codeInternalError, err)
+		}
+		p.result = &resp.Results[0]
+		p.tag = commandTag(stmts[0], len(p.result.Rows))
+		if p.stmt.described && p.stmt.columns != nil {
+			// The client was told that the columns are text.
+			p.types = make([]oid.Oid, len(p.result.Columns))
+			for i := range p.types {
+				p.types[i] = oid.T_text
+			}
+		} else {
+			p.types = resultTypes(*p.result)
+		}
+	}
+
+	rows := p.result.Rows
+	if limit > 0 && int(limit) < len(rows) {
+		rows = rows[:limit]
+	}
+	if err := c.sendRows(rows, p.types, p.resultFormats); err != nil {
+		return err
+	}
+	p.result.Rows = p.result.Rows[len(rows):]
+	if len(p.result.Rows) > 0 {
+		return c.writeBuf.finishMsg(c.wr, serverMsgPortalSuspended)
+	}
+	return c.sendCommandComplete(p.tag)
+}
+
+func (c *v3Conn) handleClose() error {
+	typ, err := c.readBuf.getByte()
+	if err != nil {
+		return err
+	}
+	name, err := c.readBuf.getString()
+	if err != nil {
+		return err
+	}
+	switch typ {
+	case 'S':
+		delete(c.preparedStatements, name)
+	case 'P':
+		delete(c.portals, name)
+	default:
+		return c.sendExtendedError(codeProtocolViolation, util.Errorf("invalid close type %q", typ))
+	}
+	return c.writeBuf.finishMsg(c.wr, serverMsgCloseComplete)
+}
+
+// resultFormat returns the format of the i-th result column. A single
+// format code applies to all columns.
+func resultFormat(formats []formatCode, i int) formatCode {
+	switch len(formats) {
+	case 0:
+		return formatText
+	case 1:
+		return formats[0]
+	default:
+		return formats[i]
+	}
+}
+
+func (c *v3Conn) sendRowDescription(columns []string, types []oid.Oid, formats []formatCode) error {
+	c.writeBuf.putInt16(int16(len(columns)))
+	for i, column := range columns {
+		c.writeBuf.putString(column)
+		c.writeBuf.putInt32(0) // Table OID (optional).
+		c.writeBuf.putInt16(0) // Column attribute ID (optional).
+		c.writeBuf.putInt32(int32(types[i]))
+		c.writeBuf.putInt16(typeLen(types[i]))
+		c.writeBuf.putInt32(-1) // Type modifier.
+		c.writeBuf.putInt16(int16(resultFormat(formats, i)))
+	}
+	return c.writeBuf.finishMsg(c.wr, serverMsgRowDescription)
+}
+
+func (c *v3Conn) sendRows(rows []driver.Result_Row, types []oid.Oid, formats []formatCode) error {
+	for _, row := range rows {
+		c.writeBuf.putInt16(int16(len(row.Values)))
+		for i, val := range row.Values {
+			c.writeBuf.putDatum(val, types[i], resultFormat(formats, i))
+		}
+		if err := c.writeBuf.finishMsg(c.wr, serverMsgDataRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxHeldRows is the number of rows of a result which are held back before
+// they are sent to the client.
+const maxHeldRows = 100
+
+// A resultWriter sends the results of the statements of a query to the client
+// as the executor produces them. The rows of a result are held back until
+// maxHeldRows rows have been produced, so that the types of its columns can
+// be taken from their first non-NULL values (see resultTypes) and so that a
+// small result can be discarded if the transaction of its statement is
+// retried.
+type resultWriter struct {
+	c     *v3Conn
+	stmts parser.StatementList
+	// describe is set if the rows of a result are preceded by a
+	// RowDescription, as for a simple query.
+	describe bool
+	// text is set if the client was told that all of the columns are text.
+	text    bool
+	formats []formatCode
+
+	columns []string
+	types   []oid.Oid // nil until the first rows are sent
+	held    []driver.Result_Row
+	rows    int    // the number of rows of the current result
+	tag     string // the tag of the last completed result
+	// err is set if sending to the client failed.
+	err error
+}
+
+var _ sql.ResultWriter = &resultWriter{}
+
+func (w *resultWriter) BeginResult(columns []string) error {
+	w.columns = columns
+	w.types = nil
+	w.held = nil
+	w.rows = 0
+	return nil
+}
+
+func (w *resultWriter) AddRow(row driver.Result_Row) error {
+	w.rows++
+	if w.types == nil {
+		w.held = append(w.held, row)
+		if len(w.held) < maxHeldRows {
+			return nil
+		}
+		return w.flush()
+	}
+	if w.err = w.c.sendRows([]driver.Result_Row{row}, w.types, w.formats); w.err != nil {
+		return w.err
+	}
+	return nil
+}
+
+func (w *resultWriter) ResetResult() bool {
+	if w.types != nil {
+		return false
+	}
+	w.held = nil
+	w.rows = 0
+	return true
+}
+
+func (w *resultWriter) EndResult() error {
+	if w.types == nil {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.tag = commandTag(w.stmts[0], w.rows)
+	w.stmts = w.stmts[1:]
+	if w.err = w.c.sendCommandComplete(w.tag); w.err != nil {
+		return w.err
+	}
+	return nil
+}
+
+// flush determines the types of the columns of the current result and sends
+// its description, if requested, and the rows held back.
+func (w *resultWriter) flush() error {
+	if w.text {
+		w.types = make([]oid.Oid, len(w.columns))
+		for i := range w.types {
+			w.types[i] = oid.T_text
+		}
+	} else {
+		w.types = resultTypes(driver.Result{Columns: w.columns, Rows: w.held})
+	}
+	if w.describe && len(w.columns) > 0 {
+		if w.err = w.c.sendRowDescription(w.columns, w.types, w.formats); w.err != nil {
+			return w.err
+		}
+	}
+	w.err = w.c.sendRows(w.held, w.types, w.formats)
+	w.held = nil
+	return w.err
+}
+
+func (c *v3Conn) sendCommandComplete(tag string) error {
+	c.writeBuf.putString(tag)
+	return c.writeBuf.finishMsg(c.wr, serverMsgCommandComplete)
+}
+
+// commandTag returns the tag reported to the client on completion of a
+// statement.
+// TODO(tamird/pmattis): report the number of rows affected by INSERT,
+// UPDATE and DELETE once the planner returns it.
+func commandTag(stmt parser.Statement, rows int) string {
+	switch stmt.(type) {
+	case *parser.AlterTable, *parser.RenameColumn, *parser.RenameTable:
+		return "ALTER TABLE"
+	case *parser.AlterUser:
+		return "ALTER ROLE"
+	case *parser.RenameDatabase:
+		return "ALTER DATABASE"
+	case *parser.BeginTransaction:
+		return "BEGIN"
+	case *parser.CommitTransaction:
+		return "COMMIT"
+	case *parser.CreateDatabase:
+		return "CREATE DATABASE"
+	case *parser.CreateIndex:
+		return "CREATE INDEX"
+	case *parser.CreateRole, *parser.CreateUser:
+		return "CREATE ROLE"
+	case *parser.CreateTable:
+		return "CREATE TABLE"
+	case *parser.Delete:
+		return "DELETE 0"
+	case *parser.DropDatabase:
+		return "DROP DATABASE"
+	case *parser.DropIndex:
+		return "DROP INDEX"
+	case *parser.DropRole, *parser.DropUser:
+		return "DROP ROLE"
+	case *parser.DropTable:
+		return "DROP TABLE"
+	case *parser.Grant:
+		return "GRANT"
+	case *parser.GrantRole:
+		return "GRANT ROLE"
+	case *parser.Insert:
+		return "INSERT 0 0"
+	case *parser.Revoke:
+		return "REVOKE"
+	case *parser.RevokeRole:
+		return "REVOKE ROLE"
+	case *parser.RollbackTransaction:
+		return "ROLLBACK"
+	case *parser.Set, *parser.SetTransaction:
+		return "SET"
+	case *parser.Truncate:
+		return "TRUNCATE TABLE"
+	case *parser.Update:
+		return "UPDATE 0"
+	default:
+		return fmt.Sprintf("SELECT %d", rows)
+	}
+}
+
+// placeholderVisitor records the highest placeholder index in a statement.
+type placeholderVisitor struct {
+	max int
+}
+
+var _ parser.Visitor = &placeholderVisitor{}
+
+func (v *placeholderVisitor) Visit(expr parser.Expr) parser.Expr {
+	if placeholder, ok := expr.(parser.ValArg); ok && int(placeholder) > v.max {
+		v.max = int(placeholder)
+	}
+	return expr
+}
@@ -32,6 +32,15 @@ type planner struct {
 	txn     *client.Txn
 	session Session
 	user    string
+	// roles are the roles granted to user, which are resolved before a
+	// statement is planned.
+	roles []string
+	// schemaChanges are the IDs of the tables whose mutations are carried out
+	// once the planner's transaction has committed.
+	schemaChanges []structured.ID
+	// descVersions records the versions of the table descriptors looked up by
+	// name, if it is not nil.
+	descVersions map[structured.ID]uint32
 }
 
 // makePlan creates the query plan for a single SQL statement. The returned
@@ -46,32 +55,62 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	}
 
 	switch n := stmt.(type) {
+	case *parser.AlterTable:
+		return p.AlterTable(n)
+	case *parser.AlterUser:
+		return p.AlterUser(n)
+	case *parser.BeginTransaction:
+		return p.BeginTransaction(n)
+	case *parser.CommitTransaction:
+		return p.CommitTransaction(n)
 	case *parser.CreateDatabase:
 		return p.CreateDatabase(n)
+	case *parser.CreateIndex:
+		return p.CreateIndex(n)
+	case *parser.CreateRole:
+		return p.CreateRole(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
+	case *parser.CreateUser:
+		return p.CreateUser(n)
 	case *parser.Delete:
 		return p.Delete(n)
 	case *parser.DropDatabase:
 		return p.DropDatabase(n)
+	case *parser.DropIndex:
+		return p.DropIndex(n)
+	case *parser.DropRole:
+		return p.DropRole(n)
 	case *parser.DropTable:
 		return p.DropTable(n)
+	case *parser.DropUser:
+		return p.DropUser(n)
 	case *parser.Explain:
 		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
+	case *parser.GrantRole:
+		return p.GrantRole(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
 		return p.makePlan(n.Select)
+	case *parser.RenameColumn:
+		return p.RenameColumn(n)
 	case *parser.RenameDatabase:
 		return p.RenameDatabase(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
+	case *parser.RevokeRole:
+		return p.RevokeRole(n)
+	case *parser.RollbackTransaction:
+		return p.RollbackTransaction(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
 		return p.Set(n)
+	case *parser.SetTransaction:
+		return p.SetTransaction(n)
 	case *parser.ShowColumns:
 		return p.ShowColumns(n)
 	case *parser.ShowDatabases:
@@ -82,8 +121,12 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowIndex(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.ShowUsers:
+		return p.ShowUsers(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
+	case *parser.Union:
+		return p.Union(n)
 	case *parser.Update:
 		return p.Update(n)
 	case parser.Values:
@@ -129,7 +172,11 @@ type planNode interface {
 	Err() error
 }
 
+var _ planNode = &groupNode{}
+var _ planNode = &indexJoinNode{}
+var _ planNode = &joinNode{}
+var _ planNode = &limitNode{}
 var _ planNode = &scanNode{}
+var _ planNode = &sortNode{}
+var _ planNode = &unionNode{}
 var _ planNode = &valuesNode{}
-
-// TODO(pmattis): orderByNode, groupByNode, joinNode.
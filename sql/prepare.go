@@ -0,0 +1,547 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/cache"
+	"github.com/cockroachdb/cockroach/util/uuid"
+)
+
+// maxPreparedStatements is the number of parsed prepared statements which
+// are cached by a server.
+const maxPreparedStatements = 1024
+
+// A preparedStatement is a parsed prepared statement cached by the server,
+// along with the information derived from it when it was prepared. The
+// SQL of the prepared statements is kept in the session, so that a server
+// which does not have a statement cached, or whose cached statement is
+// invalid, can parse it again.
+type preparedStatement struct {
+	// The SQL the statement was parsed from, which is compared against the
+	// SQL kept in the session before the cached statement is used.
+	sql string
+	// stmt is never executed itself: each execution plans a copy of it.
+	stmt parser.Statement
+	// The database of the session, which the table names of the statement
+	// are resolved against.
+	database string
+	// The number of the highest numbered placeholder of the statement and
+	// the types inferred for the placeholders.
+	numPlaceholders  int
+	placeholderTypes parser.PlaceholderTypes
+	columns          []string
+	// The versions of the descriptors of the tables used by the statement.
+	// The cached statement is invalidated if they change.
+	versions map[structured.ID]uint32
+}
+
+type preparedKey struct {
+	session string
+	id      uint32
+}
+
+func newPreparedCache() *cache.UnorderedCache {
+	return cache.NewUnorderedCache(cache.Config{
+		Policy: cache.CacheLRU,
+		ShouldEvict: func(size int, key, value interface{}) bool {
+			return size > maxPreparedStatements
+		},
+	})
+}
+
+func (s *Server) getPrepared(key preparedKey) *preparedStatement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.prepared.Get(key); ok {
+		return v.(*preparedStatement)
+	}
+	return nil
+}
+
+func (s *Server) addPrepared(key preparedKey, ps *preparedStatement) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prepared.Add(key, ps)
+}
+
+func (s *Server) delPrepared(key preparedKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prepared.Del(key)
+}
+
+// prepare parses the single statement of sql and adds it to the prepared
+// statements of the session.
+func (s *Server) prepare(sql string, planner *planner) (*driver.PreparedStatement, error) {
+	stmt, err := parseSingle(sql)
+	if err != nil {
+		return nil, err
+	}
+	ps, err := s.describe(sql, stmt, planner)
+	if err != nil {
+		return nil, err
+	}
+	if planner.session.ID == nil {
+		planner.session.ID = uuid.NewUUID4()
+	}
+	planner.session.NextStmtID++
+	id := planner.session.NextStmtID
+	planner.session.Prepared = append(planner.session.Prepared,
+		Session_PreparedStatement{ID: id, Sql: sql})
+	s.addPrepared(preparedKey{string(planner.session.ID), id}, ps)
+	return &driver.PreparedStatement{
+		ID:               id,
+		PlaceholderTypes: ps.placeholderTypeNames(),
+		Columns:          ps.columns,
+	}, nil
+}
+
+// placeholderTypeNames returns the names of the types of the placeholders,
+// in the order of their numbers. The name of an unknown type is empty.
+func (ps *preparedStatement) placeholderTypeNames() []string {
+	if ps.numPlaceholders == 0 {
+		return nil
+	}
+	names := make([]string, ps.numPlaceholders)
+	for n, typ := range ps.placeholderTypes {
+		if n >= 1 && n <= len(names) {
+			names[n-1] = typ.Type()
+		}
+	}
+	return names
+}
+
+// deallocate removes a prepared statement from the session.
+func (s *Server) deallocate(id uint32, planner *planner) error {
+	for i, p := range planner.session.Prepared {
+		if p.ID == id {
+			planner.session.Prepared = append(planner.session.Prepared[:i],
+				planner.session.Prepared[i+1:]...)
+			s.delPrepared(preparedKey{string(planner.session.ID), id})
+			return nil
+		}
+	}
+	return fmt.Errorf("prepared statement %d does not exist", id)
+}
+
+// execPrepared executes a prepared statement of the session. The statement
+// is parsed and described again before it is executed if it is not cached,
+// if the cached statement was parsed from different SQL or for another
+// database, or if the descriptors of the tables used by the statement have
+// changed since it was described. Only the parsed statement is cached: the
+// statement is planned again on each execution.
+func (s *Server) execPrepared(id uint32, params parameters, planner *planner, w ResultWriter) error {
+	var sql string
+	found := false
+	for _, p := range planner.session.Prepared {
+		if p.ID == id {
+			sql, found = p.Sql, true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("prepared statement %d does not exist", id)
+	}
+
+	key := preparedKey{string(planner.session.ID), id}
+	ps := s.getPrepared(key)
+	if ps != nil && (ps.sql != sql || ps.database != planner.session.Database) {
+		ps = nil
+	}
+	if ps != nil {
+		current, err := s.currentVersions(ps, planner)
+		if err != nil {
+			return err
+		}
+		if !current {
+			ps = nil
+		}
+	}
+	if ps == nil {
+		stmt, err := parseSingle(sql)
+		if err != nil {
+			return err
+		}
+		if ps, err = s.describe(sql, stmt, planner); err != nil {
+			return err
+		}
+		s.addPrepared(key, ps)
+	}
+
+	// The descriptors can still change between the check above and the
+	// planning of the statement, in which case the cached statement is
+	// invalidated for the next execution.
+	planner.descVersions = map[structured.ID]uint32{}
+	err := s.execStmt(parser.CloneStmt(ps.stmt), params, ps.placeholderTypes, planner, w)
+	if !sameVersions(planner.descVersions, ps.versions) {
+		s.delPrepared(key)
+	}
+	planner.descVersions = nil
+	return err
+}
+
+// currentVersions returns true if the descriptors of the tables used by the
+// prepared statement still exist with the versions recorded when it was
+// described.
+func (s *Server) currentVersions(ps *preparedStatement, planner *planner) (bool, error) {
+	if len(ps.versions) == 0 {
+		return true, nil
+	}
+	current := true
+	err := s.inSessionTxn(planner, func() error {
+		var ids []structured.ID
+		b := client.Batch{}
+		for id := range ps.versions {
+			ids = append(ids, id)
+			b.Get(structured.MakeDescMetadataKey(id))
+		}
+		if err := planner.txn.Run(&b); err != nil {
+			return err
+		}
+		for i, id := range ids {
+			kv := b.Results[i].Rows[0]
+			if !kv.Exists() {
+				current = false
+				return nil
+			}
+			desc := structured.TableDescriptor{}
+			if err := kv.ValueProto(&desc); err != nil {
+				return err
+			}
+			if desc.Version != ps.versions[id] {
+				current = false
+				return nil
+			}
+		}
+		return nil
+	})
+	return current, err
+}
+
+// describe infers the types of the placeholders of the statement and the
+// columns of the rows it returns, if any, without executing it.
+// Placeholders are bound to NULL while the statement is planned.
+func (s *Server) describe(sql string, stmt parser.Statement, planner *planner) (*preparedStatement, error) {
+	ps := &preparedStatement{
+		sql:      sql,
+		stmt:     stmt,
+		database: planner.session.Database,
+	}
+	v := placeholderCounter{}
+	parser.WalkStmt(&v, parser.CloneStmt(stmt))
+	ps.numPlaceholders = v.max
+	planner.descVersions = map[structured.ID]uint32{}
+	err := s.inSessionTxn(planner, func() error {
+		var err error
+		if ps.placeholderTypes, err = planner.inferPlaceholderTypes(parser.CloneStmt(stmt)); err != nil {
+			return err
+		}
+		if returnsRows(stmt) {
+			plan, err := planner.prepareRows(parser.CloneStmt(stmt))
+			if err != nil {
+				return err
+			}
+			ps.columns = plan.Columns()
+		}
+		return nil
+	})
+	ps.versions = planner.descVersions
+	planner.descVersions = nil
+	return ps, err
+}
+
+// inSessionTxn runs f, which must not write, with the planner's transaction
+// set to the transaction opened by BEGIN in the session, so that f sees the
+// writes of the transaction, or else to a transaction of its own.
+func (s *Server) inSessionTxn(planner *planner, f func() error) error {
+	if planner.session.Txn == nil {
+		err := s.db.Txn(func(txn *client.Txn) error {
+			planner.txn = txn
+			return f()
+		})
+		planner.txn = nil
+		return err
+	}
+	if planner.session.Txn.Status == proto.ABORTED {
+		return errTransactionAborted
+	}
+	txn := client.NewTxn(*s.db)
+	txn.Resume(*planner.session.Txn)
+	planner.txn = txn
+	err := f()
+	planner.txn = nil
+	state := txn.Proto()
+	planner.session.Txn = &state
+	return err
+}
+
+// prepareRows plans a statement which returns rows with its placeholders
+// bound to NULL.
+func (p *planner) prepareRows(stmt parser.Statement) (planNode, error) {
+	if err := parser.FillArgs(stmt, nullParameters{}); err != nil {
+		return nil, err
+	}
+	if err := p.resolveRoles(); err != nil {
+		return nil, err
+	}
+	return p.makePlan(stmt)
+}
+
+// returnsRows returns true if the statement returns rows, which makes it
+// safe to plan the statement without executing it.
+func returnsRows(stmt parser.Statement) bool {
+	switch stmt.(type) {
+	case *parser.Explain, *parser.ParenSelect, *parser.Select, *parser.ShowColumns,
+		*parser.ShowDatabases, *parser.ShowGrants, *parser.ShowIndex,
+		*parser.ShowTables, *parser.ShowUsers, *parser.Union, parser.Values:
+		return true
+	}
+	return false
+}
+
+func parseSingle(sql string) (parser.Statement, error) {
+	stmts, err := parser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, util.Errorf("expected 1 statement, but found %d", len(stmts))
+	}
+	return stmts[0], nil
+}
+
+func sameVersions(a, b map[structured.ID]uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, v := range a {
+		if w, ok := b[id]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// inferPlaceholderTypes infers the types of the placeholders of the
+// statement from the contexts in which they appear. The values inserted into
+// or assigned to a column are expected to be of the type of the column, as
+// are placeholders compared with the columns of the table of an INSERT,
+// UPDATE or DELETE or of a single table SELECT. LIMIT and OFFSET
+// placeholders are integers. The statement is modified.
+func (p *planner) inferPlaceholderTypes(stmt parser.Statement) (parser.PlaceholderTypes, error) {
+	types := parser.PlaceholderTypes{}
+	switch n := stmt.(type) {
+	case *parser.Insert:
+		desc, err := p.getTableDesc(n.Table)
+		if err != nil {
+			return nil, err
+		}
+		cols, err := p.processColumns(desc, n.Columns)
+		if err != nil {
+			return nil, err
+		}
+		values, ok := n.Rows.(parser.Values)
+		if !ok {
+			return types, p.inferSelectTypes(n.Rows, types)
+		}
+		for _, tuple := range values {
+			for i, expr := range tuple {
+				var desired parser.Datum
+				if i < len(cols) {
+					desired = columnDatumType(cols[i].Type)
+				}
+				if _, err := types.InferTypes(expr, desired, nil); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case *parser.Update:
+		desc, err := p.getAliasedTableDesc(n.Table)
+		if err != nil {
+			return nil, err
+		}
+		columns := columnTypes(desc)
+		for _, expr := range n.Exprs {
+			if _, err := types.InferTypes(expr.Expr, columns(expr.Name), columns); err != nil {
+				return nil, err
+			}
+		}
+		if err := inferWhereTypes(n.Where, types, columns); err != nil {
+			return nil, err
+		}
+	case *parser.Delete:
+		desc, err := p.getAliasedTableDesc(n.Table)
+		if err != nil {
+			return nil, err
+		}
+		if err := inferWhereTypes(n.Where, types, columnTypes(desc)); err != nil {
+			return nil, err
+		}
+	case parser.SelectStatement:
+		if err := p.inferSelectTypes(n, types); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+// inferSelectTypes infers the types of the placeholders of a query. The
+// types of the columns of the table of a single table SELECT are known.
+func (p *planner) inferSelectTypes(stmt parser.SelectStatement, types parser.PlaceholderTypes) error {
+	switch n := stmt.(type) {
+	case *parser.ParenSelect:
+		return p.inferSelectTypes(n.Select, types)
+	case *parser.Union:
+		if err := p.inferSelectTypes(n.Left, types); err != nil {
+			return err
+		}
+		if err := p.inferSelectTypes(n.Right, types); err != nil {
+			return err
+		}
+		return inferLimitTypes(n.Limit, types)
+	case parser.Values:
+		for _, tuple := range n {
+			if _, err := types.InferTypes(tuple, nil, nil); err != nil {
+				return err
+			}
+		}
+	case *parser.Select:
+		var columns parser.TypeResolver
+		if len(n.From) == 1 {
+			if ate, ok := n.From[0].(*parser.AliasedTableExpr); ok {
+				if _, ok := ate.Expr.(*parser.QualifiedName); ok {
+					desc, err := p.getAliasedTableDesc(ate)
+					if err != nil {
+						return err
+					}
+					columns = columnTypes(desc)
+				}
+			}
+		}
+		for _, expr := range n.Exprs {
+			if _, err := types.InferTypes(expr.Expr, nil, columns); err != nil {
+				return err
+			}
+		}
+		if err := inferWhereTypes(n.Where, types, columns); err != nil {
+			return err
+		}
+		for _, expr := range n.GroupBy {
+			if _, err := types.InferTypes(expr, nil, columns); err != nil {
+				return err
+			}
+		}
+		if err := inferWhereTypes(n.Having, types, columns); err != nil {
+			return err
+		}
+		for _, o := range n.OrderBy {
+			if _, err := types.InferTypes(o.Expr, nil, columns); err != nil {
+				return err
+			}
+		}
+		return inferLimitTypes(n.Limit, types)
+	}
+	return nil
+}
+
+func inferWhereTypes(where *parser.Where, types parser.PlaceholderTypes, columns parser.TypeResolver) error {
+	if where == nil {
+		return nil
+	}
+	_, err := types.InferTypes(where.Expr, parser.DBool(false), columns)
+	return err
+}
+
+func inferLimitTypes(limit *parser.Limit, types parser.PlaceholderTypes) error {
+	if limit == nil {
+		return nil
+	}
+	for _, expr := range []parser.Expr{limit.Count, limit.Offset} {
+		if expr == nil {
+			continue
+		}
+		if _, err := types.InferTypes(expr, parser.DInt(0), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnTypes returns a resolver of the types of the columns of a table.
+// Names qualified with a different table are not resolved.
+func columnTypes(desc *structured.TableDescriptor) parser.TypeResolver {
+	return func(qname *parser.QualifiedName) parser.Datum {
+		if err := qname.NormalizeColumnName(); err != nil || qname.IsStar() {
+			return nil
+		}
+		if table := qname.Table(); table != "" && !strings.EqualFold(table, desc.Alias) {
+			return nil
+		}
+		col, err := desc.FindColumnByName(qname.Column())
+		if err != nil {
+			return nil
+		}
+		return columnDatumType(col.Type)
+	}
+}
+
+// columnDatumType returns the type of the datums of the values of a column.
+func columnDatumType(typ structured.ColumnType) parser.Datum {
+	switch typ.Kind {
+	case structured.ColumnType_BIT, structured.ColumnType_INT:
+		return parser.DInt(0)
+	case structured.ColumnType_BOOL:
+		return parser.DBool(false)
+	case structured.ColumnType_FLOAT:
+		return parser.DFloat(0)
+	case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
+		structured.ColumnType_BLOB:
+		return parser.DString("")
+	case structured.ColumnType_DECIMAL:
+		return parser.DDecimal{}
+	case structured.ColumnType_DATE:
+		return parser.DDate(0)
+	case structured.ColumnType_TIMESTAMP:
+		return parser.DTimestamp{}
+	case structured.ColumnType_INTERVAL:
+		return parser.DInterval{}
+	}
+	return nil
+}
+
+// placeholderCounter determines the highest numbered placeholder of a
+// statement.
+type placeholderCounter struct {
+	max int
+}
+
+var _ parser.Visitor = &placeholderCounter{}
+
+func (v *placeholderCounter) Visit(expr parser.Expr) parser.Expr {
+	if arg, ok := expr.(parser.ValArg); ok && int(arg) > v.max {
+		v.max = int(arg)
+	}
+	return expr
+}
@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestPreparedCache verifies that a cached prepared statement is only used
+// if it was parsed from the SQL kept in the session and if the tables it
+// uses have not changed since it was described.
+func TestPreparedCache(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+	srv := NewServer(&base.Context{}, s.DB)
+
+	exec := func(session Session, req driver.Request) (Session, driver.Response) {
+		data, err := gogoproto.Marshal(&session)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.User = security.RootUser
+		req.Session = data
+		resp, err := srv.Execute(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var next Session
+		if err := gogoproto.Unmarshal(resp.Session, &next); err != nil {
+			t.Fatal(err)
+		}
+		return next, resp
+	}
+	value := func(resp driver.Response) int64 {
+		if len(resp.Results) != 1 || len(resp.Results[0].Rows) != 1 {
+			t.Fatalf("expected a single row; got %+v", resp.Results)
+		}
+		return resp.Results[0].Rows[0].Values[0].GetIntVal()
+	}
+
+	session, _ := exec(Session{}, driver.Request{Sql: "SELECT 1", Prepare: true})
+	if _, resp := exec(session, driver.Request{StmtID: 1}); value(resp) != 1 {
+		t.Errorf("expected 1; got %v", value(resp))
+	}
+
+	// A session whose statement has the ID of the cached statement but
+	// different SQL executes its own SQL.
+	other := session
+	other.Prepared = []Session_PreparedStatement{{ID: 1, Sql: "SELECT 2"}}
+	if _, resp := exec(other, driver.Request{StmtID: 1}); value(resp) != 2 {
+		t.Errorf("expected 2; got %v", value(resp))
+	}
+
+	// A statement whose table is recreated with another column type is
+	// described again before it is executed, inferring the new types of its
+	// placeholders.
+	for _, sql := range []string{
+		"CREATE DATABASE t",
+		"CREATE TABLE t.kv (k INT PRIMARY KEY, v TEXT)",
+	} {
+		session, _ = exec(session, driver.Request{Sql: sql})
+	}
+	session, _ = exec(session, driver.Request{Sql: "INSERT INTO t.kv VALUES (1, $1)", Prepare: true})
+	for _, sql := range []string{
+		"DROP TABLE t.kv",
+		"CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)",
+	} {
+		session, _ = exec(session, driver.Request{Sql: sql})
+	}
+	v := int64(3)
+	session, _ = exec(session, driver.Request{StmtID: 2, Params: []driver.Datum{{IntVal: &v}}})
+	if _, resp := exec(session, driver.Request{Sql: "SELECT v FROM t.kv"}); value(resp) != v {
+		t.Errorf("expected %d; got %v", v, value(resp))
+	}
+}
@@ -45,6 +45,13 @@ func (p *planner) RenameDatabase(n *parser.RenameDatabase) (planNode, error) {
 		return nil, fmt.Errorf("only %s is allowed to rename databases", security.RootUser)
 	}
 
+	if isVirtualDatabase(string(n.Name)) {
+		return nil, fmt.Errorf("cannot rename virtual database %q", string(n.Name))
+	}
+	if isVirtualDatabase(string(n.NewName)) {
+		return nil, fmt.Errorf("the new database name %s already exists", string(n.NewName))
+	}
+
 	dbDesc, err := p.getDatabaseDesc(string(n.Name))
 	if err != nil {
 		return nil, err
@@ -68,3 +75,65 @@ func (p *planner) RenameDatabase(n *parser.RenameDatabase) (planNode, error) {
 
 	return &valuesNode{}, nil
 }
+
+// RenameColumn renames the column.
+// Privileges: DROP on table.
+//   Notes: postgres requires ownership of the table.
+//          mysql requires ALTER, CREATE, INSERT on the table.
+func (p *planner) RenameColumn(n *parser.RenameColumn) (planNode, error) {
+	if n.NewName == "" {
+		return nil, fmt.Errorf("empty column name")
+	}
+
+	tableDesc, err := p.getTableDescIfExists(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	if tableDesc == nil {
+		if n.IfExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", n.Table.Table())
+	}
+
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeDrop); err != nil {
+		return nil, err
+	}
+
+	column, err := tableDesc.FindColumnByName(string(n.Name))
+	if err != nil {
+		return nil, err
+	}
+	if n.Name == n.NewName {
+		// Noop.
+		return &valuesNode{}, nil
+	}
+
+	// The column names of the indexes parallel their column IDs.
+	renameIndexColumn := func(index *structured.IndexDescriptor) {
+		for i, id := range index.ColumnIDs {
+			if id == column.ID {
+				index.ColumnNames[i] = string(n.NewName)
+			}
+		}
+	}
+	renameIndexColumn(&tableDesc.PrimaryIndex)
+	for i := range tableDesc.Indexes {
+		renameIndexColumn(&tableDesc.Indexes[i])
+	}
+	if err := renameCheckColumn(tableDesc, column.Name, string(n.NewName)); err != nil {
+		return nil, err
+	}
+	column.Name = string(n.NewName)
+
+	// Validate rejects a new name which is already in use by another column.
+	if err := tableDesc.Validate(); err != nil {
+		return nil, err
+	}
+	tableDesc.Version++
+	if err := p.txn.Put(structured.MakeDescMetadataKey(tableDesc.GetID()), tableDesc); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
@@ -20,7 +20,6 @@ package sql_test
 import (
 	"testing"
 
-	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -37,66 +36,35 @@ func TestRevoke(t *testing.T) {
 	// The first `MaxReservedDescID` (plus 0) are set aside.
 	descKey := structured.MakeDescMetadataKey(structured.MaxReservedDescID + 1)
 	desc := structured.DatabaseDescriptor{}
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 1 || desc.Read[0] != security.RootUser {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
-	}
-	if len(desc.Write) != 1 || desc.Write[0] != security.RootUser {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
-	}
-
-	// Add some permissions.
-	if _, err := sqlDB.Exec(`GRANT ALL ON DATABASE TEST TO rw`); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := sqlDB.Exec(`GRANT READ ON DATABASE TEST TO reader`); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := sqlDB.Exec(`GRANT WRITE ON DATABASE TEST TO writer`); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 3 || len(desc.Write) != 3 {
-		t.Fatalf("wrong read/write length: %d, %d", len(desc.Read), len(desc.Write))
-	}
-
-	// Test some revokes.
-	if _, err := sqlDB.Exec(`REVOKE WRITE ON DATABASE TEST FROM writer,reader`); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 3 {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
-	}
-	if len(desc.Write) != 2 || desc.Write[0] != security.RootUser || desc.Write[1] != "rw" {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
-	}
-
-	// Remove ALL Permissions.
-	if _, err := sqlDB.Exec(`REVOKE ALL ON DATABASE TEST FROM rw`); err != nil {
-		t.Fatal(err)
-	}
-
-	if err := kvDB.GetProto(descKey, &desc); err != nil {
-		t.Fatal(err)
-	}
-	if len(desc.Read) != 2 || desc.Read[0] != "reader" || desc.Read[1] != security.RootUser {
-		t.Fatalf("wrong Read list: %+v", desc.Read)
-	}
-	if len(desc.Write) != 1 || desc.Write[0] != security.RootUser {
-		t.Fatalf("wrong Write list: %+v", desc.Write)
+	testData := []struct {
+		stmt     string
+		expected string
+	}{
+		{`GRANT ALL ON DATABASE TEST TO rw`, `root:ALL rw:ALL`},
+		{`GRANT SELECT ON DATABASE TEST TO reader`, `reader:SELECT root:ALL rw:ALL`},
+		{`GRANT INSERT, DELETE ON DATABASE TEST TO writer`, `reader:SELECT root:ALL rw:ALL writer:INSERT,DELETE`},
+		// Revoking a privilege the user does not have is a noop.
+		{`REVOKE DELETE ON DATABASE TEST FROM writer,reader`, `reader:SELECT root:ALL rw:ALL writer:INSERT`},
+		// Revoking a single privilege from ALL keeps the others.
+		{`REVOKE GRANT ON DATABASE TEST FROM rw`, `reader:SELECT root:ALL rw:CREATE,DROP,SELECT,INSERT,DELETE,UPDATE writer:INSERT`},
+		// Users without privileges are removed.
+		{`REVOKE ALL ON DATABASE TEST FROM rw, writer`, `reader:SELECT root:ALL`},
+	}
+	for _, d := range testData {
+		if _, err := sqlDB.Exec(d.stmt); err != nil {
+			t.Fatalf("%s: %v", d.stmt, err)
+		}
+		desc.Reset()
+		if err := kvDB.GetProto(descKey, &desc); err != nil {
+			t.Fatal(err)
+		}
+		if s := privilegesString(t, desc.PrivilegeDescriptor); s != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.stmt, d.expected, s)
+		}
 	}
 
 	// Removing permissions for "root" fails.
-	if _, err := sqlDB.Exec(`REVOKE READ ON DATABASE TEST FROM root`); err == nil {
+	if _, err := sqlDB.Exec(`REVOKE SELECT ON DATABASE TEST FROM root`); err == nil {
 		t.Fatal("unexpected success")
 	}
 }
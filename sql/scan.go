@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
@@ -34,6 +35,12 @@ import (
 type qvalMap map[structured.ColumnID]*parser.ParenExpr
 type colKindMap map[structured.ColumnID]structured.ColumnType_Kind
 
+// A span is a range of keys [start, end) to scan.
+type span struct {
+	start proto.Key
+	end   proto.Key
+}
+
 // A scanNode handles scanning over the key/value pairs for a table and
 // reconstructing them into rows.
 type scanNode struct {
@@ -42,19 +49,26 @@ type scanNode struct {
 	index            *structured.IndexDescriptor
 	visibleCols      []structured.ColumnDescriptor
 	isSecondaryIndex bool
+	indexHint        bool              // the index to scan was specified by the query
+	spans            []span            // the key spans to scan; the entire index if empty
+	isVirtual        bool              // the table is generated on the fly
+	virtualKVs       []client.KeyValue // the key/value pairs of a virtual table
 	columns          []string
 	err              error
 	indexKey         []byte              // the index key of the current row
-	kvs              []client.KeyValue   // the raw key/value pairs
-	kvIndex          int                 // current index into the key/value pairs
+	fetcher          *kvFetcher          // retrieves the raw key/value pairs
+	kv               client.KeyValue     // the next key/value pair to process
+	kvEnd            bool                // all of the key/value pairs have been processed
 	rowIndex         int                 // the index of the current row
 	colID            structured.ColumnID // column ID of the current key
 	vals             []parser.Datum      // the index key values for the current row
+	primaryKeyVals   []parser.Datum      // the primary key values for unique secondary indexes
 	qvals            qvalMap             // the values in the current row
 	colKind          colKindMap          // map of column kinds for decoding column values
 	row              parser.DTuple       // the rendered row
 	filter           parser.Expr         // filtering expression for rows
 	render           []parser.Expr       // rendering expressions for rows
+	maxRows          int64               // maximum number of rows to retrieve; 0 for no limit
 	explain          explainMode
 	explainValue     parser.Datum
 }
@@ -72,8 +86,8 @@ func (n *scanNode) Next() bool {
 		return false
 	}
 
-	if n.kvs == nil {
-		if !n.init() {
+	if n.fetcher == nil {
+		if !n.init() || !n.nextKV() {
 			return false
 		}
 	}
@@ -88,28 +102,37 @@ func (n *scanNode) Next() bool {
 		if n.maybeOutputRow() {
 			return n.err == nil
 		}
-		if n.kvIndex == len(n.kvs) {
+		if n.kvEnd {
 			return false
 		}
-		if !n.processKV(n.kvs[n.kvIndex]) {
+		if !n.processKV(n.kv) || !n.nextKV() {
 			return false
 		}
-		n.kvIndex++
 	}
 }
 
+// nextKV retrieves the next key/value pair to process, setting kvEnd once all
+// of the key/value pairs have been processed.
+func (n *scanNode) nextKV() bool {
+	var ok bool
+	ok, n.kv, n.err = n.fetcher.nextKV()
+	n.kvEnd = !ok
+	return n.err == nil
+}
+
 func (n *scanNode) Err() error {
 	return n.err
 }
 
 func (n *scanNode) init() bool {
-	return n.initScan() && n.initExprs()
+	return n.initScan()
 }
 
 // initExprs initializes the render and filter expressions for the
 // scan. Initialization consists of replacing QualifiedName nodes with
 // ParenExpr nodes for which the wrapped expression can be changed for each
-// row.
+// row. The expressions are initialized when the query is planned so that
+// other nodes (e.g. the sortNode) can refer to them.
 func (n *scanNode) initExprs() bool {
 	n.qvals = make(qvalMap)
 	for i := range n.render {
@@ -122,27 +145,114 @@ func (n *scanNode) initExprs() bool {
 	return n.err == nil
 }
 
-// initScan initializes (and performs) the key-value scan.
-//
-// TODO(pmattis): The key-value scan currently reads all of the key-value
-// pairs, but they could just as easily be read in chunks. Probably worthwhile
-// to separate out the retrieval of the key-value pairs into a separate
-// structure.
+// addRender adds a render target for the specified expression, returning the
+// 1-based index of the target within the rendered row. If the expression is a
+// column that is already being rendered the existing target is reused. The
+// additional target is not visible in the result columns of the query.
+func (n *scanNode) addRender(expr parser.Expr) (int, error) {
+	expr, err := n.extractQVals(expr)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := expr.(*parser.ParenExpr); ok {
+		for i, e := range n.render {
+			if e == expr {
+				return i + 1, nil
+			}
+		}
+	}
+	n.columns = append(n.columns, expr.String())
+	n.render = append(n.render, expr)
+	return len(n.render), nil
+}
+
+func (n *scanNode) expandStar(qname *parser.QualifiedName) ([]string, []parser.Expr, error) {
+	if n.desc == nil {
+		return nil, nil, fmt.Errorf("\"%s\" with no tables specified is not valid", qname)
+	}
+	tableName := qname.Table()
+	if tableName != "" && !strings.EqualFold(n.desc.Alias, tableName) {
+		return nil, nil, fmt.Errorf("table \"%s\" not found", tableName)
+	}
+
+	var columns []string
+	var exprs []parser.Expr
+	for _, col := range n.visibleCols {
+		columns = append(columns, col.Name)
+		exprs = append(exprs, &parser.QualifiedName{Base: parser.Name(col.Name)})
+	}
+	return columns, exprs, nil
+}
+
+func (n *scanNode) getRender() ([]string, []parser.Expr) {
+	return n.columns, n.render
+}
+
+func (n *scanNode) setRender(columns []string, render []parser.Expr) {
+	n.columns = columns
+	n.render = render
+}
+
+func (n *scanNode) setFilter(filter parser.Expr) {
+	n.filter = filter
+}
+
+// ordering returns the ordering of the rows produced by the scan in terms of
+// the render targets. The rows are ordered by the columns of the index being
+// scanned, so the ordering is the longest prefix of the index columns which
+// are rendered as-is. Nullable columns end the prefix as NULL values are not
+// encoded in the index in the same order that they compare. This holds for
+// the primary index too: its columns may hold NULL unless declared NOT NULL.
+func (n *scanNode) ordering() []int {
+	if n.desc == nil {
+		return nil
+	}
+	var ordering []int
+	for _, id := range n.index.ColumnIDs {
+		col, err := n.desc.FindColumnByID(id)
+		if err != nil || col.Nullable {
+			break
+		}
+		qval := n.qvals[id]
+		index := 0
+		for i, e := range n.render {
+			if qval != nil && e == parser.Expr(qval) {
+				index = i + 1
+				break
+			}
+		}
+		if index == 0 {
+			break
+		}
+		ordering = append(ordering, index)
+	}
+	return ordering
+}
+
+// initScan initializes the key-value scan. The key-value pairs are retrieved
+// in batches as the rows are iterated over. If maxRows is specified, the first
+// batch only retrieves the key-value pairs needed for the first maxRows rows.
 func (n *scanNode) initScan() bool {
 	// Initialize our key/values.
 	if n.desc == nil {
 		// No table to read from, pretend there is a single empty row.
-		n.kvs = []client.KeyValue{}
+		n.fetcher = makeKVFetcher(n.txn, nil, 0)
 		n.indexKey = []byte{}
 		return true
 	}
 
-	// Retrieve all of the keys that start with our index key prefix.
-	startKey := proto.Key(structured.MakeIndexKeyPrefix(n.desc.ID, n.index.ID))
-	endKey := startKey.PrefixEnd()
-	n.kvs, n.err = n.txn.Scan(startKey, endKey, 0)
-	if n.err != nil {
-		return false
+	spans := n.spans
+	if len(spans) == 0 {
+		// Retrieve all of the keys that start with our index key prefix.
+		startKey := proto.Key(structured.MakeIndexKeyPrefix(n.desc.ID, n.index.ID))
+		spans = []span{{start: startKey, end: startKey.PrefixEnd()}}
+	}
+	// Empty spans indicate that there are no matching rows and are ignored by
+	// the fetcher.
+	if n.isVirtual {
+		n.fetcher = makeVirtualKVFetcher(n.virtualKVs, spans)
+	} else {
+		n.fetcher = makeKVFetcher(n.txn, spans, n.maxRows*n.kvsPerRow())
 	}
 
 	// Prepare our index key vals slice.
@@ -150,6 +260,12 @@ func (n *scanNode) initScan() bool {
 	if n.err != nil {
 		return false
 	}
+	if n.isSecondaryIndex && n.index.Unique {
+		n.primaryKeyVals, n.err = makeIndexKeyVals(n.desc, n.desc.PrimaryIndex)
+		if n.err != nil {
+			return false
+		}
+	}
 
 	// Prepare a map from column ID to column kind used for unmarshalling values.
 	n.colKind = make(colKindMap, len(n.desc.Columns))
@@ -159,6 +275,53 @@ func (n *scanNode) initScan() bool {
 	return true
 }
 
+// setSpans restricts the scan to the specified key spans and restarts the
+// iteration over the rows. If spans is empty the entire index is scanned.
+func (n *scanNode) setSpans(spans []span) {
+	n.spans = spans
+	n.fetcher = nil
+	n.indexKey = nil
+}
+
+// decodePrimaryKey initializes the values of the primary key columns from a
+// secondary index key/value pair. The primary key follows the index columns in
+// the key for non-unique indexes (which have already been decoded in n.vals)
+// and is stored in the value for unique indexes, unless the index columns
+// contain NULL in which case it follows the index columns in the key.
+func (n *scanNode) decodePrimaryKey(kv client.KeyValue, remaining []byte) bool {
+	pkVals := n.vals[len(n.index.ColumnIDs):]
+	if n.index.Unique {
+		pkVals = n.primaryKeyVals
+		suffix := remaining
+		if len(suffix) == 0 {
+			suffix = kv.ValueBytes()
+		}
+		if _, n.err = decodeKeyVals(pkVals, suffix); n.err != nil {
+			return false
+		}
+		if n.err = rescaleKeyDecimals(n.desc, n.desc.PrimaryIndex, pkVals); n.err != nil {
+			return false
+		}
+	}
+	for i, id := range n.desc.PrimaryIndex.ColumnIDs {
+		if qval := n.qvals[id]; qval != nil && qval.Expr == nil {
+			qval.Expr = pkVals[i]
+		}
+	}
+	return true
+}
+
+// kvsPerRow returns the maximum number of key-value pairs used to encode a
+// single row of the index being scanned.
+func (n *scanNode) kvsPerRow() int64 {
+	if n.isSecondaryIndex {
+		return 1
+	}
+	// The primary index contains a key for the row and a key for each non-nil
+	// column that is not part of the primary key.
+	return int64(1 + len(n.desc.Columns) - len(n.index.ColumnIDs))
+}
+
 func (n *scanNode) processKV(kv client.KeyValue) bool {
 	if n.indexKey == nil {
 		// Reset the qvals map expressions to nil. The expresssions will get filled
@@ -184,6 +347,14 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 				qval.Expr = n.vals[i]
 			}
 		}
+
+		if n.isSecondaryIndex {
+			// Each key of a secondary index is a separate row.
+			n.indexKey = []byte(kv.Key)
+			if !n.decodePrimaryKey(kv, remaining) {
+				return false
+			}
+		}
 	}
 
 	var value parser.Datum
@@ -237,7 +408,7 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 // was output or an error occurred. In either case, iteration should terminate.
 func (n *scanNode) maybeOutputRow() bool {
 	if n.indexKey != nil &&
-		(n.kvIndex == len(n.kvs) || !bytes.HasPrefix(n.kvs[n.kvIndex].Key, n.indexKey)) {
+		(n.kvEnd || !bytes.HasPrefix(n.kv.Key, n.indexKey)) {
 		// The current key belongs to a new row. Output the current row.
 		n.indexKey = nil
 		output := n.filterRow()
@@ -265,11 +436,10 @@ func (n *scanNode) filterRow() bool {
 	if n.desc != nil {
 		for _, col := range n.visibleCols {
 			if !col.Nullable {
-				break
+				continue
 			}
 			if v, ok := n.qvals[col.ID]; ok && v.Expr == nil {
 				v.Expr = parser.DNull
-				continue
 			}
 		}
 	}
@@ -278,18 +448,9 @@ func (n *scanNode) filterRow() bool {
 		return true
 	}
 
-	var d parser.Datum
-	d, n.err = parser.EvalExpr(n.filter)
-	if n.err != nil {
-		return false
-	}
-
-	v, ok := d.(parser.DBool)
-	if !ok {
-		n.err = fmt.Errorf("WHERE clause did not evaluate to a boolean")
-		return false
-	}
-	return bool(v)
+	var passes bool
+	passes, n.err = evalBool(n.filter, "WHERE")
+	return passes
 }
 
 // renderRow renders the row by evaluating the render expressions. May set
@@ -348,6 +509,8 @@ func (n *scanNode) prettyKey() string {
 			fmt.Fprintf(&buf, "/%v", t)
 		case parser.DString:
 			fmt.Fprintf(&buf, "/%v", t)
+		case parser.DDecimal, parser.DDate, parser.DTimestamp, parser.DInterval:
+			fmt.Fprintf(&buf, "/%s", t)
 		}
 	}
 	if n.colID > 0 {
@@ -364,20 +527,42 @@ func (n *scanNode) unmarshalValue(kv client.KeyValue) (parser.Datum, bool) {
 		n.err = fmt.Errorf("column-id \"%d\" does not exist", n.colID)
 		return nil, false
 	}
+	var d parser.Datum
+	if d, n.err = unmarshalColumnValue(kind, kv); n.err != nil {
+		return nil, false
+	}
+	return d, true
+}
+
+// unmarshalColumnValue decodes the value of a column of the specified kind.
+// A missing value is NULL.
+func unmarshalColumnValue(kind structured.ColumnType_Kind, kv client.KeyValue) (parser.Datum, error) {
 	if kv.Exists() {
 		switch kind {
 		case structured.ColumnType_BIT, structured.ColumnType_INT:
-			return parser.DInt(kv.ValueInt()), true
+			return parser.DInt(kv.ValueInt()), nil
 		case structured.ColumnType_BOOL:
-			return parser.DBool(kv.ValueInt() != 0), true
+			return parser.DBool(kv.ValueInt() != 0), nil
 		case structured.ColumnType_FLOAT:
-			return parser.DFloat(math.Float64frombits(uint64(kv.ValueInt()))), true
+			return parser.DFloat(math.Float64frombits(uint64(kv.ValueInt()))), nil
 		case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
 			structured.ColumnType_BLOB:
-			return parser.DString(kv.ValueBytes()), true
+			return parser.DString(kv.ValueBytes()), nil
+		case structured.ColumnType_DECIMAL:
+			return parser.ParseDDecimal(string(kv.ValueBytes()))
+		case structured.ColumnType_DATE:
+			return parser.DDate(kv.ValueInt()), nil
+		case structured.ColumnType_TIMESTAMP:
+			var t time.Time
+			if err := t.UnmarshalBinary(kv.ValueBytes()); err != nil {
+				return nil, err
+			}
+			return parser.DTimestamp{Time: t.UTC()}, nil
+		case structured.ColumnType_INTERVAL:
+			return parser.DInterval{Duration: time.Duration(kv.ValueInt())}, nil
 		}
 	}
-	return parser.DNull, true
+	return parser.DNull, nil
 }
 
 type qnameVisitor struct {
@@ -400,6 +585,11 @@ func (v *qnameVisitor) Visit(expr parser.Expr) parser.Expr {
 	if v.err != nil {
 		return expr
 	}
+	if qname.IsStar() {
+		// A "*" can only appear as the argument to a function (e.g. COUNT(*)) at
+		// this point. It is left as-is and rejected when the function is evaluated.
+		return expr
+	}
 
 	desc := v.getDesc(qname)
 	if desc != nil {
@@ -443,3 +633,39 @@ func (n *scanNode) extractQVals(expr parser.Expr) (parser.Expr, error) {
 	expr = parser.WalkExpr(&v, expr)
 	return expr, v.err
 }
+
+type qnameNormalizer struct {
+	*scanNode
+	err error
+}
+
+var _ parser.Visitor = &qnameNormalizer{}
+
+func (v *qnameNormalizer) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	qname, ok := expr.(*parser.QualifiedName)
+	if !ok {
+		return expr
+	}
+	if v.err = qname.NormalizeColumnName(); v.err != nil {
+		return expr
+	}
+	if qname.Base == "" && v.desc != nil && !qname.IsStar() {
+		qname.Base = parser.Name(v.desc.Alias)
+	}
+	return expr
+}
+
+// normalizeQNames normalizes the column names in expr in the same way as
+// extractQVals, but without replacing them. References to the same column
+// (e.g. "a" and "t.a") have the same string representation afterwards.
+func (n *scanNode) normalizeQNames(expr parser.Expr) (parser.Expr, error) {
+	if expr == nil {
+		return expr, nil
+	}
+	v := qnameNormalizer{scanNode: n}
+	expr = parser.WalkExpr(&v, expr)
+	return expr, v.err
+}
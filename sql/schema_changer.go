@@ -0,0 +1,596 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// schemaChangeChunkSize is the maximum number of key/value pairs of a table
+// processed by each of the transactions of a schema change. It is a variable
+// so that tests can exercise schema changes spanning multiple chunks.
+var schemaChangeChunkSize int64 = 1000
+
+// schemaChangeScanInterval is the interval at which each server looks for
+// tables with pending mutations.
+const schemaChangeScanInterval = time.Minute
+
+// A schemaChanger carries out the mutations recorded in the descriptor of a
+// table. The rows and index entries of the table are rewritten by a series of
+// transactions, each of which processes a bounded chunk of an index, so that a
+// schema change does not block concurrent reads and writes of the table for
+// its whole duration. Concurrent writes are safe because every statement
+// reads the table descriptor within its own transaction: a transaction which
+// read the descriptor before the mutation was recorded either commits before
+// a chunk containing its writes is processed or is forced to restart and
+// read the descriptor again.
+type schemaChanger struct {
+	db      *client.DB
+	tableID structured.ID
+}
+
+// runSchemaChanges carries out the schema changes requested by the statement
+// executed by the planner, once the statement's transaction has committed.
+// The mutations are recorded in the table descriptors, so should the server
+// fail before they are done, they are resumed by the schema change loop of
+// any server (see runSchemaChangeLoop).
+func (s *Server) runSchemaChanges(p *planner) error {
+	tableIDs := p.schemaChanges
+	p.schemaChanges = nil
+	for _, id := range tableIDs {
+		if _, err := s.runSchemaChanger(id, true /* wait */); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runSchemaChanger runs a schema changer for the table. Only one schema
+// changer runs for a table on each server at a time. If one is already
+// running, runSchemaChanger waits for it to finish and then runs another, so
+// that the mutations recorded in the meantime are carried out, unless wait is
+// false, in which case it returns false right away.
+func (s *Server) runSchemaChanger(id structured.ID, wait bool) (bool, error) {
+	s.mu.Lock()
+	for {
+		done, ok := s.schemaChangers[id]
+		if !ok {
+			break
+		}
+		s.mu.Unlock()
+		if !wait {
+			return false, nil
+		}
+		<-done
+		s.mu.Lock()
+	}
+	done := make(chan struct{})
+	s.schemaChangers[id] = done
+	s.mu.Unlock()
+
+	sc := schemaChanger{db: s.db, tableID: id}
+	err := sc.run()
+
+	s.mu.Lock()
+	delete(s.schemaChangers, id)
+	s.mu.Unlock()
+	close(done)
+	return true, err
+}
+
+// runSchemaChangeLoop carries out the pending mutations of the tables when
+// the server starts and every schemaChangeScanInterval afterwards, which
+// resumes the schema changes left pending by a server which failed before
+// they were done.
+func (s *Server) runSchemaChangeLoop(stopper *stop.Stopper) {
+	ticker := time.NewTicker(schemaChangeScanInterval)
+	defer ticker.Stop()
+	for {
+		stopper.RunTask(func() {
+			if err := s.runPendingSchemaChanges(); err != nil {
+				log.Warningf("failed to run pending schema changes: %s", err)
+			}
+		})
+		select {
+		case <-ticker.C:
+		case <-stopper.ShouldStop():
+			return
+		}
+	}
+}
+
+// runPendingSchemaChanges runs a schema changer for each table with pending
+// mutations for which no schema changer is running on this server.
+func (s *Server) runPendingSchemaChanges() error {
+	var tableIDs []structured.ID
+	if err := s.db.Txn(func(txn *client.Txn) error {
+		tableIDs = nil
+		// The namespace entries whose parent is not the root namespace are
+		// those of tables.
+		kvs, err := txn.Scan(keys.NameMetadataPrefix, keys.NameMetadataPrefix.PrefixEnd(), 0)
+		if err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			_, parentID := encoding.DecodeUvarint(kv.Key[len(keys.NameMetadataPrefix):])
+			if structured.ID(parentID) == structured.RootNamespaceID {
+				continue
+			}
+			desc := &structured.TableDescriptor{}
+			if err := txn.GetProto(kv.ValueBytes(), desc); err != nil {
+				return err
+			}
+			if len(desc.Mutations) > 0 {
+				tableIDs = append(tableIDs, desc.ID)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, id := range tableIDs {
+		if _, err := s.runSchemaChanger(id, false /* wait */); err != nil {
+			log.Warningf("schema change of table %d failed: %s", id, err)
+		}
+	}
+	return nil
+}
+
+// run carries out the mutations of the table one at a time, in order, until
+// none remain. Running the same schema changer again after an error resumes
+// the mutation which was in progress. An index which cannot be added because
+// its backfill violates the uniqueness of the index is dropped again and the
+// violation is returned once the remaining mutations are done.
+func (sc schemaChanger) run() error {
+	var uniqueErr error
+	for {
+		var mutation *structured.DescriptorMutation
+		var primaryIndexID structured.IndexID
+		if err := sc.db.Txn(func(txn *client.Txn) error {
+			mutation = nil
+			desc, err := getTableDescByID(txn, sc.tableID)
+			if err != nil || desc == nil {
+				return err
+			}
+			if len(desc.Mutations) > 0 {
+				mutation = &desc.Mutations[0]
+				primaryIndexID = desc.PrimaryIndex.ID
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if mutation == nil {
+			// The mutations are done or the table has been dropped.
+			return uniqueErr
+		}
+
+		var err error
+		switch {
+		case mutation.Column != nil:
+			err = sc.rewriteRows(*mutation, primaryIndexID)
+		case mutation.Direction == structured.DescriptorMutation_ADD:
+			err = sc.backfillIndex(*mutation, primaryIndexID)
+			if tErr, ok := err.(*proto.ConditionFailedError); ok {
+				if err := sc.reverseMutation(*mutation); err != nil {
+					return err
+				}
+				uniqueErr = fmt.Errorf("duplicate key value %q violates unique constraint %s",
+					tErr.ActualValue.Bytes, mutation.Index.Name)
+				continue
+			}
+		default:
+			err = sc.truncateIndex(*mutation)
+		}
+		if err != nil {
+			return err
+		}
+		if err := sc.completeMutation(*mutation); err != nil {
+			return err
+		}
+	}
+}
+
+// runChunks scans the specified index of the table in chunks, calling fn with
+// the key/value pairs of each chunk within the transaction which scanned
+// them. The scan stops early if the table is dropped or the mutation is
+// completed by another schema changer.
+func (sc schemaChanger) runChunks(mutation structured.DescriptorMutation, indexID structured.IndexID,
+	fn func(txn *client.Txn, desc *structured.TableDescriptor, kvs []client.KeyValue) error) error {
+	var start, end proto.Key
+	for {
+		// The chunk is only considered processed once the transaction has
+		// committed, as the transaction might be retried.
+		var next proto.Key
+		if err := sc.db.Txn(func(txn *client.Txn) error {
+			next = nil
+			desc, err := getTableDescByID(txn, sc.tableID)
+			if err != nil {
+				return err
+			}
+			if desc == nil || findMutation(desc, mutation) == -1 {
+				return nil
+			}
+			if end == nil {
+				start = proto.Key(structured.MakeIndexKeyPrefix(desc.ID, indexID))
+				end = start.PrefixEnd()
+			}
+
+			kvs, err := txn.Scan(start, end, schemaChangeChunkSize)
+			if err != nil {
+				return err
+			}
+			if err := fn(txn, desc, kvs); err != nil {
+				return err
+			}
+			if int64(len(kvs)) == schemaChangeChunkSize {
+				next = proto.Key(kvs[len(kvs)-1].Key).Next()
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+// rewriteRows backfills the default value of the column of an ADD mutation or
+// deletes the values of the column of a DROP mutation.
+func (sc schemaChanger) rewriteRows(mutation structured.DescriptorMutation,
+	primaryIndexID structured.IndexID) error {
+	col := *mutation.Column
+	var defaultExpr parser.Expr
+	if mutation.Direction == structured.DescriptorMutation_ADD {
+		if col.DefaultExpr == nil {
+			// There is nothing to backfill.
+			return nil
+		}
+		var err error
+		if defaultExpr, err = parser.ParseExpr(*col.DefaultExpr); err != nil {
+			return err
+		}
+	}
+
+	return sc.runChunks(mutation, primaryIndexID,
+		func(txn *client.Txn, desc *structured.TableDescriptor, kvs []client.KeyValue) error {
+			b, err := sc.rewriteChunk(txn, desc, col, defaultExpr, kvs)
+			if err != nil {
+				return err
+			}
+			return txn.Run(b)
+		})
+}
+
+// rewriteChunk returns a batch which rewrites the rows of a chunk of the
+// primary index of the table. For an ADD mutation (defaultExpr != nil) the
+// column is written for each row which doesn't contain it yet. For a DROP
+// mutation the column is deleted from each row containing it.
+func (sc schemaChanger) rewriteChunk(txn *client.Txn, desc *structured.TableDescriptor,
+	col structured.ColumnDescriptor, defaultExpr parser.Expr, kvs []client.KeyValue) (*client.Batch, error) {
+	vals, err := makeIndexKeyVals(desc, desc.PrimaryIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	b := txn.NewBatch()
+	if defaultExpr == nil {
+		for _, kv := range kvs {
+			remaining, err := decodeIndexKey(desc, desc.PrimaryIndex, vals, kv.Key)
+			if err != nil {
+				return nil, err
+			}
+			if len(remaining) == 0 {
+				// The row sentinel.
+				continue
+			}
+			if _, colID := encoding.DecodeUvarint(remaining); structured.ColumnID(colID) == col.ID {
+				if log.V(2) {
+					log.Infof("Del %q", kv.Key)
+				}
+				b.Del(kv.Key)
+			}
+		}
+		return b, nil
+	}
+
+	// Retrieve the column of each row in the chunk. The column keys can't be
+	// looked up within the chunk as the last row might continue in the next
+	// chunk.
+	var colKeys []proto.Key
+	getBatch := txn.NewBatch()
+	for _, kv := range kvs {
+		remaining, err := decodeIndexKey(desc, desc.PrimaryIndex, vals, kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if len(remaining) == 0 {
+			colKey := proto.Key(structured.MakeColumnKey(col.ID, kv.Key))
+			colKeys = append(colKeys, colKey)
+			getBatch.Get(colKey)
+		}
+	}
+	if len(colKeys) == 0 {
+		return b, nil
+	}
+	if err := txn.Run(getBatch); err != nil {
+		return nil, err
+	}
+	for i, result := range getBatch.Results {
+		if result.Rows[0].Exists() {
+			continue
+		}
+		val, err := parser.EvalExpr(defaultExpr)
+		if err != nil {
+			return nil, err
+		}
+		primitive, err := convertDatum(col, val)
+		if err != nil {
+			return nil, err
+		}
+		if primitive == nil {
+			continue
+		}
+		if log.V(2) {
+			log.Infof("Put %q -> %v", colKeys[i], primitive)
+		}
+		b.Put(colKeys[i], primitive)
+	}
+	return b, nil
+}
+
+// completeMutation removes the mutation from the table descriptor, making the
+// column or index of an ADD mutation visible.
+func (sc schemaChanger) completeMutation(mutation structured.DescriptorMutation) error {
+	return sc.db.Txn(func(txn *client.Txn) error {
+		desc, err := getTableDescByID(txn, sc.tableID)
+		if err != nil || desc == nil {
+			return err
+		}
+		i := findMutation(desc, mutation)
+		if i == -1 {
+			return nil
+		}
+		if m := desc.Mutations[i]; m.Direction == structured.DescriptorMutation_ADD {
+			if m.Column != nil {
+				desc.Columns = append(desc.Columns, *m.Column)
+			} else {
+				desc.Indexes = append(desc.Indexes, *m.Index)
+			}
+		}
+		desc.Mutations = append(desc.Mutations[:i], desc.Mutations[i+1:]...)
+		if err := desc.Validate(); err != nil {
+			return err
+		}
+		desc.Version++
+		return txn.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+	})
+}
+
+// backfillIndex writes the entries of the index of an ADD mutation for the
+// existing rows of the table. A row which violates the uniqueness of the
+// index results in a ConditionFailedError, as it does for inserts.
+func (sc schemaChanger) backfillIndex(mutation structured.DescriptorMutation,
+	primaryIndexID structured.IndexID) error {
+	return sc.runChunks(mutation, primaryIndexID,
+		func(txn *client.Txn, desc *structured.TableDescriptor, kvs []client.KeyValue) error {
+			return sc.backfillIndexChunk(txn, desc, *mutation.Index, kvs)
+		})
+}
+
+// backfillIndexChunk writes the index entries of the rows of a chunk of the
+// primary index of the table. Entries which were already written by
+// concurrent inserts and updates are left untouched.
+func (sc schemaChanger) backfillIndexChunk(txn *client.Txn, desc *structured.TableDescriptor,
+	index structured.IndexDescriptor, kvs []client.KeyValue) error {
+	vals, err := makeIndexKeyVals(desc, desc.PrimaryIndex)
+	if err != nil {
+		return err
+	}
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID)
+
+	// The values of the index columns which are not part of the primary key
+	// are retrieved separately for each row. As for ADD column mutations, they
+	// can't be looked up within the chunk as the last row might continue in
+	// the next chunk.
+	colIDtoRowIndex := map[structured.ColumnID]int{}
+	for i, id := range desc.PrimaryIndex.ColumnIDs {
+		colIDtoRowIndex[id] = i
+	}
+	var cols []structured.ColumnDescriptor
+	for _, id := range index.ColumnIDs {
+		if _, ok := colIDtoRowIndex[id]; ok {
+			continue
+		}
+		col, err := desc.FindColumnByID(id)
+		if err != nil {
+			return err
+		}
+		colIDtoRowIndex[id] = len(vals) + len(cols)
+		cols = append(cols, *col)
+	}
+
+	var rowKeys []proto.Key
+	var rowVals [][]parser.Datum
+	getBatch := txn.NewBatch()
+	for _, kv := range kvs {
+		remaining, err := decodeIndexKey(desc, desc.PrimaryIndex, vals, kv.Key)
+		if err != nil {
+			return err
+		}
+		if len(remaining) != 0 {
+			// Not a row sentinel.
+			continue
+		}
+		rowKeys = append(rowKeys, kv.Key)
+		rowVals = append(rowVals, append([]parser.Datum(nil), vals...))
+		for _, col := range cols {
+			getBatch.Get(structured.MakeColumnKey(col.ID, kv.Key))
+		}
+	}
+	if len(rowKeys) == 0 {
+		return nil
+	}
+	if len(cols) > 0 {
+		if err := txn.Run(getBatch); err != nil {
+			return err
+		}
+	}
+
+	var entries []indexEntry
+	for i, rowKey := range rowKeys {
+		for j, col := range cols {
+			kv := getBatch.Results[i*len(cols)+j].Rows[0]
+			val, err := unmarshalColumnValue(col.Type.Kind, kv)
+			if err != nil {
+				return err
+			}
+			rowVals[i] = append(rowVals[i], val)
+		}
+		primaryIndexKeySuffix := rowKey[len(primaryIndexKeyPrefix):]
+		rowEntries, err := encodeSecondaryIndexes(desc.ID, []structured.IndexDescriptor{index},
+			colIDtoRowIndex, rowVals[i], primaryIndexKeySuffix)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, rowEntries...)
+	}
+
+	// Entries without a value contain the primary key and can't conflict with
+	// the entries of other rows. The remaining entries are only written if they
+	// don't exist yet so that an entry which was already written for the same
+	// row isn't mistaken for a uniqueness violation.
+	var uniqueEntries []indexEntry
+	entryBatch := txn.NewBatch()
+	for _, entry := range entries {
+		if entry.value != nil {
+			uniqueEntries = append(uniqueEntries, entry)
+			entryBatch.Get(entry.key)
+		}
+	}
+	if len(uniqueEntries) > 0 {
+		if err := txn.Run(entryBatch); err != nil {
+			return err
+		}
+	}
+
+	b := txn.NewBatch()
+	for _, entry := range entries {
+		if entry.value == nil {
+			if log.V(2) {
+				log.Infof("Put %q -> NULL", entry.key)
+			}
+			b.Put(entry.key, nil)
+		}
+	}
+	for i, entry := range uniqueEntries {
+		if kv := entryBatch.Results[i].Rows[0]; kv.Exists() && bytes.Equal(kv.ValueBytes(), entry.value) {
+			continue
+		}
+		if log.V(2) {
+			log.Infof("CPut %q -> %v", entry.key, entry.value)
+		}
+		b.CPut(entry.key, entry.value, nil)
+	}
+	return txn.Run(b)
+}
+
+// truncateIndex deletes the entries of the index of a DROP mutation.
+func (sc schemaChanger) truncateIndex(mutation structured.DescriptorMutation) error {
+	return sc.runChunks(mutation, mutation.Index.ID,
+		func(txn *client.Txn, desc *structured.TableDescriptor, kvs []client.KeyValue) error {
+			if len(kvs) == 0 {
+				return nil
+			}
+			start := proto.Key(kvs[0].Key)
+			end := proto.Key(kvs[len(kvs)-1].Key).Next()
+			if log.V(2) {
+				log.Infof("DelRange %q - %q", start, end)
+			}
+			b := txn.NewBatch()
+			b.DelRange(start, end)
+			return txn.Run(b)
+		})
+}
+
+// reverseMutation turns the ADD mutation of an index which cannot be added
+// into a DROP mutation, so that the entries which have already been
+// backfilled are deleted.
+func (sc schemaChanger) reverseMutation(mutation structured.DescriptorMutation) error {
+	return sc.db.Txn(func(txn *client.Txn) error {
+		desc, err := getTableDescByID(txn, sc.tableID)
+		if err != nil || desc == nil {
+			return err
+		}
+		i := findMutation(desc, mutation)
+		if i == -1 {
+			return nil
+		}
+		desc.Mutations[i].Direction = structured.DescriptorMutation_DROP
+		desc.Version++
+		return txn.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+	})
+}
+
+// findMutation returns the index of the mutation of the table descriptor
+// affecting the same column or index in the same direction, or -1 if there is
+// none.
+func findMutation(desc *structured.TableDescriptor, mutation structured.DescriptorMutation) int {
+	for i, m := range desc.Mutations {
+		if m.Direction != mutation.Direction {
+			continue
+		}
+		if m.Column != nil && mutation.Column != nil && m.Column.ID == mutation.Column.ID {
+			return i
+		}
+		if m.Index != nil && mutation.Index != nil && m.Index.ID == mutation.Index.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+// getTableDescByID retrieves the descriptor of the table with the specified
+// ID. A nil descriptor is returned if the table does not exist.
+func getTableDescByID(txn *client.Txn, id structured.ID) (*structured.TableDescriptor, error) {
+	desc := &structured.TableDescriptor{}
+	if err := txn.GetProto(structured.MakeDescMetadataKey(id), desc); err != nil {
+		return nil, err
+	}
+	if desc.ID == 0 {
+		return nil, nil
+	}
+	if err := desc.ConvertLegacyPrivileges(); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
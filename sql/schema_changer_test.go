@@ -0,0 +1,94 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestPendingSchemaChanges verifies that the mutations left pending in a
+// table descriptor, as they are when the server executing the statement
+// fails before carrying them out, are picked up and carried out by the
+// schema change loop.
+func TestPendingSchemaChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+	srv := NewServer(&base.Context{}, s.DB)
+
+	exec := func(sql string) driver.Response {
+		req := driver.Request{Sql: sql}
+		req.User = security.RootUser
+		resp, err := srv.Execute(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'b'), ('c', 'd');
+`)
+
+	// Record the addition of a column without carrying it out.
+	gr, err := s.DB.Get(structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := s.DB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	defaultExpr := "'x'"
+	desc.Mutations = append(desc.Mutations, structured.DescriptorMutation{
+		Column: &structured.ColumnDescriptor{
+			Name:        "w",
+			ID:          desc.NextColumnID,
+			Type:        structured.ColumnType{Kind: structured.ColumnType_TEXT},
+			Nullable:    true,
+			DefaultExpr: &defaultExpr,
+		},
+		Direction: structured.DescriptorMutation_ADD,
+	})
+	desc.NextColumnID++
+	if err := s.DB.Put(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srv.runPendingSchemaChanges(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if len(desc.Mutations) != 0 {
+		t.Fatalf("expected no mutations, but found %v", desc.Mutations)
+	}
+	resp := exec(`SELECT w FROM t.kv`)
+	if rows := resp.Results[0].Rows; len(rows) != 2 || *rows[0].Values[0].StringVal != "x" || *rows[1].Values[0].StringVal != "x" {
+		t.Errorf("expected the default value to be backfilled; got %v", rows)
+	}
+}
@@ -22,74 +22,36 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
-	"github.com/cockroachdb/cockroach/structured"
-	"github.com/cockroachdb/cockroach/util"
 )
 
-// Select selects rows from a single table.
-// Privileges: READ on table
+// Select selects rows from a single table or a join of several tables. The
+// rows are optionally grouped according to the GROUP BY clause, sorted
+// according to the ORDER BY clause and restricted by the LIMIT and OFFSET
+// clauses.
+// Privileges: SELECT on table
 //   Notes: postgres requires SELECT. Also requires UPDATE on "FOR UPDATE".
 //          mysql requires SELECT.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
-	var desc *structured.TableDescriptor
-	var index *structured.IndexDescriptor
-	var visibleCols []structured.ColumnDescriptor
-
+	var s selectNode
 	switch len(n.From) {
 	case 0:
-		// desc remains nil.
+		s = &scanNode{txn: p.txn}
 
 	case 1:
-		var err error
-		desc, err = p.getAliasedTableDesc(n.From[0])
-		if err != nil {
-			return nil, err
-		}
-
-		if !desc.HasPrivilege(p.user, parser.PrivilegeRead) {
-			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-				p.user, parser.PrivilegeRead, desc.Name)
-		}
-
-		// This is only kosher because we know that getAliasedDesc() succeeded.
-		qname := n.From[0].(*parser.AliasedTableExpr).Expr.(*parser.QualifiedName)
-		indexName := qname.Index()
-		if indexName != "" && !strings.EqualFold(desc.PrimaryIndex.Name, indexName) {
-			for i := range desc.Indexes {
-				if strings.EqualFold(desc.Indexes[i].Name, indexName) {
-					// Remove all but the matching index from the descriptor.
-					desc.Indexes = desc.Indexes[i : i+1]
-					index = &desc.Indexes[0]
-					break
-				}
-			}
-			if index == nil {
-				return nil, fmt.Errorf("index \"%s\" not found", indexName)
-			}
-			// If the table was not aliased, use the index name instead of the table
-			// name for fully-qualified columns in the expression.
-			if n.From[0].(*parser.AliasedTableExpr).As == "" {
-				desc.Alias = index.Name
-			}
-			// Strip out any columns from the table that are not present in the
-			// index.
-			indexColIDs := map[structured.ColumnID]struct{}{}
-			for _, colID := range index.ColumnIDs {
-				indexColIDs[colID] = struct{}{}
-			}
-			for _, col := range desc.Columns {
-				if _, ok := indexColIDs[col.ID]; !ok {
-					continue
-				}
-				visibleCols = append(visibleCols, col)
+		if ate, ok := n.From[0].(*parser.AliasedTableExpr); ok {
+			var err error
+			if s, err = p.tableScan(ate); err != nil {
+				return nil, err
 			}
-		} else {
-			index = &desc.PrimaryIndex
-			visibleCols = desc.Columns
+			break
 		}
+		fallthrough
 
 	default:
-		return nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", n.From)
+		var err error
+		if s, err = p.join(n.From); err != nil {
+			return nil, err
+		}
 	}
 
 	// Loop over the select expressions and expand them into the expressions
@@ -106,28 +68,15 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 				return nil, err
 			}
 			if qname.IsStar() {
-				if desc == nil {
-					return nil, fmt.Errorf("\"%s\" with no tables specified is not valid", qname)
-				}
 				if e.As != "" {
 					return nil, fmt.Errorf("\"%s\" cannot be aliased", qname)
 				}
-				tableName := qname.Table()
-				if tableName != "" && !strings.EqualFold(desc.Alias, tableName) {
-					return nil, fmt.Errorf("table \"%s\" not found", tableName)
-				}
-
-				if index != &desc.PrimaryIndex {
-					for _, col := range index.ColumnNames {
-						columns = append(columns, col)
-						exprs = append(exprs, &parser.QualifiedName{Base: parser.Name(col)})
-					}
-				} else {
-					for _, col := range desc.Columns {
-						columns = append(columns, col.Name)
-						exprs = append(exprs, &parser.QualifiedName{Base: parser.Name(col.Name)})
-					}
+				starColumns, starExprs, err := s.expandStar(qname)
+				if err != nil {
+					return nil, err
 				}
+				columns = append(columns, starColumns...)
+				exprs = append(exprs, starExprs...)
 				continue
 			}
 		}
@@ -151,19 +100,121 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 		}
 	}
 
-	s := &scanNode{
-		txn:         p.txn,
-		desc:        desc,
-		index:       index,
-		visibleCols: visibleCols,
-		columns:     columns,
-		render:      exprs,
+	s.setRender(columns, exprs)
+	if n.Where != nil {
+		s.setFilter(n.Where.Expr)
 	}
-	if index != nil {
-		s.isSecondaryIndex = index != &desc.PrimaryIndex
+
+	group, err := p.groupBy(n, s)
+	if err != nil {
+		return nil, err
 	}
-	if n.Where != nil {
-		s.filter = n.Where.Expr
+	if !s.initExprs() {
+		return nil, s.Err()
+	}
+
+	var plan renderPlan = s
+	if group != nil {
+		plan = group
+	}
+	sort, err := p.orderBy(n.OrderBy, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if scan, ok := s.(*scanNode); ok {
+		// The ordering only refers to the render targets of the scan if the rows
+		// are not grouped.
+		var ordering []int
+		if sort != nil && group == nil {
+			ordering = sort.ordering
+		}
+		if err := p.selectIndex(scan, ordering); err != nil {
+			return nil, err
+		}
+		if scan.isSecondaryIndex && !scan.covers(scan.index) {
+			join, err := makeIndexJoin(scan)
+			if err != nil {
+				return nil, err
+			}
+			if group != nil {
+				group.plan = join
+			} else {
+				plan = join
+			}
+		}
+	}
+	return p.limit(n.Limit, sort.wrap(plan))
+}
+
+// A selectNode is a planNode which filters and renders the rows of the tables
+// in the FROM clause of a SELECT: a scanNode for a single table or a joinNode
+// for multiple tables.
+type selectNode interface {
+	renderPlan
+	// expandStar expands a "*" or "<table>.*" render target into the column
+	// names and expressions it refers to.
+	expandStar(qname *parser.QualifiedName) ([]string, []parser.Expr, error)
+	// getRender returns the render targets.
+	getRender() ([]string, []parser.Expr)
+	// setRender sets the render targets.
+	setRender(columns []string, render []parser.Expr)
+	// setFilter sets the filtering expression.
+	setFilter(filter parser.Expr)
+	// initExprs initializes the render and filter expressions, resolving the
+	// qualified names they contain. May set the error returned by Err().
+	initExprs() bool
+	// normalizeQNames normalizes the qualified names in expr so that
+	// references to the same column have the same string representation.
+	normalizeQNames(expr parser.Expr) (parser.Expr, error)
+}
+
+// tableScan constructs a scanNode for the table referred to by n. If n refers
+// to a secondary index of the table (i.e. "table@index") the index is scanned,
+// joining back to the primary index for columns not present in the index.
+func (p *planner) tableScan(n *parser.AliasedTableExpr) (*scanNode, error) {
+	desc, err := p.getAliasedTableDesc(n)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &scanNode{txn: p.txn, desc: desc, visibleCols: desc.Columns}
+
+	if vt := findVirtualTable(desc.ID); vt != nil {
+		// Virtual tables can be read by all users, but only contain the rows
+		// describing the objects the user holds privileges on.
+		s.isVirtual = true
+		if s.virtualKVs, err = vt.getKVs(p); err != nil {
+			return nil, err
+		}
+	} else if err := p.checkPrivilege(desc, parser.PrivilegeSelect); err != nil {
+		return nil, err
+	}
+
+	// This is only kosher because we know that getAliasedDesc() succeeded.
+	qname := n.Expr.(*parser.QualifiedName)
+	indexName := qname.Index()
+	s.indexHint = indexName != ""
+	if indexName != "" && !strings.EqualFold(desc.PrimaryIndex.Name, indexName) {
+		for i := range desc.Indexes {
+			if strings.EqualFold(desc.Indexes[i].Name, indexName) {
+				// Remove all but the matching index from the descriptor.
+				desc.Indexes = desc.Indexes[i : i+1]
+				s.index = &desc.Indexes[0]
+				break
+			}
+		}
+		if s.index == nil {
+			return nil, fmt.Errorf("index \"%s\" not found", indexName)
+		}
+		// If the table was not aliased, use the index name instead of the table
+		// name for fully-qualified columns in the expression.
+		if n.As == "" {
+			desc.Alias = s.index.Name
+		}
+		s.isSecondaryIndex = true
+	} else {
+		s.index = &desc.PrimaryIndex
 	}
 	return s, nil
 }
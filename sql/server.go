@@ -24,6 +24,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/client"
@@ -31,7 +33,11 @@ import (
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/cache"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
 
 	gogoproto "github.com/gogo/protobuf/proto"
 )
@@ -41,6 +47,7 @@ var (
 	errNoDatabase        = errors.New("no database specified")
 	errNoTable           = errors.New("no table specified")
 	errEmptyDatabaseName = errors.New("empty database name")
+	errResultSent        = errors.New("cannot retry the transaction of a statement whose rows have been sent")
 )
 
 // A Server provides an HTTP server endpoint serving the SQL API.
@@ -48,11 +55,31 @@ var (
 type Server struct {
 	context *base.Context
 	db      *client.DB
+
+	mu sync.Mutex
+	// prepared caches the parsed prepared statements of the sessions.
+	prepared *cache.UnorderedCache
+	// schemaChangers holds the tables for which a schema changer is running,
+	// mapped to a channel which is closed once it is done.
+	schemaChangers map[structured.ID]chan struct{}
 }
 
 // NewServer allocates and returns a new Server.
 func NewServer(ctx *base.Context, db *client.DB) *Server {
-	return &Server{context: ctx, db: db}
+	return &Server{
+		context:        ctx,
+		db:             db,
+		prepared:       newPreparedCache(),
+		schemaChangers: map[structured.ID]chan struct{}{},
+	}
+}
+
+// Start starts carrying out the pending schema changes of the tables until
+// the stopper stops. It is called once the node is able to serve requests.
+func (s *Server) Start(stopper *stop.Stopper) {
+	stopper.RunWorker(func() {
+		s.runSchemaChangeLoop(stopper)
+	})
 }
 
 // ServeHTTP serves the SQL API by treating the request URL path
@@ -105,7 +132,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Send the Request for SQL execution and set the application-level error
 	// on the reply.
-	reply, err := s.exec(args)
+	reply, err := s.Execute(args)
 	if err != nil {
 		errProto := proto.Error{}
 		errProto.SetResponseGoError(err)
@@ -122,103 +149,347 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
-type parameters []driver.Datum
+type parameters []parser.Datum
+
+// makeParameters converts the parameters of a request into datums.
+func makeParameters(params []driver.Datum) (parameters, error) {
+	args := make(parameters, len(params))
+	for i, p := range params {
+		switch t := p.GetValue().(type) {
+		case nil:
+			args[i] = parser.DNull
+		case *bool:
+			args[i] = parser.DBool(*t)
+		case *int64:
+			args[i] = parser.DInt(*t)
+		case *float64:
+			args[i] = parser.DFloat(*t)
+		case []byte:
+			args[i] = parser.DString(t)
+		case *string:
+			args[i] = parser.DString(*t)
+		case *driver.Datum_Timestamp:
+			args[i] = parser.DTimestamp{Time: t.GoTime()}
+		case *int32:
+			args[i] = parser.DDate(*t)
+		case *time.Duration:
+			args[i] = parser.DInterval{Duration: *t}
+		case *driver.Decimal:
+			d, err := parser.ParseDDecimal(string(*t))
+			if err != nil {
+				return nil, fmt.Errorf("invalid decimal value %q for placeholder $%d", *t, i+1)
+			}
+			args[i] = d
+		default:
+			return nil, fmt.Errorf("unsupported type %T for placeholder $%d", t, i+1)
+		}
+	}
+	return args, nil
+}
+
+// nullParameters binds every placeholder to NULL.
+type nullParameters struct{}
+
+// Arg implements the Args interface
+func (nullParameters) Arg(i int) (parser.Datum, bool) {
+	return parser.DNull, i >= 1
+}
 
 // Arg implements the Args interface
 func (p parameters) Arg(i int) (parser.Datum, bool) {
 	if i < 1 || i > len(p) {
 		return nil, false
 	}
-	arg := p[i-1].GetValue()
-	if arg == nil {
-		return parser.DNull, true
-	}
-	switch t := arg.(type) {
-	case *bool:
-		return parser.DBool(*t), true
-	case *int64:
-		return parser.DInt(*t), true
-	case *float64:
-		return parser.DFloat(*t), true
-	case []byte:
-		return parser.DString(t), true
-	case *string:
-		return parser.DString(*t), true
-	default:
-		panic(fmt.Sprintf("unexpected type %T", t))
-	}
+	return p[i-1], true
+}
+
+// Execute executes the request, collecting the results of its statements in
+// the response. Any error encountered is returned; it is the caller's
+// responsibility to update the response. The session state is returned even
+// if an error is encountered as a transaction opened by BEGIN may have been
+// aborted. The request user must have been authenticated by the caller.
+//
+// Instead of executing the statements of the request, the request can
+// prepare a statement, execute a prepared statement or deallocate a
+// prepared statement. Prepared statements are kept in the session.
+func (s *Server) Execute(req driver.Request) (driver.Response, error) {
+	w := resultBuffer{}
+	resp, err := s.ExecuteTo(req, &w)
+	resp.Results = w.results
+	return resp, err
+}
+
+// A ResultWriter receives the results of the statements executed by
+// ExecuteTo as they are produced, so that the rows of a result do not need to
+// be held in memory.
+type ResultWriter interface {
+	// BeginResult starts the result of the next statement. The columns are
+	// empty if the statement does not return rows.
+	BeginResult(columns []string) error
+	// AddRow adds a row to the current result.
+	AddRow(row driver.Result_Row) error
+	// ResetResult discards the current result as the statement is executed
+	// again when its transaction is retried. It returns false if the result
+	// can no longer be discarded, for instance because some of its rows have
+	// been sent to the client.
+	ResetResult() bool
+	// EndResult ends the current result once its statement has succeeded.
+	EndResult() error
+}
+
+// resultBuffer is a ResultWriter collecting the results in memory.
+type resultBuffer struct {
+	results []driver.Result
+	current driver.Result
+}
+
+func (b *resultBuffer) BeginResult(columns []string) error {
+	b.current = driver.Result{Columns: columns}
+	return nil
+}
+
+func (b *resultBuffer) AddRow(row driver.Result_Row) error {
+	b.current.Rows = append(b.current.Rows, row)
+	return nil
+}
+
+func (b *resultBuffer) ResetResult() bool {
+	b.current = driver.Result{}
+	return true
+}
+
+func (b *resultBuffer) EndResult() error {
+	b.results = append(b.results, b.current)
+	b.current = driver.Result{}
+	return nil
 }
 
-// exec executes the request. Any error encountered is returned; it is
-// the caller's responsibility to update the response.
-func (s *Server) exec(req driver.Request) (driver.Response, error) {
+// ExecuteTo is like Execute, but passes the results of the statements of the
+// request to w as they are produced instead of adding them to the response.
+func (s *Server) ExecuteTo(req driver.Request, w ResultWriter) (driver.Response, error) {
 	var resp driver.Response
 
 	// Pick up current session state.
-	// The request user is validated in ServeHTTP. Even in insecure mode,
-	// it is guaranteed not to be empty.
-	planner := planner{user: req.GetUser()}
+	planner, err := makePlanner(req)
+	if err != nil {
+		return resp, err
+	}
+	params, err := makeParameters(req.Params)
+	if err != nil {
+		return resp, err
+	}
+	switch {
+	case req.Prepare:
+		resp.Prepared, err = s.prepare(req.Sql, planner)
+	case req.Deallocate:
+		err = s.deallocate(req.StmtID, planner)
+	case req.StmtID != 0:
+		err = s.execPrepared(req.StmtID, params, planner, w)
+	default:
+		var stmts parser.StatementList
+		if stmts, err = parser.Parse(req.Sql); err == nil {
+			for _, stmt := range stmts {
+				if err = s.execStmt(stmt, params, nil, planner, w); err != nil {
+					break
+				}
+			}
+		}
+	}
+
+	// Update session state.
+	var sessionErr error
+	resp.Session, sessionErr = gogoproto.Marshal(&planner.session)
+	if err == nil {
+		err = sessionErr
+	}
+	return resp, err
+}
+
+// Describe returns the names of the types inferred for the placeholders of
+// the single statement of the request, in the order of their numbers, and
+// the names of the columns of the rows it returns, without executing it. The
+// name of a type which could not be inferred is empty. Placeholders are
+// bound to NULL while the statement is planned. The returned columns are nil
+// if the statement does not return rows.
+func (s *Server) Describe(req driver.Request) ([]string, []string, error) {
+	planner, err := makePlanner(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	stmt, err := parseSingle(req.Sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	ps, err := s.describe(req.Sql, stmt, planner)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ps.placeholderTypeNames(), ps.columns, nil
+}
+
+// makePlanner returns a planner for the user and session state of the
+// request. The request user is validated by the transport (ServeHTTP or the
+// pgwire server). Even in insecure mode, it is guaranteed not to be empty.
+func makePlanner(req driver.Request) (*planner, error) {
+	planner := &planner{user: req.GetUser()}
 	if req.Session != nil {
 		// TODO(tschottdorf) will have to validate the Session information (for
 		// instance, whether access to the stored database is permitted).
 		if err := gogoproto.Unmarshal(req.Session, &planner.session); err != nil {
-			return resp, err
+			return nil, err
 		}
 	}
-	stmts, err := parser.Parse(req.Sql)
-	if err != nil {
-		return resp, err
-	}
-	for _, stmt := range stmts {
-		// Bind all the placeholder variables in the stmt to actual values.
-		if err := parser.FillArgs(stmt, parameters(req.Params)); err != nil {
-			return resp, err
-		}
-		var plan planNode
-		if err := s.db.Txn(func(txn *client.Txn) error {
-			planner.txn = txn
-			plan, err = planner.makePlan(stmt)
+	return planner, nil
+}
+
+// execStmt executes a single statement, passing its result to w. Statements
+// outside of a transaction opened by BEGIN are executed within their own
+// transaction, which is retried automatically as long as w can discard the
+// result of the failed attempt. Statements within a transaction opened by
+// BEGIN are executed within that transaction, whose state is saved in the
+// session. If such a statement fails the transaction is aborted and all
+// further statements are rejected until the transaction is ended by COMMIT or
+// ROLLBACK. BEGIN within a transaction fails without aborting it.
+//
+// The arguments of the statement are checked against the types of its
+// placeholders, which are inferred from the statement if types is nil.
+func (s *Server) execStmt(stmt parser.Statement, params parameters,
+	types parser.PlaceholderTypes, planner *planner, w ResultWriter) error {
+	if planner.session.Txn == nil {
+		if _, ok := stmt.(*parser.BeginTransaction); !ok {
+			retry := false
+			err := s.db.Txn(func(txn *client.Txn) error {
+				if retry && !w.ResetResult() {
+					return errResultSent
+				}
+				retry = true
+				planner.txn = txn
+				planner.schemaChanges = nil
+				return runStmt(stmt, params, types, planner, w)
+			})
 			planner.txn = nil
+			if err == nil {
+				err = s.runSchemaChanges(planner)
+			}
+			if err == nil {
+				err = w.EndResult()
+			}
 			return err
-		}); err != nil {
-			return resp, err
 		}
+	} else if planner.session.Txn.Status == proto.ABORTED {
+		switch stmt.(type) {
+		case *parser.CommitTransaction, *parser.RollbackTransaction:
+		default:
+			return errTransactionAborted
+		}
+	} else if _, ok := stmt.(*parser.BeginTransaction); ok {
+		// A nested BEGIN is rejected without aborting the transaction.
+		return errTransactionInProgress
+	}
 
-		result := driver.Result{
-			Columns: plan.Columns(),
+	// BEGIN runs within the transaction it opens.
+	txn := client.NewTxn(*s.db)
+	if planner.session.Txn != nil {
+		txn.Resume(*planner.session.Txn)
+	}
+	planner.txn = txn
+	err := runStmt(stmt, params, types, planner, w)
+	planner.txn = nil
+	if planner.session.Txn == nil {
+		// The statement ended the transaction, or failed to open one.
+		if err == nil {
+			err = w.EndResult()
 		}
-		for plan.Next() {
-			values := plan.Values()
-			row := driver.Result_Row{}
-			row.Values = make([]driver.Datum, 0, len(values))
-			for _, val := range values {
-				if val == parser.DNull {
-					row.Values = append(row.Values, driver.Datum{})
-				} else {
-					switch vt := val.(type) {
-					case parser.DBool:
-						row.Values = append(row.Values, driver.Datum{BoolVal: (*bool)(&vt)})
-					case parser.DInt:
-						row.Values = append(row.Values, driver.Datum{IntVal: (*int64)(&vt)})
-					case parser.DFloat:
-						row.Values = append(row.Values, driver.Datum{FloatVal: (*float64)(&vt)})
-					case parser.DString:
-						row.Values = append(row.Values, driver.Datum{StringVal: (*string)(&vt)})
-					default:
-						return resp, util.Errorf("unsupported datum: %T", val)
-					}
-				}
+		return err
+	}
+	state := txn.Proto()
+	if err != nil {
+		if state.Writing {
+			if rollbackErr := txn.Rollback(); rollbackErr != nil {
+				log.Errorf("failure aborting transaction: %s; abort caused by: %s", rollbackErr, err)
 			}
-			result.Rows = append(result.Rows, row)
-		}
-		if err := plan.Err(); err != nil {
-			return resp, err
 		}
+		state.Status = proto.ABORTED
+		planner.session.Txn = &state
+		return wrapTxnError(err)
+	}
+	planner.session.Txn = &state
+	return w.EndResult()
+}
 
-		resp.Results = append(resp.Results, result)
+// runStmt plans and runs a statement within the planner's transaction. The
+// rows of the result are retrieved from the plan and passed to w one at a
+// time as they are produced; none are held by runStmt.
+func runStmt(stmt parser.Statement, params parameters,
+	types parser.PlaceholderTypes, planner *planner, w ResultWriter) error {
+	if types == nil && len(params) > 0 {
+		var err error
+		if types, err = planner.inferPlaceholderTypes(parser.CloneStmt(stmt)); err != nil {
+			return err
+		}
+	}
+	args, err := types.CheckArgs(params)
+	if err != nil {
+		return err
+	}
+	// Bind all the placeholder variables in the stmt to actual values.
+	if err := parser.FillArgs(stmt, args); err != nil {
+		return err
+	}
+	if err := planner.resolveRoles(); err != nil {
+		return err
+	}
+	plan, err := planner.makePlan(stmt)
+	if err != nil {
+		return err
+	}
+	if err := w.BeginResult(plan.Columns()); err != nil {
+		return err
 	}
+	for plan.Next() {
+		row, err := makeResultRow(plan.Values())
+		if err != nil {
+			return err
+		}
+		if err := w.AddRow(row); err != nil {
+			return err
+		}
+	}
+	return plan.Err()
+}
 
-	// Update session state.
-	resp.Session, err = gogoproto.Marshal(&planner.session)
-	return resp, err
+// makeResultRow converts the values of a row produced by a plan into a row of
+// a driver.Result.
+func makeResultRow(values parser.DTuple) (driver.Result_Row, error) {
+	row := driver.Result_Row{}
+	row.Values = make([]driver.Datum, 0, len(values))
+	for _, val := range values {
+		if val == parser.DNull {
+			row.Values = append(row.Values, driver.Datum{})
+			continue
+		}
+		switch vt := val.(type) {
+		case parser.DBool:
+			row.Values = append(row.Values, driver.Datum{BoolVal: (*bool)(&vt)})
+		case parser.DInt:
+			row.Values = append(row.Values, driver.Datum{IntVal: (*int64)(&vt)})
+		case parser.DFloat:
+			row.Values = append(row.Values, driver.Datum{FloatVal: (*float64)(&vt)})
+		case parser.DString:
+			row.Values = append(row.Values, driver.Datum{StringVal: (*string)(&vt)})
+		case parser.DDecimal:
+			d := driver.Decimal(vt.String())
+			row.Values = append(row.Values, driver.Datum{DecimalVal: &d})
+		case parser.DDate:
+			d := int32(vt)
+			row.Values = append(row.Values, driver.Datum{DateVal: &d})
+		case parser.DTimestamp:
+			row.Values = append(row.Values, driver.Datum{TimeVal: driver.TimestampFromTime(vt.Time)})
+		case parser.DInterval:
+			row.Values = append(row.Values, driver.Datum{IntervalVal: &vt.Duration})
+		default:
+			return row, util.Errorf("unsupported datum: %T", val)
+		}
+	}
+	return row, nil
 }
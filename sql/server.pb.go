@@ -15,6 +15,7 @@ package sql
 
 import proto "github.com/gogo/protobuf/proto"
 import math "math"
+import cockroach_proto1 "github.com/cockroachdb/cockroach/proto"
 
 // discarding unused import gogoproto "gogoproto"
 
@@ -26,7 +27,18 @@ var _ = proto.Marshal
 var _ = math.Inf
 
 type Session struct {
-	Database         string `protobuf:"bytes,1,opt,name=database" json:"database"`
+	Database string `protobuf:"bytes,1,opt,name=database" json:"database"`
+	// The transaction opened by BEGIN, if any. The status of the transaction
+	// is ABORTED if a statement within the transaction failed, in which case
+	// the transaction needs to be ended with COMMIT or ROLLBACK.
+	Txn *cockroach_proto1.Transaction `protobuf:"bytes,2,opt,name=txn" json:"txn,omitempty"`
+	// The random ID of the session, which is assigned when the first statement
+	// is prepared. The parsed prepared statements are cached by the servers
+	// under this ID.
+	ID       []byte                      `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Prepared []Session_PreparedStatement `protobuf:"bytes,4,rep,name=prepared" json:"prepared"`
+	// The ID assigned to the next prepared statement. IDs are not reused.
+	NextStmtID       uint32 `protobuf:"varint,5,opt,name=next_stmt_id" json:"next_stmt_id"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -41,6 +53,59 @@ func (m *Session) GetDatabase() string {
 	return ""
 }
 
+func (m *Session) GetTxn() *cockroach_proto1.Transaction {
+	if m != nil {
+		return m.Txn
+	}
+	return nil
+}
+
+func (m *Session) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *Session) GetPrepared() []Session_PreparedStatement {
+	if m != nil {
+		return m.Prepared
+	}
+	return nil
+}
+
+func (m *Session) GetNextStmtID() uint32 {
+	if m != nil {
+		return m.NextStmtID
+	}
+	return 0
+}
+
+// A PreparedStatement is a statement prepared by a request of the session.
+type Session_PreparedStatement struct {
+	ID               uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Sql              string `protobuf:"bytes,2,opt,name=sql" json:"sql"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Session_PreparedStatement) Reset()         { *m = Session_PreparedStatement{} }
+func (m *Session_PreparedStatement) String() string { return proto.CompactTextString(m) }
+func (*Session_PreparedStatement) ProtoMessage()    {}
+
+func (m *Session_PreparedStatement) GetID() uint32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Session_PreparedStatement) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
 func (m *Session) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -82,6 +147,189 @@ func (m *Session) Unmarshal(data []byte) error {
 			}
 			m.Database = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txn == nil {
+				m.Txn = &cockroach_proto1.Transaction{}
+			}
+			if err := m.Txn.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prepared = append(m.Prepared, Session_PreparedStatement{})
+			if err := m.Prepared[len(m.Prepared)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStmtID", wireType)
+			}
+			m.NextStmtID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextStmtID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipServer(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *Session_PreparedStatement) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sql = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -206,6 +454,33 @@ func (m *Session) Size() (n int) {
 	_ = l
 	l = len(m.Database)
 	n += 1 + l + sovServer(uint64(l))
+	if m.Txn != nil {
+		l = m.Txn.Size()
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.ID != nil {
+		l = len(m.ID)
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if len(m.Prepared) > 0 {
+		for _, e := range m.Prepared {
+			l = e.Size()
+			n += 1 + l + sovServer(uint64(l))
+		}
+	}
+	n += 1 + sovServer(uint64(m.NextStmtID))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Session_PreparedStatement) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovServer(uint64(m.ID))
+	l = len(m.Sql)
+	n += 1 + l + sovServer(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -244,6 +519,65 @@ func (m *Session) MarshalTo(data []byte) (n int, err error) {
 	i++
 	i = encodeVarintServer(data, i, uint64(len(m.Database)))
 	i += copy(data[i:], m.Database)
+	if m.Txn != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintServer(data, i, uint64(m.Txn.Size()))
+		n1, err := m.Txn.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.ID != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintServer(data, i, uint64(len(m.ID)))
+		i += copy(data[i:], m.ID)
+	}
+	if len(m.Prepared) > 0 {
+		for _, msg := range m.Prepared {
+			data[i] = 0x22
+			i++
+			i = encodeVarintServer(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x28
+	i++
+	i = encodeVarintServer(data, i, uint64(m.NextStmtID))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Session_PreparedStatement) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Session_PreparedStatement) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintServer(data, i, uint64(m.ID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintServer(data, i, uint64(len(m.Sql)))
+	i += copy(data[i:], m.Sql)
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -0,0 +1,92 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestSessionTxn verifies that the state of the transaction opened by BEGIN
+// is kept in the session, so that the transaction can be continued by any
+// server, and that a nested BEGIN does not abort it.
+func TestSessionTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := &kv.LocalTestCluster{}
+	s.Start(t)
+	defer s.Stop()
+	servers := []*Server{
+		NewServer(&base.Context{}, s.DB),
+		NewServer(&base.Context{}, s.DB),
+	}
+
+	var resp driver.Response
+	exec := func(srv *Server, session Session, sql string) (Session, error) {
+		data, err := gogoproto.Marshal(&session)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := driver.Request{Sql: sql}
+		req.User = security.RootUser
+		req.Session = data
+		resp, err = srv.Execute(req)
+		var next Session
+		if err := gogoproto.Unmarshal(resp.Session, &next); err != nil {
+			t.Fatal(err)
+		}
+		return next, err
+	}
+
+	session, err := exec(servers[0], Session{}, "CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session, err = exec(servers[0], session, "BEGIN; INSERT INTO t.kv VALUES (1, 1)"); err != nil {
+		t.Fatal(err)
+	}
+	if session.Txn == nil || session.Txn.Status != proto.PENDING {
+		t.Fatalf("expected a pending transaction in the session; got %+v", session.Txn)
+	}
+
+	next, err := exec(servers[1], session, "BEGIN")
+	if err != errTransactionInProgress {
+		t.Errorf("expected %q; got %v", errTransactionInProgress, err)
+	}
+	if next.Txn == nil || next.Txn.Status != proto.PENDING {
+		t.Fatalf("expected the transaction to remain pending; got %+v", next.Txn)
+	}
+
+	// The transaction is continued and committed by another server.
+	if session, err = exec(servers[1], next, "INSERT INTO t.kv VALUES (2, 2); COMMIT"); err != nil {
+		t.Fatal(err)
+	}
+	if session.Txn != nil {
+		t.Fatalf("expected the transaction to be ended; got %+v", session.Txn)
+	}
+	if _, err := exec(servers[0], session, "SELECT k FROM t.kv"); err != nil {
+		t.Fatal(err)
+	}
+	if rows := resp.Results[0].Rows; len(rows) != 2 {
+		t.Errorf("expected both writes to be committed; got %+v", rows)
+	}
+}
@@ -18,7 +18,6 @@
 package sql
 
 import (
-	"bytes"
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
@@ -50,14 +49,12 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 //   Notes: postgres does not have a "show databases"
 //          mysql has a "SHOW DATABASES" permission, but we have no system-level permissions.
 func (p *planner) ShowDatabases(n *parser.ShowDatabases) (planNode, error) {
-	prefix := structured.MakeNameMetadataKey(structured.RootNamespaceID, "")
-	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	names, err := p.getDatabaseNames()
 	if err != nil {
 		return nil, err
 	}
 	v := &valuesNode{columns: []string{"Database"}}
-	for _, row := range sr {
-		name := string(bytes.TrimPrefix(row.Key, prefix))
+	for _, name := range names {
 		v.rows = append(v.rows, []parser.Datum{parser.DString(name)})
 	}
 	return v, nil
@@ -72,7 +69,7 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 	if n.Targets == nil {
 		return nil, fmt.Errorf("TODO(marc): implement SHOW GRANT with no targets")
 	}
-	descriptor, err := p.getDescriptorFromTargetList(*n.Targets)
+	descriptors, err := p.getDescriptorsFromTargetList(*n.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -91,23 +88,25 @@ func (p *planner) ShowGrants(n *parser.ShowGrants) (planNode, error) {
 		wantedUsers[u] = struct{}{}
 	}
 
-	userPrivileges, err := descriptor.Show()
-	if err != nil {
-		return nil, err
-	}
-	for _, userPriv := range userPrivileges {
-		if wantedUsers != nil {
-			if _, ok := wantedUsers[userPriv.User]; !ok {
-				continue
+	for _, descriptor := range descriptors {
+		userPrivileges, err := descriptor.Show()
+		if err != nil {
+			return nil, err
+		}
+		for _, userPriv := range userPrivileges {
+			if wantedUsers != nil {
+				if _, ok := wantedUsers[userPriv.User]; !ok {
+					continue
+				}
 			}
+			v.rows = append(v.rows, []parser.Datum{
+				parser.DString(descriptor.GetName()),
+				parser.DString(userPriv.User),
+				// The default stringer uses ", " separators. Strip whitespace
+				// to make things easier.
+				parser.DString(userPriv.Privileges.Join(",")),
+			})
 		}
-		v.rows = append(v.rows, []parser.Datum{
-			parser.DString(descriptor.GetName()),
-			parser.DString(userPriv.User),
-			// The default stringer uses ", " separators. Strip whitespace
-			// to make things easier.
-			parser.DString(userPriv.Privileges.Join(",")),
-		})
 	}
 	return v, nil
 }
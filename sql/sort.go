@@ -0,0 +1,195 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// A renderPlan is a planNode which renders expressions and can be asked to
+// render additional ones.
+type renderPlan interface {
+	planNode
+	addRender(expr parser.Expr) (int, error)
+}
+
+// orderBy constructs a sortNode based on the ORDER BY clause. Construction of
+// the sortNode might adjust the number of render targets in the input plan if
+// any ordering expressions are specified that are not already rendered.
+func (p *planner) orderBy(orderBy parser.OrderBy, s renderPlan) (*sortNode, error) {
+	if orderBy == nil {
+		return nil, nil
+	}
+
+	// We grab a copy of columns here because we might add new render targets
+	// below. This is the set of columns requested by the query.
+	columns := s.Columns()
+	var ordering []int
+
+	for _, o := range orderBy {
+		index := 0
+
+		// An ORDER BY expression can refer to an output column by its name:
+		//   SELECT a AS b FROM t ORDER BY b
+		if qname, ok := o.Expr.(*parser.QualifiedName); ok && len(qname.Indirect) == 0 {
+			target := string(qname.Base)
+			for j, col := range columns {
+				if strings.EqualFold(target, col) {
+					index = j + 1
+					break
+				}
+			}
+		}
+
+		// Or by its (1-based) position:
+		//   SELECT a, b FROM t ORDER BY 2
+		if index == 0 {
+			if i, ok := o.Expr.(parser.IntVal); ok {
+				index = int(i)
+				if index < 1 || index > len(columns) {
+					return nil, fmt.Errorf("invalid column index: %d not in range [1, %d]",
+						index, len(columns))
+				}
+			}
+		}
+
+		// Otherwise we add a new render target to use for ordering. This handles
+		// cases where the expression is either not a qualified name or is a
+		// qualified name that is otherwise not referenced by the query:
+		//   SELECT a FROM t ORDER by b
+		//   SELECT a, b FROM t ORDER by a+b
+		if index == 0 {
+			var err error
+			index, err = s.addRender(o.Expr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if o.Direction == parser.Descending {
+			index = -index
+		}
+		ordering = append(ordering, index)
+	}
+
+	return &sortNode{columns: columns, ordering: ordering}, nil
+}
+
+// A sortNode sorts the rows of its input plan according to an ordering. The
+// ordering is specified as a slice of 1-based column indexes into the rows of
+// the input plan: a positive value indicates ascending order and a negative
+// value descending order. The input plan may contain more columns than the
+// sortNode returns if additional render targets were needed for ordering.
+type sortNode struct {
+	plan     planNode
+	columns  []string
+	ordering []int
+	needSort bool
+	err      error
+}
+
+// wrap sets the input plan of the sortNode and returns the resulting plan. If
+// the input plan already produces rows in the desired order no sorting is
+// performed. A nil sortNode returns the input plan unchanged.
+func (n *sortNode) wrap(plan planNode) planNode {
+	if n == nil {
+		return plan
+	}
+	n.plan = plan
+	n.needSort = !orderingPrefix(n.ordering, planOrdering(plan))
+	return n
+}
+
+func (n *sortNode) Columns() []string {
+	return n.columns
+}
+
+func (n *sortNode) Values() parser.DTuple {
+	// If an ordering expression was added as a render target we need to strip
+	// it off here.
+	return n.plan.Values()[:len(n.columns)]
+}
+
+func (n *sortNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if n.needSort && !n.initValues() {
+		return false
+	}
+	return n.plan.Next()
+}
+
+func (n *sortNode) Err() error {
+	if n.err != nil {
+		return n.err
+	}
+	return n.plan.Err()
+}
+
+// initValues reads all of the rows from the input plan into a valuesNode,
+// sorts them and replaces the input plan with the sorted valuesNode.
+func (n *sortNode) initValues() bool {
+	v := &valuesNode{columns: n.plan.Columns(), ordering: n.ordering}
+	for n.plan.Next() {
+		// The result from plan.Values() is only valid until the next call to
+		// plan.Next(), so make a copy.
+		values := n.plan.Values()
+		valuesCopy := make(parser.DTuple, len(values))
+		copy(valuesCopy, values)
+		v.rows = append(v.rows, valuesCopy)
+	}
+	if n.err = n.plan.Err(); n.err != nil {
+		return false
+	}
+	sort.Sort(v)
+	n.plan = v
+	n.needSort = false
+	return true
+}
+
+// planOrdering returns the ordering of the rows produced by plan using the
+// same representation as sortNode.ordering. A nil ordering indicates the rows
+// are produced in no particular order.
+func planOrdering(plan planNode) []int {
+	switch t := plan.(type) {
+	case *scanNode:
+		return t.ordering()
+	case *sortNode:
+		return t.ordering
+	case *indexJoinNode:
+		return t.ordering
+	}
+	return nil
+}
+
+// orderingPrefix returns true if the ordering a is a prefix of the ordering b,
+// in which case rows ordered according to b are also ordered according to a.
+func orderingPrefix(a, b []int) bool {
+	if len(a) > len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -20,6 +20,8 @@ package sql
 import (
 	"bytes"
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
@@ -46,46 +48,19 @@ func (tk tableKey) Name() string {
 func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 	desc := structured.TableDescriptor{}
 	desc.Name = p.Table.Table()
+	// The CHECK constraints are validated once all the columns are known.
+	var checks []*parser.CheckConstraintTableDef
 	for _, def := range p.Defs {
 		switch d := def.(type) {
 		case *parser.ColumnTableDef:
-			col := structured.ColumnDescriptor{
-				Name:     string(d.Name),
-				Nullable: (d.Nullable != parser.NotNull),
+			col, err := makeColumnDefDescriptor(d)
+			if err != nil {
+				return desc, err
 			}
-			switch t := d.Type.(type) {
-			case *parser.BitType:
-				col.Type.Kind = structured.ColumnType_BIT
-				col.Type.Width = int32(t.N)
-			case *parser.BoolType:
-				col.Type.Kind = structured.ColumnType_BOOL
-			case *parser.IntType:
-				col.Type.Kind = structured.ColumnType_INT
-				col.Type.Width = int32(t.N)
-			case *parser.FloatType:
-				col.Type.Kind = structured.ColumnType_FLOAT
-				col.Type.Precision = int32(t.Prec)
-			case *parser.DecimalType:
-				col.Type.Kind = structured.ColumnType_DECIMAL
-				col.Type.Width = int32(t.Scale)
-				col.Type.Precision = int32(t.Prec)
-			case *parser.DateType:
-				col.Type.Kind = structured.ColumnType_DATE
-			case *parser.TimeType:
-				col.Type.Kind = structured.ColumnType_TIME
-			case *parser.TimestampType:
-				col.Type.Kind = structured.ColumnType_TIMESTAMP
-			case *parser.CharType:
-				col.Type.Kind = structured.ColumnType_CHAR
-				col.Type.Width = int32(t.N)
-			case *parser.TextType:
-				col.Type.Kind = structured.ColumnType_TEXT
-			case *parser.BlobType:
-				col.Type.Kind = structured.ColumnType_BLOB
-			default:
-				panic(fmt.Sprintf("unexpected type %T", t))
+			desc.Columns = append(desc.Columns, *col)
+			for _, c := range d.CheckExprs {
+				checks = append(checks, &parser.CheckConstraintTableDef{Name: c.Name, Expr: c.Expr})
 			}
-			desc.Columns = append(desc.Columns, col)
 
 			// Create any associated index.
 			if d.PrimaryKey || d.Unique {
@@ -115,13 +90,91 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 			} else {
 				desc.Indexes = append(desc.Indexes, index)
 			}
+		case *parser.CheckConstraintTableDef:
+			checks = append(checks, d)
+		case *parser.ForeignKeyConstraintTableDef:
+			// The foreign keys are resolved by CreateTable, which looks up the
+			// referenced tables.
 		default:
 			return desc, fmt.Errorf("unsupported table def: %T", def)
 		}
 	}
+	for _, d := range checks {
+		check, err := makeCheckConstraint(desc, d.Name, d.Expr)
+		if err != nil {
+			return desc, err
+		}
+		desc.Checks = append(desc.Checks, check)
+	}
 	return desc, nil
 }
 
+// makeColumnDefDescriptor creates a column descriptor from a column
+// definition. The default expression of the column, if any, is evaluated to
+// verify that it is a constant expression of the type of the column.
+func makeColumnDefDescriptor(d *parser.ColumnTableDef) (*structured.ColumnDescriptor, error) {
+	col := &structured.ColumnDescriptor{
+		Name:     string(d.Name),
+		Nullable: (d.Nullable != parser.NotNull),
+	}
+	switch t := d.Type.(type) {
+	case *parser.BitType:
+		col.Type.Kind = structured.ColumnType_BIT
+		col.Type.Width = int32(t.N)
+	case *parser.BoolType:
+		col.Type.Kind = structured.ColumnType_BOOL
+	case *parser.IntType:
+		col.Type.Kind = structured.ColumnType_INT
+		col.Type.Width = int32(t.N)
+	case *parser.FloatType:
+		col.Type.Kind = structured.ColumnType_FLOAT
+		col.Type.Precision = int32(t.Prec)
+	case *parser.DecimalType:
+		col.Type.Kind = structured.ColumnType_DECIMAL
+		col.Type.Width = int32(t.Scale)
+		col.Type.Precision = int32(t.Prec)
+	case *parser.DateType:
+		col.Type.Kind = structured.ColumnType_DATE
+	case *parser.TimeType:
+		col.Type.Kind = structured.ColumnType_TIME
+	case *parser.TimestampType:
+		col.Type.Kind = structured.ColumnType_TIMESTAMP
+	case *parser.IntervalType:
+		col.Type.Kind = structured.ColumnType_INTERVAL
+	case *parser.CharType:
+		col.Type.Kind = structured.ColumnType_CHAR
+		col.Type.Width = int32(t.N)
+	case *parser.TextType:
+		col.Type.Kind = structured.ColumnType_TEXT
+	case *parser.BlobType:
+		col.Type.Kind = structured.ColumnType_BLOB
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+
+	if d.DefaultExpr != nil {
+		if _, err := evalDefaultExpr(*col, d.DefaultExpr); err != nil {
+			return nil, err
+		}
+		s := d.DefaultExpr.String()
+		col.DefaultExpr = &s
+	}
+	return col, nil
+}
+
+// evalDefaultExpr evaluates the default expression of a column, verifying
+// that the resulting value can be stored in the column.
+func evalDefaultExpr(col structured.ColumnDescriptor, expr parser.Expr) (parser.Datum, error) {
+	val, err := parser.EvalExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := convertDatum(col, val); err != nil {
+		return nil, err
+	}
+	return coerceDatum(col, val)
+}
+
 func (p *planner) getTableDesc(qname *parser.QualifiedName) (
 	*structured.TableDescriptor, error) {
 	if err := qname.NormalizeTableName(p.session.Database); err != nil {
@@ -132,14 +185,64 @@ func (p *planner) getTableDesc(qname *parser.QualifiedName) (
 		return nil, err
 	}
 
+	if dbDesc.ID == informationSchemaID {
+		if desc := getVirtualTableDesc(qname.Table()); desc != nil {
+			return desc, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", qname.Table())
+	}
+
 	desc := structured.TableDescriptor{}
 	if err := p.getDescriptor(tableKey{dbDesc.ID, qname.Table()}, &desc); err != nil {
 		return nil, err
 	}
+	if p.descVersions != nil {
+		p.descVersions[desc.ID] = desc.Version
+	}
+	return &desc, nil
+}
+
+// getTableDescIfExists is like getTableDesc, but returns a nil descriptor
+// instead of an error if the table does not exist.
+func (p *planner) getTableDescIfExists(qname *parser.QualifiedName) (
+	*structured.TableDescriptor, error) {
+	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+		return nil, err
+	}
+	dbDesc, err := p.getDatabaseDesc(qname.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	if dbDesc.ID == informationSchemaID {
+		return getVirtualTableDesc(qname.Table()), nil
+	}
+
+	gr, err := p.txn.Get(tableKey{dbDesc.ID, qname.Table()}.Key())
+	if err != nil {
+		return nil, err
+	}
+	if !gr.Exists() {
+		return nil, nil
+	}
+	desc := structured.TableDescriptor{}
+	if err := p.txn.GetProto(gr.ValueBytes(), &desc); err != nil {
+		return nil, err
+	}
+	if err := desc.ConvertLegacyPrivileges(); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
 	return &desc, nil
 }
 
 func (p *planner) getTableNames(dbDesc *structured.DatabaseDescriptor) (parser.QualifiedNames, error) {
+	if dbDesc.ID == informationSchemaID {
+		return getVirtualTableNames()
+	}
+
 	prefix := structured.MakeNameMetadataKey(dbDesc.ID, "")
 	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
 	if err != nil {
@@ -207,6 +310,19 @@ func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 		return encoding.EncodeNumericFloat(b, float64(t)), nil
 	case parser.DString:
 		return encoding.EncodeBytes(b, []byte(t)), nil
+	case parser.DDecimal:
+		coeff := t.Coeff
+		if coeff == nil {
+			coeff = new(big.Int)
+		}
+		return encoding.EncodeNumericDecimal(b, coeff, t.Scale), nil
+	case parser.DDate:
+		return encoding.EncodeVarint(b, int64(t)), nil
+	case parser.DTimestamp:
+		b = encoding.EncodeVarint(b, t.Unix())
+		return encoding.EncodeVarint(b, int64(t.Nanosecond())), nil
+	case parser.DInterval:
+		return encoding.EncodeVarint(b, int64(t.Duration)), nil
 	}
 	return nil, fmt.Errorf("unable to encode table key: %T", val)
 }
@@ -227,6 +343,14 @@ func makeIndexKeyVals(desc *structured.TableDescriptor,
 		case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
 			structured.ColumnType_BLOB:
 			vals[i] = parser.DString("")
+		case structured.ColumnType_DECIMAL:
+			vals[i] = parser.DDecimal{}
+		case structured.ColumnType_DATE:
+			vals[i] = parser.DDate(0)
+		case structured.ColumnType_TIMESTAMP:
+			vals[i] = parser.DTimestamp{}
+		case structured.ColumnType_INTERVAL:
+			vals[i] = parser.DInterval{}
 		default:
 			return nil, util.Errorf("TODO(pmattis): decoded index key: %s", col.Type.Kind)
 		}
@@ -266,6 +390,16 @@ func decodeIndexKey(desc *structured.TableDescriptor,
 		return nil, fmt.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
 	}
 
+	key, err := decodeKeyVals(vals, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, rescaleKeyDecimals(desc, index, vals)
+}
+
+// decodeKeyVals decodes the values encoded in key into vals, returning the
+// remaining bytes. Vals determines the number and types of values to decode.
+func decodeKeyVals(vals []parser.Datum, key []byte) ([]byte, error) {
 	for j := range vals {
 		switch vals[j].(type) {
 		case parser.DInt:
@@ -280,6 +414,24 @@ func decodeIndexKey(desc *structured.TableDescriptor,
 			var r []byte
 			key, r = encoding.DecodeBytes(key, nil)
 			vals[j] = parser.DString(r)
+		case parser.DDecimal:
+			var coeff *big.Int
+			var scale int32
+			key, coeff, scale = encoding.DecodeNumericDecimal(key)
+			vals[j] = parser.DDecimal{Coeff: coeff, Scale: scale}
+		case parser.DDate:
+			var i int64
+			key, i = encoding.DecodeVarint(key)
+			vals[j] = parser.DDate(i)
+		case parser.DTimestamp:
+			var sec, nsec int64
+			key, sec = encoding.DecodeVarint(key)
+			key, nsec = encoding.DecodeVarint(key)
+			vals[j] = parser.DTimestamp{Time: time.Unix(sec, nsec).UTC()}
+		case parser.DInterval:
+			var i int64
+			key, i = encoding.DecodeVarint(key)
+			vals[j] = parser.DInterval{Duration: time.Duration(i)}
 		default:
 			return nil, util.Errorf("TODO(pmattis): decoded index key: %s", vals[j].Type())
 		}
@@ -288,6 +440,46 @@ func decodeIndexKey(desc *structured.TableDescriptor,
 	return key, nil
 }
 
+// rescaleKeyDecimals restores the scale of the values of DECIMAL columns
+// decoded from a key of the index. Vals is a slice returned from
+// makeIndexKeyVals, possibly truncated to the index columns. The key encoding
+// of a decimal omits the trailing zeros of its fractional part, while the
+// values of a column with a precision are stored with the scale of the column.
+func rescaleKeyDecimals(desc *structured.TableDescriptor,
+	index structured.IndexDescriptor, vals []parser.Datum) error {
+	ids := index.ColumnIDs
+	if !index.Unique {
+		ids = append(append([]structured.ColumnID(nil), ids...), desc.PrimaryIndex.ColumnIDs...)
+	}
+	for i, val := range vals {
+		d, ok := val.(parser.DDecimal)
+		if !ok || i >= len(ids) {
+			continue
+		}
+		col, err := desc.FindColumnByID(ids[i])
+		if err != nil {
+			return err
+		}
+		if col.Type.Precision > 0 {
+			vals[i] = d.Rescale(col.Type.Width)
+		}
+	}
+	return nil
+}
+
+// writeIndexes returns the secondary indexes of the table which are
+// maintained by writes to its rows: the visible indexes and the indexes being
+// added by a schema change.
+func writeIndexes(desc *structured.TableDescriptor) []structured.IndexDescriptor {
+	indexes := append([]structured.IndexDescriptor(nil), desc.Indexes...)
+	for _, m := range desc.Mutations {
+		if m.Index != nil && m.Direction == structured.DescriptorMutation_ADD {
+			indexes = append(indexes, *m.Index)
+		}
+	}
+	return indexes
+}
+
 type indexEntry struct {
 	key   []byte
 	value []byte
@@ -318,6 +510,60 @@ func encodeSecondaryIndexes(tableID structured.ID, indexes []structured.IndexDes
 	return secondaryIndexEntries, nil
 }
 
+// coerceDatum returns val converted to the datum type of the values of col.
+// The values of DATE, TIMESTAMP, INTERVAL and DECIMAL columns can be given as
+// strings, which is how clients without native support for these types send
+// them; dates and timestamps are converted to each other and ints and floats
+// are converted to decimals. Decimals are rounded to the scale of the column
+// or, if the column has no precision, stripped of trailing zeros. Other
+// values are returned unchanged.
+func coerceDatum(col structured.ColumnDescriptor, val parser.Datum) (parser.Datum, error) {
+	if val == parser.DNull {
+		return val, nil
+	}
+
+	var err error
+	switch col.Type.Kind {
+	case structured.ColumnType_DECIMAL:
+		var d parser.DDecimal
+		switch t := val.(type) {
+		case parser.DDecimal:
+			d = t
+		case parser.DInt, parser.DFloat, parser.DString:
+			v, err := parser.EvalExpr(&parser.CastExpr{Expr: val, Type: &parser.DecimalType{}})
+			if err != nil {
+				return nil, err
+			}
+			d = v.(parser.DDecimal)
+		default:
+			return val, nil
+		}
+		if col.Type.Precision == 0 {
+			return d.Normalize(), nil
+		}
+		return d.Limit(int(col.Type.Precision), int(col.Type.Width))
+	case structured.ColumnType_DATE:
+		switch t := val.(type) {
+		case parser.DString:
+			return parser.ParseDDate(string(t))
+		case parser.DTimestamp:
+			val, err = parser.EvalExpr(&parser.CastExpr{Expr: val, Type: &parser.DateType{}})
+		}
+	case structured.ColumnType_TIMESTAMP:
+		switch t := val.(type) {
+		case parser.DString:
+			return parser.ParseDTimestamp(string(t))
+		case parser.DDate:
+			return parser.DTimestamp{Time: t.Time()}, nil
+		}
+	case structured.ColumnType_INTERVAL:
+		if t, ok := val.(parser.DString); ok {
+			return parser.ParseDInterval(string(t))
+		}
+	}
+	return val, err
+}
+
 // convertDatum returns a Go primitive value equivalent of val, of the
 // type expected by col. If val's type is incompatible with col, or if
 // col's type is not yet implemented, an error is returned.
@@ -325,6 +571,10 @@ func convertDatum(col structured.ColumnDescriptor, val parser.Datum) (interface{
 	if val == parser.DNull {
 		return nil, nil
 	}
+	val, err := coerceDatum(col, val)
+	if err != nil {
+		return nil, err
+	}
 
 	switch col.Type.Kind {
 	case structured.ColumnType_BOOL:
@@ -339,10 +589,23 @@ func convertDatum(col structured.ColumnDescriptor, val parser.Datum) (interface{
 		if v, ok := val.(parser.DFloat); ok {
 			return float64(v), nil
 		}
-	// case structured.ColumnType_DECIMAL:
-	// case structured.ColumnType_DATE:
+	case structured.ColumnType_DECIMAL:
+		if v, ok := val.(parser.DDecimal); ok {
+			return v.String(), nil
+		}
+	case structured.ColumnType_DATE:
+		if v, ok := val.(parser.DDate); ok {
+			return int64(v), nil
+		}
+	case structured.ColumnType_TIMESTAMP:
+		if v, ok := val.(parser.DTimestamp); ok {
+			return v.Time, nil
+		}
+	case structured.ColumnType_INTERVAL:
+		if v, ok := val.(parser.DInterval); ok {
+			return int64(v.Duration), nil
+		}
 	// case structured.ColumnType_TIME:
-	// case structured.ColumnType_TIMESTAMP:
 	case structured.ColumnType_CHAR, structured.ColumnType_TEXT, structured.ColumnType_BLOB:
 		if v, ok := val.(parser.DString); ok {
 			return string(v), nil
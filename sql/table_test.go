@@ -74,6 +74,11 @@ func TestMakeTableDescColumns(t *testing.T) {
 			structured.ColumnType{Kind: structured.ColumnType_TIMESTAMP},
 			true,
 		},
+		{
+			"INTERVAL",
+			structured.ColumnType{Kind: structured.ColumnType_INTERVAL},
+			true,
+		},
 		{
 			"CHAR",
 			structured.ColumnType{Kind: structured.ColumnType_CHAR},
@@ -28,21 +28,37 @@ import (
 )
 
 // Truncate deletes all rows from a table.
-// Privileges: WRITE on table.
+// Privileges: DROP on table.
 //   Notes: postgres requires TRUNCATE.
 //          mysql requires DROP (for mysql >= 5.1.16, DELETE before that).
 func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 	b := client.Batch{}
 
+	var descs []*structured.TableDescriptor
+	truncated := map[structured.ID]struct{}{}
 	for _, tableQualifiedName := range n.Tables {
 		tableDesc, err := p.getTableDesc(tableQualifiedName)
 		if err != nil {
 			return nil, err
 		}
 
-		if !tableDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-				p.user, parser.PrivilegeWrite, tableDesc.Name)
+		if err := p.checkPrivilege(tableDesc, parser.PrivilegeDrop); err != nil {
+			return nil, err
+		}
+		descs = append(descs, tableDesc)
+		truncated[tableDesc.ID] = struct{}{}
+	}
+
+	for _, tableDesc := range descs {
+		// The rows of a table referenced by another table can only be deleted
+		// along with the rows of the other table.
+		other, err := p.referencingTable(tableDesc, truncated)
+		if err != nil {
+			return nil, err
+		}
+		if other != nil {
+			return nil, fmt.Errorf("cannot truncate table %q because table %q references it",
+				tableDesc.Name, other.Name)
 		}
 
 		tablePrefix := structured.MakeTablePrefix(tableDesc.ID)
@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+var (
+	errTransactionInProgress = errors.New("there is already a transaction in progress")
+	errNoTransaction         = errors.New("there is no transaction in progress")
+	errTransactionAborted    = errors.New("current transaction is aborted, commands ignored until end of transaction block")
+)
+
+// A txnRestartError is returned when a transaction opened with BEGIN
+// encounters an error which requires the transaction to be restarted. The
+// statements of a transaction are not retried automatically (they were sent by
+// the client in previous requests), so the client needs to retry the
+// transaction from the BEGIN statement.
+type txnRestartError struct {
+	err     error
+	restart proto.TransactionRestart
+}
+
+func (e *txnRestartError) Error() string {
+	return fmt.Sprintf("retry transaction: %s", e.err)
+}
+
+// CanRestartTransaction implements the proto.TransactionRestartError
+// interface.
+func (e *txnRestartError) CanRestartTransaction() proto.TransactionRestart {
+	return e.restart
+}
+
+// wrapTxnError wraps errors which require the transaction to be restarted in a
+// txnRestartError.
+func wrapTxnError(err error) error {
+	if restartErr, ok := err.(proto.TransactionRestartError); ok {
+		if restart := restartErr.CanRestartTransaction(); restart != proto.TransactionRestart_ABORT {
+			return &txnRestartError{err: err, restart: restart}
+		}
+	}
+	return err
+}
+
+// BeginTransaction starts a new transaction which spans the following
+// statements until COMMIT or ROLLBACK.
+// Privileges: None.
+func (p *planner) BeginTransaction(n *parser.BeginTransaction) (planNode, error) {
+	if p.session.Txn != nil {
+		return nil, errTransactionInProgress
+	}
+	if err := p.setIsolationLevel(n.Isolation); err != nil {
+		return nil, err
+	}
+	// The transaction state is saved in the session once the statement
+	// completes.
+	p.session.Txn = &proto.Transaction{}
+	return &valuesNode{}, nil
+}
+
+// CommitTransaction commits the transaction opened by BEGIN. Committing a
+// transaction in which a statement failed rolls it back instead.
+// Privileges: None.
+func (p *planner) CommitTransaction(n *parser.CommitTransaction) (planNode, error) {
+	if p.session.Txn == nil {
+		return nil, errNoTransaction
+	}
+	aborted := p.session.Txn.Status == proto.ABORTED
+	p.session.Txn = nil
+	if !aborted && p.txn.Proto().Writing {
+		// A transaction which did not perform any writes has nothing to commit.
+		if err := p.txn.Commit(); err != nil {
+			if rollbackErr := p.txn.Rollback(); rollbackErr != nil {
+				log.Errorf("failure aborting transaction: %s; abort caused by: %s", rollbackErr, err)
+			}
+			return nil, wrapTxnError(err)
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// RollbackTransaction rolls back the transaction opened by BEGIN.
+// Privileges: None.
+func (p *planner) RollbackTransaction(n *parser.RollbackTransaction) (planNode, error) {
+	if p.session.Txn == nil {
+		return nil, errNoTransaction
+	}
+	aborted := p.session.Txn.Status == proto.ABORTED
+	p.session.Txn = nil
+	if !aborted && p.txn.Proto().Writing {
+		if err := p.txn.Rollback(); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// SetTransaction sets the isolation level of the transaction opened by BEGIN.
+// Privileges: None.
+func (p *planner) SetTransaction(n *parser.SetTransaction) (planNode, error) {
+	if p.session.Txn == nil {
+		return nil, errNoTransaction
+	}
+	if p.txn.Proto().ID != nil {
+		return nil, fmt.Errorf("SET TRANSACTION must be called before any query")
+	}
+	if err := p.setIsolationLevel(n.Isolation); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+func (p *planner) setIsolationLevel(level parser.IsolationLevel) error {
+	state := p.txn.Proto()
+	switch level {
+	case parser.UnspecifiedIsolation:
+		return nil
+	case parser.SnapshotIsolation:
+		state.Isolation = proto.SNAPSHOT
+	case parser.SerializableIsolation:
+		state.Isolation = proto.SERIALIZABLE
+	default:
+		return fmt.Errorf("unknown isolation level: %s", level)
+	}
+	p.txn.Resume(state)
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Union combines the rows of two queries using a UNION, INTERSECT or EXCEPT
+// set operation. The combined rows are optionally sorted according to the
+// ORDER BY clause and restricted by the LIMIT and OFFSET clauses.
+// Privileges: the privileges required by the two queries.
+func (p *planner) Union(n *parser.Union) (planNode, error) {
+	left, err := p.makePlan(n.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.makePlan(n.Right)
+	if err != nil {
+		return nil, err
+	}
+	if l, r := len(left.Columns()), len(right.Columns()); l != r {
+		return nil, fmt.Errorf("each %s query must have the same number of columns: %d vs %d",
+			n.Type, l, r)
+	}
+
+	u := &unionNode{
+		typ:   n.Type,
+		all:   n.All,
+		left:  left,
+		right: right,
+	}
+	if !u.all {
+		u.seen = map[string]struct{}{}
+	}
+
+	sort, err := p.orderBy(n.OrderBy, u)
+	if err != nil {
+		return nil, err
+	}
+	return p.limit(n.Limit, sort.wrap(u))
+}
+
+// A unionNode combines the rows of two plans with the same number of columns.
+// The rows are compared by value, with NULLs considered equal to each other.
+//
+// UNION returns the rows of the left plan followed by the rows of the right
+// plan. INTERSECT and EXCEPT first read all of the rows of the right plan and
+// then return the rows of the left plan which are (respectively, are not)
+// present in the right plan. With ALL, a row present m times in the left plan
+// and n times in the right plan is returned m+n times for UNION, min(m, n)
+// times for INTERSECT and max(m-n, 0) times for EXCEPT. Otherwise duplicate
+// rows are eliminated.
+type unionNode struct {
+	typ         string
+	all         bool
+	left, right planNode
+	rightDone   bool                // all of the rows of the right plan have been read
+	rightRows   map[string]int      // the number of times each row occurs in the right plan
+	seen        map[string]struct{} // the rows returned so far, if eliminating duplicates
+	row         parser.DTuple
+	err         error
+}
+
+func (n *unionNode) Columns() []string {
+	return n.left.Columns()
+}
+
+func (n *unionNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *unionNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if n.typ != parser.AstUnion && n.rightRows == nil && !n.readRight() {
+		return false
+	}
+
+	for n.left.Next() {
+		n.row = n.left.Values()
+		if n.emit(n.row.String()) {
+			return true
+		}
+	}
+	if n.err = n.left.Err(); n.err != nil {
+		return false
+	}
+
+	if n.typ == parser.AstUnion && !n.rightDone {
+		for n.right.Next() {
+			n.row = n.right.Values()
+			if n.emit(n.row.String()) {
+				return true
+			}
+		}
+		n.rightDone = true
+		n.err = n.right.Err()
+	}
+	return false
+}
+
+func (n *unionNode) Err() error {
+	return n.err
+}
+
+// addRender is only called when the ORDER BY clause of a set operation is
+// neither an output column name nor a column position, which is not allowed.
+func (n *unionNode) addRender(expr parser.Expr) (int, error) {
+	return 0, fmt.Errorf("invalid UNION/INTERSECT/EXCEPT ORDER BY clause: %s", expr)
+}
+
+// readRight reads all of the rows of the right plan, counting the number of
+// times each row occurs.
+func (n *unionNode) readRight() bool {
+	n.rightRows = map[string]int{}
+	for n.right.Next() {
+		n.rightRows[n.right.Values().String()]++
+	}
+	n.rightDone = true
+	n.err = n.right.Err()
+	return n.err == nil
+}
+
+// emit returns true if the row identified by key should be returned.
+func (n *unionNode) emit(key string) bool {
+	switch n.typ {
+	case parser.AstIntersect:
+		if n.rightRows[key] == 0 {
+			return false
+		}
+		if n.all {
+			n.rightRows[key]--
+		}
+	case parser.AstExcept:
+		if n.rightRows[key] > 0 {
+			if n.all {
+				n.rightRows[key]--
+			}
+			return false
+		}
+	}
+	if n.seen != nil {
+		if _, ok := n.seen[key]; ok {
+			return false
+		}
+		n.seen[key] = struct{}{}
+	}
+	return true
+}
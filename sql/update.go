@@ -29,7 +29,7 @@ import (
 )
 
 // Update updates columns for a selection of rows from a table.
-// Privileges: WRITE and READ on table. We currently always use a select statement.
+// Privileges: UPDATE and SELECT on table. We currently always use a select statement.
 //   Notes: postgres requires UPDATE. Requires SELECT with WHERE clause with table.
 //          mysql requires UPDATE. Also requires SELECT with WHERE clause with table.
 func (p *planner) Update(n *parser.Update) (planNode, error) {
@@ -38,9 +38,8 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, err
 	}
 
-	if !tableDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
-		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-			p.user, parser.PrivilegeWrite, tableDesc.Name)
+	if err := p.checkPrivilege(tableDesc, parser.PrivilegeUpdate); err != nil {
+		return nil, err
 	}
 
 	// Determine which columns we're inserting into.
@@ -87,6 +86,15 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		colIDtoRowIndex[c.ID] = i
 	}
 
+	checks, err := makeCheckHelper(tableDesc)
+	if err != nil {
+		return nil, err
+	}
+	fks := p.makeFKHelper(tableDesc)
+	// The previous and new values of the updated rows are kept if they need to
+	// be checked against the foreign keys.
+	var oldRows, newRows [][]parser.Datum
+
 	primaryIndex := tableDesc.PrimaryIndex
 	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
 
@@ -102,7 +110,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 
 	// Secondary indexes needing updating.
 	var indexes []structured.IndexDescriptor
-	for _, index := range tableDesc.Indexes {
+	for _, index := range writeIndexes(tableDesc) {
 		for _, id := range index.ColumnIDs {
 			if _, ok := colIDSet[id]; ok {
 				indexes = append(indexes, index)
@@ -115,6 +123,9 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	b := client.Batch{}
 	for row.Next() {
 		rowVals := row.Values()
+		if fks.needsRows() {
+			oldRows = append(oldRows, append([]parser.Datum(nil), rowVals...))
+		}
 		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, nil)
 		if err != nil {
 			return nil, err
@@ -129,13 +140,23 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		//
 		// Update the row values.
 		for i, col := range cols {
-			val := vals[i]
+			val, err := coerceDatum(col, vals[i])
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = val
 
 			if !col.Nullable && val == parser.DNull {
 				return nil, fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
 			}
 			rowVals[colIDtoRowIndex[col.ID]] = val
 		}
+		if err := checks.check(colIDtoRowIndex, rowVals); err != nil {
+			return nil, err
+		}
+		if fks.needsRows() {
+			newRows = append(newRows, append([]parser.Datum(nil), rowVals...))
+		}
 		newSecondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, rowVals, primaryIndexKeySuffix)
 		if err != nil {
 			return nil, err
@@ -197,6 +218,15 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		return nil, err
 	}
 
+	for i, rowVals := range newRows {
+		if err := fks.checkReferences(colIDtoRowIndex, rowVals, colIDSet); err != nil {
+			return nil, err
+		}
+		if err := fks.checkUpdated(colIDtoRowIndex, oldRows[i], rowVals, colIDSet); err != nil {
+			return nil, err
+		}
+	}
+
 	// TODO(tamird/pmattis): return the number of affected rows.
 	return &valuesNode{}, nil
 }
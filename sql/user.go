@@ -0,0 +1,394 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Users and roles share a namespace and are stored as UserConfigs under
+// keys.ConfigUserPrefix, the same place the `cockroach user` commands and
+// the /_admin/users handler store them. A role is a UserConfig with IsRole
+// set, which cannot log in. The privileges of a role are granted to every
+// user and role it has been granted to, either directly or through other
+// roles.
+
+// userKey returns the key of the configuration of the user or role `name`.
+func userKey(name string) proto.Key {
+	return keys.MakeKey(keys.ConfigUserPrefix, proto.Key(name))
+}
+
+// getUserConfig looks up the configuration of the user or role `name`,
+// returning nil if it does not exist. The root user always exists, even if
+// no configuration has been stored for it.
+func (p *planner) getUserConfig(name string) (*config.UserConfig, error) {
+	gr, err := p.txn.Get(userKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if !gr.Exists() {
+		if name == security.RootUser {
+			return &config.UserConfig{}, nil
+		}
+		return nil, nil
+	}
+	cfg := &config.UserConfig{}
+	if err := gr.ValueProto(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// getUser looks up the configuration of the user `name`, returning an error
+// if there is no such user.
+func (p *planner) getUser(name string) (*config.UserConfig, error) {
+	cfg, err := p.getUserConfig(name)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil || cfg.IsRole {
+		return nil, fmt.Errorf("user %q does not exist", name)
+	}
+	return cfg, nil
+}
+
+// getRole looks up the configuration of the role `name`, returning an error
+// if there is no such role.
+func (p *planner) getRole(name string) (*config.UserConfig, error) {
+	cfg, err := p.getUserConfig(name)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil || !cfg.IsRole {
+		return nil, fmt.Errorf("role %q does not exist", name)
+	}
+	return cfg, nil
+}
+
+// createUserConfig stores the configuration of a new user or role, which
+// must not clash with an existing user or role.
+func (p *planner) createUserConfig(name string, cfg *config.UserConfig) error {
+	if name == security.NodeUser {
+		return fmt.Errorf("user %q is reserved", name)
+	}
+	existing, err := p.getUserConfig(name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if existing.IsRole {
+			return fmt.Errorf("role %q already exists", name)
+		}
+		return fmt.Errorf("user %q already exists", name)
+	}
+	return p.txn.Put(userKey(name), cfg)
+}
+
+// memberOf returns the roles granted to the user or role `name`, either
+// directly or through other roles.
+func (p *planner) memberOf(name string) ([]string, error) {
+	var roles []string
+	seen := map[string]struct{}{name: {}}
+	for queue := []string{name}; len(queue) > 0; queue = queue[1:] {
+		cfg, err := p.getUserConfig(queue[0])
+		if err != nil {
+			return nil, err
+		}
+		if cfg == nil {
+			continue
+		}
+		for _, role := range cfg.Roles {
+			if _, ok := seen[role]; ok {
+				continue
+			}
+			seen[role] = struct{}{}
+			roles = append(roles, role)
+			queue = append(queue, role)
+		}
+	}
+	return roles, nil
+}
+
+// resolveRoles looks up the roles of the planner's user, which are used
+// together with the user to check privileges. The root user has all
+// privileges and does not need any roles.
+func (p *planner) resolveRoles() error {
+	p.roles = nil
+	if p.user == security.RootUser {
+		return nil
+	}
+	roles, err := p.memberOf(p.user)
+	if err != nil {
+		return err
+	}
+	p.roles = roles
+	return nil
+}
+
+// CreateUser creates a user, which can log in with its password if it has
+// one.
+// Privileges: root user.
+//   Notes: postgres requires the CREATEROLE attribute or superuser.
+func (p *planner) CreateUser(n *parser.CreateUser) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to create users", security.RootUser)
+	}
+	cfg := &config.UserConfig{}
+	if n.Password != "" {
+		hashed, err := security.HashPassword([]byte(n.Password))
+		if err != nil {
+			return nil, err
+		}
+		cfg.HashedPassword = hashed
+	}
+	if err := p.createUserConfig(string(n.Name), cfg); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// AlterUser changes the password of a user.
+// Privileges: root user, or the user itself.
+func (p *planner) AlterUser(n *parser.AlterUser) (planNode, error) {
+	name := string(n.Name)
+	if p.user != security.RootUser && p.user != name {
+		return nil, fmt.Errorf("only %s is allowed to change the password of another user",
+			security.RootUser)
+	}
+	if n.Password == "" {
+		return nil, fmt.Errorf("password cannot be empty")
+	}
+	cfg, err := p.getUser(name)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.HashedPassword, err = security.HashPassword([]byte(n.Password)); err != nil {
+		return nil, err
+	}
+	if err := p.txn.Put(userKey(name), cfg); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropUser drops a user. The privileges granted to the user on databases
+// and tables are left in place.
+// Privileges: root user.
+func (p *planner) DropUser(n *parser.DropUser) (planNode, error) {
+	name := string(n.Name)
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to drop users", security.RootUser)
+	}
+	if name == security.RootUser {
+		return nil, fmt.Errorf("user %q cannot be dropped", name)
+	}
+	if _, err := p.getUser(name); err != nil {
+		return nil, err
+	}
+	if err := p.txn.Del(userKey(name)); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// CreateRole creates a role, which is granted to users and other roles with
+// GRANT role TO user.
+// Privileges: root user.
+func (p *planner) CreateRole(n *parser.CreateRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to create roles", security.RootUser)
+	}
+	if err := p.createUserConfig(string(n.Name), &config.UserConfig{IsRole: true}); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// DropRole drops a role and revokes it from all users and roles it was
+// granted to.
+// Privileges: root user.
+func (p *planner) DropRole(n *parser.DropRole) (planNode, error) {
+	name := string(n.Name)
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to drop roles", security.RootUser)
+	}
+	if _, err := p.getRole(name); err != nil {
+		return nil, err
+	}
+	if err := p.txn.Del(userKey(name)); err != nil {
+		return nil, err
+	}
+	sr, err := p.txn.Scan(keys.ConfigUserPrefix, keys.ConfigUserPrefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range sr {
+		cfg := &config.UserConfig{}
+		if err := row.ValueProto(cfg); err != nil {
+			return nil, err
+		}
+		if roles, ok := removeString(cfg.Roles, name); ok {
+			cfg.Roles = roles
+			if err := p.txn.Put(row.Key, cfg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// GrantRole grants roles to users and other roles.
+// Privileges: root user.
+func (p *planner) GrantRole(n *parser.GrantRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to grant roles", security.RootUser)
+	}
+	for _, role := range n.Roles {
+		if _, err := p.getRole(role); err != nil {
+			return nil, err
+		}
+		// Granting a role to one of its own members would make the role a
+		// member of itself.
+		memberOf, err := p.memberOf(role)
+		if err != nil {
+			return nil, err
+		}
+		memberOf = append(memberOf, role)
+		for _, grantee := range n.Grantees {
+			if _, ok := findString(memberOf, grantee); ok {
+				return nil, fmt.Errorf("role %q is a member of role %q", role, grantee)
+			}
+		}
+	}
+	return p.updateRoles(n.Grantees, func(roles []string) []string {
+		for _, role := range n.Roles {
+			if _, ok := findString(roles, role); !ok {
+				roles = append(roles, role)
+			}
+		}
+		return roles
+	})
+}
+
+// RevokeRole revokes roles from users and other roles.
+// Privileges: root user.
+func (p *planner) RevokeRole(n *parser.RevokeRole) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to revoke roles", security.RootUser)
+	}
+	for _, role := range n.Roles {
+		if _, err := p.getRole(role); err != nil {
+			return nil, err
+		}
+	}
+	return p.updateRoles(n.Grantees, func(roles []string) []string {
+		for _, role := range n.Roles {
+			roles, _ = removeString(roles, role)
+		}
+		return roles
+	})
+}
+
+// updateRoles replaces the roles of each of the grantees, which must be
+// existing users or roles, by the result of f.
+func (p *planner) updateRoles(grantees []string, f func([]string) []string) (planNode, error) {
+	for _, grantee := range grantees {
+		cfg, err := p.getUserConfig(grantee)
+		if err != nil {
+			return nil, err
+		}
+		if cfg == nil {
+			return nil, fmt.Errorf("user or role %q does not exist", grantee)
+		}
+		cfg.Roles = f(cfg.Roles)
+		sort.Strings(cfg.Roles)
+		if err := p.txn.Put(userKey(grantee), cfg); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// ShowUsers returns all users and roles, along with the roles directly
+// granted to them, if any.
+// Privileges: None.
+func (p *planner) ShowUsers(n *parser.ShowUsers) (planNode, error) {
+	sr, err := p.txn.Scan(keys.ConfigUserPrefix, keys.ConfigUserPrefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{"User", "Role", "MemberOf"}}
+	for _, row := range sr {
+		name := string(bytes.TrimPrefix(row.Key, keys.ConfigUserPrefix))
+		if name == "" {
+			// Skip the default user configuration written at bootstrap.
+			continue
+		}
+		cfg := &config.UserConfig{}
+		if err := row.ValueProto(cfg); err != nil {
+			return nil, err
+		}
+		var memberOf parser.Datum = parser.DNull
+		if len(cfg.Roles) > 0 {
+			memberOf = parser.DString(strings.Join(cfg.Roles, ","))
+		}
+		v.rows = append(v.rows, []parser.Datum{parser.DString(name), parser.DBool(cfg.IsRole), memberOf})
+	}
+	return v, nil
+}
+
+// findString returns the index of `s` in `strs`.
+func findString(strs []string, s string) (int, bool) {
+	for i := range strs {
+		if strs[i] == s {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// removeString removes `s` from `strs`, returning false if it is not found.
+func removeString(strs []string, s string) ([]string, bool) {
+	i, ok := findString(strs, s)
+	if !ok {
+		return strs, false
+	}
+	return append(strs[:i], strs[i+1:]...), true
+}
+
+// CheckPassword returns an error unless `password` is the password of the
+// user `name`. Roles and users without a password cannot log in with a
+// password.
+func (s *Server) CheckPassword(name, password string) error {
+	cfg := &config.UserConfig{}
+	if err := s.db.GetProto(userKey(name), cfg); err != nil {
+		return err
+	}
+	if cfg.IsRole || len(cfg.HashedPassword) == 0 ||
+		security.CompareHashAndPassword(cfg.HashedPassword, []byte(password)) != nil {
+		return fmt.Errorf("password authentication failed for user %s", name)
+	}
+	return nil
+}
@@ -43,9 +43,10 @@ func (p *planner) Values(n parser.Values) (planNode, error) {
 }
 
 type valuesNode struct {
-	columns []string
-	rows    []parser.DTuple
-	nextRow int // The index of the next row.
+	columns  []string
+	ordering []int
+	rows     []parser.DTuple
+	nextRow  int // The index of the next row.
 }
 
 func (n *valuesNode) Columns() []string {
@@ -67,3 +68,30 @@ func (n *valuesNode) Next() bool {
 func (*valuesNode) Err() error {
 	return nil
 }
+
+func (n *valuesNode) Len() int {
+	return len(n.rows)
+}
+
+func (n *valuesNode) Less(i, j int) bool {
+	// TODO(pmattis): An alternative to this type of field-based comparison would
+	// be to construct a sort-key per row using encodeTableKey(). Using a
+	// sort-key approach would likely fit better with a disk-based sort.
+	ra, rb := n.rows[i], n.rows[j]
+	for _, k := range n.ordering {
+		var da, db parser.Datum
+		if k < 0 {
+			da, db = rb[-k-1], ra[-k-1]
+		} else {
+			da, db = ra[k-1], rb[k-1]
+		}
+		if c := da.Compare(db); c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
+
+func (n *valuesNode) Swap(i, j int) {
+	n.rows[i], n.rows[j] = n.rows[j], n.rows[i]
+}
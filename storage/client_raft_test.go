@@ -18,6 +18,7 @@
 package storage_test
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"strings"
@@ -256,6 +257,52 @@ func TestReplicateRange(t *testing.T) {
 	})
 }
 
+// TestReplicateLargeSnapshot verifies that a range whose data exceeds the
+// snapshot chunk size is streamed to a new replica in several chunks.
+func TestReplicateLargeSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 2)
+	defer mtc.Stop()
+
+	// Write enough data to require several chunks.
+	const numKeys = 4
+	value := bytes.Repeat([]byte("v"), 200<<10)
+	for i := 0; i < numKeys; i++ {
+		args := putArgs([]byte(fmt.Sprintf("a%d", i)), value, 1, mtc.stores[0].StoreID())
+		if _, err := mtc.stores[0].ExecuteCmd(context.Background(), &args); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rng, err := mtc.stores[0].GetReplica(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.ChangeReplicas(proto.ADD_REPLICA,
+		proto.Replica{
+			NodeID:  mtc.stores[1].Ident.NodeID,
+			StoreID: mtc.stores[1].Ident.StoreID,
+		}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify that all of the data is available on the new replica.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		for i := 0; i < numKeys; i++ {
+			getArgs := getArgs([]byte(fmt.Sprintf("a%d", i)), 1, mtc.stores[1].StoreID())
+			getArgs.ReadConsistency = proto.INCONSISTENT
+			reply, err := mtc.stores[1].ExecuteCmd(context.Background(), &getArgs)
+			if err != nil {
+				return util.Errorf("failed to read data: %s", err)
+			}
+			if v := reply.(*proto.GetResponse).Value; v == nil || !bytes.Equal(v.Bytes, value) {
+				return util.Errorf("failed to read correct data for key %d", i)
+			}
+		}
+		return nil
+	})
+}
+
 // TestRestoreReplicas ensures that consensus group membership is properly
 // persisted to disk and restored when a node is stopped and restarted.
 func TestRestoreReplicas(t *testing.T) {
@@ -863,3 +910,166 @@ func TestRaftAfterRemoveRange(t *testing.T) {
 	// Execute another replica change to ensure that MultiRaft has processed the heartbeat just sent.
 	mtc.replicateRange(proto.RangeID(1), 0, 1)
 }
+
+// TestReplicaConsistencyCheck verifies that the consistency checker
+// detects a replica whose data has diverged from the leader's and
+// reports the divergent key on the replica's store event feed.
+func TestReplicaConsistencyCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	var mu sync.Mutex
+	var events []*storage.ReplicaInconsistencyEvent
+	feed := util.NewFeed(stopper)
+	feed.Subscribe(func(event interface{}) {
+		if e, ok := event.(*storage.ReplicaInconsistencyEvent); ok {
+			mu.Lock()
+			events = append(events, e)
+			mu.Unlock()
+		}
+	})
+
+	mtc := &multiTestContext{feed: feed}
+	mtc.Start(t, 3)
+	defer mtc.Stop()
+
+	rangeID := proto.RangeID(1)
+	mtc.replicateRange(rangeID, 0, 1, 2)
+
+	if _, err := mtc.db.Inc("a", 5); err != nil {
+		t.Fatal(err)
+	}
+	rng, err := mtc.stores[0].GetReplica(rangeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Consistent replicas don't report anything.
+	if err := rng.CheckConsistency(); err != nil {
+		t.Fatal(err)
+	}
+	feed.Flush()
+	mu.Lock()
+	if len(events) != 0 {
+		t.Fatalf("expected no inconsistencies; got %+v", events)
+	}
+	mu.Unlock()
+
+	// Corrupt the data of the third replica behind raft's back.
+	key := proto.Key("corrupt")
+	if err := engine.MVCCPut(mtc.engines[2], nil, key, mtc.clock.Now(), proto.Value{Bytes: []byte("x")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.CheckConsistency(); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		feed.Flush()
+		mu.Lock()
+		defer mu.Unlock()
+		if len(events) == 0 {
+			return util.Errorf("expected an inconsistency to be reported")
+		}
+		return nil
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 1 {
+		t.Fatalf("expected a single inconsistency; got %+v", events)
+	}
+	e := events[0]
+	if e.StoreID != mtc.stores[2].StoreID() || e.RangeID != rangeID {
+		t.Errorf("expected inconsistency of range %d on store %d; got %+v", rangeID, mtc.stores[2].StoreID(), e)
+	}
+	// The diff contains the key's MVCC metadata and its value, both
+	// present only on the replica.
+	if len(e.Diff) != 2 {
+		t.Fatalf("expected two divergent entries; got %+v", e.Diff)
+	}
+	for _, d := range e.Diff {
+		if d.Leader || !d.Key.Equal(key) {
+			t.Errorf("expected diff of key %q on the replica; got %+v", key, d)
+		}
+	}
+}
+
+// TestQuarantineReplica verifies that a quarantined replica is replaced
+// with a healthy replica on another store, then removed from its range
+// and destroyed.
+func TestQuarantineReplica(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stopper := stop.NewStopper()
+	defer stopper.Stop()
+
+	var mu sync.Mutex
+	var events []*storage.QuarantineRangeEvent
+	feed := util.NewFeed(stopper)
+	feed.Subscribe(func(event interface{}) {
+		if e, ok := event.(*storage.QuarantineRangeEvent); ok {
+			mu.Lock()
+			events = append(events, e)
+			mu.Unlock()
+		}
+	})
+
+	mtc := &multiTestContext{feed: feed}
+	mtc.Start(t, 4)
+	defer mtc.Stop()
+
+	rangeID := proto.RangeID(1)
+	mtc.replicateRange(rangeID, 0, 1, 2)
+
+	// Initialize the gossip network so that a replacement can be allocated.
+	var wg sync.WaitGroup
+	wg.Add(len(mtc.stores))
+	key := gossip.MakePrefixPattern(gossip.KeyCapacityPrefix)
+	mtc.stores[0].Gossip().RegisterCallback(key, func(_ string, _ bool) { wg.Done() })
+	for _, s := range mtc.stores {
+		s.GossipCapacity()
+	}
+	wg.Wait()
+
+	rng, err := mtc.stores[2].GetReplica(rangeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng.Quarantine(util.Errorf("corrupted"))
+	if !rng.IsQuarantined() {
+		t.Fatal("expected replica to be quarantined")
+	}
+
+	// The quarantined replica is replaced by one on the fourth store and
+	// destroyed.
+	util.SucceedsWithin(t, time.Second, func() error {
+		if _, err := mtc.stores[3].GetReplica(rangeID); err != nil {
+			return err
+		}
+		if mtc.stores[2].LookupReplica(proto.KeyMin, nil) != nil {
+			return util.Errorf("quarantined replica still exists")
+		}
+		feed.Flush()
+		mu.Lock()
+		defer mu.Unlock()
+		if len(events) == 0 {
+			return util.Errorf("expected a quarantine event")
+		}
+		return nil
+	})
+
+	desc := mtc.stores[0].LookupReplica(proto.KeyMin, nil).Desc()
+	var storeIDs []proto.StoreID
+	for _, rep := range desc.Replicas {
+		storeIDs = append(storeIDs, rep.StoreID)
+	}
+	if expected := []proto.StoreID{1, 2, 4}; !reflect.DeepEqual(expected, storeIDs) {
+		t.Errorf("expected range to be replicated to stores %v; got %v", expected, storeIDs)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if e := events[0]; e.StoreID != mtc.stores[2].StoreID() || e.Removed.Desc.RangeID != rangeID {
+		t.Errorf("expected quarantine of range %d on store %d; got %+v", rangeID, mtc.stores[2].StoreID(), e)
+	}
+}
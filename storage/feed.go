@@ -56,6 +56,15 @@ type RemoveRangeEvent struct {
 	Stats   engine.MVCCStats
 }
 
+// QuarantineRangeEvent occurs when a quarantined replica has been
+// replaced and its data destroyed. This event contains a RemoveRangeEvent
+// for the destroyed replica along with the reason it was quarantined.
+type QuarantineRangeEvent struct {
+	StoreID proto.StoreID
+	Removed RemoveRangeEvent
+	Cause   string
+}
+
 // SplitRangeEvent occurs whenever a range is split in two. This Event actually
 // contains two other events: an UpdateRangeEvent for the Range which
 // originally existed, and a RegisterRangeEvent for the range created via
@@ -106,6 +115,20 @@ type ReplicationStatusEvent struct {
 	LeaderRangeCount     int32
 	ReplicatedRangeCount int32
 	AvailableRangeCount  int32
+
+	// RaftLogSize is the total size in bytes of the raft logs of the
+	// ranges in the store.
+	RaftLogSize int64
+}
+
+// ReplicaInconsistencyEvent occurs when the consistency checker finds
+// that the data of a replica on the store differs from the data of the
+// range's leader. Diff contains the divergent key/value pairs.
+type ReplicaInconsistencyEvent struct {
+	StoreID    proto.StoreID
+	RangeID    proto.RangeID
+	DetectedAt int64
+	Diff       []ReplicaSnapshotDiff
 }
 
 // BeginScanRangesEvent occurs when the store is about to scan over all ranges.
@@ -159,6 +182,19 @@ func (sef StoreEventFeed) removeRange(rng *Replica) {
 	sef.f.Publish(makeRemoveRangeEvent(sef.id, rng))
 }
 
+// quarantineRange publishes a QuarantineRangeEvent to this feed which
+// describes the destruction of the supplied quarantined Range.
+func (sef StoreEventFeed) quarantineRange(rng *Replica, cause error) {
+	sef.f.Publish(&QuarantineRangeEvent{
+		StoreID: sef.id,
+		Removed: RemoveRangeEvent{
+			Desc:  rng.Desc(),
+			Stats: rng.stats.GetMVCC(),
+		},
+		Cause: cause.Error(),
+	})
+}
+
 // splitRange publishes a SplitRangeEvent to this feed which describes a split
 // involving the supplied Ranges.
 func (sef StoreEventFeed) splitRange(rngOrig, rngNew *Replica) {
@@ -187,12 +223,23 @@ func (sef StoreEventFeed) storeStatus(desc *proto.StoreDescriptor) {
 }
 
 // replicationStatus publishes a ReplicationStatusEvent to this feed.
-func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int32) {
+func (sef StoreEventFeed) replicationStatus(leaders, replicated, available int32, raftLogSize int64) {
 	sef.f.Publish(&ReplicationStatusEvent{
 		StoreID:              sef.id,
 		LeaderRangeCount:     leaders,
 		ReplicatedRangeCount: replicated,
 		AvailableRangeCount:  available,
+		RaftLogSize:          raftLogSize,
+	})
+}
+
+// replicaInconsistency publishes a ReplicaInconsistencyEvent to this feed.
+func (sef StoreEventFeed) replicaInconsistency(rangeID proto.RangeID, detectedAt int64, diff []ReplicaSnapshotDiff) {
+	sef.f.Publish(&ReplicaInconsistencyEvent{
+		StoreID:    sef.id,
+		RangeID:    rangeID,
+		DetectedAt: detectedAt,
+		Diff:       diff,
 	})
 }
 
@@ -212,6 +259,7 @@ type StoreEventListener interface {
 	OnRegisterRange(event *RegisterRangeEvent)
 	OnUpdateRange(event *UpdateRangeEvent)
 	OnRemoveRange(event *RemoveRangeEvent)
+	OnQuarantineRange(event *QuarantineRangeEvent)
 	OnSplitRange(event *SplitRangeEvent)
 	OnMergeRange(event *MergeRangeEvent)
 	OnStartStore(event *StartStoreEvent)
@@ -219,6 +267,7 @@ type StoreEventListener interface {
 	OnEndScanRanges(event *EndScanRangesEvent)
 	OnStoreStatus(event *StoreStatusEvent)
 	OnReplicationStatus(event *ReplicationStatusEvent)
+	OnReplicaInconsistency(event *ReplicaInconsistencyEvent)
 }
 
 // ProcessStoreEvent dispatches an event on the StoreEventListener.
@@ -232,6 +281,8 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnUpdateRange(specificEvent)
 	case *RemoveRangeEvent:
 		l.OnRemoveRange(specificEvent)
+	case *QuarantineRangeEvent:
+		l.OnQuarantineRange(specificEvent)
 	case *SplitRangeEvent:
 		l.OnSplitRange(specificEvent)
 	case *MergeRangeEvent:
@@ -244,6 +295,8 @@ func ProcessStoreEvent(l StoreEventListener, event interface{}) {
 		l.OnStoreStatus(specificEvent)
 	case *ReplicationStatusEvent:
 		l.OnReplicationStatus(specificEvent)
+	case *ReplicaInconsistencyEvent:
+		l.OnReplicaInconsistency(specificEvent)
 	}
 }
 
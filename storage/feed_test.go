@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -161,6 +162,29 @@ func TestStoreEventFeed(t *testing.T) {
 				},
 			},
 		},
+		{
+			"QuarantineRange",
+			func(feed StoreEventFeed) {
+				feed.quarantineRange(rng2, errors.New("corrupted"))
+			},
+			&QuarantineRangeEvent{
+				StoreID: proto.StoreID(1),
+				Removed: RemoveRangeEvent{
+					Desc: &proto.RangeDescriptor{
+						RangeID:  2,
+						StartKey: proto.Key("b"),
+						EndKey:   proto.Key("c"),
+					},
+					Stats: engine.MVCCStats{
+						LiveBytes:       200,
+						KeyBytes:        30,
+						ValBytes:        170,
+						LastUpdateNanos: 20 * 1E9,
+					},
+				},
+				Cause: "corrupted",
+			},
+		},
 		{
 			"SplitRange",
 			func(feed StoreEventFeed) {
@@ -255,13 +279,14 @@ func TestStoreEventFeed(t *testing.T) {
 		{
 			"ReplicationStatus",
 			func(feed StoreEventFeed) {
-				feed.replicationStatus(3, 2, 1)
+				feed.replicationStatus(3, 2, 1, 100)
 			},
 			&ReplicationStatusEvent{
 				StoreID:              proto.StoreID(1),
 				LeaderRangeCount:     3,
 				ReplicatedRangeCount: 2,
 				AvailableRangeCount:  1,
+				RaftLogSize:          100,
 			},
 		},
 		{
@@ -0,0 +1,113 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// quarantineQueueMaxSize is the max size of the quarantine queue.
+	quarantineQueueMaxSize = 100
+
+	// quarantineQueueTimerDuration is the duration between replacements of
+	// queued replicas.
+	quarantineQueueTimerDuration = 0 // zero duration to replace replicas greedily
+)
+
+// quarantineQueue manages a queue of quarantined replicas, which it
+// replaces with healthy ones. Unlike the replicate queue, it processes
+// replicas which don't hold the leader lease: a quarantined replica never
+// does, and changes the membership of its range itself.
+type quarantineQueue struct {
+	*baseQueue
+	gossip    *gossip.Gossip
+	allocator *allocator
+}
+
+// newQuarantineQueue returns a new instance of quarantineQueue.
+func newQuarantineQueue(gossip *gossip.Gossip, allocator *allocator) *quarantineQueue {
+	qq := &quarantineQueue{
+		gossip:    gossip,
+		allocator: allocator,
+	}
+	qq.baseQueue = newBaseQueue("quarantine", qq, quarantineQueueMaxSize)
+	return qq
+}
+
+func (qq *quarantineQueue) needsLeaderLease() bool {
+	return false
+}
+
+func (qq *quarantineQueue) shouldQueue(now proto.Timestamp, repl *Replica) (bool, float64) {
+	return repl.IsQuarantined(), 0
+}
+
+// process adds a replacement for the quarantined replica to the range and
+// then removes the quarantined replica from it. The replacement is added
+// first so that the range doesn't lose a replica in the meantime; the
+// quarantined replica is requeued once it applies the addition, and is
+// removed on that pass. If no target is available, the quarantined replica
+// is removed regardless since its data can't be trusted. Once removed, the
+// replica is queued for destruction by the range GC queue.
+func (qq *quarantineQueue) process(now proto.Timestamp, repl *Replica) error {
+	if !repl.IsQuarantined() {
+		return nil
+	}
+	me := repl.GetReplica()
+	if me == nil {
+		// The replica has already been removed from the range.
+		return repl.rm.rangeGCQueue().Add(repl, 1.0)
+	}
+
+	// The sole replica of a range can't be replaced.
+	if len(repl.Desc().Replicas) == 1 {
+		return util.Errorf("%s: cannot replace the only replica of the range", repl)
+	}
+
+	zone, err := lookupZoneConfig(qq.gossip, repl)
+	if err != nil {
+		return err
+	}
+	// Not counting the quarantined replica, the range may already have
+	// been replenished by an earlier attempt.
+	if desc := repl.Desc(); len(desc.Replicas)-1 < len(zone.ReplicaAttrs) {
+		// The quarantined replica's store is excluded as a target since it
+		// is still a member of the range.
+		newReplica, err := qq.allocator.AllocateTarget(zone.ReplicaAttrs[0], desc.Replicas, true)
+		if err == nil {
+			return repl.ChangeReplicas(proto.ADD_REPLICA, proto.Replica{
+				NodeID:  newReplica.Node.NodeID,
+				StoreID: newReplica.StoreID,
+			})
+		}
+		log.Warningf("unable to allocate replacement for quarantined replica of %s: %s", repl, err)
+	}
+
+	if err := repl.ChangeReplicas(proto.REMOVE_REPLICA, *me); err != nil {
+		return err
+	}
+	return repl.rm.rangeGCQueue().Add(repl, 1.0)
+}
+
+func (qq *quarantineQueue) timer() time.Duration {
+	return quarantineQueueTimerDuration
+}
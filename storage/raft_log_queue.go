@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/coreos/etcd/raft"
+)
+
+const (
+	// raftLogQueueMaxSize is the max size of the raft log queue.
+	raftLogQueueMaxSize = 100
+	// raftLogQueueTimerDuration is the duration between truncations of
+	// queued ranges.
+	raftLogQueueTimerDuration = 0 // zero duration to truncate greedily.
+	// RaftLogQueueStaleThreshold is the minimum number of truncatable raft
+	// log entries before a range is queued. Exported for testing.
+	RaftLogQueueStaleThreshold = 100
+	// RaftLogMaxLag is the number of entries a follower may fall behind the
+	// leader's applied index before it is no longer considered when
+	// choosing the truncation index. Such followers are caught up with a
+	// snapshot instead of holding the log open. Exported for testing.
+	RaftLogMaxLag = 10000
+)
+
+// raftStatusFn should return the raft status of the range with the
+// given ID, or nil if the range is unknown to raft.
+type raftStatusFn func(proto.RangeID) *raft.Status
+
+// raftLogQueue manages a queue of ranges slated to have their raft
+// logs truncated.
+type raftLogQueue struct {
+	*baseQueue
+	statusFn raftStatusFn
+}
+
+// newRaftLogQueue returns a new instance of raftLogQueue.
+func newRaftLogQueue(statusFn raftStatusFn) *raftLogQueue {
+	rlq := &raftLogQueue{statusFn: statusFn}
+	rlq.baseQueue = newBaseQueue("raftlog", rlq, raftLogQueueMaxSize)
+	return rlq
+}
+
+func (rlq *raftLogQueue) needsLeaderLease() bool {
+	return true
+}
+
+// getTruncatableIndexes returns the number of raft log entries which
+// can be truncated for the range and the index of the oldest entry
+// which must be kept. Only the raft leader knows the progress of the
+// followers, so nothing is truncatable on other replicas.
+func (rlq *raftLogQueue) getTruncatableIndexes(rng *Replica) (uint64, uint64, error) {
+	raftStatus := rlq.statusFn(rng.Desc().RangeID)
+	if raftStatus == nil || raftStatus.SoftState.RaftState != raft.StateLeader {
+		return 0, 0, nil
+	}
+	firstIndex, err := rng.FirstIndex()
+	if err != nil {
+		return 0, 0, err
+	}
+	oldestIndex := computeTruncatableIndex(raftStatus, firstIndex)
+	return oldestIndex - firstIndex, oldestIndex, nil
+}
+
+// computeTruncatableIndex returns the oldest raft log index which must
+// be kept given the leader's raft status. This is the lowest index
+// matched by any follower, excluding followers which are awaiting a
+// snapshot or have fallen more than RaftLogMaxLag entries behind the
+// applied index; those will receive a snapshot instead. The result is
+// never less than firstIndex.
+func computeTruncatableIndex(raftStatus *raft.Status, firstIndex uint64) uint64 {
+	oldestIndex := raftStatus.Applied
+	for _, progress := range raftStatus.Progress {
+		if progress.State == raft.ProgressStateSnapshot {
+			continue
+		}
+		if progress.Match+RaftLogMaxLag < raftStatus.Applied {
+			continue
+		}
+		if progress.Match < oldestIndex {
+			oldestIndex = progress.Match
+		}
+	}
+	if oldestIndex < firstIndex {
+		return firstIndex
+	}
+	return oldestIndex
+}
+
+// shouldQueue determines whether a range should be queued for
+// truncating. This is true if the number of truncatable entries
+// reaches RaftLogQueueStaleThreshold. The priority is the number of
+// truncatable entries.
+func (rlq *raftLogQueue) shouldQueue(now proto.Timestamp, rng *Replica) (shouldQ bool, priority float64) {
+	truncatableIndexes, _, err := rlq.getTruncatableIndexes(rng)
+	if err != nil {
+		log.Warning(err)
+		return
+	}
+	return truncatableIndexes >= RaftLogQueueStaleThreshold, float64(truncatableIndexes)
+}
+
+// process truncates the raft log of the range up to, but not
+// including, the oldest index still needed by a follower.
+func (rlq *raftLogQueue) process(now proto.Timestamp, rng *Replica) error {
+	truncatableIndexes, oldestIndex, err := rlq.getTruncatableIndexes(rng)
+	if err != nil {
+		return err
+	}
+	// Recheck in case the followers' progress changed since queueing.
+	if truncatableIndexes < RaftLogQueueStaleThreshold {
+		return nil
+	}
+	if log.V(1) {
+		log.Infof("truncating the raft log of %s to %d", rng, oldestIndex)
+	}
+	_, err = rng.AddCmd(rng.context(), &proto.TruncateLogRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     rng.Desc().StartKey,
+			RangeID: rng.Desc().RangeID,
+		},
+		Index: oldestIndex,
+	})
+	return err
+}
+
+// timer returns interval between processing successive queued truncations.
+func (rlq *raftLogQueue) timer() time.Duration {
+	return raftLogQueueTimerDuration
+}
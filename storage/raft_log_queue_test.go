@@ -0,0 +1,116 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/coreos/etcd/raft"
+)
+
+// TestComputeTruncatableIndex verifies that the truncation index is
+// bounded by the progress of the followers, ignoring followers which
+// are awaiting a snapshot or have fallen too far behind.
+func TestComputeTruncatableIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	const applied = RaftLogMaxLag * 2
+	testCases := []struct {
+		progress   []raft.Progress
+		firstIndex uint64
+		expected   uint64
+	}{
+		// No followers: everything applied can be truncated.
+		{nil, 1, applied},
+		// All followers are caught up.
+		{[]raft.Progress{{Match: applied}, {Match: applied}}, 1, applied},
+		// A slow follower holds the log open.
+		{[]raft.Progress{{Match: applied}, {Match: applied - 10}}, 1, applied - 10},
+		// A follower behind by more than the max lag is ignored.
+		{[]raft.Progress{{Match: applied}, {Match: applied - RaftLogMaxLag - 1}}, 1, applied},
+		// A follower awaiting a snapshot is ignored.
+		{[]raft.Progress{{Match: applied}, {Match: applied - 10, State: raft.ProgressStateSnapshot}}, 1, applied},
+		// The result is never below the first index.
+		{[]raft.Progress{{Match: applied - 10}}, applied, applied},
+	}
+	for i, test := range testCases {
+		status := &raft.Status{Applied: applied, Progress: map[uint64]raft.Progress{}}
+		for j, p := range test.progress {
+			status.Progress[uint64(j+1)] = p
+		}
+		if index := computeTruncatableIndex(status, test.firstIndex); index != test.expected {
+			t.Errorf("%d: expected truncatable index %d; got %d", i, test.expected, index)
+		}
+	}
+}
+
+// TestRaftLogQueue verifies that the raft log queue truncates the log
+// of a range once enough entries have accumulated, and that the size
+// of the raft log shrinks accordingly.
+func TestRaftLogQueue(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Populate the log with enough entries to be truncatable.
+	for i := 0; i < RaftLogQueueStaleThreshold+1; i++ {
+		args := incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
+		if _, err := tc.rng.AddCmd(tc.rng.context(), &args); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldFirstIndex, err := tc.rng.FirstIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldSize := tc.rng.raftLogSize()
+
+	rlq := newRaftLogQueue(tc.store.RaftStatus)
+	now := tc.clock.Now()
+	if shouldQ, priority := rlq.shouldQueue(now, tc.rng); !shouldQ || priority < RaftLogQueueStaleThreshold {
+		t.Fatalf("expected range to be queued; got shouldQ=%t priority=%f", shouldQ, priority)
+	}
+	if err := rlq.process(now, tc.rng); err != nil {
+		t.Fatal(err)
+	}
+
+	newFirstIndex, err := tc.rng.FirstIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newFirstIndex <= oldFirstIndex+RaftLogQueueStaleThreshold {
+		t.Errorf("expected first index to advance beyond %d; got %d",
+			oldFirstIndex+RaftLogQueueStaleThreshold, newFirstIndex)
+	}
+	newSize := tc.rng.raftLogSize()
+	if newSize >= oldSize {
+		t.Errorf("expected raft log size to shrink from %d; got %d", oldSize, newSize)
+	}
+	// The size maintained as entries are appended and truncated matches the
+	// size of the entries remaining in the log.
+	if size, err := tc.rng.computeRaftLogSize(tc.store.Engine()); err != nil {
+		t.Fatal(err)
+	} else if size != newSize {
+		t.Errorf("expected raft log size %d; got %d", size, newSize)
+	}
+
+	// Nothing more is truncatable.
+	if shouldQ, _ := rlq.shouldQueue(now, tc.rng); shouldQ {
+		t.Errorf("expected range not to be queued after truncation")
+	}
+}
@@ -122,6 +122,11 @@ func (q *rangeGCQueue) process(now proto.Timestamp, rng *Replica) error {
 		if err := rng.Destroy(); err != nil {
 			return err
 		}
+		if cause := rng.getQuarantineCause(); cause != nil {
+			rng.rm.EventFeed().quarantineRange(rng, cause)
+		} else {
+			rng.rm.EventFeed().removeRange(rng)
+		}
 	} else if desc.RangeID != rng.Desc().RangeID {
 		// If we get a different  range ID back, then the range has been merged
 		// away. But currentMember is true, so we are still a member of the
@@ -164,6 +164,7 @@ type rangeManager interface {
 	allocator() *allocator
 	Gossip() *gossip.Gossip
 	splitQueue() *splitQueue
+	quarantineQueue() *quarantineQueue
 	rangeGCQueue() *rangeGCQueue
 	Stopper() *stop.Stopper
 	EventFeed() StoreEventFeed
@@ -200,15 +201,25 @@ type Replica struct {
 	lastIndex uint64
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
+	// Size in bytes of the entries in the raft log. Updated atomically.
+	logSize int64
+	// Set to 1 once the replica has been quarantined. Updated atomically.
+	quarantined  int32
 	configHashes map[int][]byte // Config map sha256 hashes @ last gossip
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
 	respCache    *ResponseCache // Provides idempotence for retries
 
+	snapMu      sync.Mutex        // Protects the following fields:
+	outSnapshot *outgoingSnapshot // Engine snapshot retained for streaming
+	inSnapshot  *incomingSnapshot // Streamed snapshot being received
+
 	sync.RWMutex                 // Protects the following fields:
 	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
 	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
 	pendingCmds  map[cmdIDKey]*pendingCmd
+	checksum     *replicaChecksum // Most recent consistency checksum
+	corruption   error            // Reason the replica was quarantined
 }
 
 // NewReplica initializes the replica using the given metadata.
@@ -228,6 +239,12 @@ func NewReplica(desc *proto.RangeDescriptor, rm rangeManager) (*Replica, error)
 	}
 	atomic.StoreUint64(&r.lastIndex, lastIndex)
 
+	raftLogSize, err := r.computeRaftLogSize(r.rm.Engine())
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt64(&r.logSize, raftLogSize)
+
 	appliedIndex, err := r.loadAppliedIndex(r.rm.Engine())
 	if err != nil {
 		return nil, err
@@ -260,6 +277,7 @@ func (r *Replica) String() string {
 
 // Destroy cleans up all data associated with this range.
 func (r *Replica) Destroy() error {
+	r.releaseOutgoingSnapshot()
 	iter := newRangeDataIterator(r.Desc(), r.rm.Engine())
 	defer iter.Close()
 	batch := r.rm.Engine().NewBatch()
@@ -267,6 +285,10 @@ func (r *Replica) Destroy() error {
 	for ; iter.Valid(); iter.Next() {
 		_ = batch.Clear(iter.Key())
 	}
+	// Also clear the chunks of any snapshot which was being received.
+	if err := clearStagedSnapshot(r.rm.Engine(), batch, r.Desc().RangeID); err != nil {
+		return err
+	}
 	return batch.Commit()
 }
 
@@ -325,6 +347,9 @@ func (r *Replica) newNotLeaderError(l *proto.Lease, originNode proto.RaftNodeID)
 // this replica. Unless an error is returned, the obtained lease will be valid
 // for a time interval containing the requested timestamp.
 func (r *Replica) requestLeaderLease(timestamp proto.Timestamp) error {
+	if r.IsQuarantined() {
+		return util.Errorf("%s: cannot acquire leader lease for quarantined replica", r)
+	}
 	// TODO(Tobias): get duration from configuration, either as a config flag
 	// or, later, dynamically adjusted.
 	duration := int64(DefaultLeaderLeaseDuration)
@@ -380,6 +405,15 @@ func (r *Replica) redirectOnOrAcquireLeaderLease(trace *tracer.Trace, timestamp
 
 	raftNodeID := r.rm.RaftNodeID()
 
+	// A quarantined replica neither holds nor acquires the lease; redirect
+	// to the current holder, if any.
+	if r.IsQuarantined() {
+		if lease := r.getLease(); lease.Covers(timestamp) && !lease.OwnedBy(raftNodeID) {
+			return r.newNotLeaderError(lease, raftNodeID)
+		}
+		return r.newNotLeaderError(nil, raftNodeID)
+	}
+
 	if lease := r.getLease(); lease.Covers(timestamp) {
 		if lease.OwnedBy(raftNodeID) {
 			// Happy path: We have an active lease, nothing to do.
@@ -611,6 +645,12 @@ func (r *Replica) addReadOnlyCmd(ctx context.Context, args proto.Request) (proto
 		return nil, err
 	}
 
+	// A quarantined replica's data can't be trusted; send the client
+	// elsewhere.
+	if r.IsQuarantined() {
+		return nil, r.newNotLeaderError(nil, r.rm.RaftNodeID())
+	}
+
 	// If read-consistency is set to INCONSISTENT, run directly.
 	if header.ReadConsistency == proto.INCONSISTENT {
 		// But disallow any inconsistent reads within txns.
@@ -1166,6 +1206,86 @@ func (r *Replica) maybeSetCorrupt(err error) error {
 	return err
 }
 
+// IsQuarantined returns true if the replica has been quarantined.
+func (r *Replica) IsQuarantined() bool {
+	return atomic.LoadInt32(&r.quarantined) == 1
+}
+
+// Quarantine marks the replica as corrupted, for instance after the
+// verify queue failed to read its data. A quarantined replica stops
+// serving reads and doesn't acquire the leader lease; a lease it holds is
+// cut short so that another replica can take over. It is handed to the
+// quarantine queue, which replaces it with a healthy replica and removes
+// it from the raft group, after which the range GC queue destroys it.
+// Subsequent calls are no-ops.
+func (r *Replica) Quarantine(cause error) {
+	if !atomic.CompareAndSwapInt32(&r.quarantined, 0, 1) {
+		return
+	}
+	log.Errorc(r.context(), "quarantining replica: %s", cause)
+	r.Lock()
+	r.corruption = cause
+	r.Unlock()
+	if !r.rm.Stopper().RunAsyncTask(func() {
+		if err := r.expireLeaderLease(); err != nil {
+			log.Errorc(r.context(), "unable to expire leader lease of quarantined replica: %s", err)
+		}
+	}) {
+		log.Errorc(r.context(), "unable to expire leader lease of quarantined replica: stopping")
+	}
+	if err := r.rm.quarantineQueue().Add(r, 1.0); err != nil {
+		// The replica will be picked up again by the next scan.
+		log.Errorc(r.context(), "unable to add quarantined replica to quarantine queue: %s", err)
+	}
+}
+
+// expireLeaderLease shortens the leader lease, if held by this replica,
+// to expire now so that another replica can acquire it without waiting
+// for the lease to run out.
+func (r *Replica) expireLeaderLease() error {
+	r.llMu.Lock()
+	defer r.llMu.Unlock()
+
+	now := r.rm.Clock().Now()
+	lease := r.getLease()
+	if !lease.OwnedBy(r.rm.RaftNodeID()) || !lease.Covers(now) {
+		return nil
+	}
+	expiration := now
+	if !lease.Start.Less(expiration) {
+		expiration = lease.Start.Next()
+	}
+	args := &proto.LeaderLeaseRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       r.Desc().StartKey,
+			Timestamp: now,
+			CmdID: proto.ClientCmdID{
+				WallTime: now.WallTime,
+				Random:   rand.Int63(),
+			},
+			RangeID: r.Desc().RangeID,
+		},
+		Lease: proto.Lease{
+			Start:      lease.Start,
+			Expiration: expiration,
+			RaftNodeID: lease.RaftNodeID,
+		},
+	}
+	errChan, pendingCmd := r.proposeRaftCommand(r.context(), args)
+	if err := <-errChan; err != nil {
+		return err
+	}
+	return (<-pendingCmd.done).Err
+}
+
+// getQuarantineCause returns the reason the replica was quarantined, or
+// nil if it isn't quarantined.
+func (r *Replica) getQuarantineCause() error {
+	r.RLock()
+	defer r.RUnlock()
+	return r.corruption
+}
+
 // resolveIntents resolves the given intents. For those which are local to the
 // range, we submit directly to the range-local Raft instance; the call returns
 // as soon as all resolve commands have been **proposed** (not executed). This
@@ -132,6 +132,22 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, args pro
 		var resp proto.LeaderLeaseResponse
 		resp, err = r.LeaderLease(batch, ms, *tArgs)
 		reply = &resp
+	case *proto.ComputeChecksumRequest:
+		var resp proto.ComputeChecksumResponse
+		resp, err = r.ComputeChecksum(batch, ms, *tArgs)
+		reply = &resp
+	case *proto.VerifyChecksumRequest:
+		var resp proto.VerifyChecksumResponse
+		resp, err = r.VerifyChecksum(batch, ms, *tArgs)
+		reply = &resp
+	case *proto.ChecksumSnapshotRequest:
+		var resp proto.ChecksumSnapshotResponse
+		resp, err = r.ChecksumSnapshot(batch, *tArgs)
+		reply = &resp
+	case *proto.ExportRequest:
+		var resp proto.ExportResponse
+		resp, intents, err = r.Export(batch, *tArgs)
+		reply = &resp
 	default:
 		err = util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -242,6 +258,28 @@ func (r *Replica) ReverseScan(batch engine.Engine, args proto.ReverseScanRequest
 	return reply, intents, err
 }
 
+// Export returns the values of the keys in the key range specified by
+// start key through end key as of the request's timestamp, up to the
+// maximum number of results. If the maximum is reached, the key following
+// the last exported one is returned, from which the export resumes. Export
+// iterates the range's data directly; it is intended for backups, which
+// export one range at a time.
+func (r *Replica) Export(batch engine.Engine, args proto.ExportRequest) (proto.ExportResponse, []proto.Intent, error) {
+	var reply proto.ExportResponse
+
+	intents, err := engine.MVCCIterate(batch, args.Key, args.EndKey, args.Timestamp,
+		args.ReadConsistency == proto.CONSISTENT, args.Txn, false, /* !reverse */
+		func(kv proto.KeyValue) (bool, error) {
+			reply.Rows = append(reply.Rows, kv)
+			if args.MaxResults > 0 && int64(len(reply.Rows)) == args.MaxResults {
+				reply.ResumeKey = kv.Key.Next()
+				return true, nil
+			}
+			return false, nil
+		})
+	return reply, intents, err
+}
+
 // EndTransaction either commits or aborts (rolls back) an extant
 // transaction according to the args.Commit parameter.
 func (r *Replica) EndTransaction(batch engine.Engine, ms *engine.MVCCStats, args proto.EndTransactionRequest) (proto.EndTransactionResponse, []proto.Intent, error) {
@@ -908,11 +946,16 @@ func (r *Replica) TruncateLog(batch engine.Engine, ms *engine.MVCCStats, args pr
 	}
 	start := keys.RaftLogKey(r.Desc().RangeID, 0)
 	end := keys.RaftLogKey(r.Desc().RangeID, args.Index)
+	var truncatedSize int64
 	if err = batch.Iterate(engine.MVCCEncodeKey(start), engine.MVCCEncodeKey(end), func(kv proto.RawKeyValue) (bool, error) {
+		truncatedSize += int64(len(kv.Key) + len(kv.Value))
 		return false, batch.Clear(kv.Key)
 	}); err != nil {
 		return reply, err
 	}
+	batch.Defer(func() {
+		atomic.AddInt64(&r.logSize, -truncatedSize)
+	})
 	ts := proto.RaftTruncatedState{
 		Index: args.Index - 1,
 		Term:  term,
@@ -920,6 +963,67 @@ func (r *Replica) TruncateLog(batch engine.Engine, ms *engine.MVCCStats, args pr
 	return reply, engine.MVCCPutProto(batch, ms, keys.RaftTruncatedStateKey(r.Desc().RangeID), proto.ZeroTimestamp, nil, &ts)
 }
 
+// ComputeChecksum takes a snapshot of the engine and starts computing a
+// checksum of the range's data from it. The checksum is computed
+// asynchronously so that hashing the range does not hold up the
+// application of subsequent commands, and is retained, along with the
+// snapshot, until the matching VerifyChecksum command is applied. Since
+// the command is applied at the same log index on every replica,
+// consistent replicas compute identical checksums.
+func (r *Replica) ComputeChecksum(batch engine.Engine, ms *engine.MVCCStats, args proto.ComputeChecksumRequest) (proto.ComputeChecksumResponse, error) {
+	var reply proto.ComputeChecksumResponse
+
+	c := newReplicaChecksum(args.ChecksumID, r.Desc(), r.rm.NewSnapshot())
+	r.setChecksum(c)
+	r.rm.Stopper().RunWorker(func() {
+		r.runChecksum(c)
+	})
+	return reply, nil
+}
+
+// VerifyChecksum hands the leader's checksum to the replica's checksum
+// computed by the matching ComputeChecksum command, which compares the
+// two once it is available. On a mismatch, the replica retrieves the
+// leader's data, diffs it against its own and reports the inconsistency.
+// Replicas which did not compute the checksum, for instance because they
+// were added to the range in the meantime, ignore the command.
+func (r *Replica) VerifyChecksum(batch engine.Engine, ms *engine.MVCCStats, args proto.VerifyChecksumRequest) (proto.VerifyChecksumResponse, error) {
+	var reply proto.VerifyChecksumResponse
+
+	r.RLock()
+	c := r.checksum
+	r.RUnlock()
+	if c == nil || !bytes.Equal(c.id, args.ChecksumID) {
+		return reply, nil
+	}
+	select {
+	case c.verify <- args.Checksum:
+	default:
+	}
+	return reply, nil
+}
+
+// ChecksumSnapshot returns the range data from which the replica computed
+// the checksum with the requested ID. It is served by the leader which
+// initiated the consistency check to replicas whose checksum does not
+// match its own.
+func (r *Replica) ChecksumSnapshot(batch engine.Engine, args proto.ChecksumSnapshotRequest) (proto.ChecksumSnapshotResponse, error) {
+	var reply proto.ChecksumSnapshotResponse
+
+	r.RLock()
+	c := r.checksum
+	r.RUnlock()
+	if c == nil || !bytes.Equal(c.id, args.ChecksumID) {
+		return reply, util.Errorf("%s: snapshot of checksum %x is no longer available", r, args.ChecksumID)
+	}
+	snapData, err := c.snapshotData()
+	if err != nil {
+		return reply, err
+	}
+	reply.Snapshot = snapData
+	return reply, nil
+}
+
 // LeaderLease sets the leader lease for this range. The command fails
 // only if the desired start timestamp collides with a previous lease.
 // Otherwise, the start timestamp is wound back to right after the expiration
@@ -1340,6 +1444,12 @@ func (r *Replica) changeReplicasTrigger(change *proto.ChangeReplicasTrigger) err
 			// will be GC'd eventually.
 			log.Errorf("unable to add range %s to GC queue: %s", r, err)
 		}
+	} else if r.IsQuarantined() {
+		// A quarantined replica is removed once its replacement has been
+		// added; requeue it now that the replacement is visible.
+		if err := r.rm.quarantineQueue().Add(r, 1.0); err != nil {
+			log.Errorf("unable to add quarantined range %s to quarantine queue: %s", r, err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,361 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
+)
+
+const (
+	// maxReplicaSnapshotDiffs is the maximum number of divergent key/value
+	// pairs reported for an inconsistent replica.
+	maxReplicaSnapshotDiffs = 100
+	// replicaChecksumTimeout is the duration for which a replica retains
+	// the snapshot its checksum was computed from while waiting for the
+	// leader's checksum, and for which the leader retains its snapshot
+	// after verification so that inconsistent replicas can retrieve its
+	// data.
+	replicaChecksumTimeout = 5 * time.Minute
+)
+
+// replicaLocalSuffixes are the suffixes of range-ID local keys which
+// each replica maintains independently of raft, and which are
+// therefore excluded from consistency checks.
+var replicaLocalSuffixes = []proto.Key{
+	keys.LocalRaftHardStateSuffix,
+	keys.LocalRaftLastIndexSuffix,
+	keys.LocalRaftLogSuffix,
+	keys.LocalRaftTruncatedStateSuffix,
+	keys.LocalRangeLastVerificationTimestampSuffix,
+}
+
+// replicaChecksum is the checksum computed by a replica for a
+// ComputeChecksum command. The engine snapshot the checksum is computed
+// from is retained until the matching VerifyChecksum command is applied
+// so that divergent keys can be found on a mismatch. The leader which
+// initiated the check retains its snapshot for a while longer so that
+// inconsistent replicas can retrieve its data.
+type replicaChecksum struct {
+	id   []byte
+	desc proto.RangeDescriptor
+	// done is closed once the checksum has been computed. checksum is nil
+	// if the computation failed.
+	done     chan struct{}
+	checksum []byte
+	// verify receives the leader's checksum once the matching
+	// VerifyChecksum command is applied.
+	verify chan []byte
+	// cancel is closed once the checksum is replaced by a newer one.
+	cancel chan struct{}
+	// retain is set to 1 by the leader which initiated the check. Updated
+	// atomically.
+	retain int32
+
+	mu   sync.RWMutex  // Protects the snapshot from being closed while read
+	snap engine.Engine // Nil once released
+}
+
+func newReplicaChecksum(id []byte, desc *proto.RangeDescriptor, snap engine.Engine) *replicaChecksum {
+	return &replicaChecksum{
+		id:     id,
+		desc:   *desc,
+		done:   make(chan struct{}),
+		verify: make(chan []byte, 1),
+		cancel: make(chan struct{}),
+		snap:   snap,
+	}
+}
+
+// snapshotData returns the consistency-checked key/value pairs of the
+// snapshot the checksum was computed from.
+func (c *replicaChecksum) snapshotData() (*proto.RaftSnapshotData, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.snap == nil {
+		return nil, util.Errorf("snapshot of checksum %x is no longer available", c.id)
+	}
+	return rangeSnapshotData(&c.desc, c.snap)
+}
+
+// CheckConsistency verifies that all replicas of the range hold the
+// same data. A ComputeChecksum command is proposed through raft so that
+// every replica computes a checksum at the same applied index. The
+// leader's checksum is then proposed with a VerifyChecksum command;
+// replicas which disagree retrieve the leader's data with a
+// ChecksumSnapshot request, compute a diff against it and report it to
+// their store's event feed.
+func (r *Replica) CheckConsistency() error {
+	desc := r.Desc()
+	id := []byte(uuid.NewUUID4())
+	header := proto.RequestHeader{
+		Key:     desc.StartKey,
+		RangeID: desc.RangeID,
+	}
+	if _, err := r.AddCmd(r.context(), &proto.ComputeChecksumRequest{
+		RequestHeader: header,
+		ChecksumID:    id,
+	}); err != nil {
+		return err
+	}
+
+	// The command has been applied locally, so the checksum is being
+	// computed unless it was since replaced by another consistency check.
+	r.RLock()
+	c := r.checksum
+	r.RUnlock()
+	if c == nil || !bytes.Equal(c.id, id) {
+		return util.Errorf("%s: checksum %x is no longer available", r, id)
+	}
+	<-c.done
+	if c.checksum == nil {
+		return util.Errorf("%s: failed to compute checksum %x", r, id)
+	}
+	// Other replicas may need the leader's data to report divergent keys.
+	if len(desc.Replicas) > 1 {
+		atomic.StoreInt32(&c.retain, 1)
+	}
+
+	_, err := r.AddCmd(r.context(), &proto.VerifyChecksumRequest{
+		RequestHeader: header,
+		ChecksumID:    id,
+		Checksum:      c.checksum,
+	})
+	return err
+}
+
+// setChecksum replaces the replica's retained checksum, cancelling the
+// checksum it replaces.
+func (r *Replica) setChecksum(c *replicaChecksum) {
+	r.Lock()
+	old := r.checksum
+	r.checksum = c
+	r.Unlock()
+	if old != nil {
+		close(old.cancel)
+	}
+}
+
+// runChecksum computes the checksum from its snapshot, waits for the
+// leader's checksum and compares the two, reporting an inconsistency on
+// a mismatch. The snapshot is released once the checksum is verified,
+// replaced or times out; the leader which initiated the check retains it
+// for up to replicaChecksumTimeout after verification.
+func (r *Replica) runChecksum(c *replicaChecksum) {
+	defer r.releaseChecksum(c)
+
+	checksum, err := computeChecksum(&c.desc, c.snap)
+	if err != nil {
+		log.Errorf("%s: failed to compute checksum %x: %s", r, c.id, err)
+	}
+	c.checksum = checksum
+	close(c.done)
+	if err != nil {
+		return
+	}
+
+	stopper := r.rm.Stopper()
+	var leaderChecksum []byte
+	select {
+	case leaderChecksum = <-c.verify:
+	case <-c.cancel:
+		return
+	case <-time.After(replicaChecksumTimeout):
+		return
+	case <-stopper.ShouldStop():
+		return
+	}
+	if !bytes.Equal(checksum, leaderChecksum) {
+		r.reportInconsistency(c, leaderChecksum)
+		return
+	}
+	if atomic.LoadInt32(&c.retain) == 1 {
+		select {
+		case <-c.cancel:
+		case <-time.After(replicaChecksumTimeout):
+		case <-stopper.ShouldStop():
+		}
+	}
+}
+
+// releaseChecksum removes the checksum from the replica if it is still
+// retained and closes its snapshot.
+func (r *Replica) releaseChecksum(c *replicaChecksum) {
+	r.Lock()
+	if r.checksum == c {
+		r.checksum = nil
+	}
+	r.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snap.Close()
+	c.snap = nil
+}
+
+// reportInconsistency retrieves the leader's data for a checksum which
+// does not match the leader's, diffs the replica's data against it, and
+// logs and publishes the inconsistency to the store's event feed.
+func (r *Replica) reportInconsistency(c *replicaChecksum, leaderChecksum []byte) {
+	var diff []ReplicaSnapshotDiff
+	args := &proto.ChecksumSnapshotRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     c.desc.StartKey,
+			RangeID: c.desc.RangeID,
+		},
+		ChecksumID: c.id,
+	}
+	reply := &proto.ChecksumSnapshotResponse{}
+	b := &client.Batch{}
+	b.InternalAddCall(proto.Call{Args: args, Reply: reply})
+	if err := r.rm.DB().Run(b); err != nil {
+		log.Errorf("%s: failed to retrieve the leader's data for checksum %x: %s", r, c.id, err)
+	} else if snapData, err := rangeSnapshotData(&c.desc, c.snap); err != nil {
+		log.Errorf("%s: failed to read snapshot for checksum %x: %s", r, c.id, err)
+	} else {
+		diff = diffRangeSnapshots(reply.Snapshot, snapData)
+	}
+	log.Errorf("%s: replica is inconsistent with the leader: checksum %x != %x; %d divergent keys",
+		r, c.checksum, leaderChecksum, len(diff))
+	for _, d := range diff {
+		log.Errorf("%s: leader=%t key=%s timestamp=%s value=%q", r, d.Leader, d.Key, d.Timestamp, d.Value)
+	}
+	r.rm.EventFeed().replicaInconsistency(c.desc.RangeID, r.rm.Clock().PhysicalNow(), diff)
+}
+
+// isConsistencyCheckedKey returns true if the MVCC-encoded key is
+// included in consistency checks. Keys whose contents are maintained
+// independently by each replica are excluded.
+func isConsistencyCheckedKey(encKey proto.EncodedKey) bool {
+	key, _, _ := engine.MVCCDecodeKey(encKey)
+	if !bytes.HasPrefix(key, keys.LocalRangeIDPrefix) {
+		return true
+	}
+	b, _ := encoding.DecodeUvarint(key[len(keys.LocalRangeIDPrefix):])
+	if len(b) < keys.LocalSuffixLength {
+		return true
+	}
+	suffix := proto.Key(b[:keys.LocalSuffixLength])
+	for _, s := range replicaLocalSuffixes {
+		if suffix.Equal(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// computeChecksum returns a sha256 checksum of the consistency-checked
+// key/value pairs of the range in the supplied engine.
+func computeChecksum(desc *proto.RangeDescriptor, e engine.Engine) ([]byte, error) {
+	hasher := sha256.New()
+	var buf []byte
+	iter := newRangeDataIterator(desc, e)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if !isConsistencyCheckedKey(key) {
+			continue
+		}
+		value := iter.Value()
+		// Prefix keys and values with their lengths so that distinct
+		// sequences of key/value pairs can't hash identically.
+		buf = encoding.EncodeUvarint(buf[:0], uint64(len(key)))
+		buf = append(buf, key...)
+		buf = encoding.EncodeUvarint(buf, uint64(len(value)))
+		buf = append(buf, value...)
+		if _, err := hasher.Write(buf); err != nil {
+			return nil, err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+// rangeSnapshotData returns the consistency-checked key/value pairs of
+// the range in the supplied engine.
+func rangeSnapshotData(desc *proto.RangeDescriptor, e engine.Engine) (*proto.RaftSnapshotData, error) {
+	snapData := &proto.RaftSnapshotData{}
+	iter := newRangeDataIterator(desc, e)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if !isConsistencyCheckedKey(iter.Key()) {
+			continue
+		}
+		snapData.KV = append(snapData.KV,
+			&proto.RaftSnapshotData_KeyValue{Key: iter.Key(), Value: iter.Value()})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return snapData, nil
+}
+
+// diffRangeSnapshots returns the key/value pairs which differ between
+// the leader's and a replica's snapshot of a range. Both snapshots must
+// be sorted by key. At most maxReplicaSnapshotDiffs entries are
+// returned.
+func diffRangeSnapshots(leader, replica *proto.RaftSnapshotData) []ReplicaSnapshotDiff {
+	var diff []ReplicaSnapshotDiff
+	add := func(isLeader bool, kv *proto.RaftSnapshotData_KeyValue) {
+		key, timestamp, _ := engine.MVCCDecodeKey(kv.Key)
+		diff = append(diff, ReplicaSnapshotDiff{
+			Leader:    isLeader,
+			Key:       key,
+			Timestamp: timestamp,
+			Value:     kv.Value,
+		})
+	}
+	l, r := leader.KV, replica.KV
+	for (len(l) > 0 || len(r) > 0) && len(diff) < maxReplicaSnapshotDiffs {
+		switch {
+		case len(r) == 0:
+			add(true, l[0])
+			l = l[1:]
+		case len(l) == 0:
+			add(false, r[0])
+			r = r[1:]
+		default:
+			switch c := bytes.Compare(l[0].Key, r[0].Key); {
+			case c < 0:
+				add(true, l[0])
+				l = l[1:]
+			case c > 0:
+				add(false, r[0])
+				r = r[1:]
+			default:
+				if !bytes.Equal(l[0].Value, r[0].Value) {
+					add(true, l[0])
+					add(false, r[0])
+				}
+				l, r = l[1:], r[1:]
+			}
+		}
+	}
+	return diff
+}
@@ -0,0 +1,108 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestIsConsistencyCheckedKey verifies that replica-local raft state
+// is excluded from consistency checks while replicated data is not.
+func TestIsConsistencyCheckedKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	testCases := []struct {
+		key      proto.Key
+		expected bool
+	}{
+		{keys.RaftLogKey(1, 10), false},
+		{keys.RaftHardStateKey(1), false},
+		{keys.RaftTruncatedStateKey(1), false},
+		{keys.RangeLastVerificationTimestampKey(1), false},
+		{keys.RangeStatsKey(1), true},
+		{keys.RangeDescriptorKey(proto.Key("a")), true},
+		{proto.Key("a"), true},
+	}
+	for i, test := range testCases {
+		if checked := isConsistencyCheckedKey(engine.MVCCEncodeKey(test.key)); checked != test.expected {
+			t.Errorf("%d: expected %q checked=%t; got %t", i, test.key, test.expected, checked)
+		}
+	}
+}
+
+// TestDiffRangeSnapshots verifies that the diff of two snapshots
+// contains exactly the key/value pairs which differ.
+func TestDiffRangeSnapshots(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ts := proto.Timestamp{WallTime: 1}
+	kv := func(key, value string) *proto.RaftSnapshotData_KeyValue {
+		return &proto.RaftSnapshotData_KeyValue{
+			Key:   engine.MVCCEncodeVersionKey(proto.Key(key), ts),
+			Value: []byte(value),
+		}
+	}
+	diff := func(leader bool, key, value string) ReplicaSnapshotDiff {
+		return ReplicaSnapshotDiff{Leader: leader, Key: proto.Key(key), Timestamp: ts, Value: []byte(value)}
+	}
+	leader := &proto.RaftSnapshotData{KV: []*proto.RaftSnapshotData_KeyValue{
+		kv("a", "1"), kv("b", "2"), kv("d", "4"), kv("e", "5"),
+	}}
+	replica := &proto.RaftSnapshotData{KV: []*proto.RaftSnapshotData_KeyValue{
+		kv("a", "1"), kv("c", "3"), kv("d", "x"), kv("e", "5"), kv("f", "6"),
+	}}
+	expected := []ReplicaSnapshotDiff{
+		diff(true, "b", "2"),
+		diff(false, "c", "3"),
+		diff(true, "d", "4"),
+		diff(false, "d", "x"),
+		diff(false, "f", "6"),
+	}
+	if d := diffRangeSnapshots(leader, replica); !reflect.DeepEqual(d, expected) {
+		t.Errorf("expected diff %+v; got %+v", expected, d)
+	}
+	if d := diffRangeSnapshots(leader, leader); len(d) != 0 {
+		t.Errorf("expected no diff of identical snapshots; got %+v", d)
+	}
+}
+
+// TestCheckConsistency verifies that a consistency check of a single
+// replica range succeeds and releases the retained checksum.
+func TestCheckConsistency(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	if err := tc.rng.CheckConsistency(); err != nil {
+		t.Fatal(err)
+	}
+	// The checksum is verified asynchronously.
+	util.SucceedsWithin(t, time.Second, func() error {
+		tc.rng.RLock()
+		defer tc.rng.RUnlock()
+		if tc.rng.checksum != nil {
+			return util.Errorf("expected checksum to be released; got %+v", tc.rng.checksum)
+		}
+		return nil
+	})
+}
@@ -32,7 +32,7 @@ import (
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
-var _ multiraft.WriteableGroupStorage = &Replica{}
+var _ multiraft.SnapshotStreamer = &Replica{}
 
 // InitialState implements the raft.Storage interface.
 func (r *Replica) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
@@ -168,6 +168,27 @@ func (r *Replica) FirstIndex() (uint64, error) {
 	return ts.Index + 1, nil
 }
 
+// raftLogSize returns the size in bytes of the keys and values of the
+// entries remaining in the range's raft log. The size is maintained as
+// entries are appended and truncated, so this is cheap to call.
+func (r *Replica) raftLogSize() int64 {
+	return atomic.LoadInt64(&r.logSize)
+}
+
+// computeRaftLogSize computes the size in bytes of the keys and values of
+// the entries in the range's raft log by iterating over them in the
+// supplied engine.
+func (r *Replica) computeRaftLogSize(eng engine.Engine) (int64, error) {
+	var size int64
+	prefix := keys.RaftLogPrefix(r.Desc().RangeID)
+	err := eng.Iterate(engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()),
+		func(kv proto.RawKeyValue) (bool, error) {
+			size += int64(len(kv.Key) + len(kv.Value))
+			return false, nil
+		})
+	return size, err
+}
+
 // loadAppliedIndex retrieves the applied index from the supplied engine.
 func (r *Replica) loadAppliedIndex(eng engine.Engine) (uint64, error) {
 	var appliedIndex uint64
@@ -228,17 +249,36 @@ func setLastIndex(eng engine.Engine, rangeID proto.RangeID, lastIndex uint64) er
 		}, nil)
 }
 
-// Snapshot implements the raft.Storage interface.
+// An outgoingSnapshot is a consistent engine snapshot of a replica which
+// is retained after a call to Snapshot() so that its data can be streamed
+// to the recipient of the raft snapshot.
+type outgoingSnapshot struct {
+	index uint64        // Applied index at which the snapshot was taken
+	snap  engine.Engine // Read-only engine snapshot
+	desc  proto.RangeDescriptor
+	refs  int  // Number of streams reading from snap
+	stale bool // Set once replaced; snap is closed when refs drops to zero
+}
+
+// An incomingSnapshot tracks the chunks of a streamed snapshot which have
+// been staged for the replica.
+type incomingSnapshot struct {
+	index   uint64 // Index of the raft snapshot
+	nextSeq uint64 // Sequence number of the next expected chunk
+	done    bool   // Set once the last chunk has been staged
+}
+
+// Snapshot implements the raft.Storage interface. The returned snapshot
+// holds only the range descriptor; its data is retained in a consistent
+// engine snapshot and streamed to the recipient by StreamSnapshot.
 func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
-	// Copy all the data from a consistent RocksDB snapshot into a RaftSnapshotData.
 	snap := r.rm.NewSnapshot()
-	defer snap.Close()
-	var snapData proto.RaftSnapshotData
 
 	// Read the range metadata from the snapshot instead of the members
 	// of the Range struct because they might be changed concurrently.
 	appliedIndex, err := r.loadAppliedIndex(snap)
 	if err != nil {
+		snap.Close()
 		return raftpb.Snapshot{}, err
 	}
 	var desc proto.RangeDescriptor
@@ -248,23 +288,33 @@ func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
 	ok, err := engine.MVCCGetProto(snap, keys.RangeDescriptorKey(r.Desc().StartKey),
 		r.rm.Clock().Now(), false /* !consistent */, nil, &desc)
 	if err != nil {
+		snap.Close()
 		return raftpb.Snapshot{}, util.Errorf("failed to get desc: %s", err)
 	}
 	if !ok {
+		snap.Close()
 		return raftpb.Snapshot{}, util.Errorf("couldn't find range descriptor")
 	}
 
-	// Store RangeDescriptor as metadata, it will be retrieved by ApplySnapshot()
-	snapData.RangeDescriptor = desc
+	term, err := r.Term(appliedIndex)
+	if err != nil {
+		snap.Close()
+		return raftpb.Snapshot{}, util.Errorf("failed to fetch term of %d: %s", appliedIndex, err)
+	}
 
-	// Iterate over all the data in the range, including local-only data like
-	// the response cache.
-	for iter := newRangeDataIterator(r.Desc(), snap); iter.Valid(); iter.Next() {
-		snapData.KV = append(snapData.KV,
-			&proto.RaftSnapshotData_KeyValue{Key: iter.Key(), Value: iter.Value()})
+	// Retain the engine snapshot for StreamSnapshot. A snapshot at the same
+	// index is equivalent to the one already retained, if any.
+	r.snapMu.Lock()
+	if out := r.outSnapshot; out != nil && out.index == appliedIndex {
+		snap.Close()
+	} else {
+		r.releaseOutgoingSnapshotLocked()
+		r.outSnapshot = &outgoingSnapshot{index: appliedIndex, snap: snap, desc: desc}
 	}
+	r.snapMu.Unlock()
 
-	data, err := gogoproto.Marshal(&snapData)
+	// Store RangeDescriptor as metadata, it will be retrieved by ApplySnapshot()
+	data, err := gogoproto.Marshal(&proto.RaftSnapshotData{RangeDescriptor: desc})
 	if err != nil {
 		return raftpb.Snapshot{}, err
 	}
@@ -275,11 +325,6 @@ func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
 		cs.Nodes = append(cs.Nodes, uint64(proto.MakeRaftNodeID(rep.NodeID, rep.StoreID)))
 	}
 
-	term, err := r.Term(appliedIndex)
-	if err != nil {
-		return raftpb.Snapshot{}, util.Errorf("failed to fetch term of %d: %s", appliedIndex, err)
-	}
-
 	return raftpb.Snapshot{
 		Data: data,
 		Metadata: raftpb.SnapshotMetadata{
@@ -290,6 +335,178 @@ func (r *Replica) Snapshot() (raftpb.Snapshot, error) {
 	}, nil
 }
 
+// releaseOutgoingSnapshot releases the retained engine snapshot, if any.
+func (r *Replica) releaseOutgoingSnapshot() {
+	r.snapMu.Lock()
+	defer r.snapMu.Unlock()
+	r.releaseOutgoingSnapshotLocked()
+}
+
+// releaseOutgoingSnapshotLocked releases the retained engine snapshot, if
+// any. The snapshot is closed right away unless it's being streamed, in
+// which case the last stream closes it. Requires snapMu to be held.
+func (r *Replica) releaseOutgoingSnapshotLocked() {
+	out := r.outSnapshot
+	if out == nil {
+		return
+	}
+	r.outSnapshot = nil
+	if out.refs == 0 {
+		out.snap.Close()
+	} else {
+		out.stale = true
+	}
+}
+
+// StreamSnapshot implements the multiraft.SnapshotStreamer interface. It
+// iterates over all the data in the range as of the supplied snapshot,
+// including local-only data like the response cache.
+func (r *Replica) StreamSnapshot(snap raftpb.Snapshot, maxBytes int,
+	send func(kvs []*proto.RaftSnapshotData_KeyValue, last bool) error) error {
+	r.snapMu.Lock()
+	out := r.outSnapshot
+	if out == nil || out.index != snap.Metadata.Index {
+		r.snapMu.Unlock()
+		return util.Errorf("snapshot of range %d at index %d is no longer available",
+			r.Desc().RangeID, snap.Metadata.Index)
+	}
+	out.refs++
+	r.snapMu.Unlock()
+
+	defer func() {
+		r.snapMu.Lock()
+		defer r.snapMu.Unlock()
+		out.refs--
+		if out.refs > 0 {
+			return
+		}
+		// The snapshot is closed once streamed; raft asks for a new one the
+		// next time a follower needs it.
+		if out.stale {
+			out.snap.Close()
+		} else if r.outSnapshot == out {
+			r.releaseOutgoingSnapshotLocked()
+		}
+	}()
+
+	iter := newRangeDataIterator(&out.desc, out.snap)
+	defer iter.Close()
+	var kvs []*proto.RaftSnapshotData_KeyValue
+	var size int
+	for ; iter.Valid(); iter.Next() {
+		kv := &proto.RaftSnapshotData_KeyValue{Key: iter.Key(), Value: iter.Value()}
+		kvSize := len(kv.Key) + len(kv.Value)
+		if len(kvs) > 0 && size+kvSize > maxBytes {
+			if err := send(kvs, false); err != nil {
+				return err
+			}
+			kvs, size = nil, 0
+		}
+		kvs = append(kvs, kv)
+		size += kvSize
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return send(kvs, true)
+}
+
+// ApplySnapshotChunk implements the multiraft.SnapshotStreamer interface.
+// The chunks of a snapshot are staged under a store-local prefix, outside
+// of the range's keyspace, so that the range is left untouched until raft
+// accepts the snapshot and ApplySnapshot installs its data. The first chunk
+// clears whatever was staged by an earlier, abandoned stream.
+func (r *Replica) ApplySnapshotChunk(chunk *proto.RaftSnapshotChunkRequest) error {
+	r.snapMu.Lock()
+	defer r.snapMu.Unlock()
+
+	hardStateKey := keys.RaftHardStateKey(chunk.GroupID)
+	batch := r.rm.Engine().NewBatch()
+	defer batch.Close()
+
+	if chunk.Seq == 0 {
+		if chunk.RangeDescriptor == nil {
+			return util.Errorf("first chunk of snapshot of range %d at index %d lacks a range descriptor",
+				chunk.GroupID, chunk.Index)
+		}
+		// A snapshot at or below the commit index would be rejected by raft,
+		// so don't bother receiving it.
+		var hs raftpb.HardState
+		if _, err := engine.MVCCGetProto(r.rm.Engine(), hardStateKey, proto.ZeroTimestamp,
+			true /* consistent */, nil, &hs); err != nil {
+			return err
+		}
+		if chunk.Index <= hs.Commit {
+			return util.Errorf("snapshot of range %d at index %d is stale; committed index is %d",
+				chunk.GroupID, chunk.Index, hs.Commit)
+		}
+		// The leader streams a single snapshot to a follower at a time, so
+		// this either starts the first stream or supersedes an abandoned one.
+		r.inSnapshot = &incomingSnapshot{index: chunk.Index}
+		if err := clearStagedSnapshot(r.rm.Engine(), batch, chunk.GroupID); err != nil {
+			return err
+		}
+	}
+
+	in := r.inSnapshot
+	if in == nil || in.index != chunk.Index || in.nextSeq != chunk.Seq || in.done {
+		return util.Errorf("unexpected chunk %d of snapshot of range %d at index %d",
+			chunk.Seq, chunk.GroupID, chunk.Index)
+	}
+
+	// Stage the chunk. The HardState is skipped because it may record a
+	// previous vote cast by this node.
+	encodedHardStateKey := engine.MVCCEncodeKey(hardStateKey)
+	for _, kv := range chunk.KV {
+		if proto.EncodedKey(kv.Key).Equal(encodedHardStateKey) {
+			continue
+		}
+		stagedKey := engine.MVCCEncodeKey(keys.StoreSnapshotKey(chunk.GroupID, kv.Key))
+		if err := batch.Put(stagedKey, kv.Value); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+	in.nextSeq++
+	in.done = chunk.Last
+	return nil
+}
+
+// clearStagedSnapshot clears the chunks of a streamed snapshot of the range
+// which have been staged in eng, writing the deletions to batch.
+func clearStagedSnapshot(eng, batch engine.Engine, rangeID proto.RangeID) error {
+	prefix := keys.StoreSnapshotPrefix(rangeID)
+	return eng.Iterate(engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()),
+		func(kv proto.RawKeyValue) (bool, error) {
+			return false, batch.Clear(kv.Key)
+		})
+}
+
+// installStagedSnapshot moves the chunks of a streamed snapshot of the range
+// which have been staged in eng into the range, writing the changes to
+// batch.
+func installStagedSnapshot(eng, batch engine.Engine, rangeID proto.RangeID) error {
+	prefix := keys.StoreSnapshotPrefix(rangeID)
+	return eng.Iterate(engine.MVCCEncodeKey(prefix), engine.MVCCEncodeKey(prefix.PrefixEnd()),
+		func(kv proto.RawKeyValue) (bool, error) {
+			stagedKey, _, _ := engine.MVCCDecodeKey(kv.Key)
+			if err := batch.Put(proto.EncodedKey(stagedKey[len(prefix):]), kv.Value); err != nil {
+				return false, err
+			}
+			return false, batch.Clear(kv.Key)
+		})
+}
+
+// SnapshotReceived implements the multiraft.SnapshotStreamer interface.
+func (r *Replica) SnapshotReceived(snap raftpb.Snapshot) bool {
+	r.snapMu.Lock()
+	defer r.snapMu.Unlock()
+	in := r.inSnapshot
+	return in != nil && in.done && in.index == snap.Metadata.Index
+}
+
 // Append implements the multiraft.WriteableGroupStorage interface.
 func (r *Replica) Append(entries []raftpb.Entry) error {
 	if len(entries) == 0 {
@@ -298,8 +515,11 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 	batch := r.rm.Engine().NewBatch()
 	defer batch.Close()
 
+	// The stats track the change in size of the raft log, which is made of
+	// system keys.
+	var ms engine.MVCCStats
 	for _, ent := range entries {
-		err := engine.MVCCPutProto(batch, nil, keys.RaftLogKey(r.Desc().RangeID, ent.Index),
+		err := engine.MVCCPutProto(batch, &ms, keys.RaftLogKey(r.Desc().RangeID, ent.Index),
 			proto.ZeroTimestamp, nil, &ent)
 		if err != nil {
 			return err
@@ -309,7 +529,7 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 	prevLastIndex := atomic.LoadUint64(&r.lastIndex)
 	// Delete any previously appended log entries which never committed.
 	for i := lastIndex + 1; i <= prevLastIndex; i++ {
-		err := engine.MVCCDelete(batch, nil,
+		err := engine.MVCCDelete(batch, &ms,
 			keys.RaftLogKey(r.Desc().RangeID, i), proto.ZeroTimestamp, nil)
 		if err != nil {
 			return err
@@ -325,6 +545,7 @@ func (r *Replica) Append(entries []raftpb.Entry) error {
 	}
 
 	atomic.StoreUint64(&r.lastIndex, lastIndex)
+	atomic.AddInt64(&r.logSize, ms.SysBytes)
 	return nil
 }
 
@@ -353,6 +574,9 @@ func (r *Replica) updateRangeInfo() error {
 }
 
 // ApplySnapshot implements the multiraft.WriteableGroupStorage interface.
+// The data of the snapshot has been staged by ApplySnapshotChunk; it
+// replaces the range's data in the same batch which updates the range's
+// metadata.
 func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 	snapData := proto.RaftSnapshotData{}
 	err := gogoproto.Unmarshal(snap.Data, &snapData)
@@ -360,45 +584,42 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
-	// First, save the HardState.  The HardState must not be changed
-	// because it may record a previous vote cast by this node.
-	hardStateKey := keys.RaftHardStateKey(r.Desc().RangeID)
-	hardState, _, err := engine.MVCCGet(r.rm.Engine(), hardStateKey, proto.ZeroTimestamp, true /* consistent */, nil)
-	if err != nil {
-		return err
-	}
-
 	// Extract the updated range descriptor.
 	desc := snapData.RangeDescriptor
 
+	r.snapMu.Lock()
+	in := r.inSnapshot
+	r.inSnapshot = nil
+	r.snapMu.Unlock()
+	if in == nil || !in.done || in.index != snap.Metadata.Index {
+		return util.Errorf("data of snapshot of range %d at index %d was not received",
+			desc.RangeID, snap.Metadata.Index)
+	}
+
 	batch := r.rm.Engine().NewBatch()
 	defer batch.Close()
 
-	// Delete everything in the range and recreate it from the snapshot.
-	for iter := newRangeDataIterator(&desc, r.rm.Engine()); iter.Valid(); iter.Next() {
+	// Delete everything in the range and recreate it from the snapshot. The
+	// HardState is left alone because it may record a previous vote cast by
+	// this node.
+	iter := newRangeDataIterator(&desc, r.rm.Engine())
+	encodedHardStateKey := engine.MVCCEncodeKey(keys.RaftHardStateKey(desc.RangeID))
+	for ; iter.Valid(); iter.Next() {
+		if iter.Key().Equal(encodedHardStateKey) {
+			continue
+		}
 		if err := batch.Clear(iter.Key()); err != nil {
+			iter.Close()
 			return err
 		}
 	}
-
-	// Write the snapshot into the range.
-	for _, kv := range snapData.KV {
-		if err := batch.Put(kv.Key, kv.Value); err != nil {
-			return err
-		}
+	err = iter.Error()
+	iter.Close()
+	if err != nil {
+		return err
 	}
-
-	// Restore the saved HardState.
-	if hardState == nil {
-		err := engine.MVCCDelete(batch, nil, hardStateKey, proto.ZeroTimestamp, nil)
-		if err != nil {
-			return err
-		}
-	} else {
-		err := engine.MVCCPut(batch, nil, hardStateKey, proto.ZeroTimestamp, *hardState, nil)
-		if err != nil {
-			return err
-		}
+	if err := installStagedSnapshot(r.rm.Engine(), batch, desc.RangeID); err != nil {
+		return err
 	}
 
 	// Read the leader lease.
@@ -431,10 +652,18 @@ func (r *Replica) ApplySnapshot(snap raftpb.Snapshot) error {
 		return err
 	}
 
+	// The snapshot replaced the raft log along with the rest of the range's
+	// data.
+	raftLogSize, err := r.computeRaftLogSize(r.rm.Engine())
+	if err != nil {
+		return err
+	}
+
 	// As outlined above, last and applied index are the same after applying
 	// the snapshot.
 	atomic.StoreUint64(&r.lastIndex, snap.Metadata.Index)
 	atomic.StoreUint64(&r.appliedIndex, snap.Metadata.Index)
+	atomic.StoreInt64(&r.logSize, raftLogSize)
 
 	// Atomically update the descriptor and lease.
 	if err := r.setDesc(&desc); err != nil {
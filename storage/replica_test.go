@@ -487,6 +487,232 @@ func TestRangeNotLeaderError(t *testing.T) {
 	}
 }
 
+// TestRangeQuarantine verifies that a quarantined replica gives up the
+// leader lease it holds and refuses to serve commands and to acquire the
+// leader lease.
+func TestRangeQuarantine(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	if err := tc.rng.redirectOnOrAcquireLeaderLease(nil, tc.clock.Now()); err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.Quarantine(util.Errorf("corrupted"))
+	if !tc.rng.IsQuarantined() {
+		t.Fatal("expected replica to be quarantined")
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if lease := tc.rng.getLease(); lease.Covers(tc.clock.Now()) {
+			return util.Errorf("expected leader lease to have expired; got %s", lease)
+		}
+		return nil
+	})
+
+	gArgs := getArgs(proto.Key("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	pArgs := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	for i, args := range []proto.Request{&gArgs, &pArgs} {
+		if _, err := tc.rng.AddCmd(tc.rng.context(), args); err == nil {
+			t.Errorf("%d: expected quarantined replica to refuse command", i)
+		} else if _, ok := err.(*proto.NotLeaderError); !ok {
+			t.Errorf("%d: expected not leader error; got %s", i, err)
+		}
+	}
+
+	// Inconsistent reads are refused as well.
+	gArgs.ReadConsistency = proto.INCONSISTENT
+	if _, err := tc.rng.AddCmd(tc.rng.context(), &gArgs); err == nil {
+		t.Error("expected quarantined replica to refuse inconsistent read")
+	}
+
+	tc.manualClock.Increment(int64(DefaultLeaderLeaseDuration + 1))
+	if err := tc.rng.requestLeaderLease(tc.clock.Now()); err == nil {
+		t.Error("expected quarantined replica to refuse the leader lease")
+	}
+}
+
+// TestReplicaStreamSnapshot verifies that the data of a snapshot is
+// streamed in chunks of bounded size and that the retained engine snapshot
+// is released afterwards.
+func TestReplicaStreamSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const numKeys = 10
+	value := bytes.Repeat([]byte("v"), 100)
+	for i := 0; i < numKeys; i++ {
+		pArgs := putArgs(proto.Key(fmt.Sprintf("a%d", i)), value, 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if _, err := tc.rng.AddCmd(tc.rng.context(), &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snap, err := tc.rng.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var snapData proto.RaftSnapshotData
+	if err := gogoproto.Unmarshal(snap.Data, &snapData); err != nil {
+		t.Fatal(err)
+	}
+	if len(snapData.KV) != 0 {
+		t.Errorf("expected snapshot without data; got %d key/value pairs", len(snapData.KV))
+	}
+
+	const maxBytes = 256
+	var chunks int
+	var last bool
+	var prev proto.EncodedKey
+	userKeys := map[string]struct{}{}
+	if err := tc.rng.StreamSnapshot(snap, maxBytes,
+		func(kvs []*proto.RaftSnapshotData_KeyValue, l bool) error {
+			if last {
+				t.Errorf("chunk %d follows the last chunk", chunks)
+			}
+			chunks++
+			last = l
+			var size int
+			for _, kv := range kvs {
+				if prev != nil && !prev.Less(kv.Key) {
+					t.Errorf("key %q does not follow %q", kv.Key, prev)
+				}
+				prev = kv.Key
+				size += len(kv.Key) + len(kv.Value)
+				if key, _, isValue := engine.MVCCDecodeKey(kv.Key); isValue {
+					userKeys[string(key)] = struct{}{}
+				}
+			}
+			if len(kvs) > 1 && size > maxBytes {
+				t.Errorf("chunk %d holds %d bytes; expected at most %d", chunks, size, maxBytes)
+			}
+			return nil
+		}); err != nil {
+		t.Fatal(err)
+	}
+	if !last {
+		t.Error("expected the last chunk to be marked")
+	}
+	if chunks < numKeys/2 {
+		t.Errorf("expected data to be split into several chunks; got %d", chunks)
+	}
+	for i := 0; i < numKeys; i++ {
+		if _, ok := userKeys[fmt.Sprintf("a%d", i)]; !ok {
+			t.Errorf("key a%d missing from snapshot", i)
+		}
+	}
+
+	// The engine snapshot is released once streamed.
+	if err := tc.rng.StreamSnapshot(snap, maxBytes,
+		func([]*proto.RaftSnapshotData_KeyValue, bool) error { return nil }); err == nil {
+		t.Error("expected streaming a released snapshot to fail")
+	}
+
+	// A snapshot at or below the commit index is refused, as are chunks
+	// which don't belong to a stream.
+	desc := tc.rng.Desc()
+	if err := tc.rng.ApplySnapshotChunk(&proto.RaftSnapshotChunkRequest{
+		GroupID: desc.RangeID, Index: snap.Metadata.Index, RangeDescriptor: desc,
+	}); err == nil {
+		t.Error("expected stale snapshot chunk to be refused")
+	}
+	if err := tc.rng.ApplySnapshotChunk(&proto.RaftSnapshotChunkRequest{
+		GroupID: desc.RangeID, Index: snap.Metadata.Index + 10, Seq: 1,
+	}); err == nil {
+		t.Error("expected out-of-sequence snapshot chunk to be refused")
+	}
+	if tc.rng.SnapshotReceived(snap) {
+		t.Error("expected snapshot not to have been received")
+	}
+}
+
+// TestReplicaStageSnapshot verifies that the chunks of a streamed snapshot
+// are staged without touching the range's data, and that the range's data
+// is replaced by the staged data once the snapshot is applied.
+func TestReplicaStageSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	put := func(key string) {
+		pArgs := putArgs(proto.Key(key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if _, err := tc.rng.AddCmd(tc.rng.context(), &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exists := func(key string) bool {
+		v, _, err := engine.MVCCGet(tc.engine, proto.Key(key), tc.clock.Now(), true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v != nil
+	}
+
+	put("a")
+	snap, err := tc.rng.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	put("b")
+
+	// Stream the snapshot back into the replica as if it came at a later
+	// index, which raft has yet to accept.
+	received := snap
+	received.Metadata.Index += 10
+	desc := tc.rng.Desc()
+	appliedIndex := atomic.LoadUint64(&tc.rng.appliedIndex)
+	var seq uint64
+	if err := tc.rng.StreamSnapshot(snap, 256,
+		func(kvs []*proto.RaftSnapshotData_KeyValue, last bool) error {
+			chunk := &proto.RaftSnapshotChunkRequest{
+				GroupID: desc.RangeID,
+				Index:   received.Metadata.Index,
+				Seq:     seq,
+				Last:    last,
+				KV:      kvs,
+			}
+			if seq == 0 {
+				chunk.RangeDescriptor = desc
+			}
+			seq++
+			return tc.rng.ApplySnapshotChunk(chunk)
+		}); err != nil {
+		t.Fatal(err)
+	}
+	if !tc.rng.SnapshotReceived(received) {
+		t.Fatal("expected snapshot to have been received")
+	}
+	if !exists("a") || !exists("b") {
+		t.Error("expected range data to be untouched by staged snapshot")
+	}
+	if idx, err := tc.rng.loadAppliedIndex(tc.engine); err != nil {
+		t.Fatal(err)
+	} else if idx != appliedIndex {
+		t.Errorf("expected applied index %d to be untouched; got %d", appliedIndex, idx)
+	}
+
+	if err := tc.rng.ApplySnapshot(received); err != nil {
+		t.Fatal(err)
+	}
+	if !exists("a") || exists("b") {
+		t.Error("expected range data to be replaced by the snapshot")
+	}
+	prefix := keys.StoreSnapshotPrefix(desc.RangeID)
+	if kvs, err := engine.Scan(tc.engine, engine.MVCCEncodeKey(prefix),
+		engine.MVCCEncodeKey(prefix.PrefixEnd()), 0); err != nil {
+		t.Fatal(err)
+	} else if len(kvs) != 0 {
+		t.Errorf("expected staged snapshot to be cleared; got %d keys", len(kvs))
+	}
+}
+
 // TestRangeGossipConfigsOnLease verifies that config info is gossiped
 // upon acquisition of the leader lease.
 func TestRangeGossipConfigsOnLease(t *testing.T) {
@@ -10,6 +10,8 @@
 
 	It has these top-level messages:
 		StoreStatus
+		ReplicaSnapshotDiff
+		ReplicaInconsistency
 */
 package storage
 
@@ -41,6 +43,8 @@ type StoreStatus struct {
 	LeaderRangeCount     int32                                         `protobuf:"varint,7,opt,name=leader_range_count" json:"leader_range_count"`
 	ReplicatedRangeCount int32                                         `protobuf:"varint,8,opt,name=replicated_range_count" json:"replicated_range_count"`
 	AvailableRangeCount  int32                                         `protobuf:"varint,9,opt,name=available_range_count" json:"available_range_count"`
+	RaftLogSize          int64                                         `protobuf:"varint,10,opt,name=raft_log_size" json:"raft_log_size"`
+	Inconsistencies      []ReplicaInconsistency                        `protobuf:"bytes,11,rep,name=inconsistencies" json:"inconsistencies"`
 	XXX_unrecognized     []byte                                        `json:"-"`
 }
 
@@ -111,6 +115,98 @@ func (m *StoreStatus) GetAvailableRangeCount() int32 {
 	return 0
 }
 
+func (m *StoreStatus) GetRaftLogSize() int64 {
+	if m != nil {
+		return m.RaftLogSize
+	}
+	return 0
+}
+
+func (m *StoreStatus) GetInconsistencies() []ReplicaInconsistency {
+	if m != nil {
+		return m.Inconsistencies
+	}
+	return nil
+}
+
+// ReplicaSnapshotDiff is a single key/value pair which differs between
+// the leader's copy of a range and the copy held by another replica.
+type ReplicaSnapshotDiff struct {
+	// leader is true if the key/value pair was present in the leader's
+	// copy of the range and missing or different in the replica's.
+	Leader           bool                                       `protobuf:"varint,1,opt,name=leader" json:"leader"`
+	Key              github_com_cockroachdb_cockroach_proto.Key `protobuf:"bytes,2,opt,name=key,casttype=github.com/cockroachdb/cockroach/proto.Key" json:"key,omitempty"`
+	Timestamp        cockroach_proto.Timestamp                  `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp"`
+	Value            []byte                                     `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte                                     `json:"-"`
+}
+
+func (m *ReplicaSnapshotDiff) Reset()         { *m = ReplicaSnapshotDiff{} }
+func (m *ReplicaSnapshotDiff) String() string { return proto.CompactTextString(m) }
+func (*ReplicaSnapshotDiff) ProtoMessage()    {}
+
+func (m *ReplicaSnapshotDiff) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+func (m *ReplicaSnapshotDiff) GetKey() github_com_cockroachdb_cockroach_proto.Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ReplicaSnapshotDiff) GetTimestamp() cockroach_proto.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return cockroach_proto.Timestamp{}
+}
+
+func (m *ReplicaSnapshotDiff) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// ReplicaInconsistency describes a replica of a range whose data was
+// found by the consistency checker to differ from the leader's.
+type ReplicaInconsistency struct {
+	RangeID          github_com_cockroachdb_cockroach_proto.RangeID `protobuf:"varint,1,opt,name=range_id,casttype=github.com/cockroachdb/cockroach/proto.RangeID" json:"range_id"`
+	DetectedAt       int64                                          `protobuf:"varint,2,opt,name=detected_at" json:"detected_at"`
+	Diff             []ReplicaSnapshotDiff                          `protobuf:"bytes,3,rep,name=diff" json:"diff"`
+	XXX_unrecognized []byte                                         `json:"-"`
+}
+
+func (m *ReplicaInconsistency) Reset()         { *m = ReplicaInconsistency{} }
+func (m *ReplicaInconsistency) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInconsistency) ProtoMessage()    {}
+
+func (m *ReplicaInconsistency) GetRangeID() github_com_cockroachdb_cockroach_proto.RangeID {
+	if m != nil {
+		return m.RangeID
+	}
+	return 0
+}
+
+func (m *ReplicaInconsistency) GetDetectedAt() int64 {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return 0
+}
+
+func (m *ReplicaInconsistency) GetDiff() []ReplicaSnapshotDiff {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
 func (m *StoreStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -296,6 +392,296 @@ func (m *StoreStatus) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLogSize", wireType)
+			}
+			m.RaftLogSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RaftLogSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconsistencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inconsistencies = append(m.Inconsistencies, ReplicaInconsistency{})
+			if err := m.Inconsistencies[len(m.Inconsistencies)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ReplicaSnapshotDiff) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append([]byte{}, data[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStatus(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ReplicaInconsistency) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeID", wireType)
+			}
+			m.RangeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.RangeID |= (github_com_cockroachdb_cockroach_proto.RangeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			m.DetectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DetectedAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = append(m.Diff, ReplicaSnapshotDiff{})
+			if err := m.Diff[len(m.Diff)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -429,6 +815,50 @@ func (m *StoreStatus) Size() (n int) {
 	n += 1 + sovStatus(uint64(m.LeaderRangeCount))
 	n += 1 + sovStatus(uint64(m.ReplicatedRangeCount))
 	n += 1 + sovStatus(uint64(m.AvailableRangeCount))
+	n += 1 + sovStatus(uint64(m.RaftLogSize))
+	if len(m.Inconsistencies) > 0 {
+		for _, e := range m.Inconsistencies {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaSnapshotDiff) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	if m.Key != nil {
+		l = len(m.Key)
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	l = m.Timestamp.Size()
+	n += 1 + l + sovStatus(uint64(l))
+	if m.Value != nil {
+		l = len(m.Value)
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaInconsistency) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStatus(uint64(m.RangeID))
+	n += 1 + sovStatus(uint64(m.DetectedAt))
+	if len(m.Diff) > 0 {
+		for _, e := range m.Diff {
+			l = e.Size()
+			n += 1 + l + sovStatus(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -500,6 +930,109 @@ func (m *StoreStatus) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x48
 	i++
 	i = encodeVarintStatus(data, i, uint64(m.AvailableRangeCount))
+	data[i] = 0x50
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RaftLogSize))
+	if len(m.Inconsistencies) > 0 {
+		for _, msg := range m.Inconsistencies {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReplicaSnapshotDiff) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReplicaSnapshotDiff) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	if m.Leader {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.Key != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintStatus(data, i, uint64(len(m.Key)))
+		i += copy(data[i:], m.Key)
+	}
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.Timestamp.Size()))
+	n3, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Value != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintStatus(data, i, uint64(len(m.Value)))
+		i += copy(data[i:], m.Value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReplicaInconsistency) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ReplicaInconsistency) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.RangeID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStatus(data, i, uint64(m.DetectedAt))
+	if len(m.Diff) > 0 {
+		for _, msg := range m.Diff {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintStatus(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -56,6 +56,12 @@ const (
 	defaultRaftTickInterval         = 100 * time.Millisecond
 	defaultHeartbeatIntervalTicks   = 3
 	defaultRaftElectionTimeoutTicks = 15
+	// defaultSnapshotRateLimit is the default rate in bytes per second at
+	// which a store streams raft snapshots.
+	defaultSnapshotRateLimit = 8 << 20
+	// defaultMaxConcurrentSnapshots is the default number of raft snapshots
+	// a store streams to other stores at once; as many may be received at once.
+	defaultMaxConcurrentSnapshots = 2
 	// ttlCapacityGossip is time-to-live for capacity-related info.
 	ttlCapacityGossip = 2 * time.Minute
 )
@@ -254,25 +260,27 @@ func (rs *storeRangeSet) EstimatedCount() int {
 // A Store maintains a map of ranges by start key. A Store corresponds
 // to one physical device.
 type Store struct {
-	Ident          proto.StoreIdent
-	ctx            StoreContext
-	db             *client.DB
-	engine         engine.Engine   // The underlying key-value store
-	_allocator     *allocator      // Makes allocation decisions
-	rangeIDAlloc   *idAllocator    // Range ID allocator
-	gcQueue        *gcQueue        // Garbage collection queue
-	_splitQueue    *splitQueue     // Range splitting queue
-	verifyQueue    *verifyQueue    // Checksum verification queue
-	replicateQueue *replicateQueue // Replication queue
-	_rangeGCQueue  *rangeGCQueue   // Range GC queue
-	scanner        *replicaScanner // Range scanner
-	feed           StoreEventFeed  // Event Feed
-	multiraft      *multiraft.MultiRaft
-	started        int32
-	stopper        *stop.Stopper
-	startedAt      int64
-	nodeDesc       *proto.NodeDescriptor
-	initComplete   sync.WaitGroup // Signaled by async init tasks
+	Ident            proto.StoreIdent
+	ctx              StoreContext
+	db               *client.DB
+	engine           engine.Engine    // The underlying key-value store
+	_allocator       *allocator       // Makes allocation decisions
+	rangeIDAlloc     *idAllocator     // Range ID allocator
+	gcQueue          *gcQueue         // Garbage collection queue
+	_splitQueue      *splitQueue      // Range splitting queue
+	verifyQueue      *verifyQueue     // Checksum verification queue
+	replicateQueue   *replicateQueue  // Replication queue
+	_quarantineQueue *quarantineQueue // Quarantined replica replacement queue
+	_rangeGCQueue    *rangeGCQueue    // Range GC queue
+	raftLogQueue     *raftLogQueue    // Raft log truncation queue
+	scanner          *replicaScanner  // Range scanner
+	feed             StoreEventFeed   // Event Feed
+	multiraft        *multiraft.MultiRaft
+	started          int32
+	stopper          *stop.Stopper
+	startedAt        int64
+	nodeDesc         *proto.NodeDescriptor
+	initComplete     sync.WaitGroup // Signaled by async init tasks
 
 	mu             sync.RWMutex               // Protects variables below...
 	replicas       map[proto.RangeID]*Replica // Map of replicas by Range ID
@@ -317,6 +325,15 @@ type StoreContext struct {
 	// stores.
 	ScanMaxIdleTime time.Duration
 
+	// SnapshotRateLimit is the maximum rate in bytes per second at which
+	// raft snapshots are streamed to other stores.
+	SnapshotRateLimit int64
+
+	// MaxConcurrentSnapshots is the maximum number of raft snapshots which
+	// are streamed to other stores at once, and the maximum number which
+	// are received from other stores at once.
+	MaxConcurrentSnapshots int
+
 	// EventFeed is a feed to which this store will publish events.
 	EventFeed *util.Feed
 
@@ -348,6 +365,12 @@ func (sc *StoreContext) setDefaults() {
 	if sc.RaftElectionTimeoutTicks == 0 {
 		sc.RaftElectionTimeoutTicks = defaultRaftElectionTimeoutTicks
 	}
+	if sc.SnapshotRateLimit == 0 {
+		sc.SnapshotRateLimit = defaultSnapshotRateLimit
+	}
+	if sc.MaxConcurrentSnapshots == 0 {
+		sc.MaxConcurrentSnapshots = defaultMaxConcurrentSnapshots
+	}
 }
 
 // NewStore returns a new instance of a store.
@@ -376,8 +399,10 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *proto.NodeDescripto
 	s._splitQueue = newSplitQueue(s.db, s.ctx.Gossip)
 	s.verifyQueue = newVerifyQueue(s.ReplicaCount)
 	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator(), s.ctx.Clock)
+	s._quarantineQueue = newQuarantineQueue(s.ctx.Gossip, s.allocator())
 	s._rangeGCQueue = newRangeGCQueue(s.db)
-	s.scanner.AddQueues(s.gcQueue, s._splitQueue, s.verifyQueue, s.replicateQueue, s._rangeGCQueue)
+	s.raftLogQueue = newRaftLogQueue(s.RaftStatus)
+	s.scanner.AddQueues(s.gcQueue, s._splitQueue, s.verifyQueue, s.replicateQueue, s._quarantineQueue, s._rangeGCQueue, s.raftLogQueue)
 
 	return s
 }
@@ -468,6 +493,8 @@ func (s *Store) Start(stopper *stop.Stopper) error {
 		TickInterval:           s.ctx.RaftTickInterval,
 		ElectionTimeoutTicks:   s.ctx.RaftElectionTimeoutTicks,
 		HeartbeatIntervalTicks: s.ctx.RaftHeartbeatIntervalTicks,
+		SnapshotRateLimit:      s.ctx.SnapshotRateLimit,
+		MaxConcurrentSnapshots: s.ctx.MaxConcurrentSnapshots,
 		EntryFormatter:         raftEntryFormatter,
 		// TODO(bdarnell): Multiraft deadlocks if the Events channel is
 		// unbuffered. Temporarily give it some breathing room until the underlying
@@ -982,6 +1009,9 @@ func (s *Store) Gossip() *gossip.Gossip { return s.ctx.Gossip }
 // splitQueue accessor.
 func (s *Store) splitQueue() *splitQueue { return s._splitQueue }
 
+// quarantineQueue accessor.
+func (s *Store) quarantineQueue() *quarantineQueue { return s._quarantineQueue }
+
 // rangeGCQueue accessor.
 func (s *Store) rangeGCQueue() *rangeGCQueue { return s._rangeGCQueue }
 
@@ -1630,12 +1660,13 @@ func (s *Store) GetStatus() (*StoreStatus, error) {
 }
 
 // computeReplicationStatus counts a number of simple replication statistics for
-// the ranges in this store.
+// the ranges in this store, along with the total size in bytes of their raft
+// logs.
 // TODO(bram): It may be appropriate to compute these statistics while scanning
 // ranges. An ideal solution would be to create incremental events whenever
 // availability changes.
 func (s *Store) computeReplicationStatus(now int64) (
-	leaderRangeCount, replicatedRangeCount, availableRangeCount int32) {
+	leaderRangeCount, replicatedRangeCount, availableRangeCount int32, raftLogSize int64) {
 	// Get the zone configs, which are needed to determine if a range is
 	// under-replicated.
 	zoneMap, err := s.Gossip().GetInfo(gossip.KeyConfigZone)
@@ -1649,6 +1680,7 @@ func (s *Store) computeReplicationStatus(now int64) (
 	defer s.mu.Unlock()
 	for rangeID, rng := range s.replicas {
 		zoneConfig := zoneMap.(config.PrefixConfigMap).MatchByPrefix(rng.Desc().StartKey).Config.(*config.ZoneConfig)
+		raftLogSize += rng.raftLogSize()
 		raftStatus := s.RaftStatus(rangeID)
 		if raftStatus == nil {
 			continue
@@ -1698,9 +1730,9 @@ func (s *Store) PublishStatus() error {
 
 	// broadcast replication status.
 	now := s.ctx.Clock.Now().WallTime
-	leaderRangeCount, replicatedRangeCount, availableRangeCount :=
+	leaderRangeCount, replicatedRangeCount, availableRangeCount, raftLogSize :=
 		s.computeReplicationStatus(now)
-	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount)
+	s.feed.replicationStatus(leaderRangeCount, replicatedRangeCount, availableRangeCount, raftLogSize)
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -76,7 +77,8 @@ func (vq *verifyQueue) shouldQueue(now proto.Timestamp, rng *Replica) (shouldQ b
 
 // process iterates through all keys and values in a range. The very
 // act of scanning keys verifies on-disk checksums, as each block
-// checksum is checked on load.
+// checksum is checked on load. If the replica holds the leader lease,
+// it then checks the consistency of the range's replicas.
 func (vq *verifyQueue) process(now proto.Timestamp, rng *Replica) error {
 	snap := rng.rm.Engine().NewSnapshot()
 	iter := newRangeDataIterator(rng.Desc(), snap)
@@ -87,12 +89,23 @@ func (vq *verifyQueue) process(now proto.Timestamp, rng *Replica) error {
 	for ; iter.Valid(); iter.Next() {
 	}
 	// An error during iteration is presumed to mean a checksum failure
-	// while iterating over the underlying key/value data.
-	if iter.Error() != nil {
-		// TODO(spencer): do something other than fatal error here. We
-		// want to quarantine this range, make it a non-participating raft
-		// follower until it can be replaced and then destroyed.
-		log.Fatalf("unhandled failure when scanning range %s; probable data corruption: %s", rng, iter.Error())
+	// while iterating over the underlying key/value data. Quarantine the
+	// replica so that it's replaced by a healthy one instead of taking
+	// down the node.
+	if err := iter.Error(); err != nil {
+		err = util.Errorf("failure when scanning range %s; probable data corruption: %s", rng, err)
+		rng.Quarantine(err)
+		return err
+	}
+
+	// The leader additionally checks that the replicas of the range are
+	// consistent with each other. The lease check is only a heuristic to
+	// avoid every replica initiating the check; the commands themselves
+	// are subject to the usual leader lease checks.
+	if lease := rng.getLease(); lease.OwnedBy(rng.rm.RaftNodeID()) && lease.Covers(now) {
+		if err := rng.CheckConsistency(); err != nil {
+			log.Warningf("unable to check consistency of range %s: %s", rng, err)
+		}
 	}
 
 	// Store current timestamp as last verification for this range.
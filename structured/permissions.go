@@ -18,6 +18,7 @@
 package structured
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
 
@@ -25,161 +26,206 @@ import (
 	"github.com/cockroachdb/cockroach/sql/parser"
 )
 
-// permissions describes the full set of permissions for a descriptor.
-// This is used for easier manipulation of permisssions.
-// TODO(marc): we should eventually store ALL as a specific permission,
-// so that newly-added permissions will automatically be picked up.
-type permissions struct {
-	read  map[string]struct{}
-	write map[string]struct{}
-}
+// The privileges of a user are stored as a bit field of parser.PrivilegeType
+// masks. ALL is stored as a privilege of its own, so that privileges added
+// later are automatically granted to the users which have ALL.
 
-// permissionsFromDescriptor builds a permissions object from a descriptor.
-func permissionsFromDescriptor(descriptor *PrivilegeDescriptor) (*permissions, error) {
-	p := &permissions{
-		read:  map[string]struct{}{},
-		write: map[string]struct{}{},
+// NewDefaultPrivilegeDescriptor returns a privilege descriptor which grants
+// ALL privileges to the root user only.
+func NewDefaultPrivilegeDescriptor() PrivilegeDescriptor {
+	return PrivilegeDescriptor{
+		Users: []UserPrivileges{
+			{User: security.RootUser, Privileges: parser.PrivilegeAll.Mask()},
+		},
 	}
+}
 
-	for _, readUser := range descriptor.Read {
-		p.read[readUser] = struct{}{}
+// ConvertLegacyPrivileges converts the lists of users with read and write
+// privileges of a descriptor written before privileges were stored per user,
+// which are left unrecognized when the descriptor is unmarshaled, into the
+// corresponding privileges: READ is SELECT and WRITE is every other
+// privilege, so a user with both has ALL. It must be called on descriptors
+// read from the KV store before they are validated.
+func (p *PrivilegeDescriptor) ConvertLegacyPrivileges() error {
+	if len(p.XXX_unrecognized) == 0 {
+		return nil
+	}
+	readBits := parser.PrivilegeSelect.Mask()
+	writeBits := parser.Privileges.ToBitField() &^ readBits
+	var rest []byte
+	for data := p.XXX_unrecognized; len(data) > 0; {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrInvalidLengthStructured
+		}
+		if field, wireType := key>>3, key&0x7; (field == 1 || field == 2) && wireType == 2 {
+			length, m := binary.Uvarint(data[n:])
+			if m <= 0 || length > uint64(len(data)-n-m) {
+				return ErrInvalidLengthStructured
+			}
+			user := string(data[n+m : n+m+int(length)])
+			bits := readBits
+			if field == 2 {
+				bits = writeBits
+			}
+			userPriv := p.findOrCreateUser(user)
+			if userPriv.Privileges |= bits; userPriv.Privileges == readBits|writeBits {
+				userPriv.Privileges = parser.PrivilegeAll.Mask()
+			}
+			data = data[n+m+int(length):]
+			continue
+		}
+		skip, err := skipStructured(data)
+		if err != nil {
+			return err
+		}
+		rest = append(rest, data[:skip]...)
+		data = data[skip:]
 	}
-	for _, writeUser := range descriptor.Write {
-		p.write[writeUser] = struct{}{}
+	p.XXX_unrecognized = rest
+	return nil
+}
+
+// Copy returns a copy of the privilege descriptor which does not share any
+// memory with it.
+func (p PrivilegeDescriptor) Copy() PrivilegeDescriptor {
+	return PrivilegeDescriptor{Users: append([]UserPrivileges(nil), p.Users...)}
+}
+
+// findUserIndex returns the index of `user` in the sorted list of users,
+// and whether it was found.
+func (p *PrivilegeDescriptor) findUserIndex(user string) (int, bool) {
+	i := sort.Search(len(p.Users), func(i int) bool {
+		return p.Users[i].User >= user
+	})
+	return i, i < len(p.Users) && p.Users[i].User == user
+}
+
+// findOrCreateUser returns the privileges of `user`, adding the user with no
+// privileges if it is not found.
+func (p *PrivilegeDescriptor) findOrCreateUser(user string) *UserPrivileges {
+	i, ok := p.findUserIndex(user)
+	if !ok {
+		p.Users = append(p.Users, UserPrivileges{})
+		copy(p.Users[i+1:], p.Users[i:])
+		p.Users[i] = UserPrivileges{User: user}
 	}
+	return &p.Users[i]
+}
 
-	if err := p.Validate(); err != nil {
-		return nil, err
+// removeUser removes `user` from the list of users.
+func (p *PrivilegeDescriptor) removeUser(user string) {
+	if i, ok := p.findUserIndex(user); ok {
+		p.Users = append(p.Users[:i], p.Users[i+1:]...)
 	}
-	return p, nil
 }
 
-// Validate verifies the validity of the permissions object.
-// For now, this only involves making sure that the root user
-// still has all permissions.
-func (p *permissions) Validate() error {
-	if _, ok := p.read[security.RootUser]; !ok {
-		return fmt.Errorf("%s user does not have read privileges", security.RootUser)
+// grant adds `privileges` to those of `user`.
+func (p *PrivilegeDescriptor) grant(user string, privileges parser.PrivilegeList) {
+	userPriv := p.findOrCreateUser(user)
+	if userPriv.Privileges&parser.PrivilegeAll.Mask() != 0 {
+		// The user already has all privileges.
+		return
 	}
-	if _, ok := p.write[security.RootUser]; !ok {
-		return fmt.Errorf("%s user does not have write privileges", security.RootUser)
+	bits := privileges.ToBitField()
+	if bits&parser.PrivilegeAll.Mask() != 0 {
+		// ALL replaces all the other privileges.
+		userPriv.Privileges = parser.PrivilegeAll.Mask()
+		return
 	}
-	return nil
+	userPriv.Privileges |= bits
 }
 
-// FillDescriptor writes the permissions from this object into a descriptor.
-func (p *permissions) FillDescriptor(descriptor *PrivilegeDescriptor) error {
-	if err := p.Validate(); err != nil {
-		return err
+// revoke removes `privileges` from those of `user`, removing the user if it
+// is left without privileges.
+func (p *PrivilegeDescriptor) revoke(user string, privileges parser.PrivilegeList) {
+	i, ok := p.findUserIndex(user)
+	if !ok {
+		return
+	}
+	userPriv := &p.Users[i]
+	bits := privileges.ToBitField()
+	if bits&parser.PrivilegeAll.Mask() != 0 {
+		userPriv.Privileges = 0
+	} else {
+		if userPriv.Privileges&parser.PrivilegeAll.Mask() != 0 {
+			// Revoking one privilege from ALL leaves all the others.
+			userPriv.Privileges = parser.Privileges.ToBitField()
+		}
+		userPriv.Privileges &^= bits
 	}
-
-	descriptor.Read, descriptor.Write = []string{}, []string{}
-	for u := range p.read {
-		descriptor.Read = append(descriptor.Read, u)
+	if userPriv.Privileges == 0 {
+		p.removeUser(user)
 	}
+}
 
-	for u := range p.write {
-		descriptor.Write = append(descriptor.Write, u)
+// Grant adds new privileges to this descriptor for a given list of users.
+func (p *PrivilegeDescriptor) Grant(n *parser.Grant) error {
+	for _, user := range n.Grantees {
+		p.grant(user, n.Privileges)
 	}
-
-	sort.Strings(descriptor.Read)
-	sort.Strings(descriptor.Write)
-	return nil
+	return p.Validate()
 }
 
-// addToMap adds all entries in `newUsers` to the `users` map.
-func addToMap(users map[string]struct{}, newUsers []string) {
-	for _, u := range newUsers {
-		users[u] = struct{}{}
+// Revoke removes privileges from this descriptor for a given list of users.
+func (p *PrivilegeDescriptor) Revoke(n *parser.Revoke) error {
+	for _, user := range n.Grantees {
+		p.revoke(user, n.Privileges)
 	}
+	return p.Validate()
 }
 
-// removeFromMap removes all entries in `newUsers` from the `users` map.
-func removeFromMap(users map[string]struct{}, newUsers []string) {
-	for _, u := range newUsers {
-		delete(users, u)
+// Validate verifies the validity of the privilege descriptor.
+// For now, this only involves making sure that the root user
+// still has all privileges.
+func (p *PrivilegeDescriptor) Validate() error {
+	i, ok := p.findUserIndex(security.RootUser)
+	if !ok || p.Users[i].Privileges&parser.PrivilegeAll.Mask() == 0 {
+		return fmt.Errorf("%s user does not have %s privileges", security.RootUser, parser.PrivilegeAll)
 	}
+	return nil
 }
 
-// Grant takes a parser.Grant node and grants the specified privileges to users.
-func (p *permissions) Grant(n *parser.Grant) error {
-	for _, priv := range n.Privileges {
-		switch priv {
-		case parser.PrivilegeAll:
-			addToMap(p.read, n.Grantees)
-			addToMap(p.write, n.Grantees)
-		case parser.PrivilegeRead:
-			addToMap(p.read, n.Grantees)
-		case parser.PrivilegeWrite:
-			addToMap(p.write, n.Grantees)
-		default:
-			return fmt.Errorf("grant: unknown privilege %q", priv)
+// HasPrivilege returns true if `user`, or one of the `roles` granted to
+// `user` either directly or through other roles, has `privilege` on this
+// descriptor.
+func (p *PrivilegeDescriptor) HasPrivilege(user string, roles []string, privilege parser.PrivilegeType) bool {
+	if p.userHasPrivilege(user, privilege) {
+		return true
+	}
+	for _, role := range roles {
+		if p.userHasPrivilege(role, privilege) {
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
-// Revoke takes a parser.Revoke node and removes the specified privileges from users.
-func (p *permissions) Revoke(n *parser.Revoke) error {
-	for _, priv := range n.Privileges {
-		switch priv {
-		case parser.PrivilegeAll:
-			removeFromMap(p.read, n.Grantees)
-			removeFromMap(p.write, n.Grantees)
-		case parser.PrivilegeRead:
-			removeFromMap(p.read, n.Grantees)
-		case parser.PrivilegeWrite:
-			removeFromMap(p.write, n.Grantees)
-		default:
-			return fmt.Errorf("revoke: unknown privilege %q", priv)
-		}
+// userHasPrivilege returns true if `user` itself has `privilege` on this
+// descriptor.
+func (p *PrivilegeDescriptor) userHasPrivilege(user string, privilege parser.PrivilegeType) bool {
+	i, ok := p.findUserIndex(user)
+	if !ok {
+		return false
 	}
-	return nil
+	return p.Users[i].Privileges&(parser.PrivilegeAll.Mask()|privilege.Mask()) != 0
 }
 
-// UserPrivileges contains a user and list of associated privileges.
-type UserPrivileges struct {
+// UserPrivilegeString contains a user and list of associated privileges.
+type UserPrivilegeString struct {
 	User       string
 	Privileges parser.PrivilegeList
 }
 
-// UserPrivilegeList is a list of UserPrivileges.
-type UserPrivilegeList []*UserPrivileges
-
-func (upl UserPrivilegeList) Len() int {
-	return len(upl)
-}
-
-func (upl UserPrivilegeList) Swap(i, j int) {
-	upl[i], upl[j] = upl[j], upl[i]
-}
-
-func (upl UserPrivilegeList) Less(i, j int) bool {
-	return upl[i].User < upl[j].User
-}
-
-// Show returns a slice of { user, list of privileges } sorted by user.
-func (p *permissions) Show() UserPrivilegeList {
-	// Combine all privileges by user.
-	tmp := map[string]*UserPrivileges{}
-	for u := range p.read {
-		tmp[u] = &UserPrivileges{User: u, Privileges: []parser.PrivilegeType{parser.PrivilegeRead}}
-	}
-	for u := range p.write {
-		if _, ok := tmp[u]; !ok {
-			tmp[u] = &UserPrivileges{User: u, Privileges: []parser.PrivilegeType{parser.PrivilegeWrite}}
-		} else {
-			tmp[u].Privileges = append(tmp[u].Privileges, parser.PrivilegeWrite)
-		}
-	}
-
-	// Put them all into a slice and sort.
-	ret := make(UserPrivilegeList, len(tmp), len(tmp))
-	i := 0
-	for _, up := range tmp {
-		ret[i] = up
-		i++
-	}
-	sort.Sort(ret)
-	return ret
+// Show returns the list of users and associated privileges for this
+// descriptor, sorted by user.
+func (p *PrivilegeDescriptor) Show() ([]UserPrivilegeString, error) {
+	ret := make([]UserPrivilegeString, 0, len(p.Users))
+	for _, userPriv := range p.Users {
+		ret = append(ret, UserPrivilegeString{
+			User:       userPriv.User,
+			Privileges: parser.PrivilegesFromBitField(userPriv.Privileges),
+		})
+	}
+	return ret, nil
 }
@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package structured
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPrivilege(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	descriptor := NewDefaultPrivilegeDescriptor()
+	testData := []struct {
+		grant, revoke parser.PrivilegeList
+		user          string
+		expected      uint32
+	}{
+		{parser.PrivilegeList{parser.PrivilegeAll}, nil, security.RootUser,
+			parser.PrivilegeAll.Mask()},
+		{parser.PrivilegeList{parser.PrivilegeSelect, parser.PrivilegeInsert}, nil, "foo",
+			parser.PrivilegeSelect.Mask() | parser.PrivilegeInsert.Mask()},
+		{nil, parser.PrivilegeList{parser.PrivilegeInsert}, "foo",
+			parser.PrivilegeSelect.Mask()},
+		{parser.PrivilegeList{parser.PrivilegeAll}, nil, "foo",
+			parser.PrivilegeAll.Mask()},
+		{nil, parser.PrivilegeList{parser.PrivilegeGrant}, "foo",
+			parser.Privileges.ToBitField() &^ parser.PrivilegeGrant.Mask()},
+		{nil, parser.PrivilegeList{parser.PrivilegeAll}, "foo", 0},
+	}
+	for i, d := range testData {
+		if d.grant != nil {
+			descriptor.grant(d.user, d.grant)
+		}
+		if d.revoke != nil {
+			descriptor.revoke(d.user, d.revoke)
+		}
+		var bits uint32
+		if j, ok := descriptor.findUserIndex(d.user); ok {
+			bits = descriptor.Users[j].Privileges
+		} else if d.expected != 0 {
+			t.Fatalf("#%d: user %s not found", i, d.user)
+		}
+		if bits != d.expected {
+			t.Errorf("#%d: expected %s, but found %s", i,
+				parser.PrivilegesFromBitField(d.expected), parser.PrivilegesFromBitField(bits))
+		}
+		if err := descriptor.Validate(); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+	}
+
+	if len(descriptor.Users) != 1 {
+		t.Fatalf("expected only the root user, found %+v", descriptor.Users)
+	}
+	descriptor.revoke(security.RootUser, parser.PrivilegeList{parser.PrivilegeSelect})
+	if err := descriptor.Validate(); err == nil {
+		t.Fatal("expected an error when revoking privileges from root")
+	}
+}
+
+func TestHasPrivilege(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	descriptor := NewDefaultPrivilegeDescriptor()
+	descriptor.grant("foo", parser.PrivilegeList{parser.PrivilegeSelect})
+	descriptor.grant("readers", parser.PrivilegeList{parser.PrivilegeInsert})
+
+	testData := []struct {
+		user      string
+		roles     []string
+		privilege parser.PrivilegeType
+		expected  bool
+	}{
+		{security.RootUser, nil, parser.PrivilegeDrop, true},
+		{"foo", nil, parser.PrivilegeSelect, true},
+		{"foo", nil, parser.PrivilegeInsert, false},
+		{"foo", []string{"readers"}, parser.PrivilegeInsert, true},
+		{"bar", nil, parser.PrivilegeSelect, false},
+		{"bar", []string{"unknown"}, parser.PrivilegeSelect, false},
+	}
+	for i, d := range testData {
+		if has := descriptor.HasPrivilege(d.user, d.roles, d.privilege); has != d.expected {
+			t.Errorf("#%d: expected %t, but found %t", i, d.expected, has)
+		}
+	}
+}
+
+func TestConvertLegacyPrivileges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	// A descriptor in the format predating per-user privileges, with root
+	// and foo in the read list (field 1) and root and bar in the write list
+	// (field 2).
+	var data []byte
+	for _, e := range []struct {
+		key  byte
+		user string
+	}{
+		{0xa, security.RootUser}, {0xa, "foo"}, {0x12, security.RootUser}, {0x12, "bar"},
+	} {
+		data = append(data, e.key, byte(len(e.user)))
+		data = append(data, e.user...)
+	}
+	var descriptor PrivilegeDescriptor
+	if err := descriptor.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := descriptor.ConvertLegacyPrivileges(); err != nil {
+		t.Fatal(err)
+	}
+	if err := descriptor.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if descriptor.XXX_unrecognized != nil {
+		t.Errorf("expected the legacy lists to be consumed; found %q", descriptor.XXX_unrecognized)
+	}
+
+	writeBits := parser.Privileges.ToBitField() &^ parser.PrivilegeSelect.Mask()
+	expected := []UserPrivileges{
+		{User: "bar", Privileges: writeBits},
+		{User: "foo", Privileges: parser.PrivilegeSelect.Mask()},
+		{User: security.RootUser, Privileges: parser.PrivilegeAll.Mask()},
+	}
+	if len(descriptor.Users) != len(expected) {
+		t.Fatalf("expected %v, but found %v", expected, descriptor.Users)
+	}
+	for i := range expected {
+		if e, a := expected[i], descriptor.Users[i]; e.User != a.User || e.Privileges != a.Privileges {
+			t.Errorf("#%d: expected %v, but found %v", i, e, a)
+		}
+	}
+}
@@ -20,9 +20,6 @@ package structured
 import (
 	"errors"
 	"fmt"
-	"sort"
-
-	"github.com/cockroachdb/cockroach/sql/parser"
 )
 
 // ID, ColumnID, and IndexID are all uint32, but are each given a
@@ -58,8 +55,16 @@ func validateName(name, typ string) error {
 	return nil
 }
 
-// SetID implements the sql.descriptorProto interface.
+// SetID implements the sql.descriptorProto interface. The foreign keys by
+// which a new table references itself are created before the ID of the table
+// is allocated and refer to the table by its previous ID, which is updated as
+// well.
 func (desc *TableDescriptor) SetID(id ID) {
+	for i := range desc.ForeignKeys {
+		if desc.ForeignKeys[i].Table == desc.ID {
+			desc.ForeignKeys[i].Table = id
+		}
+	}
 	desc.ID = id
 }
 
@@ -73,8 +78,8 @@ func (desc *TableDescriptor) SetName(name string) {
 	desc.Name = name
 }
 
-// AllocateIDs allocates column and index ids for any column or index which has
-// an ID of 0.
+// AllocateIDs allocates column and index ids for any column or index
+// (including the columns and indexes of mutations) which has an ID of 0.
 func (desc *TableDescriptor) AllocateIDs() error {
 	if desc.NextColumnID == 0 {
 		desc.NextColumnID = 1
@@ -93,6 +98,12 @@ func (desc *TableDescriptor) AllocateIDs() error {
 		columnNames[desc.Columns[i].Name] = columnID
 		desc.Columns[i].ID = columnID
 	}
+	for _, m := range desc.Mutations {
+		if m.Column != nil && m.Column.ID == 0 {
+			m.Column.ID = desc.NextColumnID
+			desc.NextColumnID++
+		}
+	}
 
 	// Create a slice of modifiable index descriptors.
 	var indexes []*IndexDescriptor
@@ -100,6 +111,11 @@ func (desc *TableDescriptor) AllocateIDs() error {
 	for i := range desc.Indexes {
 		indexes = append(indexes, &desc.Indexes[i])
 	}
+	for _, m := range desc.Mutations {
+		if m.Index != nil {
+			indexes = append(indexes, m.Index)
+		}
+	}
 	// Populate IDs
 	for _, index := range indexes {
 		if index.ID == 0 {
@@ -144,7 +160,7 @@ func (desc *TableDescriptor) Validate() error {
 
 	columnNames := map[string]ColumnID{}
 	columnIDs := map[ColumnID]string{}
-	for _, column := range desc.Columns {
+	validateColumn := func(column ColumnDescriptor) error {
 		if err := validateName(column.Name, "column"); err != nil {
 			return err
 		}
@@ -167,6 +183,30 @@ func (desc *TableDescriptor) Validate() error {
 			return fmt.Errorf("column \"%s\" invalid ID (%d) > next column ID (%d)",
 				column.Name, column.ID, desc.NextColumnID)
 		}
+		return nil
+	}
+	for _, column := range desc.Columns {
+		if err := validateColumn(column); err != nil {
+			return err
+		}
+	}
+
+	// The columns of mutations share the column names and IDs of the table, but
+	// cannot be referenced by indexes.
+	mutationColumnNames := map[string]struct{}{}
+	var mutationIndexes []IndexDescriptor
+	for _, m := range desc.Mutations {
+		if (m.Column == nil) == (m.Index == nil) {
+			return fmt.Errorf("mutation must contain either a column or an index")
+		}
+		if m.Index != nil {
+			mutationIndexes = append(mutationIndexes, *m.Index)
+			continue
+		}
+		if err := validateColumn(*m.Column); err != nil {
+			return err
+		}
+		mutationColumnNames[m.Column.Name] = struct{}{}
 	}
 
 	// TODO(pmattis): Check that the indexes are unique. That is, no 2 indexes
@@ -177,7 +217,9 @@ func (desc *TableDescriptor) Validate() error {
 
 	indexNames := map[string]struct{}{}
 	indexIDs := map[IndexID]string{}
-	for _, index := range append([]IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+	// The indexes of mutations share the index names and IDs of the table.
+	indexes := append([]IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...)
+	for _, index := range append(indexes, mutationIndexes...) {
 		if err := validateName(index.Name, "index"); err != nil {
 			return err
 		}
@@ -212,7 +254,7 @@ func (desc *TableDescriptor) Validate() error {
 
 		for i, name := range index.ColumnNames {
 			colID, ok := columnNames[name]
-			if !ok {
+			if _, isMutation := mutationColumnNames[name]; !ok || isMutation {
 				return fmt.Errorf("index \"%s\" contains unknown column \"%s\"", index.Name, name)
 			}
 			if colID != index.ColumnIDs[i] {
@@ -221,6 +263,34 @@ func (desc *TableDescriptor) Validate() error {
 			}
 		}
 	}
+
+	fkNames := map[string]struct{}{}
+	for _, fk := range desc.ForeignKeys {
+		if err := validateName(fk.Name, "foreign key"); err != nil {
+			return err
+		}
+		if _, ok := fkNames[fk.Name]; ok {
+			return fmt.Errorf("duplicate foreign key name: \"%s\"", fk.Name)
+		}
+		fkNames[fk.Name] = struct{}{}
+
+		if fk.Table == 0 {
+			return fmt.Errorf("foreign key \"%s\" references invalid table ID 0", fk.Name)
+		}
+		if len(fk.ColumnIDs) == 0 {
+			return fmt.Errorf("foreign key \"%s\" must contain at least 1 column", fk.Name)
+		}
+		if len(fk.ColumnIDs) != len(fk.ReferencedColumnIDs) {
+			return fmt.Errorf("mismatched column IDs (%d) and referenced column IDs (%d)",
+				len(fk.ColumnIDs), len(fk.ReferencedColumnIDs))
+		}
+		for _, id := range fk.ColumnIDs {
+			name, ok := columnIDs[id]
+			if _, isMutation := mutationColumnNames[name]; !ok || isMutation {
+				return fmt.Errorf("foreign key \"%s\" contains unknown column ID %d", fk.Name, id)
+			}
+		}
+	}
 	return nil
 }
 
@@ -282,8 +352,8 @@ func (desc *DatabaseDescriptor) SetName(name string) {
 }
 
 // Validate validates that the database descriptor is well formed.
-// Checks include validate the database name, and verifying that there
-// is at least one read and write user.
+// Checks include validate the database name, and verifying that the
+// root user has all privileges.
 func (desc *DatabaseDescriptor) Validate() error {
 	if err := validateName(desc.Name, "descriptor"); err != nil {
 		return err
@@ -291,68 +361,5 @@ func (desc *DatabaseDescriptor) Validate() error {
 	if desc.ID == 0 {
 		return fmt.Errorf("invalid database ID 0")
 	}
-	if len(desc.Read) == 0 {
-		return fmt.Errorf("empty Read permissions")
-	}
-	if len(desc.Write) == 0 {
-		return fmt.Errorf("empty Write permissions")
-	}
-	return nil
-}
-
-// Grant adds new privileges to this descriptor for a given list of users.
-func (p *PrivilegeDescriptor) Grant(n *parser.Grant) error {
-	permissions, err := permissionsFromDescriptor(p)
-	if err != nil {
-		return err
-	}
-
-	if err := permissions.Grant(n); err != nil {
-		return err
-	}
-
-	if err := permissions.FillDescriptor(p); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Revoke removes privileges from this descriptor for a given list of users.
-func (p *PrivilegeDescriptor) Revoke(n *parser.Revoke) error {
-	permissions, err := permissionsFromDescriptor(p)
-	if err != nil {
-		return err
-	}
-
-	if err := permissions.Revoke(n); err != nil {
-		return err
-	}
-
-	if err := permissions.FillDescriptor(p); err != nil {
-		return err
-	}
-	return nil
-}
-
-// Show returns the list of users and associated privileges for this descriptor.
-func (p *PrivilegeDescriptor) Show() (UserPrivilegeList, error) {
-	permissions, err := permissionsFromDescriptor(p)
-	if err != nil {
-		return nil, err
-	}
-
-	return permissions.Show(), nil
-}
-
-// HasPrivilege returns true if `user` has `privilege` on this descriptor.
-func (p *PrivilegeDescriptor) HasPrivilege(user string, privilege parser.PrivilegeType) bool {
-	var privUsers []string
-	switch privilege {
-	case parser.PrivilegeRead:
-		privUsers = p.Read
-	case parser.PrivilegeWrite:
-		privUsers = p.Write
-	}
-	result := sort.SearchStrings(privUsers, user)
-	return result < len(privUsers) && privUsers[result] == user
+	return desc.PrivilegeDescriptor.Validate()
 }
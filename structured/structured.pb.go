@@ -12,7 +12,11 @@
 		ColumnType
 		ColumnDescriptor
 		IndexDescriptor
+		UserPrivileges
 		PrivilegeDescriptor
+		DescriptorMutation
+		CheckConstraint
+		ForeignKeyReference
 		TableDescriptor
 		DatabaseDescriptor
 */
@@ -46,6 +50,7 @@ const (
 	ColumnType_CHAR      ColumnType_Kind = 8
 	ColumnType_TEXT      ColumnType_Kind = 9
 	ColumnType_BLOB      ColumnType_Kind = 10
+	ColumnType_INTERVAL  ColumnType_Kind = 11
 )
 
 var ColumnType_Kind_name = map[int32]string{
@@ -60,6 +65,7 @@ var ColumnType_Kind_name = map[int32]string{
 	8:  "CHAR",
 	9:  "TEXT",
 	10: "BLOB",
+	11: "INTERVAL",
 }
 var ColumnType_Kind_value = map[string]int32{
 	"BIT":       0,
@@ -73,6 +79,7 @@ var ColumnType_Kind_value = map[string]int32{
 	"CHAR":      8,
 	"TEXT":      9,
 	"BLOB":      10,
+	"INTERVAL":  11,
 }
 
 func (x ColumnType_Kind) Enum() *ColumnType_Kind {
@@ -92,6 +99,76 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type DescriptorMutation_Direction int32
+
+const (
+	// The column is being added to the table.
+	DescriptorMutation_ADD DescriptorMutation_Direction = 0
+	// The column is being dropped from the table.
+	DescriptorMutation_DROP DescriptorMutation_Direction = 1
+)
+
+var DescriptorMutation_Direction_name = map[int32]string{
+	0: "ADD",
+	1: "DROP",
+}
+var DescriptorMutation_Direction_value = map[string]int32{
+	"ADD":  0,
+	"DROP": 1,
+}
+
+func (x DescriptorMutation_Direction) Enum() *DescriptorMutation_Direction {
+	p := new(DescriptorMutation_Direction)
+	*p = x
+	return p
+}
+func (x DescriptorMutation_Direction) String() string {
+	return proto.EnumName(DescriptorMutation_Direction_name, int32(x))
+}
+func (x *DescriptorMutation_Direction) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(DescriptorMutation_Direction_value, data, "DescriptorMutation_Direction")
+	if err != nil {
+		return err
+	}
+	*x = DescriptorMutation_Direction(value)
+	return nil
+}
+
+type ForeignKeyReference_Action int32
+
+const (
+	// The referenced row cannot be deleted while it is referenced.
+	ForeignKeyReference_RESTRICT ForeignKeyReference_Action = 0
+	// The referencing rows are deleted along with the referenced row.
+	ForeignKeyReference_CASCADE ForeignKeyReference_Action = 1
+)
+
+var ForeignKeyReference_Action_name = map[int32]string{
+	0: "RESTRICT",
+	1: "CASCADE",
+}
+var ForeignKeyReference_Action_value = map[string]int32{
+	"RESTRICT": 0,
+	"CASCADE":  1,
+}
+
+func (x ForeignKeyReference_Action) Enum() *ForeignKeyReference_Action {
+	p := new(ForeignKeyReference_Action)
+	*p = x
+	return p
+}
+func (x ForeignKeyReference_Action) String() string {
+	return proto.EnumName(ForeignKeyReference_Action_name, int32(x))
+}
+func (x *ForeignKeyReference_Action) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ForeignKeyReference_Action_value, data, "ForeignKeyReference_Action")
+	if err != nil {
+		return err
+	}
+	*x = ForeignKeyReference_Action(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.structured.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
@@ -127,11 +204,14 @@ func (m *ColumnType) GetPrecision() int32 {
 }
 
 type ColumnDescriptor struct {
-	Name             string     `protobuf:"bytes,1,opt,name=name" json:"name"`
-	ID               ColumnID   `protobuf:"varint,2,opt,name=id,casttype=ColumnID" json:"id"`
-	Type             ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
-	Nullable         bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
-	XXX_unrecognized []byte     `json:"-"`
+	Name     string     `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID       ColumnID   `protobuf:"varint,2,opt,name=id,casttype=ColumnID" json:"id"`
+	Type     ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
+	Nullable bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
+	// Default expression to use to populate the column on insert if no
+	// value is provided.
+	DefaultExpr      *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
@@ -166,6 +246,13 @@ func (m *ColumnDescriptor) GetNullable() bool {
 	return false
 }
 
+func (m *ColumnDescriptor) GetDefaultExpr() string {
+	if m != nil && m.DefaultExpr != nil {
+		return *m.DefaultExpr
+	}
+	return ""
+}
+
 type IndexDescriptor struct {
 	Name   string  `protobuf:"bytes,1,opt,name=name" json:"name"`
 	ID     IndexID `protobuf:"varint,2,opt,name=id,casttype=IndexID" json:"id"`
@@ -220,33 +307,169 @@ func (m *IndexDescriptor) GetColumnIDs() []ColumnID {
 	return nil
 }
 
-// PrivilegeDescriptor represents the sets of privileges on a descriptor.
+// UserPrivileges describes the privileges of a user or role.
+type UserPrivileges struct {
+	User string `protobuf:"bytes,1,opt,name=user" json:"user"`
+	// privileges is a bit field of the privileges, see parser.PrivilegeType.
+	Privileges       uint32 `protobuf:"varint,2,opt,name=privileges" json:"privileges"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UserPrivileges) Reset()         { *m = UserPrivileges{} }
+func (m *UserPrivileges) String() string { return proto.CompactTextString(m) }
+func (*UserPrivileges) ProtoMessage()    {}
+
+func (m *UserPrivileges) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserPrivileges) GetPrivileges() uint32 {
+	if m != nil {
+		return m.Privileges
+	}
+	return 0
+}
+
+// PrivilegeDescriptor represents the privileges of the users and roles on a
+// descriptor, sorted by user.
 type PrivilegeDescriptor struct {
-	// lists of users with read permissions.
-	Read []string `protobuf:"bytes,1,rep,name=read" json:"read,omitempty"`
-	// lists of users with write permissions.
-	Write            []string `protobuf:"bytes,2,rep,name=write" json:"write,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	Users            []UserPrivileges `protobuf:"bytes,3,rep,name=users" json:"users"`
+	XXX_unrecognized []byte           `json:"-"`
 }
 
 func (m *PrivilegeDescriptor) Reset()         { *m = PrivilegeDescriptor{} }
 func (m *PrivilegeDescriptor) String() string { return proto.CompactTextString(m) }
 func (*PrivilegeDescriptor) ProtoMessage()    {}
 
-func (m *PrivilegeDescriptor) GetRead() []string {
+func (m *PrivilegeDescriptor) GetUsers() []UserPrivileges {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+// A DescriptorMutation represents a column or an index which is being added
+// to or dropped from a table. Exactly one of column and index is set. The
+// column or index of a mutation is not visible to queries. The column or
+// index of an ADD mutation is written by inserts, updates and deletes while
+// the existing rows are backfilled, and the column or index of a DROP
+// mutation is no longer written while its values are deleted.
+type DescriptorMutation struct {
+	Column           *ColumnDescriptor            `protobuf:"bytes,1,opt,name=column" json:"column,omitempty"`
+	Direction        DescriptorMutation_Direction `protobuf:"varint,2,opt,name=direction,enum=cockroach.structured.DescriptorMutation_Direction" json:"direction"`
+	Index            *IndexDescriptor             `protobuf:"bytes,3,opt,name=index" json:"index,omitempty"`
+	XXX_unrecognized []byte                       `json:"-"`
+}
+
+func (m *DescriptorMutation) Reset()         { *m = DescriptorMutation{} }
+func (m *DescriptorMutation) String() string { return proto.CompactTextString(m) }
+func (*DescriptorMutation) ProtoMessage()    {}
+
+func (m *DescriptorMutation) GetColumn() *ColumnDescriptor {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *DescriptorMutation) GetDirection() DescriptorMutation_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return DescriptorMutation_ADD
+}
+
+func (m *DescriptorMutation) GetIndex() *IndexDescriptor {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+// A CheckConstraint is a boolean expression over the columns of a table
+// which must not evaluate to false for any row of the table.
+type CheckConstraint struct {
+	// The name of the constraint, which may be empty.
+	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Expr             string `protobuf:"bytes,2,opt,name=expr" json:"expr"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CheckConstraint) Reset()         { *m = CheckConstraint{} }
+func (m *CheckConstraint) String() string { return proto.CompactTextString(m) }
+func (*CheckConstraint) ProtoMessage()    {}
+
+func (m *CheckConstraint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckConstraint) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+// A ForeignKeyReference requires the values of a list of columns of each row
+// of a table, unless one of them is NULL, to be the values of the referenced
+// columns of a row of the referenced table.
+type ForeignKeyReference struct {
+	Name      string     `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ColumnIDs []ColumnID `protobuf:"varint,2,rep,name=column_ids,casttype=ColumnID" json:"column_ids,omitempty"`
+	// The ID of the referenced table.
+	Table ID `protobuf:"varint,3,opt,name=table,casttype=ID" json:"table"`
+	// The referenced columns, which parallel the column_ids list. The
+	// referenced table has a unique index on these columns.
+	ReferencedColumnIDs []ColumnID                 `protobuf:"varint,4,rep,name=referenced_column_ids,casttype=ColumnID" json:"referenced_column_ids,omitempty"`
+	OnDelete            ForeignKeyReference_Action `protobuf:"varint,5,opt,name=on_delete,enum=cockroach.structured.ForeignKeyReference_Action" json:"on_delete"`
+	XXX_unrecognized    []byte                     `json:"-"`
+}
+
+func (m *ForeignKeyReference) Reset()         { *m = ForeignKeyReference{} }
+func (m *ForeignKeyReference) String() string { return proto.CompactTextString(m) }
+func (*ForeignKeyReference) ProtoMessage()    {}
+
+func (m *ForeignKeyReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForeignKeyReference) GetColumnIDs() []ColumnID {
 	if m != nil {
-		return m.Read
+		return m.ColumnIDs
 	}
 	return nil
 }
 
-func (m *PrivilegeDescriptor) GetWrite() []string {
+func (m *ForeignKeyReference) GetTable() ID {
+	if m != nil {
+		return m.Table
+	}
+	return 0
+}
+
+func (m *ForeignKeyReference) GetReferencedColumnIDs() []ColumnID {
 	if m != nil {
-		return m.Write
+		return m.ReferencedColumnIDs
 	}
 	return nil
 }
 
+func (m *ForeignKeyReference) GetOnDelete() ForeignKeyReference_Action {
+	if m != nil {
+		return m.OnDelete
+	}
+	return ForeignKeyReference_RESTRICT
+}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -265,7 +488,21 @@ type TableDescriptor struct {
 	// next_index_id is used to ensure that deleted index ids are not reused.
 	NextIndexID         IndexID `protobuf:"varint,8,opt,name=next_index_id,casttype=IndexID" json:"next_index_id"`
 	PrivilegeDescriptor `protobuf:"bytes,9,opt,name=privileges,embedded=privileges" json:"privileges"`
-	XXX_unrecognized    []byte `json:"-"`
+	// mutations are the schema changes which are in progress, in the order in
+	// which they were requested.
+	Mutations []DescriptorMutation `protobuf:"bytes,10,rep,name=mutations" json:"mutations"`
+	// checks are the CHECK constraints of the table.
+	Checks []CheckConstraint `protobuf:"bytes,11,rep,name=checks" json:"checks"`
+	// foreign_keys are the FOREIGN KEY constraints of the table.
+	ForeignKeys []ForeignKeyReference `protobuf:"bytes,12,rep,name=foreign_keys" json:"foreign_keys"`
+	// referenced_by are the IDs of the other tables with foreign keys
+	// referencing this table.
+	ReferencedBy []ID `protobuf:"varint,13,rep,name=referenced_by,casttype=ID" json:"referenced_by,omitempty"`
+	// version is incremented whenever the schema of the table changes.
+	// Cached information derived from the descriptor, such as the prepared
+	// statements using the table, is invalidated when the version changes.
+	Version          uint32 `protobuf:"varint,14,opt,name=version" json:"version"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -328,6 +565,41 @@ func (m *TableDescriptor) GetNextIndexID() IndexID {
 	return 0
 }
 
+func (m *TableDescriptor) GetMutations() []DescriptorMutation {
+	if m != nil {
+		return m.Mutations
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetChecks() []CheckConstraint {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetForeignKeys() []ForeignKeyReference {
+	if m != nil {
+		return m.ForeignKeys
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetReferencedBy() []ID {
+	if m != nil {
+		return m.ReferencedBy
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -359,6 +631,8 @@ func (m *DatabaseDescriptor) GetID() ID {
 
 func init() {
 	proto.RegisterEnum("cockroach.structured.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.structured.DescriptorMutation_Direction", DescriptorMutation_Direction_name, DescriptorMutation_Direction_value)
+	proto.RegisterEnum("cockroach.structured.ForeignKeyReference_Action", ForeignKeyReference_Action_name, ForeignKeyReference_Action_value)
 }
 func (m *ColumnType) Unmarshal(data []byte) error {
 	l := len(data)
@@ -555,6 +829,29 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.Nullable = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.DefaultExpr = &s
+			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -722,7 +1019,7 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
+func (m *UserPrivileges) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -743,7 +1040,7 @@ func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -761,30 +1058,24 @@ func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Read = append(m.Read, string(data[iNdEx:postIndex]))
+			m.User = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
 			}
-			var stringLen uint64
+			m.Privileges = 0
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Privileges |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + int(stringLen)
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Write = append(m.Write, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -812,7 +1103,7 @@ func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *TableDescriptor) Unmarshal(data []byte) error {
+func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -831,69 +1122,129 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + int(stringLen)
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[iNdEx:postIndex])
+			m.Users = append(m.Users, UserPrivileges{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *DescriptorMutation) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + int(stringLen)
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(data[iNdEx:postIndex])
+			if m.Column == nil {
+				m.Column = &ColumnDescriptor{}
+			}
+			if err := m.Column.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			m.ID = 0
+			m.Direction = 0
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ID |= (ID(b) & 0x7F) << shift
+				m.Direction |= (DescriptorMutation_Direction(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -914,12 +1265,372 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, ColumnDescriptor{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if m.Index == nil {
+				m.Index = &IndexDescriptor{}
+			}
+			if err := m.Index.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *CheckConstraint) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ForeignKeyReference) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
+			}
+			var v ColumnID
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIDs = append(m.ColumnIDs, v)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			m.Table = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Table |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedColumnIDs", wireType)
+			}
+			var v ColumnID
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReferencedColumnIDs = append(m.ReferencedColumnIDs, v)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+			}
+			m.OnDelete = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OnDelete |= (ForeignKeyReference_Action(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *TableDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, ColumnDescriptor{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextColumnID", wireType)
 			}
@@ -937,7 +1648,133 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrimaryIndex.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, IndexDescriptor{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexID", wireType)
+			}
+			m.NextIndexID = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextIndexID |= (IndexID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrivilegeDescriptor.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutations = append(m.Mutations, DescriptorMutation{})
+			if err := m.Mutations[len(m.Mutations)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -958,13 +1795,14 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrimaryIndex.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Checks = append(m.Checks, CheckConstraint{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -985,54 +1823,44 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexes = append(m.Indexes, IndexDescriptor{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.ForeignKeys = append(m.ForeignKeys, ForeignKeyReference{})
+			if err := m.ForeignKeys[len(m.ForeignKeys)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedBy", wireType)
 			}
-			m.NextIndexID = 0
+			var v ID
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.NextIndexID |= (IndexID(b) & 0x7F) << shift
+				v |= (ID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivilegeDescriptor", wireType)
+			m.ReferencedBy = append(m.ReferencedBy, v)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Version |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + msglen
-			if msglen < 0 {
-				return ErrInvalidLengthStructured
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PrivilegeDescriptor.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1284,6 +2112,10 @@ func (m *ColumnDescriptor) Size() (n int) {
 	l = m.Type.Size()
 	n += 1 + l + sovStructured(uint64(l))
 	n += 2
+	if m.DefaultExpr != nil {
+		l = len(*m.DefaultExpr)
+		n += 1 + l + sovStructured(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1314,21 +2146,81 @@ func (m *IndexDescriptor) Size() (n int) {
 	return n
 }
 
+func (m *UserPrivileges) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.User)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.Privileges))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PrivilegeDescriptor) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Read) > 0 {
-		for _, s := range m.Read {
-			l = len(s)
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
 			n += 1 + l + sovStructured(uint64(l))
 		}
 	}
-	if len(m.Write) > 0 {
-		for _, s := range m.Write {
-			l = len(s)
-			n += 1 + l + sovStructured(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescriptorMutation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 1 + sovStructured(uint64(m.Direction))
+	if m.Index != nil {
+		l = m.Index.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckConstraint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Expr)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyReference) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	n += 1 + sovStructured(uint64(m.Table))
+	if len(m.ReferencedColumnIDs) > 0 {
+		for _, e := range m.ReferencedColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.OnDelete))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1361,6 +2253,30 @@ func (m *TableDescriptor) Size() (n int) {
 	n += 1 + sovStructured(uint64(m.NextIndexID))
 	l = m.PrivilegeDescriptor.Size()
 	n += 1 + l + sovStructured(uint64(l))
+	if len(m.Mutations) > 0 {
+		for _, e := range m.Mutations {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ForeignKeys) > 0 {
+		for _, e := range m.ForeignKeys {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, e := range m.ReferencedBy {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.Version))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1462,6 +2378,12 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	if m.DefaultExpr != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
+		i += copy(data[i:], *m.DefaultExpr)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1526,6 +2448,34 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *UserPrivileges) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *UserPrivileges) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.User)))
+	i += copy(data[i:], m.User)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Privileges))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *PrivilegeDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1541,36 +2491,136 @@ func (m *PrivilegeDescriptor) MarshalTo(data []byte) (n int, err error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Read) > 0 {
-		for _, s := range m.Read {
-			data[i] = 0xa
+	if len(m.Users) > 0 {
+		for _, msg := range m.Users {
+			data[i] = 0x1a
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
 			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DescriptorMutation) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DescriptorMutation) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Column != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Column.Size()))
+		n2, err := m.Column.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n2
+	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Direction))
+	if m.Index != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
+		n3, err := m.Index.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CheckConstraint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckConstraint) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Expr)))
+	i += copy(data[i:], m.Expr)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForeignKeyReference) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Write) > 0 {
-		for _, s := range m.Write {
-			data[i] = 0x12
+	return data[:n], nil
+}
+
+func (m *ForeignKeyReference) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.ColumnIDs) > 0 {
+		for _, num := range m.ColumnIDs {
+			data[i] = 0x10
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Table))
+	if len(m.ReferencedColumnIDs) > 0 {
+		for _, num := range m.ReferencedColumnIDs {
+			data[i] = 0x20
 			i++
-			i += copy(data[i:], s)
+			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.OnDelete))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1621,11 +2671,11 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x32
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.PrimaryIndex.Size()))
-	n2, err := m.PrimaryIndex.MarshalTo(data[i:])
+	n4, err := m.PrimaryIndex.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n4
 	if len(m.Indexes) > 0 {
 		for _, msg := range m.Indexes {
 			data[i] = 0x3a
@@ -1644,11 +2694,57 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x4a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.PrivilegeDescriptor.Size()))
-	n3, err := m.PrivilegeDescriptor.MarshalTo(data[i:])
+	n5, err := m.PrivilegeDescriptor.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n5
+	if len(m.Mutations) > 0 {
+		for _, msg := range m.Mutations {
+			data[i] = 0x52
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Checks) > 0 {
+		for _, msg := range m.Checks {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ForeignKeys) > 0 {
+		for _, msg := range m.ForeignKeys {
+			data[i] = 0x62
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, num := range m.ReferencedBy {
+			data[i] = 0x68
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	data[i] = 0x70
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Version))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1680,11 +2776,11 @@ func (m *DatabaseDescriptor) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.PrivilegeDescriptor.Size()))
-	n4, err := m.PrivilegeDescriptor.MarshalTo(data[i:])
+	n6, err := m.PrivilegeDescriptor.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n6
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -230,6 +230,116 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`duplicate column name: "bar"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				Mutations: []DescriptorMutation{
+					{Column: &ColumnDescriptor{ID: 2, Name: "bar"}},
+				},
+				NextColumnID: 3,
+				NextIndexID:  2,
+			}},
+		{`column "blah" invalid ID (2) > next column ID (2)`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				Mutations: []DescriptorMutation{
+					{Column: &ColumnDescriptor{ID: 2, Name: "blah"}, Direction: DescriptorMutation_DROP},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`index "bar" contains unknown column "blah"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1, 2}, ColumnNames: []string{"bar", "blah"}},
+				Mutations: []DescriptorMutation{
+					{Column: &ColumnDescriptor{ID: 2, Name: "blah"}},
+				},
+				NextColumnID: 3,
+				NextIndexID:  2,
+			}},
+		{`foreign key "baz" contains unknown column ID 2`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				ForeignKeys: []ForeignKeyReference{
+					{Name: "baz", ColumnIDs: []ColumnID{2}, Table: 2, ReferencedColumnIDs: []ColumnID{1}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`mismatched column IDs (1) and referenced column IDs (2)`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				ForeignKeys: []ForeignKeyReference{
+					{Name: "baz", ColumnIDs: []ColumnID{1}, Table: 2, ReferencedColumnIDs: []ColumnID{1, 2}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`mutation must contain either a column or an index`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				Mutations:    []DescriptorMutation{{}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`duplicate index name: "bar"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				Mutations: []DescriptorMutation{
+					{Index: &IndexDescriptor{ID: 2, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  3,
+			}},
+		{`index "blah" invalid index ID (2) > next index ID (2)`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}},
+				Mutations: []DescriptorMutation{
+					{Index: &IndexDescriptor{ID: 2, Name: "blah", ColumnIDs: []ColumnID{1}, ColumnNames: []string{"bar"}}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {
@@ -259,6 +369,7 @@ func TestColumnTypeSQLString(t *testing.T) {
 		{ColumnType{Kind: ColumnType_DATE}, "DATE"},
 		{ColumnType{Kind: ColumnType_TIME}, "TIME"},
 		{ColumnType{Kind: ColumnType_TIMESTAMP}, "TIMESTAMP"},
+		{ColumnType{Kind: ColumnType_INTERVAL}, "INTERVAL"},
 		{ColumnType{Kind: ColumnType_CHAR}, "CHAR"},
 		{ColumnType{Kind: ColumnType_CHAR, Width: 10}, "CHAR(10)"},
 		{ColumnType{Kind: ColumnType_TEXT}, "TEXT"},
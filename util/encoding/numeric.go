@@ -26,6 +26,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 )
 
@@ -387,6 +388,139 @@ func makeFloatFromMandE(negative bool, e int, m []byte) float64 {
 	return f
 }
 
+// EncodeNumericDecimal returns the resulting byte slice with the encoded
+// decimal unscaled*10^-scale appended to b. The encoding is the one described
+// in EncodeNumericFloat, so encoded decimals are comparable with encoded ints
+// and floats. Trailing zeros of the fractional part are not encoded: 1.5 and
+// 1.50 have the same encoding.
+func EncodeNumericDecimal(b []byte, unscaled *big.Int, scale int32) []byte {
+	if unscaled.Sign() == 0 {
+		return append(b, orderedEncodingZero)
+	}
+	negative := unscaled.Sign() < 0
+	e, m := decimalMandE(unscaled, scale)
+	buf := make([]byte, len(m)+maxVarintSize+2)
+	switch {
+	case e < 0:
+		return append(b, encodeSmallNumber(negative, e, m, buf)...)
+	case e >= 0 && e <= 10:
+		return append(b, encodeMediumNumber(negative, e, m, buf)...)
+	default:
+		return append(b, encodeLargeNumber(negative, e, m, buf)...)
+	}
+}
+
+// DecodeNumericDecimal returns the remaining byte slice after decoding and the
+// decoded decimal from buf, as an unscaled value and a scale. The scale is the
+// smallest one able to represent the decimal exactly.
+func DecodeNumericDecimal(buf []byte) ([]byte, *big.Int, int32) {
+	if buf[0] == orderedEncodingZero {
+		return buf[1:], new(big.Int), 0
+	}
+	idx := bytes.Index(buf, []byte{orderedEncodingTerminator})
+	switch {
+	case buf[0] == 0x08:
+		// Negative large.
+		e, m := decodeLargeNumber(true, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(true, e, m)
+		return buf[idx+1:], unscaled, scale
+	case buf[0] > 0x08 && buf[0] <= 0x13:
+		// Negative medium.
+		e, m := decodeMediumNumber(true, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(true, e, m)
+		return buf[idx+1:], unscaled, scale
+	case buf[0] == 0x14:
+		// Negative small.
+		e, m := decodeSmallNumber(true, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(true, e, m)
+		return buf[idx+1:], unscaled, scale
+	case buf[0] == 0x22:
+		// Positive large.
+		e, m := decodeLargeNumber(false, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(false, e, m)
+		return buf[idx+1:], unscaled, scale
+	case buf[0] >= 0x17 && buf[0] < 0x22:
+		// Positive medium.
+		e, m := decodeMediumNumber(false, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(false, e, m)
+		return buf[idx+1:], unscaled, scale
+	case buf[0] == 0x16:
+		// Positive small.
+		e, m := decodeSmallNumber(false, buf[:idx+1])
+		unscaled, scale := makeDecimalFromMandE(false, e, m)
+		return buf[idx+1:], unscaled, scale
+	default:
+		panic(fmt.Sprintf("unknown prefix of the encoded byte slice: %q", buf))
+	}
+}
+
+// decimalMandE computes and returns the mantissa M and exponent E for the
+// decimal unscaled*10^-scale. See floatMandE for a description of M and E.
+func decimalMandE(unscaled *big.Int, scale int32) (int, []byte) {
+	b := []byte(new(big.Int).Abs(unscaled).String())
+	// The value is 0.bbbb * 10^e10.
+	e10 := len(b) - int(scale)
+
+	// Trailing zeros are not part of the mantissa.
+	for len(b) > 1 && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+
+	// Convert the power-10 exponent to a power of 100 exponent, prepending a
+	// 0 if the power-10 exponent is odd.
+	if e10%2 != 0 {
+		b = append([]byte{'0'}, b...)
+		e10++
+	}
+
+	// Ensure that the number of digits is even.
+	if len(b)%2 != 0 {
+		b = append(b, '0')
+	}
+
+	m := make([]byte, len(b)/2)
+	for i := 0; i < len(b); i += 2 {
+		accum := 10*int(b[i]-'0') + int(b[i+1]-'0')
+		// The bytes are encoded as 2n+1.
+		m[i/2] = byte(2*accum + 1)
+	}
+	// The last byte is encoded as 2n+0.
+	m[len(m)-1]--
+
+	return e10 / 2, m
+}
+
+// makeDecimalFromMandE reconstructs the decimal from the mantissa M and
+// exponent E, returning its unscaled value and scale.
+func makeDecimalFromMandE(negative bool, e int, m []byte) (*big.Int, int32) {
+	b := make([]byte, 0, len(m)*2)
+	for _, v := range m {
+		// Dividing by two recovers the centimal digit of both the 2n+1 and the
+		// final 2n+0 encodings.
+		t := int(v) / 2
+		b = append(b, byte(t/10)+'0', byte(t%10)+'0')
+	}
+
+	// The value is 0.bbbb * 10^(2*e).
+	scale := len(b) - 2*e
+	for scale > 0 && b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+		scale--
+	}
+	for ; scale < 0; scale++ {
+		b = append(b, '0')
+	}
+
+	unscaled, ok := new(big.Int).SetString(string(b), 10)
+	if !ok {
+		panic(fmt.Sprintf("malformed decimal mantissa: %q", b))
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	return unscaled, int32(scale)
+}
+
 func encodeSmallNumber(negative bool, e int, m []byte, buf []byte) []byte {
 	n := putUvarint(buf[1:], uint64(-e))
 	copy(buf[n+1:], m)
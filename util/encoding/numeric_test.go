@@ -20,6 +20,8 @@ package encoding
 import (
 	"bytes"
 	"math"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/util/randutil"
@@ -232,6 +234,74 @@ func TestEncodeNumericFloat(t *testing.T) {
 	}
 }
 
+// parseDecimal splits a decimal string such as "-12.345" into its unscaled
+// value and scale.
+func parseDecimal(t *testing.T, s string) (*big.Int, int32) {
+	var scale int32
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		scale = int32(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+	unscaled, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid decimal %q", s)
+	}
+	return unscaled, scale
+}
+
+func TestEncodeNumericDecimal(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Encoding []byte
+		Decoded  string
+	}{
+		{"-123456789012345678901234567890", []byte{0x08, 0xf0, 0xe6, 0xba, 0x8e, 0x62, 0x4a, 0xe6, 0xba, 0x8e, 0x62, 0x4a, 0xe6, 0xba, 0x8e, 0x62, 0x4b, 0x0}, ""},
+		{"-10000", []byte{0x10, 0xfd, 0x0}, ""},
+		{"-9999.0", []byte{0x11, 0x38, 0x39, 0x00}, "-9999"},
+		{"-1.00", []byte{0x12, 0xfd, 0x0}, "-1"},
+		{"-0.00123", []byte{0x14, 0x1, 0xe6, 0xc3, 0x0}, ""},
+		{"0.000", []byte{0x15}, "0"},
+		{"0.00123", []byte{0x16, 0xfe, 0x19, 0x3c, 0x0}, ""},
+		{"0.0123", []byte{0x17, 0x03, 0x2e, 0x0}, ""},
+		{"1", []byte{0x18, 0x02, 0x0}, ""},
+		{"1.5", []byte{0x18, 0x03, 0x64, 0x0}, ""},
+		{"1.50", []byte{0x18, 0x03, 0x64, 0x0}, "1.5"},
+		{"12.345", []byte{0x18, 0x19, 0x45, 0x64, 0x0}, ""},
+		{"100.01", []byte{0x19, 0x03, 0x01, 0x02, 0x0}, ""},
+		{"1234.5", []byte{0x19, 0x19, 0x45, 0x64, 0x0}, ""},
+		{"123450", []byte{0x1a, 0x19, 0x45, 0x64, 0x0}, ""},
+		{"123456789012345678901234567890", []byte{0x22, 0x0f, 0x19, 0x45, 0x71, 0x9d, 0xb5, 0x19, 0x45, 0x71, 0x9d, 0xb5, 0x19, 0x45, 0x71, 0x9d, 0xb4, 0x0}, ""},
+	}
+
+	for i, c := range testCases {
+		unscaled, scale := parseDecimal(t, c.Value)
+		enc := EncodeNumericDecimal(nil, unscaled, scale)
+		if !bytes.Equal(enc, c.Encoding) {
+			t.Errorf("unexpected mismatch for %v. expected [% x], got [% x]",
+				c.Value, c.Encoding, enc)
+		}
+		if i > 0 {
+			if bytes.Compare(testCases[i-1].Encoding, enc) > 0 {
+				t.Errorf("%v: expected [% x] to be less than or equal to [% x]",
+					c.Value, testCases[i-1].Encoding, enc)
+			}
+		}
+		expected := c.Decoded
+		if expected == "" {
+			expected = c.Value
+		}
+		expectedUnscaled, expectedScale := parseDecimal(t, expected)
+		remaining, decUnscaled, decScale := DecodeNumericDecimal(append(enc, 'x'))
+		if decUnscaled.Cmp(expectedUnscaled) != 0 || decScale != expectedScale {
+			t.Errorf("unexpected mismatch for %v. expected %se-%d, got %se-%d",
+				c.Value, expectedUnscaled, expectedScale, decUnscaled, decScale)
+		}
+		if !bytes.Equal(remaining, []byte("x")) {
+			t.Errorf("%v: unexpected remaining bytes %q", c.Value, remaining)
+		}
+	}
+}
+
 func BenchmarkEncodeNumericInt(b *testing.B) {
 	rng, _ := randutil.NewPseudoRand()
 